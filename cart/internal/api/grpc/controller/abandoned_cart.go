@@ -0,0 +1,161 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Go-Marketplace/backend/cart/internal/api/grpc/dto"
+	"github.com/Go-Marketplace/backend/cart/internal/model"
+	"github.com/Go-Marketplace/backend/cart/internal/usecase"
+	pbCart "github.com/Go-Marketplace/backend/proto/gen/cart"
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const abandonedCartMetricsPeriod = 30 * 24 * time.Hour
+
+// Stores the contents of the expired cart with the current product prices and categories,
+// the cart is read before its cartlines are deleted
+func AbandonCart(
+	ctx context.Context,
+	cartUsecase usecase.ICartUsecase,
+	productClient pbProduct.ProductClient,
+	cart *model.Cart,
+) (*model.AbandonedCart, error) {
+	productIDs := make([]string, 0, len(cart.Cartlines))
+	for _, cartline := range cart.Cartlines {
+		productIDs = append(productIDs, cartline.ProductID.String())
+	}
+
+	products, err := getProducts(ctx, productClient, productIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get products: %w", err)
+	}
+
+	abandonedCart := model.NewAbandonedCart(cart, products)
+
+	if err = cartUsecase.CreateAbandonedCart(ctx, abandonedCart); err != nil {
+		return nil, fmt.Errorf("failed to create abandoned cart: %w", err)
+	}
+
+	return abandonedCart, nil
+}
+
+// Adds the abandoned cart products to the user cart with one batch update,
+// so either all of them are reserved again or none
+func RestoreAbandonedCart(
+	ctx context.Context,
+	cartUsecase usecase.ICartUsecase,
+	productClient pbProduct.ProductClient,
+	orderClient pbOrder.OrderClient,
+	purchaseLimitLookback time.Duration,
+	req *pbCart.RestoreAbandonedCartRequest,
+) (*model.Cart, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid user id: %s", err)
+	}
+
+	abandonedCartID, err := uuid.Parse(req.AbandonedCartId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid abandoned cart id: %s", err)
+	}
+
+	abandonedCart, err := cartUsecase.GetAbandonedCart(ctx, abandonedCartID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get abandoned cart: %s", err)
+	}
+
+	if abandonedCart == nil || abandonedCart.UserID != userID {
+		return nil, status.Errorf(codes.NotFound, "Abandoned cart not found")
+	}
+
+	cart, err := cartUsecase.GetUserCart(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get user cart: %s", err)
+	}
+
+	if cart == nil {
+		return nil, status.Errorf(codes.NotFound, "Cart not found")
+	}
+
+	quantities := make(map[uuid.UUID]int64, len(cart.Cartlines))
+	for _, cartline := range cart.Cartlines {
+		quantities[cartline.ProductID] = cartline.Quantity
+	}
+
+	changes := make([]*pbCart.CartlineChange, 0, len(abandonedCart.Cartlines))
+	for _, abandonedCartline := range abandonedCart.Cartlines {
+		changes = append(changes, &pbCart.CartlineChange{
+			ProductId: abandonedCartline.ProductID.String(),
+			Quantity:  quantities[abandonedCartline.ProductID] + abandonedCartline.Quantity,
+		})
+	}
+
+	if err = cartUsecase.SetAbandonedCartRestored(ctx, abandonedCartID, true); err != nil {
+		if errors.Is(err, model.ErrAlreadyRestored) {
+			return nil, status.Errorf(codes.FailedPrecondition, "Abandoned cart is already restored")
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to restore abandoned cart: %s", err)
+	}
+
+	newCart, err := BatchUpdateCart(ctx, cartUsecase, productClient, orderClient, purchaseLimitLookback, &pbCart.BatchUpdateCartRequest{
+		UserId:  req.UserId,
+		Changes: changes,
+	})
+	if err != nil {
+		if errUnmark := cartUsecase.SetAbandonedCartRestored(ctx, abandonedCartID, false); errUnmark != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to unmark abandoned cart: %s", errUnmark)
+		}
+		if status.Code(err) == codes.FailedPrecondition && !isPurchaseLimitError(err) {
+			return nil, status.Errorf(codes.FailedPrecondition, "Not enough products in stock to restore the cart")
+		}
+		return nil, err
+	}
+
+	return newCart, nil
+}
+
+func GetAbandonedCartMetrics(
+	ctx context.Context,
+	cartUsecase usecase.ICartUsecase,
+	req *pbCart.GetAbandonedCartMetricsRequest,
+) ([]*model.AbandonedCartMetric, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	to := time.Now()
+	if req.To != nil {
+		to = req.To.AsTime()
+	}
+
+	from := to.Add(-abandonedCartMetricsPeriod)
+	if req.From != nil {
+		from = req.From.AsTime()
+	}
+
+	if !from.Before(to) {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid period: from must be before to")
+	}
+
+	metrics, err := cartUsecase.GetAbandonedCartMetrics(ctx, dto.SearchAbandonedCartsDTO{
+		From:       from,
+		To:         to,
+		CategoryID: req.CategoryId,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get abandoned cart metrics: %s", err)
+	}
+
+	return metrics, nil
+}
@@ -0,0 +1,235 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Go-Marketplace/backend/cart/internal/api/grpc/controller"
+	"github.com/Go-Marketplace/backend/cart/internal/api/grpc/dto"
+	mocks "github.com/Go-Marketplace/backend/cart/internal/mocks/usecase"
+	"github.com/Go-Marketplace/backend/cart/internal/model"
+	pbCart "github.com/Go-Marketplace/backend/proto/gen/cart"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestRestoreAbandonedCart(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ctx context.Context
+		req *pbCart.RestoreAbandonedCartRequest
+	}
+
+	ctx := context.Background()
+	userID := uuid.New()
+	abandonedCartID := uuid.New()
+
+	req := &pbCart.RestoreAbandonedCartRequest{
+		UserId:          userID.String(),
+		AbandonedCartId: abandonedCartID.String(),
+	}
+
+	abandonedCart := &model.AbandonedCart{
+		ID:     abandonedCartID,
+		UserID: userID,
+		Cartlines: []*model.AbandonedCartline{
+			{AbandonedCartID: abandonedCartID, ProductID: uuid.New(), Quantity: 1},
+		},
+	}
+
+	testcases := []struct {
+		name         string
+		args         args
+		mock         func(usecase *mocks.MockICartUsecase)
+		expectedCart *model.Cart
+		expectedErr  error
+	}{
+		{
+			name: "Abandoned cart not found",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			mock: func(usecase *mocks.MockICartUsecase) {
+				usecase.EXPECT().GetAbandonedCart(ctx, abandonedCartID).Return(nil, nil).Times(1)
+			},
+			expectedCart: nil,
+			expectedErr:  status.Errorf(codes.NotFound, "Abandoned cart not found"),
+		},
+		{
+			name: "Abandoned cart of another user",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			mock: func(usecase *mocks.MockICartUsecase) {
+				usecase.EXPECT().GetAbandonedCart(ctx, abandonedCartID).Return(&model.AbandonedCart{
+					ID:     abandonedCartID,
+					UserID: uuid.New(),
+				}, nil).Times(1)
+			},
+			expectedCart: nil,
+			expectedErr:  status.Errorf(codes.NotFound, "Abandoned cart not found"),
+		},
+		{
+			name: "Abandoned cart is already restored",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			mock: func(usecase *mocks.MockICartUsecase) {
+				usecase.EXPECT().GetAbandonedCart(ctx, abandonedCartID).Return(abandonedCart, nil).Times(1)
+				usecase.EXPECT().GetUserCart(ctx, userID).Return(&model.Cart{UserID: userID}, nil).Times(1)
+				usecase.EXPECT().SetAbandonedCartRestored(ctx, abandonedCartID, true).Return(model.ErrAlreadyRestored).Times(1)
+			},
+			expectedCart: nil,
+			expectedErr:  status.Errorf(codes.FailedPrecondition, "Abandoned cart is already restored"),
+		},
+		{
+			name: "Invalid abandoned cart id",
+			args: args{
+				ctx: ctx,
+				req: &pbCart.RestoreAbandonedCartRequest{
+					UserId:          userID.String(),
+					AbandonedCartId: "cart",
+				},
+			},
+			mock:         func(usecase *mocks.MockICartUsecase) {},
+			expectedCart: nil,
+			expectedErr:  status.Errorf(codes.InvalidArgument, "Invalid abandoned cart id: %s", "invalid UUID length: 4"),
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			cartUsecase := cartHelper(t)
+			testcase.mock(cartUsecase)
+
+			actualCart, actualErr := controller.RestoreAbandonedCart(
+				testcase.args.ctx,
+				cartUsecase,
+				nil,
+				nil,
+				0,
+				testcase.args.req,
+			)
+
+			assert.Equal(t, testcase.expectedCart, actualCart)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestGetAbandonedCartMetrics(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ctx context.Context
+		req *pbCart.GetAbandonedCartMetricsRequest
+	}
+
+	ctx := context.Background()
+	from := time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2023, 12, 8, 0, 0, 0, 0, time.UTC)
+
+	searchParams := dto.SearchAbandonedCartsDTO{
+		From:       from,
+		To:         to,
+		CategoryID: 3,
+	}
+
+	expectedMetricsFromUsecase := []*model.AbandonedCartMetric{
+		{
+			Day:        from,
+			CategoryID: 3,
+			Carts:      2,
+			Quantity:   5,
+			Value:      1000,
+		},
+	}
+	expectedErrFromUsecase := errors.New("test error")
+
+	testcases := []struct {
+		name            string
+		args            args
+		mock            func(usecase *mocks.MockICartUsecase)
+		expectedMetrics []*model.AbandonedCartMetric
+		expectedErr     error
+	}{
+		{
+			name: "Successfully get abandoned cart metrics",
+			args: args{
+				ctx: ctx,
+				req: &pbCart.GetAbandonedCartMetricsRequest{
+					From:       timestamppb.New(from),
+					To:         timestamppb.New(to),
+					CategoryId: 3,
+				},
+			},
+			mock: func(usecase *mocks.MockICartUsecase) {
+				usecase.EXPECT().GetAbandonedCartMetrics(ctx, searchParams).Return(expectedMetricsFromUsecase, nil).Times(1)
+			},
+			expectedMetrics: expectedMetricsFromUsecase,
+			expectedErr:     nil,
+		},
+		{
+			name: "Invalid period",
+			args: args{
+				ctx: ctx,
+				req: &pbCart.GetAbandonedCartMetricsRequest{
+					From: timestamppb.New(to),
+					To:   timestamppb.New(from),
+				},
+			},
+			mock:            func(usecase *mocks.MockICartUsecase) {},
+			expectedMetrics: nil,
+			expectedErr:     status.Errorf(codes.InvalidArgument, "Invalid period: from must be before to"),
+		},
+		{
+			name: "Got error when get abandoned cart metrics",
+			args: args{
+				ctx: ctx,
+				req: &pbCart.GetAbandonedCartMetricsRequest{
+					From:       timestamppb.New(from),
+					To:         timestamppb.New(to),
+					CategoryId: 3,
+				},
+			},
+			mock: func(usecase *mocks.MockICartUsecase) {
+				usecase.EXPECT().GetAbandonedCartMetrics(ctx, searchParams).Return(nil, expectedErrFromUsecase).Times(1)
+			},
+			expectedMetrics: nil,
+			expectedErr:     status.Errorf(codes.Internal, "Failed to get abandoned cart metrics: %s", expectedErrFromUsecase),
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			cartUsecase := cartHelper(t)
+			testcase.mock(cartUsecase)
+
+			actualMetrics, actualErr := controller.GetAbandonedCartMetrics(
+				testcase.args.ctx,
+				cartUsecase,
+				testcase.args.req,
+			)
+
+			assert.Equal(t, testcase.expectedMetrics, actualMetrics)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
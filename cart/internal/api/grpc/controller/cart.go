@@ -3,11 +3,14 @@ package controller
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Go-Marketplace/backend/cart/internal/model"
 	"github.com/Go-Marketplace/backend/cart/internal/usecase"
+	"github.com/Go-Marketplace/backend/pkg/money"
 	pbCart "github.com/Go-Marketplace/backend/proto/gen/cart"
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
 	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
@@ -50,6 +53,7 @@ func CreateCart(ctx context.Context, cartUsecase usecase.ICartUsecase, req *pbCa
 		UserID:    userID,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
+		Guest:     req.Guest,
 	}
 
 	if err = cartUsecase.CreateCart(ctx, cart); err != nil {
@@ -63,6 +67,8 @@ func CreateCartline(
 	ctx context.Context,
 	cartUsecase usecase.ICartUsecase,
 	productClient pbProduct.ProductClient,
+	orderClient pbOrder.OrderClient,
+	purchaseLimitLookback time.Duration,
 	req *pbCart.CreateCartlineRequest,
 ) (*model.CartLine, error) {
 	if req == nil {
@@ -79,34 +85,105 @@ func CreateCartline(
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid product id: %s", err)
 	}
 
-	productResp, err := productClient.GetProduct(ctx, &pbProduct.GetProductRequest{
-		ProductId: req.ProductId,
-	})
+	quantity := req.Quantity
+	if quantity == 0 {
+		quantity = 1
+	}
+
+	oldCartline, err := cartUsecase.GetCartline(ctx, userID, productID)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to get product: %s", err)
+		return nil, status.Errorf(codes.Internal, "Failed to get cartline: %s", err)
 	}
 
-	cartline := &model.CartLine{
+	cartQuantity := quantity
+	if oldCartline != nil {
+		cartQuantity += oldCartline.Quantity
+	}
+
+	if err = checkPurchaseLimit(ctx, productClient, orderClient, purchaseLimitLookback, userID, productID, cartQuantity); err != nil {
+		return nil, err
+	}
+
+	if oldCartline != nil {
+		return addToCartline(ctx, cartUsecase, productClient, oldCartline, quantity)
+	}
+
+	newCartline := model.CartLine{
 		UserID:    userID,
 		ProductID: productID,
-		Quantity:  1,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		Quantity:  quantity,
+	}
+
+	if err = newCartline.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid cartline request: %s", err)
+	}
+
+	reservationResp, err := productClient.ReserveProduct(ctx, &pbProduct.ReserveProductRequest{
+		ProductId:        req.ProductId,
+		Quantity:         quantity,
+		Strategy:         req.Strategy,
+		ShippingLocation: req.ShippingLocation,
+	})
+	if err != nil {
+		return nil, status.Errorf(status.Code(err), "Failed to reserve product: %s", status.Convert(err).Message())
+	}
+
+	warehouseID, err := uuid.Parse(reservationResp.WarehouseId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Invalid warehouse id: %s", err)
+	}
+
+	cartline := &model.CartLine{
+		UserID:      userID,
+		ProductID:   productID,
+		Quantity:    quantity,
+		WarehouseID: warehouseID,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
 	}
 
 	if err = cartUsecase.CreateCartline(ctx, cartline); err != nil {
+		if _, errRelease := productClient.ReleaseProduct(ctx, &pbProduct.ReleaseProductRequest{
+			ProductId:   req.ProductId,
+			Quantity:    quantity,
+			WarehouseId: reservationResp.WarehouseId,
+		}); errRelease != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to release product: %s", errRelease)
+		}
 		return nil, status.Errorf(codes.Internal, "Failed to create cartline: %s", err)
 	}
 
-	newQuantity := productResp.Quantity - 1
-	if _, err = productClient.UpdateProduct(ctx, &pbProduct.UpdateProductRequest{
-		ProductId: req.ProductId,
-		Quantity:  &newQuantity,
-	}); err != nil {
-		if errDelete := cartUsecase.DeleteCartline(ctx, cartline.UserID, cartline.ProductID); errDelete != nil {
-			return nil, status.Errorf(codes.Internal, "Failed to delete cartline: %s", errDelete)
+	return cartline, nil
+}
+
+// Adds the quantity to the cartline, reserving it in the cartline warehouse
+func addToCartline(
+	ctx context.Context,
+	cartUsecase usecase.ICartUsecase,
+	productClient pbProduct.ProductClient,
+	oldCartline *model.CartLine,
+	quantity int64,
+) (*model.CartLine, error) {
+	newCartline := model.CartLine{
+		UserID:    oldCartline.UserID,
+		ProductID: oldCartline.ProductID,
+		Quantity:  oldCartline.Quantity + quantity,
+	}
+
+	if err := newCartline.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid cartline request: %s", err)
+	}
+
+	if err := changeReservation(ctx, productClient, oldCartline, quantity); err != nil {
+		return nil, status.Errorf(status.Code(err), "Failed to reserve product: %s", status.Convert(err).Message())
+	}
+
+	cartline, err := cartUsecase.UpdateCartline(ctx, newCartline)
+	if err != nil {
+		if errRelease := changeReservation(ctx, productClient, oldCartline, -quantity); errRelease != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to release product: %s", errRelease)
 		}
-		return nil, status.Errorf(codes.Internal, "Failed to update product: %s", err)
+		return nil, status.Errorf(codes.Internal, "Failed to update cartline: %s", err)
 	}
 
 	return cartline, nil
@@ -146,21 +223,18 @@ func returnProducts(ctx context.Context, productClient pbProduct.ProductClient,
 		return fmt.Errorf("failed to get products: %w", err)
 	}
 
-	updateProductRequests := make([]*pbProduct.UpdateProductRequest, 0, len(cartlines))
 	for i, cartline := range cartlines {
-		if products[i] != nil {
-			newQuantity := products[i].Quantity + cartline.Quantity
-			updateProductRequests = append(updateProductRequests, &pbProduct.UpdateProductRequest{
-				ProductId: cartline.ProductID.String(),
-				Quantity:  &newQuantity,
-			})
+		if products[i] == nil {
+			continue
 		}
-	}
 
-	if _, err = productClient.UpdateProducts(ctx, &pbProduct.UpdateProductsRequest{
-		Products: updateProductRequests,
-	}); err != nil {
-		return fmt.Errorf("failed to update products: %w", err)
+		if _, err = productClient.ReleaseProduct(ctx, &pbProduct.ReleaseProductRequest{
+			ProductId:   cartline.ProductID.String(),
+			Quantity:    cartline.Quantity,
+			WarehouseId: cartline.WarehouseID.String(),
+		}); err != nil {
+			return fmt.Errorf("failed to release product %s: %w", cartline.ProductID, err)
+		}
 	}
 
 	return nil
@@ -170,6 +244,8 @@ func UpdateCartline(
 	ctx context.Context,
 	cartUsecase usecase.ICartUsecase,
 	productClient pbProduct.ProductClient,
+	orderClient pbOrder.OrderClient,
+	purchaseLimitLookback time.Duration,
 	req *pbCart.UpdateCartlineRequest,
 ) (*model.CartLine, error) {
 	if req == nil {
@@ -205,36 +281,51 @@ func UpdateCartline(
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid cartline request: %s", err)
 	}
 
+	if req.Quantity > oldCartline.Quantity {
+		if err = checkPurchaseLimit(ctx, productClient, orderClient, purchaseLimitLookback, userID, productID, req.Quantity); err != nil {
+			return nil, err
+		}
+	}
+
 	cartline, err := cartUsecase.UpdateCartline(ctx, newCartline)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Failed to update cartline: %s", err)
 	}
 
 	if req.Quantity != 0 {
-		diff := oldCartline.Quantity - req.Quantity
-
-		productResp, err := productClient.GetProduct(ctx, &pbProduct.GetProductRequest{
-			ProductId: req.ProductId,
-		})
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Failed to get product: %s", err)
-		}
-
-		newQuantity := productResp.Quantity + diff
-		if _, err = productClient.UpdateProduct(ctx, &pbProduct.UpdateProductRequest{
-			ProductId: req.ProductId,
-			Quantity:  &newQuantity,
-		}); err != nil {
+		if err = changeReservation(ctx, productClient, oldCartline, req.Quantity-oldCartline.Quantity); err != nil {
 			if _, errUpdate := cartUsecase.UpdateCartline(ctx, *oldCartline); errUpdate != nil {
 				return nil, status.Errorf(codes.Internal, "Failed to update cartline: %s", errUpdate)
 			}
-			return nil, status.Errorf(codes.Internal, "Failed to update product: %s", err)
+			return nil, status.Errorf(status.Code(err), "Failed to update product reservation: %s", status.Convert(err).Message())
 		}
 	}
 
 	return cartline, nil
 }
 
+// Reserves more of the cartline product in its warehouse when diff is positive
+// and releases it back when diff is negative
+func changeReservation(ctx context.Context, productClient pbProduct.ProductClient, cartline *model.CartLine, diff int64) error {
+	var err error
+	switch {
+	case diff > 0:
+		_, err = productClient.ReserveProduct(ctx, &pbProduct.ReserveProductRequest{
+			ProductId:   cartline.ProductID.String(),
+			Quantity:    diff,
+			WarehouseId: cartline.WarehouseID.String(),
+		})
+	case diff < 0:
+		_, err = productClient.ReleaseProduct(ctx, &pbProduct.ReleaseProductRequest{
+			ProductId:   cartline.ProductID.String(),
+			Quantity:    -diff,
+			WarehouseId: cartline.WarehouseID.String(),
+		})
+	}
+
+	return err
+}
+
 func DeleteCart(
 	ctx context.Context,
 	cartUsecase usecase.ICartUsecase,
@@ -315,16 +406,31 @@ func DeleteCartline(
 	return nil
 }
 
-func DeleteProductCartlines(ctx context.Context, cartUsecase usecase.ICartUsecase, req *pbCart.DeleteProductCartlinesRequest) error {
+// Removes the product from every cart and releases the reserved stock, the product
+// service calls it before the product is deleted, while the product can still be found
+func DeleteProductCartlines(
+	ctx context.Context,
+	cartUsecase usecase.ICartUsecase,
+	productClient pbProduct.ProductClient,
+	req *pbCart.DeleteProductCartlinesRequest,
+) error {
 	productID, err := uuid.Parse(req.ProductId)
 	if err != nil {
 		return status.Errorf(codes.InvalidArgument, "Invalid product id: %s", err)
 	}
 
-	if err = cartUsecase.DeleteProductCartlines(ctx, productID); err != nil {
+	cartlines, err := cartUsecase.DeleteProductCartlines(ctx, productID)
+	if err != nil {
 		return status.Errorf(codes.Internal, "Failed to delete product cartlines: %s", err)
 	}
 
+	if err = returnProducts(ctx, productClient, cartlines...); err != nil {
+		if errCreate := cartUsecase.CreateCartlines(ctx, cartlines); errCreate != nil {
+			return status.Errorf(codes.Internal, "Failed to create cartlines: %s", errCreate)
+		}
+		return status.Errorf(codes.Internal, "Failed to return products: %s", err)
+	}
+
 	return nil
 }
 
@@ -385,5 +491,685 @@ func PrepareOrder(
 		return status.Errorf(codes.Internal, "Failed to delete cart cartlines: %s", err)
 	}
 
+	if _, err = cartUsecase.SetPromoCode(ctx, userID, ""); err != nil {
+		return status.Errorf(codes.Internal, "Failed to reset cart promo code: %s", err)
+	}
+
 	return nil
 }
+
+// Returns the promo lines with the prices in the base currency, the promo code values are in it
+func promoLines(
+	cartlines []*model.CartLine,
+	products []*pbProduct.ProductResponse,
+	baseCurrency string,
+	rates money.ExchangeRates,
+) ([]*pbOrder.PromoLine, error) {
+	lines := make([]*pbOrder.PromoLine, 0, len(cartlines))
+	for i, cartline := range cartlines {
+		if products[i] == nil {
+			continue
+		}
+
+		rate, err := rates.Rate(money.ProductCurrency(products[i], baseCurrency), baseCurrency)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert product %s price: %w", products[i].ProductId, err)
+		}
+
+		lines = append(lines, &pbOrder.PromoLine{
+			ProductId:  products[i].ProductId,
+			CategoryId: products[i].CategoryId,
+			SellerId:   products[i].UserId,
+			Price:      money.ConvertAmount(products[i].Price, rate),
+			Quantity:   cartline.Quantity,
+		})
+	}
+
+	return lines, nil
+}
+
+func ApplyPromoCode(
+	ctx context.Context,
+	cartUsecase usecase.ICartUsecase,
+	productClient pbProduct.ProductClient,
+	orderClient pbOrder.OrderClient,
+	req *pbCart.ApplyPromoCodeRequest,
+) (*model.Cart, int64, error) {
+	if req == nil {
+		return nil, 0, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, 0, status.Errorf(codes.InvalidArgument, "Invalid user id: %s", err)
+	}
+
+	cart, err := cartUsecase.GetUserCart(ctx, userID)
+	if err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "Failed to get user cart: %s", err)
+	}
+
+	if cart == nil {
+		return nil, 0, status.Errorf(codes.NotFound, "Cart not found")
+	}
+
+	var discount int64
+	code := strings.ToUpper(req.Code)
+	if code != "" {
+		productIDs := make([]string, 0, len(cart.Cartlines))
+		for _, cartline := range cart.Cartlines {
+			productIDs = append(productIDs, cartline.ProductID.String())
+		}
+
+		products, err := getProducts(ctx, productClient, productIDs)
+		if err != nil {
+			return nil, 0, status.Errorf(codes.Internal, "Failed to get products: %s", err)
+		}
+
+		baseCurrency, rates, err := money.GetExchangeRates(ctx, productClient)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		lines, err := promoLines(cart.Cartlines, products, baseCurrency, rates)
+		if err != nil {
+			return nil, 0, status.Errorf(codes.Internal, "Failed to get promo lines: %s", err)
+		}
+
+		promoResp, err := orderClient.ValidatePromoCode(ctx, &pbOrder.ValidatePromoCodeRequest{
+			UserId: req.UserId,
+			Code:   code,
+			Lines:  lines,
+		})
+		if err != nil {
+			return nil, 0, status.Errorf(status.Code(err), "Failed to validate promo code: %s", status.Convert(err).Message())
+		}
+
+		discount = promoResp.Discount
+	}
+
+	cart, err = cartUsecase.SetPromoCode(ctx, userID, code)
+	if err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "Failed to set cart promo code: %s", err)
+	}
+
+	return cart, discount, nil
+}
+
+// Returns the product quantity left in each warehouse that keeps the product
+func getProductStocks(ctx context.Context, productClient pbProduct.ProductClient, productID uuid.UUID) (map[string]int64, error) {
+	stocksResp, err := productClient.GetProductStocks(ctx, &pbProduct.GetProductStocksRequest{
+		ProductId: productID.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stocks := make(map[string]int64, len(stocksResp.Stocks))
+	for _, stock := range stocksResp.Stocks {
+		stocks[stock.WarehouseId] = stock.Quantity
+	}
+
+	return stocks, nil
+}
+
+// Moves the guest cartline to the user cart. A product the user does not have yet keeps
+// its guest reservation, otherwise the guest reservation is released and the quantity
+// is reserved again in the warehouse of the user cartline, as far as its stock allows.
+// The merged quantity is capped by the purchase limit of the user, the excess is released
+func mergeCartline(
+	ctx context.Context,
+	cartUsecase usecase.ICartUsecase,
+	productClient pbProduct.ProductClient,
+	orderClient pbOrder.OrderClient,
+	purchaseLimitLookback time.Duration,
+	userID uuid.UUID,
+	guestCartline *model.CartLine,
+) error {
+	cartline, err := cartUsecase.GetCartline(ctx, userID, guestCartline.ProductID)
+	if err != nil {
+		return fmt.Errorf("failed to get cartline: %w", err)
+	}
+
+	var cartQuantity int64
+	if cartline != nil {
+		cartQuantity = cartline.Quantity
+	}
+
+	allowed, err := capPurchaseLimit(
+		ctx,
+		productClient,
+		orderClient,
+		purchaseLimitLookback,
+		userID,
+		guestCartline.ProductID,
+		cartQuantity+guestCartline.Quantity,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to check purchase limit: %w", err)
+	}
+
+	quantity := allowed - cartQuantity
+	if quantity < 0 {
+		quantity = 0
+	}
+
+	if cartline == nil && quantity > 0 {
+		if excess := guestCartline.Quantity - quantity; excess > 0 {
+			if err = changeReservation(ctx, productClient, guestCartline, -excess); err != nil {
+				return fmt.Errorf("failed to release product: %w", err)
+			}
+		}
+
+		newCartline := *guestCartline
+		newCartline.UserID = userID
+		newCartline.Quantity = quantity
+		newCartline.UpdatedAt = time.Now()
+
+		if err = cartUsecase.CreateCartline(ctx, &newCartline); err != nil {
+			return fmt.Errorf("failed to create cartline: %w", err)
+		}
+
+		if err = cartUsecase.DeleteCartline(ctx, guestCartline.UserID, guestCartline.ProductID); err != nil {
+			return fmt.Errorf("failed to delete guest cartline: %w", err)
+		}
+
+		return nil
+	}
+
+	if err = cartUsecase.DeleteCartline(ctx, guestCartline.UserID, guestCartline.ProductID); err != nil {
+		return fmt.Errorf("failed to delete guest cartline: %w", err)
+	}
+
+	if err = returnProducts(ctx, productClient, guestCartline); err != nil {
+		if errCreate := cartUsecase.CreateCartline(ctx, guestCartline); errCreate != nil {
+			return fmt.Errorf("failed to create guest cartline: %w", errCreate)
+		}
+		return fmt.Errorf("failed to return products: %w", err)
+	}
+
+	// The purchase limit leaves no room for the guest cartline
+	if cartline == nil {
+		return nil
+	}
+
+	products, err := getProducts(ctx, productClient, []string{cartline.ProductID.String()})
+	if err != nil {
+		return fmt.Errorf("failed to get products: %w", err)
+	}
+
+	if products[0] == nil {
+		return nil
+	}
+
+	stocks, err := getProductStocks(ctx, productClient, cartline.ProductID)
+	if err != nil {
+		return fmt.Errorf("failed to get product stocks: %w", err)
+	}
+
+	if stock := stocks[cartline.WarehouseID.String()]; quantity > stock {
+		quantity = stock
+	}
+
+	if quantity == 0 {
+		return nil
+	}
+
+	if err = changeReservation(ctx, productClient, cartline, quantity); err != nil {
+		// The stock was taken by another cart in the meantime
+		if status.Code(err) == codes.FailedPrecondition {
+			return nil
+		}
+		return fmt.Errorf("failed to reserve product: %w", err)
+	}
+
+	if _, err = cartUsecase.UpdateCartline(ctx, model.CartLine{
+		UserID:    userID,
+		ProductID: cartline.ProductID,
+		Quantity:  cartline.Quantity + quantity,
+	}); err != nil {
+		if errRelease := changeReservation(ctx, productClient, cartline, -quantity); errRelease != nil {
+			return fmt.Errorf("failed to release product: %w", errRelease)
+		}
+		return fmt.Errorf("failed to update cartline: %w", err)
+	}
+
+	return nil
+}
+
+// Moves the guest cart cartlines to the user cart and deletes the guest cart
+func MergeCarts(
+	ctx context.Context,
+	cartUsecase usecase.ICartUsecase,
+	productClient pbProduct.ProductClient,
+	orderClient pbOrder.OrderClient,
+	purchaseLimitLookback time.Duration,
+	req *pbCart.MergeCartsRequest,
+) (*model.Cart, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid user id: %s", err)
+	}
+
+	guestID, err := uuid.Parse(req.GuestId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid guest id: %s", err)
+	}
+
+	guestCart, err := cartUsecase.GetUserCart(ctx, guestID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get guest cart: %s", err)
+	}
+
+	if guestCart == nil {
+		return nil, status.Errorf(codes.NotFound, "Guest cart not found")
+	}
+
+	if !guestCart.Guest {
+		return nil, status.Errorf(codes.InvalidArgument, "Cart %s is not a guest cart", guestID)
+	}
+
+	cart, err := cartUsecase.GetUserCart(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get user cart: %s", err)
+	}
+
+	if cart == nil {
+		return nil, status.Errorf(codes.NotFound, "Cart not found")
+	}
+
+	if cart.Guest {
+		return nil, status.Errorf(codes.InvalidArgument, "Cart %s is a guest cart", userID)
+	}
+
+	for _, guestCartline := range guestCart.Cartlines {
+		if err = mergeCartline(ctx, cartUsecase, productClient, orderClient, purchaseLimitLookback, userID, guestCartline); err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to merge cartline: %s", err)
+		}
+	}
+
+	if err = cartUsecase.DeleteCart(ctx, guestID); err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to delete guest cart: %s", err)
+	}
+
+	cart, err = cartUsecase.GetUserCart(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get user cart: %s", err)
+	}
+
+	return cart, nil
+}
+
+// The cartline quantity is already taken from the warehouse stock when it is reserved,
+// so the line is only short when its warehouse no longer keeps the product.
+// Lines reserved before products were kept per warehouse have no warehouse, they are
+// short when all warehouses together keep less than the cartline quantity
+func summaryLine(
+	ctx context.Context,
+	productClient pbProduct.ProductClient,
+	cartline *model.CartLine,
+	product *pbProduct.ProductResponse,
+	exchangeRate float64,
+) (*model.CartSummaryLine, error) {
+	line := &model.CartSummaryLine{
+		ProductID:   cartline.ProductID,
+		WarehouseID: cartline.WarehouseID,
+		Quantity:    cartline.Quantity,
+		Issues:      make([]model.CartlineIssue, 0),
+	}
+
+	if product == nil {
+		line.Issues = append(line.Issues, model.ProductRemoved)
+		return line, nil
+	}
+
+	line.Name = product.Name
+	line.CategoryID = product.CategoryId
+	line.UnitPrice = money.ConvertAmount(product.Price, exchangeRate)
+	if money.DiscountActiveAt(product.Discount, time.Now()) {
+		line.Discount = product.Discount
+	}
+
+	if product.ModerationStatus != pbProduct.ModerationStatus_APPROVED {
+		line.Issues = append(line.Issues, model.ProductUnmoderated)
+	}
+
+	stocks, err := getProductStocks(ctx, productClient, cartline.ProductID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product stocks: %w", err)
+	}
+
+	if cartline.WarehouseID != uuid.Nil {
+		if _, ok := stocks[cartline.WarehouseID.String()]; !ok {
+			line.Issues = append(line.Issues, model.StockShort)
+		}
+		return line, nil
+	}
+
+	var stockQuantity int64
+	for _, quantity := range stocks {
+		stockQuantity += quantity
+	}
+
+	if stockQuantity < cartline.Quantity {
+		line.Issues = append(line.Issues, model.StockShort)
+	}
+
+	return line, nil
+}
+
+// Fills the taxes the lines would get at checkout, lines of removed products have no price and no tax
+func calculateSummaryTaxes(
+	ctx context.Context,
+	orderClient pbOrder.OrderClient,
+	summary *model.CartSummary,
+	products []*pbProduct.ProductResponse,
+) error {
+	taxLines := make([]*pbOrder.TaxLine, 0, len(summary.Lines))
+	pricedLines := make([]*model.CartSummaryLine, 0, len(summary.Lines))
+	for i, line := range summary.Lines {
+		if products[i] == nil {
+			continue
+		}
+
+		taxLines = append(taxLines, &pbOrder.TaxLine{
+			ProductId:  line.ProductID.String(),
+			CategoryId: line.CategoryID,
+			Amount:     line.TaxableAmount(),
+		})
+		pricedLines = append(pricedLines, line)
+	}
+
+	if len(taxLines) == 0 {
+		return nil
+	}
+
+	taxResp, err := orderClient.CalculateTax(ctx, &pbOrder.CalculateTaxRequest{
+		ShippingRegion: summary.ShippingRegion,
+		Lines:          taxLines,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(taxResp.Lines) != len(pricedLines) {
+		return status.Errorf(codes.Internal, "got %d taxes for %d lines", len(taxResp.Lines), len(pricedLines))
+	}
+
+	summary.ShippingRegion = taxResp.ShippingRegion
+	for i, lineTax := range taxResp.Lines {
+		pricedLines[i].TaxRate = lineTax.Rate
+		pricedLines[i].Tax = lineTax.Tax
+	}
+
+	return nil
+}
+
+func GetCartSummary(
+	ctx context.Context,
+	cartUsecase usecase.ICartUsecase,
+	productClient pbProduct.ProductClient,
+	orderClient pbOrder.OrderClient,
+	req *pbCart.GetCartSummaryRequest,
+) (*model.CartSummary, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid user id: %s", err)
+	}
+
+	cart, err := cartUsecase.GetUserCart(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get user cart: %s", err)
+	}
+
+	if cart == nil {
+		return nil, status.Errorf(codes.NotFound, "Cart not found")
+	}
+
+	productIDs := make([]string, 0, len(cart.Cartlines))
+	for _, cartline := range cart.Cartlines {
+		productIDs = append(productIDs, cartline.ProductID.String())
+	}
+
+	products, err := getProducts(ctx, productClient, productIDs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get products: %s", err)
+	}
+
+	baseCurrency, rates, err := money.GetExchangeRates(ctx, productClient)
+	if err != nil {
+		return nil, err
+	}
+
+	currency := money.NormalizeCurrency(req.Currency)
+	if currency == "" {
+		currency = baseCurrency
+	}
+
+	if _, ok := rates[currency]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "Unknown currency: %s", currency)
+	}
+
+	summary := &model.CartSummary{
+		UserID:         userID,
+		Lines:          make([]*model.CartSummaryLine, 0, len(cart.Cartlines)),
+		PromoCode:      cart.PromoCode,
+		ShippingRegion: req.ShippingRegion,
+		Currency:       currency,
+	}
+
+	for i, cartline := range cart.Cartlines {
+		var exchangeRate float64
+		if products[i] != nil {
+			exchangeRate, err = rates.Rate(money.ProductCurrency(products[i], baseCurrency), currency)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Failed to convert product %s price: %s", cartline.ProductID, err)
+			}
+		}
+
+		line, err := summaryLine(ctx, productClient, cartline, products[i], exchangeRate)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to get cartline summary: %s", err)
+		}
+
+		summary.Lines = append(summary.Lines, line)
+	}
+
+	if cart.PromoCode != "" {
+		lines, err := promoLines(cart.Cartlines, products, baseCurrency, rates)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to get promo lines: %s", err)
+		}
+
+		promoResp, err := orderClient.ValidatePromoCode(ctx, &pbOrder.ValidatePromoCodeRequest{
+			UserId: req.UserId,
+			Code:   cart.PromoCode,
+			Lines:  lines,
+		})
+		switch status.Code(err) {
+		case codes.OK:
+			promoRate, err := rates.Rate(baseCurrency, currency)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Failed to convert promo discount: %s", err)
+			}
+			summary.PromoDiscount = money.ConvertAmount(promoResp.Discount, promoRate)
+		case codes.NotFound, codes.FailedPrecondition:
+			summary.PromoError = status.Convert(err).Message()
+		default:
+			return nil, status.Errorf(status.Code(err), "Failed to validate promo code: %s", status.Convert(err).Message())
+		}
+	}
+
+	if err = calculateSummaryTaxes(ctx, orderClient, summary, products); err != nil {
+		return nil, status.Errorf(status.Code(err), "Failed to calculate taxes: %s", status.Convert(err).Message())
+	}
+
+	return summary, nil
+}
+
+// Returns the reserved quantities back, used when the cart could not be updated after reserving
+func revertReservations(ctx context.Context, productClient pbProduct.ProductClient, reservations []*pbProduct.ReservationResponse) error {
+	if len(reservations) == 0 {
+		return nil
+	}
+
+	changes := make([]*pbProduct.ReservationChange, 0, len(reservations))
+	for _, reservation := range reservations {
+		changes = append(changes, &pbProduct.ReservationChange{
+			ProductId:   reservation.ProductId,
+			Quantity:    -reservation.Quantity,
+			WarehouseId: reservation.WarehouseId,
+		})
+	}
+
+	_, err := productClient.ChangeReservations(ctx, &pbProduct.ChangeReservationsRequest{
+		Changes: changes,
+	})
+
+	return err
+}
+
+// Sets the quantities of many cartlines at once. The reservations of all changed lines are
+// changed with one product service call, which either reserves everything or nothing,
+// and then the cartlines are written in one transaction
+func BatchUpdateCart(
+	ctx context.Context,
+	cartUsecase usecase.ICartUsecase,
+	productClient pbProduct.ProductClient,
+	orderClient pbOrder.OrderClient,
+	purchaseLimitLookback time.Duration,
+	req *pbCart.BatchUpdateCartRequest,
+) (*model.Cart, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid user id: %s", err)
+	}
+
+	cart, err := cartUsecase.GetUserCart(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get user cart: %s", err)
+	}
+
+	if cart == nil {
+		return nil, status.Errorf(codes.NotFound, "Cart not found")
+	}
+
+	oldCartlines := make(map[uuid.UUID]*model.CartLine, len(cart.Cartlines))
+	for _, cartline := range cart.Cartlines {
+		oldCartlines[cartline.ProductID] = cartline
+	}
+
+	cartlines := make([]*model.CartLine, 0, len(req.Changes))
+	changes := make([]*pbProduct.ReservationChange, 0, len(req.Changes))
+	// New cartlines by the index of their reservation change, their warehouse is chosen on reservation
+	newCartlines := make(map[int]*model.CartLine)
+	// Cartlines whose quantity grows are checked against the purchase limits
+	grownCartlines := make([]*model.CartLine, 0, len(req.Changes))
+	seen := make(map[uuid.UUID]bool, len(req.Changes))
+
+	for _, change := range req.Changes {
+		productID, err := uuid.Parse(change.ProductId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid product id: %s", err)
+		}
+
+		if seen[productID] {
+			return nil, status.Errorf(codes.InvalidArgument, "Duplicate product id: %s", productID)
+		}
+		seen[productID] = true
+
+		cartline := &model.CartLine{
+			UserID:    userID,
+			ProductID: productID,
+			Quantity:  change.Quantity,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		}
+
+		if err = cartline.Validate(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid cartline change: %s", err)
+		}
+
+		oldCartline, ok := oldCartlines[productID]
+		switch {
+		case !ok && change.Quantity == 0:
+			continue
+		case !ok:
+			newCartlines[len(changes)] = cartline
+			grownCartlines = append(grownCartlines, cartline)
+			changes = append(changes, &pbProduct.ReservationChange{
+				ProductId:        change.ProductId,
+				Quantity:         change.Quantity,
+				Strategy:         req.Strategy,
+				ShippingLocation: req.ShippingLocation,
+			})
+		default:
+			cartline.WarehouseID = oldCartline.WarehouseID
+			cartline.CreatedAt = oldCartline.CreatedAt
+			if diff := change.Quantity - oldCartline.Quantity; diff != 0 {
+				changes = append(changes, &pbProduct.ReservationChange{
+					ProductId:   change.ProductId,
+					Quantity:    diff,
+					WarehouseId: oldCartline.WarehouseID.String(),
+				})
+			}
+			if change.Quantity > oldCartline.Quantity {
+				grownCartlines = append(grownCartlines, cartline)
+			}
+		}
+
+		cartlines = append(cartlines, cartline)
+	}
+
+	for _, cartline := range grownCartlines {
+		if err = checkPurchaseLimit(ctx, productClient, orderClient, purchaseLimitLookback, userID, cartline.ProductID, cartline.Quantity); err != nil {
+			return nil, err
+		}
+	}
+
+	var reservations []*pbProduct.ReservationResponse
+	if len(changes) != 0 {
+		reservationsResp, err := productClient.ChangeReservations(ctx, &pbProduct.ChangeReservationsRequest{
+			Changes: changes,
+		})
+		if err != nil {
+			return nil, status.Errorf(status.Code(err), "Failed to change reservations: %s", status.Convert(err).Message())
+		}
+
+		reservations = reservationsResp.Reservations
+	}
+
+	for i, cartline := range newCartlines {
+		if i < len(reservations) {
+			cartline.WarehouseID, err = uuid.Parse(reservations[i].WarehouseId)
+		}
+		if err != nil || i >= len(reservations) {
+			if errRevert := revertReservations(ctx, productClient, reservations); errRevert != nil {
+				return nil, status.Errorf(codes.Internal, "Failed to revert reservations: %s", errRevert)
+			}
+			return nil, status.Errorf(codes.Internal, "Invalid reservation of product %s", cartline.ProductID)
+		}
+	}
+
+	newCart, err := cartUsecase.UpdateCartlines(ctx, userID, cartlines)
+	if err != nil {
+		if errRevert := revertReservations(ctx, productClient, reservations); errRevert != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to revert reservations: %s", errRevert)
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to update cartlines: %s", err)
+	}
+
+	return newCart, nil
+}
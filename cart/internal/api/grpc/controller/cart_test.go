@@ -4,18 +4,94 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/Go-Marketplace/backend/cart/internal/api/grpc/controller"
 	mocks "github.com/Go-Marketplace/backend/cart/internal/mocks/usecase"
 	"github.com/Go-Marketplace/backend/cart/internal/model"
 	pbCart "github.com/Go-Marketplace/backend/proto/gen/cart"
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+type productClient struct {
+	pbProduct.ProductClient
+
+	product  *pbProduct.ProductResponse
+	rates    *pbProduct.ExchangeRatesResponse
+	stocks   []*pbProduct.WarehouseStockResponse
+	released int64
+}
+
+func (client *productClient) GetProduct(
+	_ context.Context,
+	_ *pbProduct.GetProductRequest,
+	_ ...grpc.CallOption,
+) (*pbProduct.ProductResponse, error) {
+	return client.product, nil
+}
+
+func (client *productClient) GetExchangeRates(
+	_ context.Context,
+	_ *pbProduct.GetExchangeRatesRequest,
+	_ ...grpc.CallOption,
+) (*pbProduct.ExchangeRatesResponse, error) {
+	return client.rates, nil
+}
+
+func (client *productClient) GetProductStocks(
+	_ context.Context,
+	_ *pbProduct.GetProductStocksRequest,
+	_ ...grpc.CallOption,
+) (*pbProduct.WarehouseStocksResponse, error) {
+	return &pbProduct.WarehouseStocksResponse{Stocks: client.stocks}, nil
+}
+
+func (client *productClient) ReleaseProduct(
+	_ context.Context,
+	req *pbProduct.ReleaseProductRequest,
+	_ ...grpc.CallOption,
+) (*pbProduct.ReservationResponse, error) {
+	client.released += req.Quantity
+	return &pbProduct.ReservationResponse{}, nil
+}
+
+type orderClient struct {
+	pbOrder.OrderClient
+
+	purchased int64
+}
+
+func (client *orderClient) GetPurchasedQuantity(
+	_ context.Context,
+	_ *pbOrder.GetPurchasedQuantityRequest,
+	_ ...grpc.CallOption,
+) (*pbOrder.PurchasedQuantityResponse, error) {
+	return &pbOrder.PurchasedQuantityResponse{Quantity: client.purchased}, nil
+}
+
+func (client *orderClient) CalculateTax(
+	_ context.Context,
+	req *pbOrder.CalculateTaxRequest,
+	_ ...grpc.CallOption,
+) (*pbOrder.CalculateTaxResponse, error) {
+	lines := make([]*pbOrder.LineTaxResponse, 0, len(req.Lines))
+	for _, line := range req.Lines {
+		lines = append(lines, &pbOrder.LineTaxResponse{ProductId: line.ProductId})
+	}
+
+	return &pbOrder.CalculateTaxResponse{
+		ShippingRegion: req.ShippingRegion,
+		Lines:          lines,
+	}, nil
+}
+
 func cartHelper(t *testing.T) *mocks.MockICartUsecase {
 	t.Helper()
 
@@ -98,3 +174,443 @@ func TestGetUserCart(t *testing.T) {
 		})
 	}
 }
+
+func TestMergeCarts(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ctx context.Context
+		req *pbCart.MergeCartsRequest
+	}
+
+	ctx := context.Background()
+	userID := uuid.New()
+	guestID := uuid.New()
+	productID := uuid.New()
+	warehouseID := uuid.New()
+
+	guestCartline := &model.CartLine{
+		UserID:      guestID,
+		ProductID:   productID,
+		Quantity:    2,
+		WarehouseID: warehouseID,
+	}
+
+	req := &pbCart.MergeCartsRequest{
+		UserId:  userID.String(),
+		GuestId: guestID.String(),
+	}
+
+	expectedCartFromUsecase := &model.Cart{
+		UserID: userID,
+		Cartlines: []*model.CartLine{
+			{
+				UserID:      userID,
+				ProductID:   productID,
+				Quantity:    2,
+				WarehouseID: warehouseID,
+			},
+		},
+	}
+
+	testcases := []struct {
+		name             string
+		args             args
+		product          *pbProduct.ProductResponse
+		purchased        int64
+		mock             func(usecase *mocks.MockICartUsecase)
+		expectedCart     *model.Cart
+		expectedReleased int64
+		expectedErr      error
+	}{
+		{
+			name: "Successfully move guest cartline to user cart",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			product: &pbProduct.ProductResponse{ProductId: productID.String()},
+			mock: func(usecase *mocks.MockICartUsecase) {
+				usecase.EXPECT().GetUserCart(ctx, guestID).Return(&model.Cart{
+					UserID:    guestID,
+					Guest:     true,
+					Cartlines: []*model.CartLine{guestCartline},
+				}, nil).Times(1)
+				usecase.EXPECT().GetUserCart(ctx, userID).Return(&model.Cart{UserID: userID}, nil).Times(1)
+				usecase.EXPECT().GetCartline(ctx, userID, productID).Return(nil, nil).Times(1)
+				usecase.EXPECT().CreateCartline(ctx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, cartline *model.CartLine) error {
+						assert.Equal(t, userID, cartline.UserID)
+						assert.Equal(t, guestCartline.Quantity, cartline.Quantity)
+						assert.Equal(t, warehouseID, cartline.WarehouseID)
+						return nil
+					},
+				).Times(1)
+				usecase.EXPECT().DeleteCartline(ctx, guestID, productID).Return(nil).Times(1)
+				usecase.EXPECT().DeleteCart(ctx, guestID).Return(nil).Times(1)
+				usecase.EXPECT().GetUserCart(ctx, userID).Return(expectedCartFromUsecase, nil).Times(1)
+			},
+			expectedCart: expectedCartFromUsecase,
+			expectedErr:  nil,
+		},
+		{
+			name: "Merged quantity is capped by the purchase limit",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			product:   &pbProduct.ProductResponse{ProductId: productID.String(), MaxPerCustomer: 3},
+			purchased: 2,
+			mock: func(usecase *mocks.MockICartUsecase) {
+				usecase.EXPECT().GetUserCart(ctx, guestID).Return(&model.Cart{
+					UserID:    guestID,
+					Guest:     true,
+					Cartlines: []*model.CartLine{guestCartline},
+				}, nil).Times(1)
+				usecase.EXPECT().GetUserCart(ctx, userID).Return(&model.Cart{UserID: userID}, nil).Times(1)
+				usecase.EXPECT().GetCartline(ctx, userID, productID).Return(nil, nil).Times(1)
+				usecase.EXPECT().CreateCartline(ctx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, cartline *model.CartLine) error {
+						assert.Equal(t, int64(1), cartline.Quantity)
+						return nil
+					},
+				).Times(1)
+				usecase.EXPECT().DeleteCartline(ctx, guestID, productID).Return(nil).Times(1)
+				usecase.EXPECT().DeleteCart(ctx, guestID).Return(nil).Times(1)
+				usecase.EXPECT().GetUserCart(ctx, userID).Return(expectedCartFromUsecase, nil).Times(1)
+			},
+			expectedCart:     expectedCartFromUsecase,
+			expectedReleased: 1,
+			expectedErr:      nil,
+		},
+		{
+			name: "Guest cartline over the purchase limit is released",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			product:   &pbProduct.ProductResponse{ProductId: productID.String(), MaxPerCustomer: 2},
+			purchased: 2,
+			mock: func(usecase *mocks.MockICartUsecase) {
+				usecase.EXPECT().GetUserCart(ctx, guestID).Return(&model.Cart{
+					UserID:    guestID,
+					Guest:     true,
+					Cartlines: []*model.CartLine{guestCartline},
+				}, nil).Times(1)
+				usecase.EXPECT().GetUserCart(ctx, userID).Return(&model.Cart{UserID: userID}, nil).Times(1)
+				usecase.EXPECT().GetCartline(ctx, userID, productID).Return(nil, nil).Times(1)
+				usecase.EXPECT().DeleteCartline(ctx, guestID, productID).Return(nil).Times(1)
+				usecase.EXPECT().DeleteCart(ctx, guestID).Return(nil).Times(1)
+				usecase.EXPECT().GetUserCart(ctx, userID).Return(&model.Cart{UserID: userID}, nil).Times(1)
+			},
+			expectedCart:     &model.Cart{UserID: userID},
+			expectedReleased: 2,
+			expectedErr:      nil,
+		},
+		{
+			name: "Invalid guest id",
+			args: args{
+				ctx: ctx,
+				req: &pbCart.MergeCartsRequest{
+					UserId:  userID.String(),
+					GuestId: "guest",
+				},
+			},
+			mock:         func(usecase *mocks.MockICartUsecase) {},
+			expectedCart: nil,
+			expectedErr:  status.Errorf(codes.InvalidArgument, "Invalid guest id: %s", "invalid UUID length: 5"),
+		},
+		{
+			name: "Guest cart not found",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			mock: func(usecase *mocks.MockICartUsecase) {
+				usecase.EXPECT().GetUserCart(ctx, guestID).Return(nil, nil).Times(1)
+			},
+			expectedCart: nil,
+			expectedErr:  status.Errorf(codes.NotFound, "Guest cart not found"),
+		},
+		{
+			name: "Cart is not a guest cart",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			mock: func(usecase *mocks.MockICartUsecase) {
+				usecase.EXPECT().GetUserCart(ctx, guestID).Return(&model.Cart{UserID: guestID}, nil).Times(1)
+			},
+			expectedCart: nil,
+			expectedErr:  status.Errorf(codes.InvalidArgument, "Cart %s is not a guest cart", guestID),
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			cartUsecase := cartHelper(t)
+			testcase.mock(cartUsecase)
+
+			products := &productClient{product: testcase.product}
+			orders := &orderClient{purchased: testcase.purchased}
+
+			actualCart, actualErr := controller.MergeCarts(
+				testcase.args.ctx,
+				cartUsecase,
+				products,
+				orders,
+				time.Hour,
+				testcase.args.req,
+			)
+
+			assert.Equal(t, testcase.expectedCart, actualCart)
+			assert.Equal(t, testcase.expectedReleased, products.released)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestBatchUpdateCart(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ctx context.Context
+		req *pbCart.BatchUpdateCartRequest
+	}
+
+	ctx := context.Background()
+	userID := uuid.New()
+	productID := uuid.New()
+	missingProductID := uuid.New()
+	warehouseID := uuid.New()
+
+	cartline := &model.CartLine{
+		UserID:      userID,
+		ProductID:   productID,
+		Quantity:    2,
+		WarehouseID: warehouseID,
+	}
+
+	expectedCartFromUsecase := &model.Cart{
+		UserID:    userID,
+		Cartlines: []*model.CartLine{cartline},
+	}
+	expectedErrFromUsecase := errors.New("test error")
+
+	testcases := []struct {
+		name         string
+		args         args
+		mock         func(usecase *mocks.MockICartUsecase)
+		expectedCart *model.Cart
+		expectedErr  error
+	}{
+		{
+			name: "Successfully update cart without reservation changes",
+			args: args{
+				ctx: ctx,
+				req: &pbCart.BatchUpdateCartRequest{
+					UserId: userID.String(),
+					Changes: []*pbCart.CartlineChange{
+						{ProductId: productID.String(), Quantity: 2},
+						{ProductId: missingProductID.String(), Quantity: 0},
+					},
+				},
+			},
+			mock: func(usecase *mocks.MockICartUsecase) {
+				usecase.EXPECT().GetUserCart(ctx, userID).Return(expectedCartFromUsecase, nil).Times(1)
+				usecase.EXPECT().UpdateCartlines(ctx, userID, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ uuid.UUID, cartlines []*model.CartLine) (*model.Cart, error) {
+						assert.Len(t, cartlines, 1)
+						assert.Equal(t, productID, cartlines[0].ProductID)
+						assert.Equal(t, int64(2), cartlines[0].Quantity)
+						assert.Equal(t, warehouseID, cartlines[0].WarehouseID)
+						return expectedCartFromUsecase, nil
+					},
+				).Times(1)
+			},
+			expectedCart: expectedCartFromUsecase,
+			expectedErr:  nil,
+		},
+		{
+			name: "Cart not found",
+			args: args{
+				ctx: ctx,
+				req: &pbCart.BatchUpdateCartRequest{
+					UserId: userID.String(),
+				},
+			},
+			mock: func(usecase *mocks.MockICartUsecase) {
+				usecase.EXPECT().GetUserCart(ctx, userID).Return(nil, nil).Times(1)
+			},
+			expectedCart: nil,
+			expectedErr:  status.Errorf(codes.NotFound, "Cart not found"),
+		},
+		{
+			name: "Duplicate product id",
+			args: args{
+				ctx: ctx,
+				req: &pbCart.BatchUpdateCartRequest{
+					UserId: userID.String(),
+					Changes: []*pbCart.CartlineChange{
+						{ProductId: productID.String(), Quantity: 1},
+						{ProductId: productID.String(), Quantity: 3},
+					},
+				},
+			},
+			mock: func(usecase *mocks.MockICartUsecase) {
+				usecase.EXPECT().GetUserCart(ctx, userID).Return(expectedCartFromUsecase, nil).Times(1)
+			},
+			expectedCart: nil,
+			expectedErr:  status.Errorf(codes.InvalidArgument, "Duplicate product id: %s", productID),
+		},
+		{
+			name: "Got error when get cart",
+			args: args{
+				ctx: ctx,
+				req: &pbCart.BatchUpdateCartRequest{
+					UserId: userID.String(),
+				},
+			},
+			mock: func(usecase *mocks.MockICartUsecase) {
+				usecase.EXPECT().GetUserCart(ctx, userID).Return(nil, expectedErrFromUsecase).Times(1)
+			},
+			expectedCart: nil,
+			expectedErr:  status.Errorf(codes.Internal, "Failed to get user cart: %s", expectedErrFromUsecase),
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			cartUsecase := cartHelper(t)
+			testcase.mock(cartUsecase)
+
+			actualCart, actualErr := controller.BatchUpdateCart(
+				testcase.args.ctx,
+				cartUsecase,
+				nil,
+				nil,
+				0,
+				testcase.args.req,
+			)
+
+			assert.Equal(t, testcase.expectedCart, actualCart)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestGetCartSummary(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	userID := uuid.New()
+	productID := uuid.New()
+
+	cart := &model.Cart{
+		UserID: userID,
+		Cartlines: []*model.CartLine{
+			{UserID: userID, ProductID: productID, Quantity: 2},
+		},
+	}
+
+	product := &pbProduct.ProductResponse{
+		ProductId:        productID.String(),
+		Name:             "Fish",
+		Price:            100,
+		Currency:         "USD",
+		ModerationStatus: pbProduct.ModerationStatus_APPROVED,
+	}
+
+	rates := &pbProduct.ExchangeRatesResponse{
+		BaseCurrency: "RUB",
+		Rates: []*pbProduct.ExchangeRateResponse{
+			{Currency: "USD", Rate: 90},
+			{Currency: "EUR", Rate: 100},
+		},
+	}
+
+	stocks := []*pbProduct.WarehouseStockResponse{
+		{WarehouseId: uuid.NewString(), ProductId: productID.String(), Quantity: 1},
+		{WarehouseId: uuid.NewString(), ProductId: productID.String(), Quantity: 1},
+	}
+
+	testcases := []struct {
+		name              string
+		req               *pbCart.GetCartSummaryRequest
+		stocks            []*pbProduct.WarehouseStockResponse
+		expectedCurrency  string
+		expectedUnitPrice int64
+		expectedIssues    []model.CartlineIssue
+		expectedErr       error
+	}{
+		{
+			name:              "Summary is priced in the base currency by default",
+			req:               &pbCart.GetCartSummaryRequest{UserId: userID.String()},
+			stocks:            stocks,
+			expectedCurrency:  "RUB",
+			expectedUnitPrice: 9000,
+			expectedIssues:    []model.CartlineIssue{},
+			expectedErr:       nil,
+		},
+		{
+			name:              "Summary is priced in the requested currency",
+			req:               &pbCart.GetCartSummaryRequest{UserId: userID.String(), Currency: "eur"},
+			stocks:            stocks,
+			expectedCurrency:  "EUR",
+			expectedUnitPrice: 90,
+			expectedIssues:    []model.CartlineIssue{},
+			expectedErr:       nil,
+		},
+		{
+			name:              "Line without warehouse is short when the stock is less than its quantity",
+			req:               &pbCart.GetCartSummaryRequest{UserId: userID.String()},
+			stocks:            stocks[:1],
+			expectedCurrency:  "RUB",
+			expectedUnitPrice: 9000,
+			expectedIssues:    []model.CartlineIssue{model.StockShort},
+			expectedErr:       nil,
+		},
+		{
+			name:        "Got error with unknown currency",
+			req:         &pbCart.GetCartSummaryRequest{UserId: userID.String(), Currency: "XYZ"},
+			expectedErr: status.Errorf(codes.InvalidArgument, "Unknown currency: %s", "XYZ"),
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			cartUsecase := cartHelper(t)
+			cartUsecase.EXPECT().GetUserCart(ctx, userID).Return(cart, nil).Times(1)
+
+			summary, actualErr := controller.GetCartSummary(
+				ctx,
+				cartUsecase,
+				&productClient{product: product, rates: rates, stocks: testcase.stocks},
+				&orderClient{},
+				testcase.req,
+			)
+
+			assert.Equal(t, testcase.expectedErr, actualErr)
+			if testcase.expectedErr != nil {
+				return
+			}
+
+			assert.Equal(t, testcase.expectedCurrency, summary.Currency)
+			assert.Equal(t, testcase.expectedUnitPrice, summary.Lines[0].UnitPrice)
+			assert.Equal(t, testcase.expectedUnitPrice*2, summary.Total())
+			assert.Equal(t, testcase.expectedIssues, summary.Lines[0].Issues)
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	purchaseLimitViolation = "PURCHASE_LIMIT"
+	purchaseLimitReason    = "PURCHASE_LIMIT_EXCEEDED"
+	purchaseLimitDomain    = "cart"
+)
+
+// Checks that the quantity of the product in the cart together with the quantity the user ordered
+// within the lookback window does not exceed the max per customer of the product
+func checkPurchaseLimit(
+	ctx context.Context,
+	productClient pbProduct.ProductClient,
+	orderClient pbOrder.OrderClient,
+	lookback time.Duration,
+	userID uuid.UUID,
+	productID uuid.UUID,
+	quantity int64,
+) error {
+	product, err := productClient.GetProduct(ctx, &pbProduct.GetProductRequest{
+		ProductId: productID.String(),
+	})
+	if err != nil {
+		return status.Errorf(status.Code(err), "Failed to get product: %s", status.Convert(err).Message())
+	}
+
+	if product.MaxPerCustomer == 0 {
+		return nil
+	}
+
+	if quantity > product.MaxPerCustomer {
+		return purchaseLimitError(productID, product.MaxPerCustomer, 0, quantity)
+	}
+
+	purchased, err := getPurchasedQuantity(ctx, orderClient, lookback, userID, productID)
+	if err != nil {
+		return err
+	}
+
+	if purchased+quantity > product.MaxPerCustomer {
+		return purchaseLimitError(productID, product.MaxPerCustomer, purchased, quantity)
+	}
+
+	return nil
+}
+
+// Returns the part of the quantity the purchase limit allows the user to have in the cart,
+// the quantity of a product that is no longer found is left as it is
+func capPurchaseLimit(
+	ctx context.Context,
+	productClient pbProduct.ProductClient,
+	orderClient pbOrder.OrderClient,
+	lookback time.Duration,
+	userID uuid.UUID,
+	productID uuid.UUID,
+	quantity int64,
+) (int64, error) {
+	product, err := productClient.GetProduct(ctx, &pbProduct.GetProductRequest{
+		ProductId: productID.String(),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return quantity, nil
+		}
+		return 0, status.Errorf(status.Code(err), "Failed to get product: %s", status.Convert(err).Message())
+	}
+
+	if product.MaxPerCustomer == 0 {
+		return quantity, nil
+	}
+
+	purchased, err := getPurchasedQuantity(ctx, orderClient, lookback, userID, productID)
+	if err != nil {
+		return 0, err
+	}
+
+	allowed := product.MaxPerCustomer - purchased
+	if allowed < 0 {
+		allowed = 0
+	}
+
+	if quantity > allowed {
+		return allowed, nil
+	}
+
+	return quantity, nil
+}
+
+// Returns the quantity of the product the user ordered within the lookback window
+func getPurchasedQuantity(
+	ctx context.Context,
+	orderClient pbOrder.OrderClient,
+	lookback time.Duration,
+	userID uuid.UUID,
+	productID uuid.UUID,
+) (int64, error) {
+	purchasedResp, err := orderClient.GetPurchasedQuantity(ctx, &pbOrder.GetPurchasedQuantityRequest{
+		UserId:    userID.String(),
+		ProductId: productID.String(),
+		Since:     timestamppb.New(time.Now().Add(-lookback)),
+	})
+	if err != nil {
+		return 0, status.Errorf(status.Code(err), "Failed to get purchased quantity: %s", status.Convert(err).Message())
+	}
+
+	return purchasedResp.Quantity, nil
+}
+
+// Builds the FailedPrecondition error of the exceeded purchase limit, the details let clients
+// show how many more units the user can still add
+func purchaseLimitError(productID uuid.UUID, maxPerCustomer, purchased, requested int64) error {
+	st := status.Newf(
+		codes.FailedPrecondition,
+		"Purchase limit exceeded: at most %d of product %s per customer, %d already ordered",
+		maxPerCustomer,
+		productID,
+		purchased,
+	)
+
+	detailed, err := st.WithDetails(
+		&errdetails.ErrorInfo{
+			Reason: purchaseLimitReason,
+			Domain: purchaseLimitDomain,
+			Metadata: map[string]string{
+				"product_id":       productID.String(),
+				"max_per_customer": strconv.FormatInt(maxPerCustomer, 10),
+				"purchased":        strconv.FormatInt(purchased, 10),
+				"requested":        strconv.FormatInt(requested, 10),
+			},
+		},
+		&errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{
+				{
+					Type:        purchaseLimitViolation,
+					Subject:     productID.String(),
+					Description: fmt.Sprintf("At most %d per customer", maxPerCustomer),
+				},
+			},
+		},
+	)
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+// Reports whether the error is the exceeded purchase limit error
+func isPurchaseLimitError(err error) bool {
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.Reason == purchaseLimitReason {
+			return true
+		}
+	}
+
+	return false
+}
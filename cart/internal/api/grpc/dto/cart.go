@@ -0,0 +1,9 @@
+package dto
+
+import "time"
+
+type SearchAbandonedCartsDTO struct {
+	From       time.Time
+	To         time.Time
+	CategoryID int32
+}
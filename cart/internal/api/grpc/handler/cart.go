@@ -2,11 +2,13 @@ package handler
 
 import (
 	"context"
+	"time"
 
 	"github.com/Go-Marketplace/backend/cart/internal/api/grpc/controller"
 	"github.com/Go-Marketplace/backend/cart/internal/usecase"
 	"github.com/Go-Marketplace/backend/pkg/logger"
 	pbCart "github.com/Go-Marketplace/backend/proto/gen/cart"
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
 	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
 )
 
@@ -15,18 +17,25 @@ type cartRoutes struct {
 
 	cartUsecase   *usecase.CartUsecase
 	productClient pbProduct.ProductClient
-	logger        *logger.Logger
+	orderClient   pbOrder.OrderClient
+	// Window of the past orders counted against the product purchase limits
+	purchaseLimitLookback time.Duration
+	logger                *logger.Logger
 }
 
 func NewCartRoutes(
 	cartUsecase *usecase.CartUsecase,
 	productClient pbProduct.ProductClient,
+	orderClient pbOrder.OrderClient,
+	purchaseLimitLookback time.Duration,
 	logger *logger.Logger,
 ) *cartRoutes {
 	return &cartRoutes{
-		cartUsecase:   cartUsecase,
-		productClient: productClient,
-		logger:        logger,
+		cartUsecase:           cartUsecase,
+		productClient:         productClient,
+		orderClient:           orderClient,
+		purchaseLimitLookback: purchaseLimitLookback,
+		logger:                logger,
 	}
 }
 
@@ -49,7 +58,7 @@ func (router *cartRoutes) CreateCart(ctx context.Context, req *pbCart.CreateCart
 }
 
 func (router *cartRoutes) CreateCartline(ctx context.Context, req *pbCart.CreateCartlineRequest) (*pbCart.CartlineResponse, error) {
-	cartline, err := controller.CreateCartline(ctx, router.cartUsecase, router.productClient, req)
+	cartline, err := controller.CreateCartline(ctx, router.cartUsecase, router.productClient, router.orderClient, router.purchaseLimitLookback, req)
 	if err != nil {
 		return nil, err
 	}
@@ -58,7 +67,7 @@ func (router *cartRoutes) CreateCartline(ctx context.Context, req *pbCart.Create
 }
 
 func (router *cartRoutes) UpdateCartline(ctx context.Context, req *pbCart.UpdateCartlineRequest) (*pbCart.CartlineResponse, error) {
-	cartline, err := controller.UpdateCartline(ctx, router.cartUsecase, router.productClient, req)
+	cartline, err := controller.UpdateCartline(ctx, router.cartUsecase, router.productClient, router.orderClient, router.purchaseLimitLookback, req)
 	if err != nil {
 		return nil, err
 	}
@@ -83,7 +92,7 @@ func (router *cartRoutes) DeleteCartline(ctx context.Context, req *pbCart.Delete
 }
 
 func (router *cartRoutes) DeleteProductCartlines(ctx context.Context, req *pbCart.DeleteProductCartlinesRequest) (*pbCart.DeleteProductCartlinesResponse, error) {
-	if err := controller.DeleteProductCartlines(ctx, router.cartUsecase, req); err != nil {
+	if err := controller.DeleteProductCartlines(ctx, router.cartUsecase, router.productClient, req); err != nil {
 		return nil, err
 	}
 
@@ -105,3 +114,67 @@ func (router *cartRoutes) PrepareOrder(ctx context.Context, req *pbCart.PrepareO
 
 	return &pbCart.PrepareOrderResponse{}, nil
 }
+
+func (router *cartRoutes) ApplyPromoCode(ctx context.Context, req *pbCart.ApplyPromoCodeRequest) (*pbCart.ApplyPromoCodeResponse, error) {
+	cart, discount, err := controller.ApplyPromoCode(ctx, router.cartUsecase, router.productClient, router.orderClient, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbCart.ApplyPromoCodeResponse{
+		Cart:     cart.ToProto(),
+		Discount: discount,
+	}, nil
+}
+
+func (router *cartRoutes) MergeCarts(ctx context.Context, req *pbCart.MergeCartsRequest) (*pbCart.CartResponse, error) {
+	cart, err := controller.MergeCarts(ctx, router.cartUsecase, router.productClient, router.orderClient, router.purchaseLimitLookback, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return cart.ToProto(), nil
+}
+
+func (router *cartRoutes) GetCartSummary(ctx context.Context, req *pbCart.GetCartSummaryRequest) (*pbCart.CartSummaryResponse, error) {
+	summary, err := controller.GetCartSummary(ctx, router.cartUsecase, router.productClient, router.orderClient, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return summary.ToProto(), nil
+}
+
+func (router *cartRoutes) BatchUpdateCart(ctx context.Context, req *pbCart.BatchUpdateCartRequest) (*pbCart.CartResponse, error) {
+	cart, err := controller.BatchUpdateCart(ctx, router.cartUsecase, router.productClient, router.orderClient, router.purchaseLimitLookback, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return cart.ToProto(), nil
+}
+
+func (router *cartRoutes) RestoreAbandonedCart(ctx context.Context, req *pbCart.RestoreAbandonedCartRequest) (*pbCart.CartResponse, error) {
+	cart, err := controller.RestoreAbandonedCart(ctx, router.cartUsecase, router.productClient, router.orderClient, router.purchaseLimitLookback, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return cart.ToProto(), nil
+}
+
+func (router *cartRoutes) GetAbandonedCartMetrics(ctx context.Context, req *pbCart.GetAbandonedCartMetricsRequest) (*pbCart.AbandonedCartMetricsResponse, error) {
+	metrics, err := controller.GetAbandonedCartMetrics(ctx, router.cartUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	protoMetrics := make([]*pbCart.AbandonedCartMetricResponse, 0, len(metrics))
+	for _, metric := range metrics {
+		protoMetrics = append(protoMetrics, metric.ToProto())
+	}
+
+	return &pbCart.AbandonedCartMetricsResponse{
+		Metrics: protoMetrics,
+	}, nil
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/Go-Marketplace/backend/pkg/postgres"
 	"github.com/Go-Marketplace/backend/pkg/redis"
 	pbCart "github.com/Go-Marketplace/backend/proto/gen/cart"
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
 	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
 	"github.com/xiam/to"
 	"google.golang.org/grpc"
@@ -55,10 +56,39 @@ func Run(cfg *config.Config) {
 
 	productClient := pbProduct.NewProductClient(productConn)
 
+	// Create order client
+	orderConn, err := grpc.Dial(
+		fmt.Sprintf("%s:%v", cfg.OrderConfig.GRPC.Host, cfg.OrderConfig.GRPC.Port),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		log.Fatalf("failed to create orderConn: %v", err)
+	}
+	defer orderConn.Close()
+
+	orderClient := pbOrder.NewOrderClient(orderConn)
+
 	cartRepo := repository.NewCartRepo(pg, logger)
 	cartTaskRepo := repository.NewCartTaskRepo(redis, logger)
-	cartUsecase := usecase.NewCartUsecase(cartRepo, cartTaskRepo, logger)
-	cartHandler := handler.NewCartRoutes(cartUsecase, productClient, logger)
+	abandonedCartRepo := repository.NewAbandonedCartRepo(pg, logger)
+	cartUsecase := usecase.NewCartUsecase(
+		cartRepo,
+		cartTaskRepo,
+		abandonedCartRepo,
+		usecase.CartExpiryConfig{
+			CartTTL:       to.Duration(cfg.CartConfig.CartTaskWorker.CartTTL),
+			ExpiryNotice:  to.Duration(cfg.CartConfig.CartTaskWorker.ExpiryNotice),
+			RecoveryDelay: to.Duration(cfg.CartConfig.CartTaskWorker.RecoveryDelay),
+		},
+		logger,
+	)
+	cartHandler := handler.NewCartRoutes(
+		cartUsecase,
+		productClient,
+		orderClient,
+		to.Duration(cfg.CartConfig.PurchaseLimit.Lookback),
+		logger,
+	)
 
 	interceptor := interceptors.NewInterceptorManager(logger)
 	grpcServer, err := grpcserver.New(
@@ -84,8 +114,8 @@ func Run(cfg *config.Config) {
 		cartUsecase,
 		productClient,
 		worker.CartTaskWorkerConfig{
-			CartTTL:                to.Duration(cfg.CartConfig.CartTaskWorker.CartTTL),
 			CartTaskWorkerInterval: to.Duration(cfg.CartConfig.CartTaskWorker.Interval),
+			RestoreURL:             cfg.CartConfig.CartTaskWorker.RestoreURL,
 		},
 		logger,
 	)
@@ -0,0 +1,16 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/Go-Marketplace/backend/cart/internal/api/grpc/dto"
+	"github.com/Go-Marketplace/backend/cart/internal/model"
+	"github.com/google/uuid"
+)
+
+type AbandonedCartRepo interface {
+	CreateAbandonedCart(ctx context.Context, abandonedCart *model.AbandonedCart) error
+	GetAbandonedCart(ctx context.Context, abandonedCartID uuid.UUID) (*model.AbandonedCart, error)
+	SetAbandonedCartRestored(ctx context.Context, abandonedCartID uuid.UUID, restored bool) error
+	GetAbandonedCartMetrics(ctx context.Context, searchParams dto.SearchAbandonedCartsDTO) ([]*model.AbandonedCartMetric, error)
+}
@@ -12,11 +12,13 @@ type CartRepo interface {
 	CreateCart(ctx context.Context, cart model.Cart) error
 	DeleteCart(ctx context.Context, userID uuid.UUID) error
 	DeleteCartCartlines(ctx context.Context, userID uuid.UUID) error
+	SetCartPromoCode(ctx context.Context, userID uuid.UUID, code string) error
 
 	GetCartline(ctx context.Context, userID, productID uuid.UUID) (*model.CartLine, error)
 	CreateCartline(ctx context.Context, cartline *model.CartLine) error
 	CreateCartlines(ctx context.Context, cartlines []*model.CartLine) error
 	UpdateCartline(ctx context.Context, cartline model.CartLine) error
+	UpdateCartlines(ctx context.Context, userID uuid.UUID, cartlines []*model.CartLine) error
 	DeleteCartline(ctx context.Context, userID uuid.UUID, productID uuid.UUID) error
-	DeleteProductCartlines(ctx context.Context, productID uuid.UUID) error
+	DeleteProductCartlines(ctx context.Context, productID uuid.UUID) ([]*model.CartLine, error)
 }
@@ -4,9 +4,14 @@ import (
 	"context"
 
 	"github.com/Go-Marketplace/backend/cart/internal/model"
+	"github.com/google/uuid"
 )
 
 type CartTaskRepo interface {
 	CreateCartTask(ctx context.Context, task model.CartTask) error
+	GetCartTask(ctx context.Context, userID uuid.UUID) (*model.CartTask, error)
 	GetCartTasks(ctx context.Context, to int64) ([]*model.CartTask, error)
+	GetCartReminders(ctx context.Context, to int64) ([]*model.CartTask, error)
+	CreateRecoveryTask(ctx context.Context, task model.RecoveryTask) error
+	GetRecoveryTasks(ctx context.Context, to int64) ([]*model.RecoveryTask, error)
 }
@@ -0,0 +1,194 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Go-Marketplace/backend/cart/internal/api/grpc/dto"
+	"github.com/Go-Marketplace/backend/cart/internal/model"
+	"github.com/Go-Marketplace/backend/pkg/logger"
+	"github.com/Go-Marketplace/backend/pkg/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type AbandonedCartRepo struct {
+	pg     *postgres.Postgres
+	logger *logger.Logger
+}
+
+func NewAbandonedCartRepo(pg *postgres.Postgres, logger *logger.Logger) *AbandonedCartRepo {
+	return &AbandonedCartRepo{
+		pg:     pg,
+		logger: logger,
+	}
+}
+
+func scanAbandonedCart(rows pgx.Rows, abandonedCart *model.AbandonedCart) error {
+	return rows.Scan(
+		&abandonedCart.ID,
+		&abandonedCart.UserID,
+		&abandonedCart.Value,
+		&abandonedCart.CreatedAt,
+		&abandonedCart.AbandonedAt,
+		&abandonedCart.RestoredAt,
+	)
+}
+
+func scanAbandonedCartline(rows pgx.Rows, abandonedCartline *model.AbandonedCartline) error {
+	return rows.Scan(
+		&abandonedCartline.AbandonedCartID,
+		&abandonedCartline.ProductID,
+		&abandonedCartline.CategoryID,
+		&abandonedCartline.Quantity,
+		&abandonedCartline.Price,
+	)
+}
+
+func scanAbandonedCartMetric(rows pgx.Rows, metric *model.AbandonedCartMetric) error {
+	return rows.Scan(
+		&metric.Day,
+		&metric.CategoryID,
+		&metric.Carts,
+		&metric.Quantity,
+		&metric.Value,
+		&metric.RestoredCarts,
+	)
+}
+
+func (repo *AbandonedCartRepo) CreateAbandonedCart(ctx context.Context, abandonedCart *model.AbandonedCart) error {
+	conn, err := repo.pg.Pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to Acquire in CreateAbandonedCart: %w", err)
+	}
+	defer conn.Release()
+
+	tx, err := conn.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to begin CreateAbandonedCart transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				repo.logger.Error("failed to rollback transaction", err)
+			}
+		} else {
+			if err := tx.Commit(ctx); err != nil {
+				repo.logger.Error("failed to commit transaction", err)
+			}
+		}
+	}()
+
+	sqlQuery, args, err := createAbandonedCartQuery(abandonedCart).ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec createAbandonedCart: %w", err)
+	}
+
+	for _, abandonedCartline := range abandonedCart.Cartlines {
+		sqlQuery, args, err = createAbandonedCartlineQuery(abandonedCartline).ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to get sql query: %w", err)
+		}
+
+		if _, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+			return fmt.Errorf("failed to Exec createAbandonedCartline: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (repo *AbandonedCartRepo) GetAbandonedCart(ctx context.Context, abandonedCartID uuid.UUID) (*model.AbandonedCart, error) {
+	sqlQuery, args, err := getAbandonedCartQuery(abandonedCartID).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Query getAbandonedCart: %w", err)
+	}
+	defer rows.Close()
+
+	abandonedCart := &model.AbandonedCart{}
+	found := false
+	for rows.Next() {
+		if err = scanAbandonedCart(rows, abandonedCart); err != nil {
+			return nil, fmt.Errorf("failed to scan abandoned cart: %w", err)
+		}
+		found = true
+	}
+
+	if !found {
+		return nil, nil
+	}
+
+	sqlQuery, args, err = getAbandonedCartlinesQuery(abandonedCartID).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	lineRows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Query getAbandonedCartlines: %w", err)
+	}
+	defer lineRows.Close()
+
+	for lineRows.Next() {
+		abandonedCartline := &model.AbandonedCartline{}
+		if err = scanAbandonedCartline(lineRows, abandonedCartline); err != nil {
+			return nil, fmt.Errorf("failed to scan abandoned cartline: %w", err)
+		}
+		abandonedCart.Cartlines = append(abandonedCart.Cartlines, abandonedCartline)
+	}
+
+	return abandonedCart, nil
+}
+
+// Marks the abandoned cart restored, model.ErrAlreadyRestored is returned when it already is,
+// so only one restore of the cart goes through. Unmarking is used when the restore failed
+func (repo *AbandonedCartRepo) SetAbandonedCartRestored(ctx context.Context, abandonedCartID uuid.UUID, restored bool) error {
+	sqlQuery, args, err := setAbandonedCartRestoredQuery(abandonedCartID, restored).ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	tag, err := repo.pg.Pool.Exec(ctx, sqlQuery, args...)
+	if err != nil {
+		return fmt.Errorf("failed to Exec setAbandonedCartRestored: %w", err)
+	}
+
+	if restored && tag.RowsAffected() == 0 {
+		return model.ErrAlreadyRestored
+	}
+
+	return nil
+}
+
+func (repo *AbandonedCartRepo) GetAbandonedCartMetrics(ctx context.Context, searchParams dto.SearchAbandonedCartsDTO) ([]*model.AbandonedCartMetric, error) {
+	sqlQuery, args, err := getAbandonedCartMetricsQuery(searchParams).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Query getAbandonedCartMetrics: %w", err)
+	}
+	defer rows.Close()
+
+	metrics := make([]*model.AbandonedCartMetric, 0)
+	for rows.Next() {
+		metric := &model.AbandonedCartMetric{}
+		if err = scanAbandonedCartMetric(rows, metric); err != nil {
+			return nil, fmt.Errorf("failed to scan abandoned cart metric: %w", err)
+		}
+		metrics = append(metrics, metric)
+	}
+
+	return metrics, nil
+}
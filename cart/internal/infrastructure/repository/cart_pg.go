@@ -28,6 +28,8 @@ func scanCart(rows pgx.Rows, cart *model.Cart) error {
 		&cart.UserID,
 		&cart.CreatedAt,
 		&cart.UpdatedAt,
+		&cart.PromoCode,
+		&cart.Guest,
 	)
 }
 
@@ -36,9 +38,12 @@ func scanFullCart(rows pgx.Rows, cart *model.Cart, cartline *model.CartLine) err
 		&cart.UserID,
 		&cart.CreatedAt,
 		&cart.UpdatedAt,
+		&cart.PromoCode,
+		&cart.Guest,
 		&cartline.UserID,
 		&cartline.ProductID,
 		&cartline.Quantity,
+		&cartline.WarehouseID,
 		&cartline.CreatedAt,
 		&cartline.UpdatedAt,
 	)
@@ -49,6 +54,7 @@ func scanCartline(rows pgx.Rows, cartline *model.CartLine) error {
 		&cartline.UserID,
 		&cartline.ProductID,
 		&cartline.Quantity,
+		&cartline.WarehouseID,
 		&cartline.CreatedAt,
 		&cartline.UpdatedAt,
 	)
@@ -167,6 +173,21 @@ func (repo *CartRepo) CreateCart(ctx context.Context, cart model.Cart) error {
 	return nil
 }
 
+func (repo *CartRepo) SetCartPromoCode(ctx context.Context, userID uuid.UUID, code string) error {
+	query := setCartPromoCodeQuery(userID, code)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = repo.pg.Pool.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec setCartPromoCode: %w", err)
+	}
+
+	return nil
+}
+
 func updateCartInTx(ctx context.Context, tx pgx.Tx, userID uuid.UUID) error {
 	query := updateCartQuery(userID)
 
@@ -283,6 +304,54 @@ func (repo *CartRepo) UpdateCartline(ctx context.Context, cartline model.CartLin
 	return nil
 }
 
+// Upserts the cartlines and deletes the ones with zero quantity in one transaction
+func (repo *CartRepo) UpdateCartlines(ctx context.Context, userID uuid.UUID, cartlines []*model.CartLine) error {
+	conn, err := repo.pg.Pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to Acquire in UpdateCartlines: %w", err)
+	}
+	defer conn.Release()
+
+	tx, err := conn.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to begin UpdateCartlines transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				repo.logger.Error("failed to rollback transaction", err)
+			}
+		} else {
+			if err := tx.Commit(ctx); err != nil {
+				repo.logger.Error("failed to commit transaction", err)
+			}
+		}
+	}()
+
+	for _, cartline := range cartlines {
+		var sqlQuery string
+		var args []interface{}
+		if cartline.Quantity == 0 {
+			sqlQuery, args, err = deleteCartlineQuery(cartline.UserID, cartline.ProductID).ToSql()
+		} else {
+			sqlQuery, args, err = upsertCartlineQuery(cartline).ToSql()
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get sql query: %w", err)
+		}
+
+		if _, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+			return fmt.Errorf("failed to Exec updateCartlines: %w", err)
+		}
+	}
+
+	if err = updateCartInTx(ctx, tx, userID); err != nil {
+		return fmt.Errorf("failed to update cart in transaction: %w", err)
+	}
+
+	return nil
+}
+
 func (repo *CartRepo) DeleteCart(ctx context.Context, userID uuid.UUID) error {
 	query := deleteCartQuery(userID)
 
@@ -339,19 +408,34 @@ func (repo *CartRepo) DeleteCartline(ctx context.Context, userID uuid.UUID, prod
 	return nil
 }
 
-func (repo *CartRepo) DeleteProductCartlines(ctx context.Context, productID uuid.UUID) error {
+func (repo *CartRepo) DeleteProductCartlines(ctx context.Context, productID uuid.UUID) ([]*model.CartLine, error) {
 	query := deleteProductCartlinesQuery(productID)
 
 	sqlQuery, args, err := query.ToSql()
 	if err != nil {
-		return fmt.Errorf("failed to get sql query")
+		return nil, fmt.Errorf("failed to get sql query")
 	}
 
-	if _, err = repo.pg.Pool.Exec(ctx, sqlQuery, args...); err != nil {
-		return fmt.Errorf("failed to Exec deleteProductCartlines: %w", err)
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Query deleteProductCartlines: %w", err)
 	}
+	defer rows.Close()
 
-	return nil
+	cartlines := make([]*model.CartLine, 0)
+	for rows.Next() {
+		cartline := &model.CartLine{}
+		if err = scanCartline(rows, cartline); err != nil {
+			return nil, fmt.Errorf("failed to scan cartline: %w", err)
+		}
+		cartlines = append(cartlines, cartline)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read deleted cartlines: %w", err)
+	}
+
+	return cartlines, nil
 }
 
 func (repo *CartRepo) DeleteCartCartlines(ctx context.Context, userID uuid.UUID) error {
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 
@@ -9,8 +10,12 @@ import (
 	"github.com/Go-Marketplace/backend/pkg/logger"
 	redisWrap "github.com/Go-Marketplace/backend/pkg/redis"
 	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
 )
 
+// Cart tasks, reminders and recovery tasks are sorted sets scored by the time they are due.
+// The member of cart tasks and reminders is the user id, so scheduling the cart again
+// moves its task instead of adding one more
 type CartTaskRepo struct {
 	redis  *redisWrap.Redis
 	logger *logger.Logger
@@ -24,17 +29,22 @@ func NewCartTaskRepo(redis *redisWrap.Redis, logger *logger.Logger) *CartTaskRep
 }
 
 func (repo *CartTaskRepo) CreateCartTask(ctx context.Context, task model.CartTask) error {
-	taskBinary, err := task.MarshalBinary()
-	if err != nil {
-		return err
-	}
-
-	z := &redis.Z{
+	pipe := repo.redis.Client.TxPipeline()
+	pipe.ZAdd(ctx, cartTasksKey, &redis.Z{
 		Score:  float64(task.Timestamp),
-		Member: taskBinary,
+		Member: task.UserID.String(),
+	})
+
+	if task.NotifyAt != 0 {
+		pipe.ZAdd(ctx, cartRemindersKey, &redis.Z{
+			Score:  float64(task.NotifyAt),
+			Member: task.UserID.String(),
+		})
+	} else {
+		pipe.ZRem(ctx, cartRemindersKey, task.UserID.String())
 	}
-	err = repo.redis.Client.ZAdd(ctx, cartTasksKey, z).Err()
-	if err != nil {
+
+	if _, err := pipe.Exec(ctx); err != nil {
 		return fmt.Errorf("failed to ZAdd new task: %w", err)
 	}
 
@@ -43,30 +53,91 @@ func (repo *CartTaskRepo) CreateCartTask(ctx context.Context, task model.CartTas
 	return nil
 }
 
-func (repo *CartTaskRepo) GetCartTasks(ctx context.Context, to int64) ([]*model.CartTask, error) {
-	pipe := repo.redis.Client.TxPipeline()
-	pipe.ZRangeByScore(ctx, cartTasksKey, &redis.ZRangeBy{
+func (repo *CartTaskRepo) GetCartTask(ctx context.Context, userID uuid.UUID) (*model.CartTask, error) {
+	score, err := repo.redis.Client.ZScore(ctx, cartTasksKey, userID.String()).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to ZScore cart task: %w", err)
+	}
+
+	return &model.CartTask{
+		UserID:    userID,
+		Timestamp: int64(score),
+	}, nil
+}
+
+// Pops the due members of the sorted set
+func popDueCartTasks(ctx context.Context, client *redis.Client, key string, to int64) ([]redis.Z, error) {
+	pipe := client.TxPipeline()
+	pipe.ZRangeByScoreWithScores(ctx, key, &redis.ZRangeBy{
 		Min: "-inf",
 		Max: strconv.FormatInt(to, 10),
 	})
-	pipe.ZRemRangeByScore(ctx, cartTasksKey, "-inf", strconv.FormatInt(to, 10))
+	pipe.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(to, 10))
 
 	response, err := pipe.Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return response[0].(*redis.ZSliceCmd).Result()
+}
+
+// Parses the member of the sorted set, tasks created before the member became
+// the user id are stored as whole json tasks
+func parseCartTaskMember(member interface{}) (*model.CartTask, error) {
+	value, ok := member.(string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected cart task member: %v", member)
+	}
+
+	if userID, err := uuid.Parse(value); err == nil {
+		return &model.CartTask{UserID: userID}, nil
+	}
+
+	task := &model.CartTask{}
+	if err := task.UnmarshalBinary([]byte(value)); err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
+
+func (repo *CartTaskRepo) GetCartTasks(ctx context.Context, to int64) ([]*model.CartTask, error) {
+	data, err := popDueCartTasks(ctx, repo.redis.Client, cartTasksKey, to)
 	if err != nil {
 		return nil, fmt.Errorf("failed to Exec getCartTasks: %w", err)
 	}
 
-	data, err := response[0].(*redis.StringSliceCmd).Result()
+	tasks := make([]*model.CartTask, 0, len(data))
+	for _, val := range data {
+		task, err := parseCartTaskMember(val.Member)
+		if err != nil {
+			return nil, err
+		}
+		task.Timestamp = int64(val.Score)
+
+		tasks = append(tasks, task)
+	}
+
+	return tasks, nil
+}
+
+func (repo *CartTaskRepo) GetCartReminders(ctx context.Context, to int64) ([]*model.CartTask, error) {
+	data, err := popDueCartTasks(ctx, repo.redis.Client, cartRemindersKey, to)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read CartTasks: %w", err)
+		return nil, fmt.Errorf("failed to Exec getCartReminders: %w", err)
 	}
 
 	tasks := make([]*model.CartTask, 0, len(data))
 	for _, val := range data {
-		task := &model.CartTask{}
-		if err := task.UnmarshalBinary([]byte(val)); err != nil {
+		task, err := parseCartTaskMember(val.Member)
+		if err != nil {
 			return nil, err
 		}
+		task.NotifyAt = int64(val.Score)
 
 		tasks = append(tasks, task)
 	}
@@ -74,4 +145,46 @@ func (repo *CartTaskRepo) GetCartTasks(ctx context.Context, to int64) ([]*model.
 	return tasks, nil
 }
 
-const cartTasksKey = "cart-tasks"
+func (repo *CartTaskRepo) CreateRecoveryTask(ctx context.Context, task model.RecoveryTask) error {
+	if err := repo.redis.Client.ZAdd(ctx, cartRecoveriesKey, &redis.Z{
+		Score:  float64(task.Timestamp),
+		Member: task.AbandonedCartID.String(),
+	}).Err(); err != nil {
+		return fmt.Errorf("failed to ZAdd new recovery task: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *CartTaskRepo) GetRecoveryTasks(ctx context.Context, to int64) ([]*model.RecoveryTask, error) {
+	data, err := popDueCartTasks(ctx, repo.redis.Client, cartRecoveriesKey, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Exec getRecoveryTasks: %w", err)
+	}
+
+	tasks := make([]*model.RecoveryTask, 0, len(data))
+	for _, val := range data {
+		member, ok := val.Member.(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected recovery task member: %v", val.Member)
+		}
+
+		abandonedCartID, err := uuid.Parse(member)
+		if err != nil {
+			return nil, fmt.Errorf("invalid recovery task member: %w", err)
+		}
+
+		tasks = append(tasks, &model.RecoveryTask{
+			AbandonedCartID: abandonedCartID,
+			Timestamp:       int64(val.Score),
+		})
+	}
+
+	return tasks, nil
+}
+
+const (
+	cartTasksKey      = "cart-tasks"
+	cartRemindersKey  = "cart-reminders"
+	cartRecoveriesKey = "cart-recoveries"
+)
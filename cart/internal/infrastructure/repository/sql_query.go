@@ -3,6 +3,7 @@ package repository
 import (
 	"time"
 
+	"github.com/Go-Marketplace/backend/cart/internal/api/grpc/dto"
 	"github.com/Go-Marketplace/backend/cart/internal/model"
 	sq "github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
@@ -15,6 +16,8 @@ func getCartsQuery() sq.SelectBuilder {
 		"user_id",
 		"created_at",
 		"updated_at",
+		"promo_code",
+		"guest",
 	).
 		From("carts")
 }
@@ -24,9 +27,12 @@ func getFullCartsQuery() sq.SelectBuilder {
 		"carts.user_id",
 		"carts.created_at",
 		"carts.updated_at",
+		"carts.promo_code",
+		"carts.guest",
 		"cartlines.user_id",
 		"cartlines.product_id",
 		"cartlines.quantity",
+		"cartlines.warehouse_id",
 		"cartlines.created_at",
 		"cartlines.updated_at",
 	).
@@ -54,11 +60,15 @@ func createCartQuery(cart model.Cart) sq.InsertBuilder {
 			"user_id",
 			"created_at",
 			"updated_at",
+			"promo_code",
+			"guest",
 		).
 		Values(
 			cart.UserID,
 			cart.CreatedAt,
 			cart.UpdatedAt,
+			cart.PromoCode,
+			cart.Guest,
 		)
 }
 
@@ -70,11 +80,17 @@ func updateCartQuery(userID uuid.UUID) sq.UpdateBuilder {
 		})
 }
 
+func setCartPromoCodeQuery(userID uuid.UUID, code string) sq.UpdateBuilder {
+	return updateCartQuery(userID).
+		Set("promo_code", code)
+}
+
 func getCartlines() sq.SelectBuilder {
 	return psql.Select(
 		"user_id",
 		"product_id",
 		"quantity",
+		"warehouse_id",
 		"created_at",
 		"updated_at",
 	).
@@ -99,6 +115,7 @@ func createCartlineQuery(cartline *model.CartLine) sq.InsertBuilder {
 			"user_id",
 			"product_id",
 			"quantity",
+			"warehouse_id",
 			"created_at",
 			"updated_at",
 		).
@@ -106,11 +123,18 @@ func createCartlineQuery(cartline *model.CartLine) sq.InsertBuilder {
 			cartline.UserID,
 			cartline.ProductID,
 			cartline.Quantity,
+			cartline.WarehouseID,
 			cartline.CreatedAt,
 			cartline.UpdatedAt,
 		)
 }
 
+// Inserts the cartline or sets the quantity of the existing one, its warehouse is kept
+func upsertCartlineQuery(cartline *model.CartLine) sq.InsertBuilder {
+	return createCartlineQuery(cartline).
+		Suffix("ON CONFLICT (user_id, product_id) DO UPDATE SET quantity = EXCLUDED.quantity, updated_at = EXCLUDED.updated_at")
+}
+
 func updateCartlineQuery(cartline model.CartLine) sq.UpdateBuilder {
 	query := psql.Update("cartlines")
 
@@ -149,11 +173,13 @@ func deleteCartlineQuery(userID uuid.UUID, productID uuid.UUID) sq.DeleteBuilder
 		})
 }
 
+// Returns the deleted cartlines, so that their reserved stock can be released
 func deleteProductCartlinesQuery(productID uuid.UUID) sq.DeleteBuilder {
 	return psql.Delete("cartlines").
 		Where(sq.Eq{
 			"product_id": productID,
-		})
+		}).
+		Suffix("RETURNING user_id, product_id, quantity, warehouse_id, created_at, updated_at")
 }
 
 func deleteCartCartlinesQuery(userID uuid.UUID) sq.DeleteBuilder {
@@ -162,3 +188,114 @@ func deleteCartCartlinesQuery(userID uuid.UUID) sq.DeleteBuilder {
 			"user_id": userID,
 		})
 }
+
+func createAbandonedCartQuery(abandonedCart *model.AbandonedCart) sq.InsertBuilder {
+	return psql.Insert("abandoned_carts").
+		Columns(
+			"abandoned_cart_id",
+			"user_id",
+			"value",
+			"created_at",
+			"abandoned_at",
+		).
+		Values(
+			abandonedCart.ID,
+			abandonedCart.UserID,
+			abandonedCart.Value,
+			abandonedCart.CreatedAt,
+			abandonedCart.AbandonedAt,
+		)
+}
+
+func createAbandonedCartlineQuery(abandonedCartline *model.AbandonedCartline) sq.InsertBuilder {
+	return psql.Insert("abandoned_cartlines").
+		Columns(
+			"abandoned_cart_id",
+			"product_id",
+			"category_id",
+			"quantity",
+			"price",
+		).
+		Values(
+			abandonedCartline.AbandonedCartID,
+			abandonedCartline.ProductID,
+			abandonedCartline.CategoryID,
+			abandonedCartline.Quantity,
+			abandonedCartline.Price,
+		)
+}
+
+func getAbandonedCartQuery(abandonedCartID uuid.UUID) sq.SelectBuilder {
+	return psql.Select(
+		"abandoned_cart_id",
+		"user_id",
+		"value",
+		"created_at",
+		"abandoned_at",
+		"restored_at",
+	).
+		From("abandoned_carts").
+		Where(sq.Eq{
+			"abandoned_cart_id": abandonedCartID,
+		})
+}
+
+func getAbandonedCartlinesQuery(abandonedCartID uuid.UUID) sq.SelectBuilder {
+	return psql.Select(
+		"abandoned_cart_id",
+		"product_id",
+		"category_id",
+		"quantity",
+		"price",
+	).
+		From("abandoned_cartlines").
+		Where(sq.Eq{
+			"abandoned_cart_id": abandonedCartID,
+		})
+}
+
+func setAbandonedCartRestoredQuery(abandonedCartID uuid.UUID, restored bool) sq.UpdateBuilder {
+	query := psql.Update("abandoned_carts").
+		Where(sq.Eq{
+			"abandoned_cart_id": abandonedCartID,
+		})
+
+	if !restored {
+		return query.Set("restored_at", nil)
+	}
+
+	return query.
+		Set("restored_at", time.Now()).
+		Where(sq.Eq{
+			"restored_at": nil,
+		})
+}
+
+func getAbandonedCartMetricsQuery(searchParams dto.SearchAbandonedCartsDTO) sq.SelectBuilder {
+	query := psql.Select(
+		"date_trunc('day', abandoned_carts.abandoned_at) AS day",
+		"abandoned_cartlines.category_id",
+		"COUNT(DISTINCT abandoned_carts.abandoned_cart_id)",
+		"SUM(abandoned_cartlines.quantity)::BIGINT",
+		"SUM(abandoned_cartlines.quantity * abandoned_cartlines.price)::BIGINT",
+		"COUNT(DISTINCT abandoned_carts.abandoned_cart_id) FILTER (WHERE abandoned_carts.restored_at IS NOT NULL)",
+	).
+		From("abandoned_carts").
+		Join("abandoned_cartlines USING (abandoned_cart_id)").
+		Where(sq.GtOrEq{
+			"abandoned_carts.abandoned_at": searchParams.From,
+		}).
+		Where(sq.Lt{
+			"abandoned_carts.abandoned_at": searchParams.To,
+		})
+
+	if searchParams.CategoryID != 0 {
+		query = query.Where(sq.Eq{
+			"abandoned_cartlines.category_id": searchParams.CategoryID,
+		})
+	}
+
+	return query.
+		GroupBy("day", "abandoned_cartlines.category_id").
+		OrderBy("day", "abandoned_cartlines.category_id")
+}
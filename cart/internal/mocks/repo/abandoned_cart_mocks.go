@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: cart/internal/infrastructure/interfaces/abandoned_cart.go
+
+// Package mock_interfaces is a generated GoMock package.
+package mock_interfaces
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/Go-Marketplace/backend/cart/internal/api/grpc/dto"
+	model "github.com/Go-Marketplace/backend/cart/internal/model"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockAbandonedCartRepo is a mock of AbandonedCartRepo interface.
+type MockAbandonedCartRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockAbandonedCartRepoMockRecorder
+}
+
+// MockAbandonedCartRepoMockRecorder is the mock recorder for MockAbandonedCartRepo.
+type MockAbandonedCartRepoMockRecorder struct {
+	mock *MockAbandonedCartRepo
+}
+
+// NewMockAbandonedCartRepo creates a new mock instance.
+func NewMockAbandonedCartRepo(ctrl *gomock.Controller) *MockAbandonedCartRepo {
+	mock := &MockAbandonedCartRepo{ctrl: ctrl}
+	mock.recorder = &MockAbandonedCartRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAbandonedCartRepo) EXPECT() *MockAbandonedCartRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateAbandonedCart mocks base method.
+func (m *MockAbandonedCartRepo) CreateAbandonedCart(ctx context.Context, abandonedCart *model.AbandonedCart) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAbandonedCart", ctx, abandonedCart)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAbandonedCart indicates an expected call of CreateAbandonedCart.
+func (mr *MockAbandonedCartRepoMockRecorder) CreateAbandonedCart(ctx, abandonedCart interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAbandonedCart", reflect.TypeOf((*MockAbandonedCartRepo)(nil).CreateAbandonedCart), ctx, abandonedCart)
+}
+
+// GetAbandonedCart mocks base method.
+func (m *MockAbandonedCartRepo) GetAbandonedCart(ctx context.Context, abandonedCartID uuid.UUID) (*model.AbandonedCart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAbandonedCart", ctx, abandonedCartID)
+	ret0, _ := ret[0].(*model.AbandonedCart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAbandonedCart indicates an expected call of GetAbandonedCart.
+func (mr *MockAbandonedCartRepoMockRecorder) GetAbandonedCart(ctx, abandonedCartID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAbandonedCart", reflect.TypeOf((*MockAbandonedCartRepo)(nil).GetAbandonedCart), ctx, abandonedCartID)
+}
+
+// GetAbandonedCartMetrics mocks base method.
+func (m *MockAbandonedCartRepo) GetAbandonedCartMetrics(ctx context.Context, searchParams dto.SearchAbandonedCartsDTO) ([]*model.AbandonedCartMetric, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAbandonedCartMetrics", ctx, searchParams)
+	ret0, _ := ret[0].([]*model.AbandonedCartMetric)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAbandonedCartMetrics indicates an expected call of GetAbandonedCartMetrics.
+func (mr *MockAbandonedCartRepoMockRecorder) GetAbandonedCartMetrics(ctx, searchParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAbandonedCartMetrics", reflect.TypeOf((*MockAbandonedCartRepo)(nil).GetAbandonedCartMetrics), ctx, searchParams)
+}
+
+// SetAbandonedCartRestored mocks base method.
+func (m *MockAbandonedCartRepo) SetAbandonedCartRestored(ctx context.Context, abandonedCartID uuid.UUID, restored bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAbandonedCartRestored", ctx, abandonedCartID, restored)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAbandonedCartRestored indicates an expected call of SetAbandonedCartRestored.
+func (mr *MockAbandonedCartRepoMockRecorder) SetAbandonedCartRestored(ctx, abandonedCartID, restored interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAbandonedCartRestored", reflect.TypeOf((*MockAbandonedCartRepo)(nil).SetAbandonedCartRestored), ctx, abandonedCartID, restored)
+}
@@ -121,11 +121,12 @@ func (mr *MockCartRepoMockRecorder) DeleteCartline(ctx, userID, productID interf
 }
 
 // DeleteProductCartlines mocks base method.
-func (m *MockCartRepo) DeleteProductCartlines(ctx context.Context, productID uuid.UUID) error {
+func (m *MockCartRepo) DeleteProductCartlines(ctx context.Context, productID uuid.UUID) ([]*model.CartLine, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteProductCartlines", ctx, productID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].([]*model.CartLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // DeleteProductCartlines indicates an expected call of DeleteProductCartlines.
@@ -164,6 +165,20 @@ func (mr *MockCartRepoMockRecorder) GetUserCart(ctx, userID interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserCart", reflect.TypeOf((*MockCartRepo)(nil).GetUserCart), ctx, userID)
 }
 
+// SetCartPromoCode mocks base method.
+func (m *MockCartRepo) SetCartPromoCode(ctx context.Context, userID uuid.UUID, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCartPromoCode", ctx, userID, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCartPromoCode indicates an expected call of SetCartPromoCode.
+func (mr *MockCartRepoMockRecorder) SetCartPromoCode(ctx, userID, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCartPromoCode", reflect.TypeOf((*MockCartRepo)(nil).SetCartPromoCode), ctx, userID, code)
+}
+
 // UpdateCartline mocks base method.
 func (m *MockCartRepo) UpdateCartline(ctx context.Context, cartline model.CartLine) error {
 	m.ctrl.T.Helper()
@@ -177,3 +192,17 @@ func (mr *MockCartRepoMockRecorder) UpdateCartline(ctx, cartline interface{}) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCartline", reflect.TypeOf((*MockCartRepo)(nil).UpdateCartline), ctx, cartline)
 }
+
+// UpdateCartlines mocks base method.
+func (m *MockCartRepo) UpdateCartlines(ctx context.Context, userID uuid.UUID, cartlines []*model.CartLine) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCartlines", ctx, userID, cartlines)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCartlines indicates an expected call of UpdateCartlines.
+func (mr *MockCartRepoMockRecorder) UpdateCartlines(ctx, userID, cartlines interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCartlines", reflect.TypeOf((*MockCartRepo)(nil).UpdateCartlines), ctx, userID, cartlines)
+}
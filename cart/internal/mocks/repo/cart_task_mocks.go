@@ -10,6 +10,7 @@ import (
 
 	model "github.com/Go-Marketplace/backend/cart/internal/model"
 	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
 )
 
 // MockCartTaskRepo is a mock of CartTaskRepo interface.
@@ -49,6 +50,50 @@ func (mr *MockCartTaskRepoMockRecorder) CreateCartTask(ctx, task interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCartTask", reflect.TypeOf((*MockCartTaskRepo)(nil).CreateCartTask), ctx, task)
 }
 
+// CreateRecoveryTask mocks base method.
+func (m *MockCartTaskRepo) CreateRecoveryTask(ctx context.Context, task model.RecoveryTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRecoveryTask", ctx, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRecoveryTask indicates an expected call of CreateRecoveryTask.
+func (mr *MockCartTaskRepoMockRecorder) CreateRecoveryTask(ctx, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecoveryTask", reflect.TypeOf((*MockCartTaskRepo)(nil).CreateRecoveryTask), ctx, task)
+}
+
+// GetCartReminders mocks base method.
+func (m *MockCartTaskRepo) GetCartReminders(ctx context.Context, to int64) ([]*model.CartTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCartReminders", ctx, to)
+	ret0, _ := ret[0].([]*model.CartTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCartReminders indicates an expected call of GetCartReminders.
+func (mr *MockCartTaskRepoMockRecorder) GetCartReminders(ctx, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCartReminders", reflect.TypeOf((*MockCartTaskRepo)(nil).GetCartReminders), ctx, to)
+}
+
+// GetCartTask mocks base method.
+func (m *MockCartTaskRepo) GetCartTask(ctx context.Context, userID uuid.UUID) (*model.CartTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCartTask", ctx, userID)
+	ret0, _ := ret[0].(*model.CartTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCartTask indicates an expected call of GetCartTask.
+func (mr *MockCartTaskRepoMockRecorder) GetCartTask(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCartTask", reflect.TypeOf((*MockCartTaskRepo)(nil).GetCartTask), ctx, userID)
+}
+
 // GetCartTasks mocks base method.
 func (m *MockCartTaskRepo) GetCartTasks(ctx context.Context, to int64) ([]*model.CartTask, error) {
 	m.ctrl.T.Helper()
@@ -63,3 +108,18 @@ func (mr *MockCartTaskRepoMockRecorder) GetCartTasks(ctx, to interface{}) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCartTasks", reflect.TypeOf((*MockCartTaskRepo)(nil).GetCartTasks), ctx, to)
 }
+
+// GetRecoveryTasks mocks base method.
+func (m *MockCartTaskRepo) GetRecoveryTasks(ctx context.Context, to int64) ([]*model.RecoveryTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecoveryTasks", ctx, to)
+	ret0, _ := ret[0].([]*model.RecoveryTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecoveryTasks indicates an expected call of GetRecoveryTasks.
+func (mr *MockCartTaskRepoMockRecorder) GetRecoveryTasks(ctx, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecoveryTasks", reflect.TypeOf((*MockCartTaskRepo)(nil).GetRecoveryTasks), ctx, to)
+}
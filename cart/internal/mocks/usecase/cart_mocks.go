@@ -8,6 +8,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	dto "github.com/Go-Marketplace/backend/cart/internal/api/grpc/dto"
 	model "github.com/Go-Marketplace/backend/cart/internal/model"
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -36,6 +37,20 @@ func (m *MockICartUsecase) EXPECT() *MockICartUsecaseMockRecorder {
 	return m.recorder
 }
 
+// CreateAbandonedCart mocks base method.
+func (m *MockICartUsecase) CreateAbandonedCart(ctx context.Context, abandonedCart *model.AbandonedCart) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAbandonedCart", ctx, abandonedCart)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAbandonedCart indicates an expected call of CreateAbandonedCart.
+func (mr *MockICartUsecaseMockRecorder) CreateAbandonedCart(ctx, abandonedCart interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAbandonedCart", reflect.TypeOf((*MockICartUsecase)(nil).CreateAbandonedCart), ctx, abandonedCart)
+}
+
 // CreateCart mocks base method.
 func (m *MockICartUsecase) CreateCart(ctx context.Context, cart model.Cart) error {
 	m.ctrl.T.Helper()
@@ -121,11 +136,12 @@ func (mr *MockICartUsecaseMockRecorder) DeleteCartline(ctx, userID, productID in
 }
 
 // DeleteProductCartlines mocks base method.
-func (m *MockICartUsecase) DeleteProductCartlines(ctx context.Context, productID uuid.UUID) error {
+func (m *MockICartUsecase) DeleteProductCartlines(ctx context.Context, productID uuid.UUID) ([]*model.CartLine, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteProductCartlines", ctx, productID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].([]*model.CartLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // DeleteProductCartlines indicates an expected call of DeleteProductCartlines.
@@ -134,6 +150,36 @@ func (mr *MockICartUsecaseMockRecorder) DeleteProductCartlines(ctx, productID in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductCartlines", reflect.TypeOf((*MockICartUsecase)(nil).DeleteProductCartlines), ctx, productID)
 }
 
+// GetAbandonedCart mocks base method.
+func (m *MockICartUsecase) GetAbandonedCart(ctx context.Context, abandonedCartID uuid.UUID) (*model.AbandonedCart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAbandonedCart", ctx, abandonedCartID)
+	ret0, _ := ret[0].(*model.AbandonedCart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAbandonedCart indicates an expected call of GetAbandonedCart.
+func (mr *MockICartUsecaseMockRecorder) GetAbandonedCart(ctx, abandonedCartID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAbandonedCart", reflect.TypeOf((*MockICartUsecase)(nil).GetAbandonedCart), ctx, abandonedCartID)
+}
+
+// GetAbandonedCartMetrics mocks base method.
+func (m *MockICartUsecase) GetAbandonedCartMetrics(ctx context.Context, searchParams dto.SearchAbandonedCartsDTO) ([]*model.AbandonedCartMetric, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAbandonedCartMetrics", ctx, searchParams)
+	ret0, _ := ret[0].([]*model.AbandonedCartMetric)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAbandonedCartMetrics indicates an expected call of GetAbandonedCartMetrics.
+func (mr *MockICartUsecaseMockRecorder) GetAbandonedCartMetrics(ctx, searchParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAbandonedCartMetrics", reflect.TypeOf((*MockICartUsecase)(nil).GetAbandonedCartMetrics), ctx, searchParams)
+}
+
 // GetCartline mocks base method.
 func (m *MockICartUsecase) GetCartline(ctx context.Context, userID, productID uuid.UUID) (*model.CartLine, error) {
 	m.ctrl.T.Helper()
@@ -164,6 +210,35 @@ func (mr *MockICartUsecaseMockRecorder) GetUserCart(ctx, userID interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserCart", reflect.TypeOf((*MockICartUsecase)(nil).GetUserCart), ctx, userID)
 }
 
+// SetAbandonedCartRestored mocks base method.
+func (m *MockICartUsecase) SetAbandonedCartRestored(ctx context.Context, abandonedCartID uuid.UUID, restored bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAbandonedCartRestored", ctx, abandonedCartID, restored)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAbandonedCartRestored indicates an expected call of SetAbandonedCartRestored.
+func (mr *MockICartUsecaseMockRecorder) SetAbandonedCartRestored(ctx, abandonedCartID, restored interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAbandonedCartRestored", reflect.TypeOf((*MockICartUsecase)(nil).SetAbandonedCartRestored), ctx, abandonedCartID, restored)
+}
+
+// SetPromoCode mocks base method.
+func (m *MockICartUsecase) SetPromoCode(ctx context.Context, userID uuid.UUID, code string) (*model.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPromoCode", ctx, userID, code)
+	ret0, _ := ret[0].(*model.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPromoCode indicates an expected call of SetPromoCode.
+func (mr *MockICartUsecaseMockRecorder) SetPromoCode(ctx, userID, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPromoCode", reflect.TypeOf((*MockICartUsecase)(nil).SetPromoCode), ctx, userID, code)
+}
+
 // UpdateCartline mocks base method.
 func (m *MockICartUsecase) UpdateCartline(ctx context.Context, cartline model.CartLine) (*model.CartLine, error) {
 	m.ctrl.T.Helper()
@@ -178,3 +253,18 @@ func (mr *MockICartUsecaseMockRecorder) UpdateCartline(ctx, cartline interface{}
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCartline", reflect.TypeOf((*MockICartUsecase)(nil).UpdateCartline), ctx, cartline)
 }
+
+// UpdateCartlines mocks base method.
+func (m *MockICartUsecase) UpdateCartlines(ctx context.Context, userID uuid.UUID, cartlines []*model.CartLine) (*model.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCartlines", ctx, userID, cartlines)
+	ret0, _ := ret[0].(*model.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCartlines indicates an expected call of UpdateCartlines.
+func (mr *MockICartUsecaseMockRecorder) UpdateCartlines(ctx, userID, cartlines interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCartlines", reflect.TypeOf((*MockICartUsecase)(nil).UpdateCartlines), ctx, userID, cartlines)
+}
@@ -0,0 +1,85 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	pbCart "github.com/Go-Marketplace/backend/proto/gen/cart"
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var ErrAlreadyRestored = errors.New("abandoned cart is already restored")
+
+// Represents the contents of the cart at the moment it expired
+type AbandonedCart struct {
+	ID          uuid.UUID  `json:"abandoned_cart_id"`
+	UserID      uuid.UUID  `json:"user_id"`
+	Value       int64      `json:"value"`
+	CreatedAt   time.Time  `json:"created_at"`
+	AbandonedAt time.Time  `json:"abandoned_at"`
+	RestoredAt  *time.Time `json:"restored_at"`
+
+	Cartlines []*AbandonedCartline
+}
+
+// Represents the abandoned cart line with the product price and category it had when the cart expired
+type AbandonedCartline struct {
+	AbandonedCartID uuid.UUID `json:"abandoned_cart_id"`
+	ProductID       uuid.UUID `json:"product_id"`
+	CategoryID      int32     `json:"category_id"`
+	Quantity        int64     `json:"quantity"`
+	Price           int64     `json:"price"`
+}
+
+// Creates the abandoned cart from the expired cart, products go in the order of the cartlines
+// and are nil for removed products, which count with zero price
+func NewAbandonedCart(cart *Cart, products []*pbProduct.ProductResponse) *AbandonedCart {
+	abandonedCart := &AbandonedCart{
+		ID:          uuid.New(),
+		UserID:      cart.UserID,
+		CreatedAt:   cart.CreatedAt,
+		AbandonedAt: time.Now(),
+		Cartlines:   make([]*AbandonedCartline, 0, len(cart.Cartlines)),
+	}
+
+	for i, cartline := range cart.Cartlines {
+		abandonedCartline := &AbandonedCartline{
+			AbandonedCartID: abandonedCart.ID,
+			ProductID:       cartline.ProductID,
+			Quantity:        cartline.Quantity,
+		}
+
+		if i < len(products) && products[i] != nil {
+			abandonedCartline.CategoryID = products[i].CategoryId
+			abandonedCartline.Price = products[i].Price
+		}
+
+		abandonedCart.Value += abandonedCartline.Price * abandonedCartline.Quantity
+		abandonedCart.Cartlines = append(abandonedCart.Cartlines, abandonedCartline)
+	}
+
+	return abandonedCart
+}
+
+// Represents the abandoned carts of one category abandoned on one day
+type AbandonedCartMetric struct {
+	Day           time.Time `json:"day"`
+	CategoryID    int32     `json:"category_id"`
+	Carts         int64     `json:"carts"`
+	Quantity      int64     `json:"quantity"`
+	Value         int64     `json:"value"`
+	RestoredCarts int64     `json:"restored_carts"`
+}
+
+func (metric *AbandonedCartMetric) ToProto() *pbCart.AbandonedCartMetricResponse {
+	return &pbCart.AbandonedCartMetricResponse{
+		Day:           timestamppb.New(metric.Day),
+		CategoryId:    metric.CategoryID,
+		Carts:         metric.Carts,
+		Quantity:      metric.Quantity,
+		Value:         metric.Value,
+		RestoredCarts: metric.RestoredCarts,
+	}
+}
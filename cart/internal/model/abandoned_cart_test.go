@@ -0,0 +1,43 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/Go-Marketplace/backend/cart/internal/model"
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAbandonedCart(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+
+	cart := &model.Cart{
+		UserID: userID,
+		Cartlines: []*model.CartLine{
+			{UserID: userID, ProductID: uuid.New(), Quantity: 2},
+			{UserID: userID, ProductID: uuid.New(), Quantity: 3},
+		},
+	}
+
+	products := []*pbProduct.ProductResponse{
+		{CategoryId: 7, Price: 150},
+		nil,
+	}
+
+	abandonedCart := model.NewAbandonedCart(cart, products)
+
+	assert.Equal(t, userID, abandonedCart.UserID)
+	assert.Equal(t, int64(300), abandonedCart.Value)
+	assert.Len(t, abandonedCart.Cartlines, 2)
+
+	assert.Equal(t, abandonedCart.ID, abandonedCart.Cartlines[0].AbandonedCartID)
+	assert.Equal(t, int32(7), abandonedCart.Cartlines[0].CategoryID)
+	assert.Equal(t, int64(150), abandonedCart.Cartlines[0].Price)
+
+	assert.Equal(t, cart.Cartlines[1].ProductID, abandonedCart.Cartlines[1].ProductID)
+	assert.Equal(t, int64(3), abandonedCart.Cartlines[1].Quantity)
+	assert.Equal(t, int64(0), abandonedCart.Cartlines[1].Price)
+}
@@ -9,11 +9,17 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-// Represents how the cart structure is stored in the database
+// Represents how the cart structure is stored in the database.
+// A guest cart belongs to an anonymous visitor and UserID is their guest id
 type Cart struct {
 	UserID    uuid.UUID `json:"user_id"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+	PromoCode string    `json:"promo_code"`
+	Guest     bool      `json:"guest"`
+
+	// Comes from the cart task, zero when no cleanup is scheduled
+	ExpiresAt time.Time `json:"expires_at"`
 
 	Cartlines []*CartLine
 }
@@ -24,21 +30,30 @@ func (cart *Cart) ToProto() *pbCart.CartResponse {
 		protoCartlines = append(protoCartlines, cartline.ToProto())
 	}
 
+	var expiresAt *timestamppb.Timestamp
+	if !cart.ExpiresAt.IsZero() {
+		expiresAt = timestamppb.New(cart.ExpiresAt)
+	}
+
 	return &pbCart.CartResponse{
 		UserId:    cart.UserID.String(),
 		Cartlines: protoCartlines,
 		CreatedAt: timestamppb.New(cart.CreatedAt),
 		UpdatedAt: timestamppb.New(cart.UpdatedAt),
+		PromoCode: cart.PromoCode,
+		Guest:     cart.Guest,
+		ExpiresAt: expiresAt,
 	}
 }
 
 // Represents one line with a product in a shopping cart in the database
 type CartLine struct {
-	UserID    uuid.UUID `json:"user_id"`
-	ProductID uuid.UUID `json:"product_id"`
-	Quantity  int64     `json:"quantity" validate:"min=0,max=10000000"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	UserID      uuid.UUID `json:"user_id"`
+	ProductID   uuid.UUID `json:"product_id"`
+	Quantity    int64     `json:"quantity" validate:"min=0,max=10000000"`
+	WarehouseID uuid.UUID `json:"warehouse_id"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
 }
 
 func (cartline *CartLine) Validate() error {
@@ -48,10 +63,11 @@ func (cartline *CartLine) Validate() error {
 
 func (cartline *CartLine) ToProto() *pbCart.CartlineResponse {
 	return &pbCart.CartlineResponse{
-		UserId:    cartline.UserID.String(),
-		ProductId: cartline.ProductID.String(),
-		Quantity:  cartline.Quantity,
-		CreatedAt: timestamppb.New(cartline.CreatedAt),
-		UpdatedAt: timestamppb.New(cartline.UpdatedAt),
+		UserId:      cartline.UserID.String(),
+		ProductId:   cartline.ProductID.String(),
+		Quantity:    cartline.Quantity,
+		WarehouseId: cartline.WarehouseID.String(),
+		CreatedAt:   timestamppb.New(cartline.CreatedAt),
+		UpdatedAt:   timestamppb.New(cartline.UpdatedAt),
 	}
 }
@@ -7,9 +7,12 @@ import (
 	"github.com/google/uuid"
 )
 
+// Represents the scheduled cleanup of the cart. Timestamp is when the cart expires,
+// NotifyAt is when the user is told about it, zero when they are not
 type CartTask struct {
 	UserID    uuid.UUID `json:"user_id"`
 	Timestamp int64     `json:"timestamp"`
+	NotifyAt  int64     `json:"notify_at"`
 }
 
 func (task *CartTask) MarshalBinary() ([]byte, error) {
@@ -23,3 +26,9 @@ func (task *CartTask) UnmarshalBinary(data []byte) error {
 
 	return nil
 }
+
+// Represents the scheduled invitation to restore the abandoned cart
+type RecoveryTask struct {
+	AbandonedCartID uuid.UUID `json:"abandoned_cart_id"`
+	Timestamp       int64     `json:"timestamp"`
+}
@@ -0,0 +1,161 @@
+package model
+
+import (
+	pbCart "github.com/Go-Marketplace/backend/proto/gen/cart"
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+	"github.com/google/uuid"
+)
+
+type CartlineIssue int
+
+const (
+	ProductRemoved CartlineIssue = iota + 1
+	ProductUnmoderated
+	StockShort
+)
+
+// Represents a cartline priced with the current product data.
+// Lines of removed products have no price and are left out of the totals, like at checkout
+type CartSummaryLine struct {
+	ProductID   uuid.UUID
+	WarehouseID uuid.UUID
+	CategoryID  int32
+	Name        string
+	Quantity    int64
+	UnitPrice   int64
+	Discount    *pbProduct.DiscountResponse
+	// Tax rate in percent and the tax added to the discounted line total
+	TaxRate float32
+	Tax     int64
+	Issues  []CartlineIssue
+}
+
+func (line *CartSummaryLine) Subtotal() int64 {
+	return line.UnitPrice * line.Quantity
+}
+
+func (line *CartSummaryLine) DiscountAmount() int64 {
+	if line.Discount == nil {
+		return 0
+	}
+
+	return int64(float64(line.Subtotal()) * float64(line.Discount.Percent) / 100)
+}
+
+// Returns the line total after the product discount, the tax is calculated from it
+func (line *CartSummaryLine) TaxableAmount() int64 {
+	return line.Subtotal() - line.DiscountAmount()
+}
+
+func (line *CartSummaryLine) Total() int64 {
+	return line.TaxableAmount() + line.Tax
+}
+
+func (line *CartSummaryLine) ToProto() *pbCart.CartSummaryLineResponse {
+	issues := make([]pbCart.CartlineIssue, 0, len(line.Issues))
+	for _, issue := range line.Issues {
+		issues = append(issues, pbCart.CartlineIssue(issue))
+	}
+
+	return &pbCart.CartSummaryLineResponse{
+		ProductId:      line.ProductID.String(),
+		WarehouseId:    line.WarehouseID.String(),
+		Name:           line.Name,
+		Quantity:       line.Quantity,
+		UnitPrice:      line.UnitPrice,
+		Discount:       line.Discount,
+		Subtotal:       line.Subtotal(),
+		DiscountAmount: line.DiscountAmount(),
+		Total:          line.Total(),
+		Issues:         issues,
+		TaxRate:        line.TaxRate,
+		Tax:            line.Tax,
+	}
+}
+
+// Represents the cart priced as it would be at checkout, all amounts are in the currency.
+// PromoError explains why the cart promo code no longer applies
+type CartSummary struct {
+	UserID         uuid.UUID
+	Lines          []*CartSummaryLine
+	PromoCode      string
+	PromoDiscount  int64
+	PromoError     string
+	ShippingRegion string
+	Currency       string
+}
+
+func (summary *CartSummary) Subtotal() int64 {
+	var subtotal int64
+	for _, line := range summary.Lines {
+		subtotal += line.Subtotal()
+	}
+
+	return subtotal
+}
+
+// Returns the sum of the product discounts without the promo code discount
+func (summary *CartSummary) Discount() int64 {
+	var discount int64
+	for _, line := range summary.Lines {
+		discount += line.DiscountAmount()
+	}
+
+	return discount
+}
+
+// Returns the sum of the line taxes, the promo code discount does not lower the taxes like at checkout
+func (summary *CartSummary) Tax() int64 {
+	var tax int64
+	for _, line := range summary.Lines {
+		tax += line.Tax
+	}
+
+	return tax
+}
+
+func (summary *CartSummary) Total() int64 {
+	total := summary.Subtotal() - summary.Discount() - summary.PromoDiscount + summary.Tax()
+	if total < 0 {
+		return 0
+	}
+
+	return total
+}
+
+// Reports whether the cart has lines and none of them has an issue
+func (summary *CartSummary) ReadyForCheckout() bool {
+	if len(summary.Lines) == 0 || summary.PromoError != "" {
+		return false
+	}
+
+	for _, line := range summary.Lines {
+		if len(line.Issues) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (summary *CartSummary) ToProto() *pbCart.CartSummaryResponse {
+	lines := make([]*pbCart.CartSummaryLineResponse, 0, len(summary.Lines))
+	for _, line := range summary.Lines {
+		lines = append(lines, line.ToProto())
+	}
+
+	return &pbCart.CartSummaryResponse{
+		UserId:           summary.UserID.String(),
+		Lines:            lines,
+		Subtotal:         summary.Subtotal(),
+		Discount:         summary.Discount(),
+		PromoCode:        summary.PromoCode,
+		PromoDiscount:    summary.PromoDiscount,
+		PromoError:       summary.PromoError,
+		Total:            summary.Total(),
+		ReadyForCheckout: summary.ReadyForCheckout(),
+		ShippingRegion:   summary.ShippingRegion,
+		Tax:              summary.Tax(),
+		Currency:         summary.Currency,
+	}
+}
@@ -0,0 +1,121 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/Go-Marketplace/backend/cart/internal/model"
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCartSummaryTotals(t *testing.T) {
+	t.Parallel()
+
+	discountedLine := &model.CartSummaryLine{
+		Quantity:  2,
+		UnitPrice: 1000,
+		Discount:  &pbProduct.DiscountResponse{Percent: 10},
+	}
+	plainLine := &model.CartSummaryLine{
+		Quantity:  1,
+		UnitPrice: 500,
+	}
+	taxedLine := &model.CartSummaryLine{
+		Quantity:  1,
+		UnitPrice: 1000,
+		TaxRate:   20,
+		Tax:       200,
+	}
+	removedLine := &model.CartSummaryLine{
+		Quantity: 3,
+		Issues:   []model.CartlineIssue{model.ProductRemoved},
+	}
+
+	testcases := []struct {
+		name             string
+		summary          *model.CartSummary
+		expectedSubtotal int64
+		expectedDiscount int64
+		expectedTax      int64
+		expectedTotal    int64
+		expectedReady    bool
+	}{
+		{
+			name: "Product discounts and promo discount",
+			summary: &model.CartSummary{
+				Lines:         []*model.CartSummaryLine{discountedLine, plainLine},
+				PromoDiscount: 300,
+			},
+			expectedSubtotal: 2500,
+			expectedDiscount: 200,
+			expectedTotal:    2000,
+			expectedReady:    true,
+		},
+		{
+			name: "Taxes are added to the total after the promo discount",
+			summary: &model.CartSummary{
+				Lines:         []*model.CartSummaryLine{taxedLine, plainLine},
+				PromoDiscount: 300,
+			},
+			expectedSubtotal: 1500,
+			expectedDiscount: 0,
+			expectedTax:      200,
+			expectedTotal:    1400,
+			expectedReady:    true,
+		},
+		{
+			name: "Removed product is left out of the totals",
+			summary: &model.CartSummary{
+				Lines: []*model.CartSummaryLine{plainLine, removedLine},
+			},
+			expectedSubtotal: 500,
+			expectedDiscount: 0,
+			expectedTotal:    500,
+			expectedReady:    false,
+		},
+		{
+			name: "Total is not negative",
+			summary: &model.CartSummary{
+				Lines:         []*model.CartSummaryLine{plainLine},
+				PromoDiscount: 1000,
+			},
+			expectedSubtotal: 500,
+			expectedDiscount: 0,
+			expectedTotal:    0,
+			expectedReady:    true,
+		},
+		{
+			name: "Promo code no longer applies",
+			summary: &model.CartSummary{
+				Lines:      []*model.CartSummaryLine{plainLine},
+				PromoError: "promo code expired",
+			},
+			expectedSubtotal: 500,
+			expectedDiscount: 0,
+			expectedTotal:    500,
+			expectedReady:    false,
+		},
+		{
+			name:             "Empty cart",
+			summary:          &model.CartSummary{},
+			expectedSubtotal: 0,
+			expectedDiscount: 0,
+			expectedTotal:    0,
+			expectedReady:    false,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testcase.expectedSubtotal, testcase.summary.Subtotal())
+			assert.Equal(t, testcase.expectedDiscount, testcase.summary.Discount())
+			assert.Equal(t, testcase.expectedTax, testcase.summary.Tax())
+			assert.Equal(t, testcase.expectedTotal, testcase.summary.Total())
+			assert.Equal(t, testcase.expectedReady, testcase.summary.ReadyForCheckout())
+		})
+	}
+}
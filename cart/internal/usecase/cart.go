@@ -4,46 +4,83 @@ import (
 	"context"
 	"time"
 
+	"github.com/Go-Marketplace/backend/cart/internal/api/grpc/dto"
 	"github.com/Go-Marketplace/backend/cart/internal/infrastructure/interfaces"
 	"github.com/Go-Marketplace/backend/cart/internal/model"
 	"github.com/Go-Marketplace/backend/pkg/logger"
 	"github.com/google/uuid"
 )
 
-const (
-	cartTTL = 5 * time.Minute
-)
-
 type ICartUsecase interface {
 	GetUserCart(ctx context.Context, userID uuid.UUID) (*model.Cart, error)
 	CreateCart(ctx context.Context, cart model.Cart) error
 	DeleteCart(ctx context.Context, userID uuid.UUID) error
+	SetPromoCode(ctx context.Context, userID uuid.UUID, code string) (*model.Cart, error)
 
 	GetCartline(ctx context.Context, userID, productID uuid.UUID) (*model.CartLine, error)
 	CreateCartline(ctx context.Context, cartline *model.CartLine) error
 	CreateCartlines(ctx context.Context, cartlines []*model.CartLine) error
 	UpdateCartline(ctx context.Context, cartline model.CartLine) (*model.CartLine, error)
+	UpdateCartlines(ctx context.Context, userID uuid.UUID, cartlines []*model.CartLine) (*model.Cart, error)
 	DeleteCartline(ctx context.Context, userID uuid.UUID, productID uuid.UUID) error
-	DeleteProductCartlines(ctx context.Context, productID uuid.UUID) error
+	DeleteProductCartlines(ctx context.Context, productID uuid.UUID) ([]*model.CartLine, error)
 	DeleteCartCartlines(ctx context.Context, userID uuid.UUID) error
+
+	CreateAbandonedCart(ctx context.Context, abandonedCart *model.AbandonedCart) error
+	GetAbandonedCart(ctx context.Context, abandonedCartID uuid.UUID) (*model.AbandonedCart, error)
+	SetAbandonedCartRestored(ctx context.Context, abandonedCartID uuid.UUID, restored bool) error
+	GetAbandonedCartMetrics(ctx context.Context, searchParams dto.SearchAbandonedCartsDTO) ([]*model.AbandonedCartMetric, error)
+}
+
+// The cart expires CartTTL after its last cartline change,
+// the user is notified ExpiryNotice before that, zero disables the notification.
+// RecoveryDelay after the cart expired the user is invited to restore it, zero disables the invitation
+type CartExpiryConfig struct {
+	CartTTL       time.Duration
+	ExpiryNotice  time.Duration
+	RecoveryDelay time.Duration
 }
 
 type CartUsecase struct {
-	cartTaskRepo interfaces.CartTaskRepo
-	cartRepo     interfaces.CartRepo
-	logger       *logger.Logger
+	cartTaskRepo      interfaces.CartTaskRepo
+	cartRepo          interfaces.CartRepo
+	abandonedCartRepo interfaces.AbandonedCartRepo
+	expiry            CartExpiryConfig
+	logger            *logger.Logger
 }
 
-func NewCartUsecase(cartRepo interfaces.CartRepo, cartTaskRepo interfaces.CartTaskRepo, logger *logger.Logger) *CartUsecase {
+func NewCartUsecase(
+	cartRepo interfaces.CartRepo,
+	cartTaskRepo interfaces.CartTaskRepo,
+	abandonedCartRepo interfaces.AbandonedCartRepo,
+	expiry CartExpiryConfig,
+	logger *logger.Logger,
+) *CartUsecase {
 	return &CartUsecase{
-		cartTaskRepo: cartTaskRepo,
-		cartRepo:     cartRepo,
-		logger:       logger,
+		cartTaskRepo:      cartTaskRepo,
+		cartRepo:          cartRepo,
+		abandonedCartRepo: abandonedCartRepo,
+		expiry:            expiry,
+		logger:            logger,
 	}
 }
 
 func (usecase *CartUsecase) GetUserCart(ctx context.Context, userID uuid.UUID) (*model.Cart, error) {
-	return usecase.cartRepo.GetUserCart(ctx, userID)
+	cart, err := usecase.cartRepo.GetUserCart(ctx, userID)
+	if err != nil || cart == nil {
+		return cart, err
+	}
+
+	task, err := usecase.cartTaskRepo.GetCartTask(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if task != nil {
+		cart.ExpiresAt = time.Unix(task.Timestamp, 0)
+	}
+
+	return cart, nil
 }
 
 func (usecase *CartUsecase) CreateCart(ctx context.Context, cart model.Cart) error {
@@ -51,14 +88,38 @@ func (usecase *CartUsecase) CreateCart(ctx context.Context, cart model.Cart) err
 		return err
 	}
 
-	if err := usecase.cartTaskRepo.CreateCartTask(ctx, model.CartTask{
-		UserID:    cart.UserID,
-		Timestamp: time.Now().Add(cartTTL).Unix(),
-	}); err != nil {
-		return err
+	return usecase.ExtendCart(ctx, cart.UserID)
+}
+
+// Schedules the cart cleanup CartTTL from now, moving the previous schedule
+func (usecase *CartUsecase) ExtendCart(ctx context.Context, userID uuid.UUID) error {
+	expiresAt := time.Now().Add(usecase.expiry.CartTTL)
+
+	task := model.CartTask{
+		UserID:    userID,
+		Timestamp: expiresAt.Unix(),
 	}
 
-	return nil
+	if usecase.expiry.ExpiryNotice > 0 && usecase.expiry.ExpiryNotice < usecase.expiry.CartTTL {
+		task.NotifyAt = expiresAt.Add(-usecase.expiry.ExpiryNotice).Unix()
+	}
+
+	return usecase.cartTaskRepo.CreateCartTask(ctx, task)
+}
+
+// The cartline is already changed, so a failed extension only shortens the cart life
+func (usecase *CartUsecase) extendCartAfterChange(ctx context.Context, userID uuid.UUID) {
+	if err := usecase.ExtendCart(ctx, userID); err != nil {
+		usecase.logger.Error("failed to extend cart %s expiry: %s", userID, err)
+	}
+}
+
+func (usecase *CartUsecase) SetPromoCode(ctx context.Context, userID uuid.UUID, code string) (*model.Cart, error) {
+	if err := usecase.cartRepo.SetCartPromoCode(ctx, userID, code); err != nil {
+		return nil, err
+	}
+
+	return usecase.GetUserCart(ctx, userID)
 }
 
 func (usecase *CartUsecase) GetCartline(ctx context.Context, userID, productID uuid.UUID) (*model.CartLine, error) {
@@ -66,11 +127,30 @@ func (usecase *CartUsecase) GetCartline(ctx context.Context, userID, productID u
 }
 
 func (usecase *CartUsecase) CreateCartline(ctx context.Context, cartline *model.CartLine) error {
-	return usecase.cartRepo.CreateCartline(ctx, cartline)
+	if err := usecase.cartRepo.CreateCartline(ctx, cartline); err != nil {
+		return err
+	}
+
+	usecase.extendCartAfterChange(ctx, cartline.UserID)
+
+	return nil
 }
 
+// Creates the cartlines and extends every cart they belong to
 func (usecase *CartUsecase) CreateCartlines(ctx context.Context, cartlines []*model.CartLine) error {
-	return usecase.cartRepo.CreateCartlines(ctx, cartlines)
+	if err := usecase.cartRepo.CreateCartlines(ctx, cartlines); err != nil {
+		return err
+	}
+
+	extended := make(map[uuid.UUID]bool, len(cartlines))
+	for _, cartline := range cartlines {
+		if !extended[cartline.UserID] {
+			usecase.extendCartAfterChange(ctx, cartline.UserID)
+			extended[cartline.UserID] = true
+		}
+	}
+
+	return nil
 }
 
 func (usecase *CartUsecase) UpdateCartline(ctx context.Context, cartline model.CartLine) (*model.CartLine, error) {
@@ -78,21 +158,67 @@ func (usecase *CartUsecase) UpdateCartline(ctx context.Context, cartline model.C
 		return nil, err
 	}
 
+	usecase.extendCartAfterChange(ctx, cartline.UserID)
+
 	return usecase.GetCartline(ctx, cartline.UserID, cartline.ProductID)
 }
 
+func (usecase *CartUsecase) UpdateCartlines(ctx context.Context, userID uuid.UUID, cartlines []*model.CartLine) (*model.Cart, error) {
+	if err := usecase.cartRepo.UpdateCartlines(ctx, userID, cartlines); err != nil {
+		return nil, err
+	}
+
+	usecase.extendCartAfterChange(ctx, userID)
+
+	return usecase.GetUserCart(ctx, userID)
+}
+
 func (usecase *CartUsecase) DeleteCart(ctx context.Context, userID uuid.UUID) error {
 	return usecase.cartRepo.DeleteCart(ctx, userID)
 }
 
 func (usecase *CartUsecase) DeleteCartline(ctx context.Context, userID uuid.UUID, productID uuid.UUID) error {
-	return usecase.cartRepo.DeleteCartline(ctx, userID, productID)
+	if err := usecase.cartRepo.DeleteCartline(ctx, userID, productID); err != nil {
+		return err
+	}
+
+	usecase.extendCartAfterChange(ctx, userID)
+
+	return nil
 }
 
-func (usecase *CartUsecase) DeleteProductCartlines(ctx context.Context, productID uuid.UUID) error {
+func (usecase *CartUsecase) DeleteProductCartlines(ctx context.Context, productID uuid.UUID) ([]*model.CartLine, error) {
 	return usecase.cartRepo.DeleteProductCartlines(ctx, productID)
 }
 
 func (usecase *CartUsecase) DeleteCartCartlines(ctx context.Context, userID uuid.UUID) error {
 	return usecase.cartRepo.DeleteCartCartlines(ctx, userID)
 }
+
+// Stores the abandoned cart and enqueues the invitation to restore it
+func (usecase *CartUsecase) CreateAbandonedCart(ctx context.Context, abandonedCart *model.AbandonedCart) error {
+	if err := usecase.abandonedCartRepo.CreateAbandonedCart(ctx, abandonedCart); err != nil {
+		return err
+	}
+
+	if usecase.expiry.RecoveryDelay <= 0 {
+		return nil
+	}
+
+	return usecase.cartTaskRepo.CreateRecoveryTask(ctx, model.RecoveryTask{
+		AbandonedCartID: abandonedCart.ID,
+		Timestamp:       abandonedCart.AbandonedAt.Add(usecase.expiry.RecoveryDelay).Unix(),
+	})
+}
+
+func (usecase *CartUsecase) GetAbandonedCart(ctx context.Context, abandonedCartID uuid.UUID) (*model.AbandonedCart, error) {
+	return usecase.abandonedCartRepo.GetAbandonedCart(ctx, abandonedCartID)
+}
+
+func (usecase *CartUsecase) SetAbandonedCartRestored(ctx context.Context, abandonedCartID uuid.UUID, restored bool) error {
+	return usecase.abandonedCartRepo.SetAbandonedCartRestored(ctx, abandonedCartID, restored)
+}
+
+func (usecase *CartUsecase) GetAbandonedCartMetrics(ctx context.Context, searchParams dto.SearchAbandonedCartsDTO) ([]*model.AbandonedCartMetric, error) {
+	return usecase.abandonedCartRepo.GetAbandonedCartMetrics(ctx, searchParams)
+}
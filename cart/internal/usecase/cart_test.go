@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	mocks "github.com/Go-Marketplace/backend/cart/internal/mocks/repo"
 	"github.com/Go-Marketplace/backend/cart/internal/model"
@@ -14,6 +15,12 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+var testExpiryConfig = usecase.CartExpiryConfig{
+	CartTTL:       5 * time.Minute,
+	ExpiryNotice:  time.Minute,
+	RecoveryDelay: time.Hour,
+}
+
 func cartHelper(t *testing.T) (*usecase.CartUsecase, *mocks.MockCartRepo, *mocks.MockCartTaskRepo) {
 	t.Helper()
 
@@ -24,11 +31,28 @@ func cartHelper(t *testing.T) (*usecase.CartUsecase, *mocks.MockCartRepo, *mocks
 
 	cartRepo := mocks.NewMockCartRepo(mockCtrl)
 	cartTaskRepo := mocks.NewMockCartTaskRepo(mockCtrl)
-	cartUsecase := usecase.NewCartUsecase(cartRepo, cartTaskRepo, logger)
+	abandonedCartRepo := mocks.NewMockAbandonedCartRepo(mockCtrl)
+	cartUsecase := usecase.NewCartUsecase(cartRepo, cartTaskRepo, abandonedCartRepo, testExpiryConfig, logger)
 
 	return cartUsecase, cartRepo, cartTaskRepo
 }
 
+func abandonedCartHelper(t *testing.T) (*usecase.CartUsecase, *mocks.MockAbandonedCartRepo, *mocks.MockCartTaskRepo) {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	logger := logger.New("debug")
+
+	cartRepo := mocks.NewMockCartRepo(mockCtrl)
+	cartTaskRepo := mocks.NewMockCartTaskRepo(mockCtrl)
+	abandonedCartRepo := mocks.NewMockAbandonedCartRepo(mockCtrl)
+	cartUsecase := usecase.NewCartUsecase(cartRepo, cartTaskRepo, abandonedCartRepo, testExpiryConfig, logger)
+
+	return cartUsecase, abandonedCartRepo, cartTaskRepo
+}
+
 func TestGetUserCart(t *testing.T) {
 	type args struct {
 		ctx    context.Context
@@ -42,13 +66,14 @@ func TestGetUserCart(t *testing.T) {
 	expectedCartFromRepo := &model.Cart{
 		UserID: userID,
 	}
+	expiresAt := time.Unix(time.Now().Add(time.Minute).Unix(), 0)
 
 	expectedErrFromRepo := errors.New("test error")
 
 	testcases := []struct {
 		name         string
 		args         args
-		mock         func(cartRepo *mocks.MockCartRepo)
+		mock         func(cartRepo *mocks.MockCartRepo, cartTaskRepo *mocks.MockCartTaskRepo)
 		expectedCart *model.Cart
 		expectedErr  error
 	}{
@@ -58,19 +83,39 @@ func TestGetUserCart(t *testing.T) {
 				ctx:    ctx,
 				userID: userID,
 			},
-			mock: func(cartRepo *mocks.MockCartRepo) {
+			mock: func(cartRepo *mocks.MockCartRepo, cartTaskRepo *mocks.MockCartTaskRepo) {
 				cartRepo.EXPECT().GetUserCart(ctx, userID).Return(expectedCartFromRepo, nil).Times(1)
+				cartTaskRepo.EXPECT().GetCartTask(ctx, userID).Return(nil, nil).Times(1)
 			},
 			expectedCart: expectedCartFromRepo,
 			expectedErr:  nil,
 		},
+		{
+			name: "Successfully get user cart with expiry",
+			args: args{
+				ctx:    ctx,
+				userID: userID,
+			},
+			mock: func(cartRepo *mocks.MockCartRepo, cartTaskRepo *mocks.MockCartTaskRepo) {
+				cartRepo.EXPECT().GetUserCart(ctx, userID).Return(&model.Cart{UserID: userID}, nil).Times(1)
+				cartTaskRepo.EXPECT().GetCartTask(ctx, userID).Return(&model.CartTask{
+					UserID:    userID,
+					Timestamp: expiresAt.Unix(),
+				}, nil).Times(1)
+			},
+			expectedCart: &model.Cart{
+				UserID:    userID,
+				ExpiresAt: expiresAt,
+			},
+			expectedErr: nil,
+		},
 		{
 			name: "Got error when get user cart",
 			args: args{
 				ctx:    ctx,
 				userID: userID,
 			},
-			mock: func(cartRepo *mocks.MockCartRepo) {
+			mock: func(cartRepo *mocks.MockCartRepo, cartTaskRepo *mocks.MockCartTaskRepo) {
 				cartRepo.EXPECT().GetUserCart(ctx, userID).Return(nil, expectedErrFromRepo).Times(1)
 			},
 			expectedCart: nil,
@@ -82,8 +127,8 @@ func TestGetUserCart(t *testing.T) {
 		testcase := testcase
 
 		t.Run(testcase.name, func(t *testing.T) {
-			cartUsecase, cartRepo, _ := cartHelper(t)
-			testcase.mock(cartRepo)
+			cartUsecase, cartRepo, cartTaskRepo := cartHelper(t)
+			testcase.mock(cartRepo, cartTaskRepo)
 
 			actualCart, actualErr := cartUsecase.GetUserCart(
 				testcase.args.ctx,
@@ -266,7 +311,7 @@ func TestCreateCartline(t *testing.T) {
 	testcases := []struct {
 		name        string
 		args        args
-		mock        func(cartRepo *mocks.MockCartRepo)
+		mock        func(cartRepo *mocks.MockCartRepo, cartTaskRepo *mocks.MockCartTaskRepo)
 		expectedErr error
 	}{
 		{
@@ -275,8 +320,9 @@ func TestCreateCartline(t *testing.T) {
 				ctx:      ctx,
 				cartline: testCartline,
 			},
-			mock: func(cartRepo *mocks.MockCartRepo) {
+			mock: func(cartRepo *mocks.MockCartRepo, cartTaskRepo *mocks.MockCartTaskRepo) {
 				cartRepo.EXPECT().CreateCartline(ctx, testCartline).Return(nil).Times(1)
+				cartTaskRepo.EXPECT().CreateCartTask(ctx, gomock.Any()).Return(nil).Times(1)
 			},
 			expectedErr: nil,
 		},
@@ -286,7 +332,7 @@ func TestCreateCartline(t *testing.T) {
 				ctx:      ctx,
 				cartline: testCartline,
 			},
-			mock: func(cartRepo *mocks.MockCartRepo) {
+			mock: func(cartRepo *mocks.MockCartRepo, cartTaskRepo *mocks.MockCartTaskRepo) {
 				cartRepo.EXPECT().CreateCartline(ctx, testCartline).Return(expectedErrFromRepo).Times(1)
 			},
 			expectedErr: expectedErrFromRepo,
@@ -297,8 +343,8 @@ func TestCreateCartline(t *testing.T) {
 		testcase := testcase
 
 		t.Run(testcase.name, func(t *testing.T) {
-			cartUsecase, cartRepo, _ := cartHelper(t)
-			testcase.mock(cartRepo)
+			cartUsecase, cartRepo, cartTaskRepo := cartHelper(t)
+			testcase.mock(cartRepo, cartTaskRepo)
 
 			actualErr := cartUsecase.CreateCartline(
 				testcase.args.ctx,
@@ -310,6 +356,77 @@ func TestCreateCartline(t *testing.T) {
 	}
 }
 
+func TestCreateCartlines(t *testing.T) {
+	type args struct {
+		ctx       context.Context
+		cartlines []*model.CartLine
+	}
+
+	ctx := context.Background()
+
+	userID := uuid.New()
+
+	testCartlines := []*model.CartLine{
+		{
+			UserID:    userID,
+			ProductID: uuid.New(),
+		},
+		{
+			UserID:    userID,
+			ProductID: uuid.New(),
+		},
+	}
+
+	expectedErrFromRepo := errors.New("test error")
+
+	testcases := []struct {
+		name        string
+		args        args
+		mock        func(cartRepo *mocks.MockCartRepo, cartTaskRepo *mocks.MockCartTaskRepo)
+		expectedErr error
+	}{
+		{
+			name: "Successfully create cartlines and extend the cart once",
+			args: args{
+				ctx:       ctx,
+				cartlines: testCartlines,
+			},
+			mock: func(cartRepo *mocks.MockCartRepo, cartTaskRepo *mocks.MockCartTaskRepo) {
+				cartRepo.EXPECT().CreateCartlines(ctx, testCartlines).Return(nil).Times(1)
+				cartTaskRepo.EXPECT().CreateCartTask(ctx, gomock.Any()).Return(nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Got error when create cartlines",
+			args: args{
+				ctx:       ctx,
+				cartlines: testCartlines,
+			},
+			mock: func(cartRepo *mocks.MockCartRepo, cartTaskRepo *mocks.MockCartTaskRepo) {
+				cartRepo.EXPECT().CreateCartlines(ctx, testCartlines).Return(expectedErrFromRepo).Times(1)
+			},
+			expectedErr: expectedErrFromRepo,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			cartUsecase, cartRepo, cartTaskRepo := cartHelper(t)
+			testcase.mock(cartRepo, cartTaskRepo)
+
+			actualErr := cartUsecase.CreateCartlines(
+				testcase.args.ctx,
+				testcase.args.cartlines,
+			)
+
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
 func TestUpdateCartline(t *testing.T) {
 	type args struct {
 		ctx      context.Context
@@ -332,7 +449,7 @@ func TestUpdateCartline(t *testing.T) {
 	testcases := []struct {
 		name                     string
 		args                     args
-		mock                     func(cartRepo *mocks.MockCartRepo)
+		mock                     func(cartRepo *mocks.MockCartRepo, cartTaskRepo *mocks.MockCartTaskRepo)
 		expectedCartlineFromRepo *model.CartLine
 		expectedErr              error
 	}{
@@ -342,8 +459,9 @@ func TestUpdateCartline(t *testing.T) {
 				ctx:      ctx,
 				cartline: testCartline,
 			},
-			mock: func(cartRepo *mocks.MockCartRepo) {
+			mock: func(cartRepo *mocks.MockCartRepo, cartTaskRepo *mocks.MockCartTaskRepo) {
 				cartRepo.EXPECT().UpdateCartline(ctx, testCartline).Return(nil).Times(1)
+				cartTaskRepo.EXPECT().CreateCartTask(ctx, gomock.Any()).Return(nil).Times(1)
 				cartRepo.EXPECT().GetCartline(ctx, userID, productID).Return(expectedCartlineFromRepo, nil)
 			},
 			expectedCartlineFromRepo: expectedCartlineFromRepo,
@@ -355,7 +473,7 @@ func TestUpdateCartline(t *testing.T) {
 				ctx:      ctx,
 				cartline: testCartline,
 			},
-			mock: func(cartRepo *mocks.MockCartRepo) {
+			mock: func(cartRepo *mocks.MockCartRepo, cartTaskRepo *mocks.MockCartTaskRepo) {
 				cartRepo.EXPECT().UpdateCartline(ctx, testCartline).Return(expectedErrFromRepo).Times(1)
 			},
 			expectedCartlineFromRepo: nil,
@@ -367,7 +485,7 @@ func TestUpdateCartline(t *testing.T) {
 				ctx:      ctx,
 				cartline: testCartline,
 			},
-			mock: func(cartRepo *mocks.MockCartRepo) {
+			mock: func(cartRepo *mocks.MockCartRepo, cartTaskRepo *mocks.MockCartTaskRepo) {
 				cartRepo.EXPECT().UpdateCartline(ctx, testCartline).Return(expectedErrFromRepo).Times(1)
 				cartRepo.EXPECT().GetCartline(ctx, userID, productID).Return(nil, expectedErrFromRepo).Times(1)
 			},
@@ -380,8 +498,8 @@ func TestUpdateCartline(t *testing.T) {
 		testcase := testcase
 
 		t.Run(testcase.name, func(t *testing.T) {
-			cartUsecase, cartRepo, _ := cartHelper(t)
-			testcase.mock(cartRepo)
+			cartUsecase, cartRepo, cartTaskRepo := cartHelper(t)
+			testcase.mock(cartRepo, cartTaskRepo)
 
 			actualCartline, actualErr := cartUsecase.UpdateCartline(
 				testcase.args.ctx,
@@ -470,7 +588,7 @@ func TestDeleteCartline(t *testing.T) {
 	testcases := []struct {
 		name        string
 		args        args
-		mock        func(cartRepo *mocks.MockCartRepo)
+		mock        func(cartRepo *mocks.MockCartRepo, cartTaskRepo *mocks.MockCartTaskRepo)
 		expectedErr error
 	}{
 		{
@@ -480,8 +598,9 @@ func TestDeleteCartline(t *testing.T) {
 				userID:    userID,
 				productID: productID,
 			},
-			mock: func(cartRepo *mocks.MockCartRepo) {
+			mock: func(cartRepo *mocks.MockCartRepo, cartTaskRepo *mocks.MockCartTaskRepo) {
 				cartRepo.EXPECT().DeleteCartline(ctx, userID, productID).Return(nil).Times(1)
+				cartTaskRepo.EXPECT().CreateCartTask(ctx, gomock.Any()).Return(nil).Times(1)
 			},
 			expectedErr: nil,
 		},
@@ -492,7 +611,7 @@ func TestDeleteCartline(t *testing.T) {
 				userID:    userID,
 				productID: productID,
 			},
-			mock: func(cartRepo *mocks.MockCartRepo) {
+			mock: func(cartRepo *mocks.MockCartRepo, cartTaskRepo *mocks.MockCartTaskRepo) {
 				cartRepo.EXPECT().DeleteCartline(ctx, userID, productID).Return(expectedErrFromRepo).Times(1)
 			},
 			expectedErr: expectedErrFromRepo,
@@ -503,8 +622,8 @@ func TestDeleteCartline(t *testing.T) {
 		testcase := testcase
 
 		t.Run(testcase.name, func(t *testing.T) {
-			cartUsecase, cartRepo, _ := cartHelper(t)
-			testcase.mock(cartRepo)
+			cartUsecase, cartRepo, cartTaskRepo := cartHelper(t)
+			testcase.mock(cartRepo, cartTaskRepo)
 
 			actualErr := cartUsecase.DeleteCartline(
 				testcase.args.ctx,
@@ -527,13 +646,21 @@ func TestDeleteProductCartlines(t *testing.T) {
 
 	productID := uuid.New()
 
+	expectedCartlinesFromRepo := []*model.CartLine{
+		{
+			UserID:    uuid.New(),
+			ProductID: productID,
+			Quantity:  2,
+		},
+	}
 	expectedErrFromRepo := errors.New("test error")
 
 	testcases := []struct {
-		name        string
-		args        args
-		mock        func(cartRepo *mocks.MockCartRepo)
-		expectedErr error
+		name              string
+		args              args
+		mock              func(cartRepo *mocks.MockCartRepo)
+		expectedCartlines []*model.CartLine
+		expectedErr       error
 	}{
 		{
 			name: "Successfully delete product cartlines",
@@ -542,9 +669,10 @@ func TestDeleteProductCartlines(t *testing.T) {
 				productID: productID,
 			},
 			mock: func(cartRepo *mocks.MockCartRepo) {
-				cartRepo.EXPECT().DeleteProductCartlines(ctx, productID).Return(nil).Times(1)
+				cartRepo.EXPECT().DeleteProductCartlines(ctx, productID).Return(expectedCartlinesFromRepo, nil).Times(1)
 			},
-			expectedErr: nil,
+			expectedCartlines: expectedCartlinesFromRepo,
+			expectedErr:       nil,
 		},
 		{
 			name: "Got error when delete product cartlines",
@@ -553,9 +681,10 @@ func TestDeleteProductCartlines(t *testing.T) {
 				productID: productID,
 			},
 			mock: func(cartRepo *mocks.MockCartRepo) {
-				cartRepo.EXPECT().DeleteProductCartlines(ctx, productID).Return(expectedErrFromRepo).Times(1)
+				cartRepo.EXPECT().DeleteProductCartlines(ctx, productID).Return(nil, expectedErrFromRepo).Times(1)
 			},
-			expectedErr: expectedErrFromRepo,
+			expectedCartlines: nil,
+			expectedErr:       expectedErrFromRepo,
 		},
 	}
 
@@ -566,11 +695,12 @@ func TestDeleteProductCartlines(t *testing.T) {
 			cartUsecase, cartRepo, _ := cartHelper(t)
 			testcase.mock(cartRepo)
 
-			actualErr := cartUsecase.DeleteProductCartlines(
+			actualCartlines, actualErr := cartUsecase.DeleteProductCartlines(
 				testcase.args.ctx,
 				testcase.args.productID,
 			)
 
+			assert.Equal(t, testcase.expectedCartlines, actualCartlines)
 			assert.Equal(t, testcase.expectedErr, actualErr)
 		})
 	}
@@ -634,3 +764,222 @@ func TestDeleteCartCartlines(t *testing.T) {
 		})
 	}
 }
+
+func TestSetPromoCode(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		userID uuid.UUID
+		code   string
+	}
+
+	ctx := context.Background()
+
+	userID := uuid.New()
+	code := "SALE10"
+
+	expectedCartFromRepo := &model.Cart{
+		UserID:    userID,
+		PromoCode: code,
+	}
+	expectedErrFromRepo := errors.New("test error")
+
+	testcases := []struct {
+		name         string
+		args         args
+		mock         func(cartRepo *mocks.MockCartRepo, cartTaskRepo *mocks.MockCartTaskRepo)
+		expectedCart *model.Cart
+		expectedErr  error
+	}{
+		{
+			name: "Successfully set cart promo code",
+			args: args{
+				ctx:    ctx,
+				userID: userID,
+				code:   code,
+			},
+			mock: func(cartRepo *mocks.MockCartRepo, cartTaskRepo *mocks.MockCartTaskRepo) {
+				cartRepo.EXPECT().SetCartPromoCode(ctx, userID, code).Return(nil).Times(1)
+				cartRepo.EXPECT().GetUserCart(ctx, userID).Return(expectedCartFromRepo, nil).Times(1)
+				cartTaskRepo.EXPECT().GetCartTask(ctx, userID).Return(nil, nil).Times(1)
+			},
+			expectedCart: expectedCartFromRepo,
+			expectedErr:  nil,
+		},
+		{
+			name: "Got error when set cart promo code",
+			args: args{
+				ctx:    ctx,
+				userID: userID,
+				code:   code,
+			},
+			mock: func(cartRepo *mocks.MockCartRepo, cartTaskRepo *mocks.MockCartTaskRepo) {
+				cartRepo.EXPECT().SetCartPromoCode(ctx, userID, code).Return(expectedErrFromRepo).Times(1)
+			},
+			expectedCart: nil,
+			expectedErr:  expectedErrFromRepo,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			cartUsecase, cartRepo, cartTaskRepo := cartHelper(t)
+			testcase.mock(cartRepo, cartTaskRepo)
+
+			actualCart, actualErr := cartUsecase.SetPromoCode(
+				testcase.args.ctx,
+				testcase.args.userID,
+				testcase.args.code,
+			)
+
+			assert.Equal(t, testcase.expectedCart, actualCart)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestExtendCart(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		userID uuid.UUID
+	}
+
+	ctx := context.Background()
+
+	userID := uuid.New()
+
+	expectedErrFromRepo := errors.New("test error")
+
+	testcases := []struct {
+		name        string
+		args        args
+		mock        func(cartTaskRepo *mocks.MockCartTaskRepo)
+		expectedErr error
+	}{
+		{
+			name: "Successfully extend cart",
+			args: args{
+				ctx:    ctx,
+				userID: userID,
+			},
+			mock: func(cartTaskRepo *mocks.MockCartTaskRepo) {
+				cartTaskRepo.EXPECT().CreateCartTask(ctx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, task model.CartTask) error {
+						assert.Equal(t, userID, task.UserID)
+						assert.InDelta(t, time.Now().Add(5*time.Minute).Unix(), task.Timestamp, 1)
+						assert.Equal(t, task.Timestamp-int64(time.Minute.Seconds()), task.NotifyAt)
+						return nil
+					},
+				).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Got error when create cart task",
+			args: args{
+				ctx:    ctx,
+				userID: userID,
+			},
+			mock: func(cartTaskRepo *mocks.MockCartTaskRepo) {
+				cartTaskRepo.EXPECT().CreateCartTask(ctx, gomock.Any()).Return(expectedErrFromRepo).Times(1)
+			},
+			expectedErr: expectedErrFromRepo,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			cartUsecase, _, cartTaskRepo := cartHelper(t)
+			testcase.mock(cartTaskRepo)
+
+			actualErr := cartUsecase.ExtendCart(
+				testcase.args.ctx,
+				testcase.args.userID,
+			)
+
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestCreateAbandonedCart(t *testing.T) {
+	type args struct {
+		ctx           context.Context
+		abandonedCart *model.AbandonedCart
+	}
+
+	ctx := context.Background()
+
+	abandonedCart := &model.AbandonedCart{
+		ID:          uuid.New(),
+		UserID:      uuid.New(),
+		AbandonedAt: time.Now(),
+	}
+
+	expectedErrFromRepo := errors.New("test error")
+
+	testcases := []struct {
+		name        string
+		args        args
+		mock        func(abandonedCartRepo *mocks.MockAbandonedCartRepo, cartTaskRepo *mocks.MockCartTaskRepo)
+		expectedErr error
+	}{
+		{
+			name: "Successfully create abandoned cart",
+			args: args{
+				ctx:           ctx,
+				abandonedCart: abandonedCart,
+			},
+			mock: func(abandonedCartRepo *mocks.MockAbandonedCartRepo, cartTaskRepo *mocks.MockCartTaskRepo) {
+				abandonedCartRepo.EXPECT().CreateAbandonedCart(ctx, abandonedCart).Return(nil).Times(1)
+				cartTaskRepo.EXPECT().CreateRecoveryTask(ctx, model.RecoveryTask{
+					AbandonedCartID: abandonedCart.ID,
+					Timestamp:       abandonedCart.AbandonedAt.Add(time.Hour).Unix(),
+				}).Return(nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Got error when create abandoned cart",
+			args: args{
+				ctx:           ctx,
+				abandonedCart: abandonedCart,
+			},
+			mock: func(abandonedCartRepo *mocks.MockAbandonedCartRepo, cartTaskRepo *mocks.MockCartTaskRepo) {
+				abandonedCartRepo.EXPECT().CreateAbandonedCart(ctx, abandonedCart).Return(expectedErrFromRepo).Times(1)
+			},
+			expectedErr: expectedErrFromRepo,
+		},
+		{
+			name: "Got error when create recovery task",
+			args: args{
+				ctx:           ctx,
+				abandonedCart: abandonedCart,
+			},
+			mock: func(abandonedCartRepo *mocks.MockAbandonedCartRepo, cartTaskRepo *mocks.MockCartTaskRepo) {
+				abandonedCartRepo.EXPECT().CreateAbandonedCart(ctx, abandonedCart).Return(nil).Times(1)
+				cartTaskRepo.EXPECT().CreateRecoveryTask(ctx, gomock.Any()).Return(expectedErrFromRepo).Times(1)
+			},
+			expectedErr: expectedErrFromRepo,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			cartUsecase, abandonedCartRepo, cartTaskRepo := abandonedCartHelper(t)
+			testcase.mock(abandonedCartRepo, cartTaskRepo)
+
+			actualErr := cartUsecase.CreateAbandonedCart(
+				testcase.args.ctx,
+				testcase.args.abandonedCart,
+			)
+
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
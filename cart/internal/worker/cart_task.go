@@ -2,11 +2,12 @@ package worker
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Go-Marketplace/backend/cart/internal/api/grpc/controller"
 	"github.com/Go-Marketplace/backend/cart/internal/infrastructure/interfaces"
-	"github.com/Go-Marketplace/backend/cart/internal/model"
 	"github.com/Go-Marketplace/backend/cart/internal/usecase"
 	"github.com/Go-Marketplace/backend/pkg/logger"
 	"github.com/Go-Marketplace/backend/proto/gen/cart"
@@ -14,9 +15,10 @@ import (
 	"gopkg.in/tomb.v2"
 )
 
+// RestoreURL is the gateway address the cart restore links in recovery notifications point to
 type CartTaskWorkerConfig struct {
-	CartTTL                time.Duration
 	CartTaskWorkerInterval time.Duration
+	RestoreURL             string
 }
 
 type cartTaskWorker struct {
@@ -46,7 +48,7 @@ func NewCartTaskWorker(
 }
 
 func (worker *cartTaskWorker) Run(ctx context.Context) {
-	worker.logger.Info("Start cart task worker with %v interval, cart ttl: %v", worker.config.CartTaskWorkerInterval, worker.config.CartTTL)
+	worker.logger.Info("Start cart task worker with %v interval", worker.config.CartTaskWorkerInterval)
 
 	worker.tomb.Go(func() error {
 		ticker := time.NewTicker(worker.config.CartTaskWorkerInterval)
@@ -70,6 +72,32 @@ func (worker *cartTaskWorker) Run(ctx context.Context) {
 
 				for _, task := range tasks {
 					if task != nil {
+						userCart, err := worker.cartUsecase.GetUserCart(ctx, task.UserID)
+						if err != nil {
+							worker.logger.Error("failed to get cart %v: %s", task.UserID, err.Error())
+							continue
+						}
+
+						// Merged guest carts are already gone
+						if userCart == nil {
+							continue
+						}
+
+						// Guest carts are not renewed, the guest gets a new one with a new guest token
+						if userCart.Guest {
+							if err = controller.DeleteCart(
+								ctx,
+								worker.cartUsecase,
+								worker.productClient,
+								&cart.DeleteCartRequest{
+									UserId: task.UserID.String(),
+								},
+							); err != nil {
+								worker.logger.Error("failed to delete guest cart %v: %s", task.UserID, err.Error())
+							}
+							continue
+						}
+
 						if err = controller.DeleteCartCartlines(
 							ctx,
 							worker.cartUsecase,
@@ -82,12 +110,15 @@ func (worker *cartTaskWorker) Run(ctx context.Context) {
 							continue
 						}
 
-						cartTask := model.CartTask{
-							UserID:    task.UserID,
-							Timestamp: time.Now().Add(worker.config.CartTTL).Unix(),
+						// The task stays due until the cartlines are deleted, so the cart is recorded
+						// as abandoned only once, from the cartlines read before the deletion
+						if len(userCart.Cartlines) != 0 {
+							if _, err = controller.AbandonCart(ctx, worker.cartUsecase, worker.productClient, userCart); err != nil {
+								worker.logger.Error("failed to save abandoned cart %v: %s", task.UserID, err.Error())
+							}
 						}
 
-						if err = worker.cartTaskRepo.CreateCartTask(ctx, cartTask); err != nil {
+						if err = worker.cartUsecase.ExtendCart(ctx, task.UserID); err != nil {
 							worker.logger.Error("failed to create cart %v task: %s", task.UserID, err.Error())
 							continue
 						}
@@ -95,11 +126,83 @@ func (worker *cartTaskWorker) Run(ctx context.Context) {
 						worker.logger.Warn("Got nil task")
 					}
 				}
+
+				worker.notifyExpiringCarts(ctx)
+				worker.notifyAbandonedCarts(ctx)
 			}
 		}
 	})
 }
 
+// Tells the users whose carts are about to expire, guest and empty carts are skipped
+func (worker *cartTaskWorker) notifyExpiringCarts(ctx context.Context) {
+	reminders, err := worker.cartTaskRepo.GetCartReminders(ctx, time.Now().Unix())
+	if err != nil {
+		worker.logger.Error("Cannot get cart reminders: %s", err.Error())
+		return
+	}
+
+	for _, reminder := range reminders {
+		userCart, err := worker.cartUsecase.GetUserCart(ctx, reminder.UserID)
+		if err != nil {
+			worker.logger.Error("failed to get cart %v: %s", reminder.UserID, err.Error())
+			continue
+		}
+
+		if userCart == nil || userCart.Guest || len(userCart.Cartlines) == 0 || userCart.ExpiresAt.IsZero() {
+			continue
+		}
+
+		if _, err = worker.productClient.CreateNotification(ctx, &pbProduct.CreateNotificationRequest{
+			UserId: reminder.UserID.String(),
+			Kind:   pbProduct.NotificationKind_CART_EXPIRING,
+			Message: fmt.Sprintf(
+				"Your cart with %d products expires at %s, its products will be returned to the store",
+				len(userCart.Cartlines),
+				userCart.ExpiresAt.UTC().Format("2006-01-02 15:04 MST"),
+			),
+		}); err != nil {
+			worker.logger.Error("failed to notify about cart %v expiry: %s", reminder.UserID, err.Error())
+		}
+	}
+}
+
+// Invites the users to restore their abandoned carts with a one-click link,
+// carts that are already restored are skipped
+func (worker *cartTaskWorker) notifyAbandonedCarts(ctx context.Context) {
+	tasks, err := worker.cartTaskRepo.GetRecoveryTasks(ctx, time.Now().Unix())
+	if err != nil {
+		worker.logger.Error("Cannot get recovery tasks: %s", err.Error())
+		return
+	}
+
+	for _, task := range tasks {
+		abandonedCart, err := worker.cartUsecase.GetAbandonedCart(ctx, task.AbandonedCartID)
+		if err != nil {
+			worker.logger.Error("failed to get abandoned cart %v: %s", task.AbandonedCartID, err.Error())
+			continue
+		}
+
+		if abandonedCart == nil || abandonedCart.RestoredAt != nil {
+			continue
+		}
+
+		if _, err = worker.productClient.CreateNotification(ctx, &pbProduct.CreateNotificationRequest{
+			UserId: abandonedCart.UserID.String(),
+			Kind:   pbProduct.NotificationKind_CART_ABANDONED,
+			Message: fmt.Sprintf(
+				"Your cart with %d products is waiting for you, restore it in one click: %s/api/v1/user/%s/cart/restore/%s",
+				len(abandonedCart.Cartlines),
+				strings.TrimSuffix(worker.config.RestoreURL, "/"),
+				abandonedCart.UserID,
+				abandonedCart.ID,
+			),
+		}); err != nil {
+			worker.logger.Error("failed to notify about abandoned cart %v: %s", task.AbandonedCartID, err.Error())
+		}
+	}
+}
+
 func (worker *cartTaskWorker) Stop() error {
 	worker.logger.Info("Stop cart task worker")
 	worker.tomb.Kill(nil)
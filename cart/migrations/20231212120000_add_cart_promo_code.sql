@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE carts ADD COLUMN IF NOT EXISTS promo_code TEXT NOT NULL DEFAULT '';
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+ALTER TABLE carts DROP COLUMN IF EXISTS promo_code;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+ALTER TABLE carts ADD COLUMN IF NOT EXISTS guest BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DELETE FROM carts WHERE guest;
+
+ALTER TABLE carts DROP COLUMN IF EXISTS guest;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
@@ -0,0 +1,36 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS abandoned_carts (
+    abandoned_cart_id UUID NOT NULL PRIMARY KEY,
+    user_id UUID NOT NULL,
+    value BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL,
+    abandoned_at TIMESTAMP NOT NULL,
+    restored_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS abandoned_carts_abandoned_at_idx ON abandoned_carts (abandoned_at);
+
+-- Price and category are copied from the product when the cart expires, zero for removed products
+CREATE TABLE IF NOT EXISTS abandoned_cartlines (
+    abandoned_cart_id UUID NOT NULL,
+    product_id UUID NOT NULL,
+    category_id INT NOT NULL DEFAULT 0,
+    quantity BIGINT NOT NULL,
+    price BIGINT NOT NULL DEFAULT 0,
+
+    PRIMARY KEY (abandoned_cart_id, product_id),
+    FOREIGN KEY (abandoned_cart_id) REFERENCES abandoned_carts(abandoned_cart_id) ON DELETE CASCADE
+);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE IF EXISTS abandoned_cartlines;
+
+DROP TABLE IF EXISTS abandoned_carts;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
@@ -8,11 +8,13 @@ import (
 
 type (
 	Config struct {
-		OrderConfig   *OrderConfig
-		GatewayConfig *GatewayConfig
-		UserConfig    *UserConfig
-		CartConfig    *CartConfig
-		ProductConfig *ProductConfig
+		OrderConfig      *OrderConfig
+		GatewayConfig    *GatewayConfig
+		UserConfig       *UserConfig
+		CartConfig       *CartConfig
+		ProductConfig    *ProductConfig
+		TaxConfig        *TaxConfig
+		CommissionConfig *CommissionConfig
 	}
 
 	App struct {
@@ -50,9 +52,59 @@ type (
 		AccessTokenMaxAge     string `env-required:"false" yaml:"access_token_max_age" env:"ACCESS_TOKEN_MAX_AGE"`
 	}
 
+	DiscountCache struct {
+		TTL string `env-required:"false" yaml:"discount_cache_ttl" env:"DISCOUNT_CACHE_TTL"`
+	}
+
 	CartTaskWorker struct {
-		CartTTL  string `env-required:"false" yaml:"cart_ttl" env:"CART_TTL"`
-		Interval string `env-required:"false" yaml:"cart_task_worker_interval" env:"CART_TASK_WORKER_INTERVAL"`
+		CartTTL       string `env-required:"false" yaml:"cart_ttl" env:"CART_TTL"`
+		ExpiryNotice  string `env-required:"false" yaml:"cart_expiry_notice" env:"CART_EXPIRY_NOTICE"`
+		RecoveryDelay string `env-required:"false" yaml:"cart_recovery_delay" env:"CART_RECOVERY_DELAY"`
+		RestoreURL    string `env-required:"false" yaml:"cart_restore_url" env:"CART_RESTORE_URL"`
+		Interval      string `env-required:"false" yaml:"cart_task_worker_interval" env:"CART_TASK_WORKER_INTERVAL"`
+	}
+
+	PurchaseLimit struct {
+		Lookback string `env-required:"false" yaml:"purchase_limit_lookback" env:"PURCHASE_LIMIT_LOOKBACK"`
+	}
+
+	// Tax rules of the checkout, an empty region matches any region
+	// and a zero category id matches any category
+	TaxConfig struct {
+		DefaultRate float32   `yaml:"default_rate" env:"TAX_DEFAULT_RATE"`
+		Rules       []TaxRule `yaml:"rules"`
+	}
+
+	TaxRule struct {
+		Region     string  `yaml:"region"`
+		CategoryID int32   `yaml:"category_id"`
+		Rate       float32 `yaml:"rate"`
+	}
+
+	// Platform commission taken from the seller share of the delivered orderlines,
+	// a category without its own rate gets the default rate
+	CommissionConfig struct {
+		DefaultRate float32              `yaml:"default_rate" env:"COMMISSION_DEFAULT_RATE"`
+		Categories  []CategoryCommission `yaml:"categories"`
+	}
+
+	CategoryCommission struct {
+		CategoryID int32   `yaml:"category_id"`
+		Rate       float32 `yaml:"rate"`
+	}
+
+	// Order message attachments must be files under the base URL of the media store
+	Messages struct {
+		MediaBaseURL string `env-required:"false" yaml:"media_base_url" env:"MEDIA_BASE_URL"`
+	}
+
+	SalesWorker struct {
+		Interval string `env-required:"false" yaml:"sales_worker_interval" env:"SALES_WORKER_INTERVAL"`
+	}
+
+	PurgeWorker struct {
+		Retention string `env-required:"false" yaml:"deleted_product_retention" env:"DELETED_PRODUCT_RETENTION"`
+		Interval  string `env-required:"false" yaml:"purge_worker_interval" env:"PURGE_WORKER_INTERVAL"`
 	}
 
 	GatewayConfig struct {
@@ -71,10 +123,12 @@ type (
 	}
 
 	OrderConfig struct {
-		App  `yaml:"app"`
-		GRPC `yaml:"grpc"`
-		PG   `yaml:"postgres"`
-		Log  `yaml:"logger"`
+		App         `yaml:"app"`
+		GRPC        `yaml:"grpc"`
+		PG          `yaml:"postgres"`
+		SalesWorker `yaml:"reports"`
+		Messages    `yaml:"messages"`
+		Log         `yaml:"logger"`
 	}
 
 	CartConfig struct {
@@ -83,15 +137,18 @@ type (
 		Redis          `yaml:"redis"`
 		PG             `yaml:"postgres"`
 		CartTaskWorker `yaml:"worker"`
+		PurchaseLimit  `yaml:"purchase_limit"`
 		Log            `yaml:"logger"`
 	}
 
 	ProductConfig struct {
-		App   `yaml:"app"`
-		GRPC  `yaml:"grpc"`
-		Redis `yaml:"redis"`
-		PG    `yaml:"postgres"`
-		Log   `yaml:"logger"`
+		App           `yaml:"app"`
+		GRPC          `yaml:"grpc"`
+		Redis         `yaml:"redis"`
+		PG            `yaml:"postgres"`
+		DiscountCache `yaml:"discount"`
+		PurgeWorker   `yaml:"purge"`
+		Log           `yaml:"logger"`
 	}
 )
 
@@ -137,12 +194,24 @@ func NewConfig() (*Config, error) {
 		return nil, fmt.Errorf("config error: %w", err)
 	}
 
+	taxConfig := &TaxConfig{}
+	if err := getServiceFromConfig("./config/tax.yml", taxConfig); err != nil {
+		return nil, fmt.Errorf("config error: %w", err)
+	}
+
+	commissionConfig := &CommissionConfig{}
+	if err := getServiceFromConfig("./config/commission.yml", commissionConfig); err != nil {
+		return nil, fmt.Errorf("config error: %w", err)
+	}
+
 	cfg := &Config{
-		OrderConfig:   orderConfig,
-		GatewayConfig: gatewayConfig,
-		UserConfig:    userConfig,
-		CartConfig:    cartConfig,
-		ProductConfig: productConfig,
+		OrderConfig:      orderConfig,
+		GatewayConfig:    gatewayConfig,
+		UserConfig:       userConfig,
+		CartConfig:       cartConfig,
+		ProductConfig:    productConfig,
+		TaxConfig:        taxConfig,
+		CommissionConfig: commissionConfig,
 	}
 
 	return cfg, nil
@@ -2,12 +2,18 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 
 	"github.com/Go-Marketplace/backend/gateway/internal/usecase"
+	pbCart "github.com/Go-Marketplace/backend/proto/gen/cart"
 	pbGateway "github.com/Go-Marketplace/backend/proto/gen/gateway"
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
 	pbUser "github.com/Go-Marketplace/backend/proto/gen/user"
 	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -18,6 +24,23 @@ type UserClaim struct {
 	Role pbUser.UserRole `json:"role"`
 }
 
+func (claim *UserClaim) IsAdmin() bool {
+	return claim.Role == pbUser.UserRole_ADMIN || claim.Role == pbUser.UserRole_SUPERADMIN
+}
+
+type claimKey struct{}
+
+// Returns the context with the claim of the authorized caller
+func ContextWithClaim(ctx context.Context, claim *UserClaim) context.Context {
+	return context.WithValue(ctx, claimKey{}, claim)
+}
+
+// Returns the claim the auth interceptors put into the context
+func ClaimFromContext(ctx context.Context) (*UserClaim, bool) {
+	claim, ok := ctx.Value(claimKey{}).(*UserClaim)
+	return claim, ok
+}
+
 func HashPassword(password string) (string, error) {
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
@@ -134,3 +157,294 @@ func Login(
 		Token: token,
 	}, nil
 }
+
+// Creates an anonymous cart and returns the guest token for it. The token is signed
+// like an access token, with the guest role and the guest cart id
+func CreateGuestCart(
+	ctx context.Context,
+	cartClient pbCart.CartClient,
+	jwtManager *usecase.JWTManager,
+) (*AuthUserResponse, error) {
+	id := uuid.New()
+
+	if _, err := cartClient.CreateCart(ctx, &pbCart.CreateCartRequest{
+		UserId: id.String(),
+		Guest:  true,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create guest cart: %s", err)
+	}
+
+	token, err := jwtManager.CreateToken(UserClaim{
+		ID:   id.String(),
+		Role: pbUser.UserRole_GUEST,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create token: %s", err)
+	}
+
+	return &AuthUserResponse{
+		ID:    id.String(),
+		Token: token,
+	}, nil
+}
+
+// Merges the cart of the guest token into the user cart
+func MergeGuestCart(
+	ctx context.Context,
+	cartClient pbCart.CartClient,
+	jwtManager *usecase.JWTManager,
+	userID string,
+	guestToken string,
+) error {
+	payload, err := jwtManager.ValidateToken(guestToken)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to validate guest token: %s", err)
+	}
+
+	claim := UserClaim{}
+	if err = mapstructure.Decode(payload, &claim); err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to get guest claim from payload: %s", err)
+	}
+
+	if claim.Role != pbUser.UserRole_GUEST || claim.ID == "" {
+		return status.Errorf(codes.InvalidArgument, "invalid guest token")
+	}
+
+	if _, err = cartClient.MergeCarts(ctx, &pbCart.MergeCartsRequest{
+		UserId:  userID,
+		GuestId: claim.ID,
+	}); err != nil {
+		return status.Errorf(status.Code(err), "failed to merge guest cart: %s", status.Convert(err).Message())
+	}
+
+	return nil
+}
+
+// Relays the order events to the caller, who is taken from the claim of the stream
+// and not from the request, so only the participants of the order watch it
+func WatchOrder(
+	ctx context.Context,
+	orderClient pbOrder.OrderClient,
+	req *pbOrder.WatchOrderRequest,
+	stream pbGateway.Gateway_WatchOrderServer,
+) error {
+	claim, ok := ClaimFromContext(ctx)
+	if !ok {
+		return status.Errorf(codes.Unauthenticated, "Caller is not authorized")
+	}
+
+	orderStream, err := orderClient.WatchOrder(ctx, &pbOrder.WatchOrderRequest{
+		OrderId: req.OrderId,
+		UserId:  claim.ID,
+		Admin:   claim.IsAdmin(),
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		event, err := orderStream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if err = stream.Send(event); err != nil {
+			return err
+		}
+	}
+}
+
+// Sets the purchase limit on behalf of the caller from the claim, the product service
+// lets sellers limit only their own products
+func SetPurchaseLimit(
+	ctx context.Context,
+	productClient pbProduct.ProductClient,
+	req *pbProduct.SetPurchaseLimitRequest,
+) (*pbProduct.ProductResponse, error) {
+	claim, ok := ClaimFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Caller is not authorized")
+	}
+
+	return productClient.SetPurchaseLimit(ctx, &pbProduct.SetPurchaseLimitRequest{
+		ProductId:      req.ProductId,
+		MaxPerCustomer: req.MaxPerCustomer,
+		UserId:         claim.ID,
+		Admin:          claim.IsAdmin(),
+	})
+}
+
+// Updates the product on behalf of the caller from the claim, the actor of the request
+// is never trusted, so the product history shows who really made the change
+func UpdateProduct(
+	ctx context.Context,
+	productClient pbProduct.ProductClient,
+	req *pbProduct.UpdateProductRequest,
+) (*pbProduct.ProductResponse, error) {
+	claim, ok := ClaimFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Caller is not authorized")
+	}
+
+	req.ActorId = claim.ID
+
+	return productClient.UpdateProduct(ctx, req)
+}
+
+// Deletes the product on behalf of the caller from the claim, like UpdateProduct
+func DeleteProduct(
+	ctx context.Context,
+	productClient pbProduct.ProductClient,
+	req *pbProduct.DeleteProductRequest,
+) (*pbProduct.DeleteProductResponse, error) {
+	claim, ok := ClaimFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Caller is not authorized")
+	}
+
+	req.ActorId = claim.ID
+
+	return productClient.DeleteProduct(ctx, req)
+}
+
+// Creates the review on behalf of the caller from the claim, so only a buyer who
+// received the product can review it under their own id
+func CreateReview(
+	ctx context.Context,
+	productClient pbProduct.ProductClient,
+	req *pbProduct.CreateReviewRequest,
+) (*pbProduct.ReviewResponse, error) {
+	claim, ok := ClaimFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Caller is not authorized")
+	}
+
+	req.UserId = claim.ID
+
+	return productClient.CreateReview(ctx, req)
+}
+
+// Replies to the review on behalf of the caller from the claim, the product service
+// lets only the seller of the product reply
+func ReplyReview(
+	ctx context.Context,
+	productClient pbProduct.ProductClient,
+	req *pbProduct.ReplyReviewRequest,
+) (*pbProduct.ReviewResponse, error) {
+	claim, ok := ClaimFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Caller is not authorized")
+	}
+
+	req.UserId = claim.ID
+
+	return productClient.ReplyReview(ctx, req)
+}
+
+// Creates the warehouse on behalf of the caller from the claim, the product service
+// lets sellers create warehouses only for themselves
+func CreateWarehouse(
+	ctx context.Context,
+	productClient pbProduct.ProductClient,
+	req *pbProduct.CreateWarehouseRequest,
+) (*pbProduct.WarehouseResponse, error) {
+	claim, ok := ClaimFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Caller is not authorized")
+	}
+
+	req.CallerId = claim.ID
+	req.Admin = claim.IsAdmin()
+
+	return productClient.CreateWarehouse(ctx, req)
+}
+
+// Sets the warehouse stock on behalf of the caller from the claim, the product service
+// lets sellers stock only their own warehouses
+func SetWarehouseStock(
+	ctx context.Context,
+	productClient pbProduct.ProductClient,
+	req *pbProduct.SetWarehouseStockRequest,
+) (*pbProduct.WarehouseStockResponse, error) {
+	claim, ok := ClaimFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Caller is not authorized")
+	}
+
+	req.UserId = claim.ID
+	req.Admin = claim.IsAdmin()
+
+	return productClient.SetWarehouseStock(ctx, req)
+}
+
+// Sets the stock alert on behalf of the caller from the claim, the product service
+// lets sellers set alerts only on their own products
+func SetStockAlert(
+	ctx context.Context,
+	productClient pbProduct.ProductClient,
+	req *pbProduct.SetStockAlertRequest,
+) (*pbProduct.ProductResponse, error) {
+	claim, ok := ClaimFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Caller is not authorized")
+	}
+
+	req.UserId = claim.ID
+	req.Admin = claim.IsAdmin()
+
+	return productClient.SetStockAlert(ctx, req)
+}
+
+// Moderates the product on behalf of the admin from the claim, so the moderator
+// and the product history can't be forged
+func ModerateProduct(
+	ctx context.Context,
+	productClient pbProduct.ProductClient,
+	req *pbProduct.ModerateProductRequest,
+) (*pbProduct.ProductResponse, error) {
+	claim, ok := ClaimFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Caller is not authorized")
+	}
+
+	req.ReviewerId = claim.ID
+
+	return productClient.ModerateProduct(ctx, req)
+}
+
+// Submits the product on behalf of the caller from the claim, the product service
+// lets sellers submit only their own products
+func SubmitProduct(
+	ctx context.Context,
+	productClient pbProduct.ProductClient,
+	req *pbProduct.SubmitProductRequest,
+) (*pbProduct.ProductResponse, error) {
+	claim, ok := ClaimFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Caller is not authorized")
+	}
+
+	req.UserId = claim.ID
+	req.Admin = claim.IsAdmin()
+
+	return productClient.SubmitProduct(ctx, req)
+}
+
+// Restores the product on behalf of the admin from the claim, like ModerateProduct
+func RestoreProduct(
+	ctx context.Context,
+	productClient pbProduct.ProductClient,
+	req *pbProduct.RestoreProductRequest,
+) (*pbProduct.ProductResponse, error) {
+	claim, ok := ClaimFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Caller is not authorized")
+	}
+
+	req.AdminId = claim.ID
+
+	return productClient.RestoreProduct(ctx, req)
+}
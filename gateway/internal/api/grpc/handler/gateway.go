@@ -50,6 +50,8 @@ func (router *gatewayRoutes) RegisterUser(ctx context.Context, req *pbGateway.Re
 		return nil, err
 	}
 
+	router.mergeGuestCart(ctx, resp.ID, req.GuestToken)
+
 	return &pbGateway.RegisterUserResponse{
 		UserId:      resp.ID,
 		AccessToken: resp.Token,
@@ -62,12 +64,25 @@ func (router *gatewayRoutes) Login(ctx context.Context, req *pbGateway.LoginRequ
 		return nil, err
 	}
 
+	router.mergeGuestCart(ctx, resp.ID, req.GuestToken)
+
 	return &pbGateway.LoginResponse{
 		UserId:      resp.ID,
 		AccessToken: resp.Token,
 	}, nil
 }
 
+// A failed merge does not fail the authentication, the guest cart is kept until it expires
+func (router *gatewayRoutes) mergeGuestCart(ctx context.Context, userID string, guestToken string) {
+	if guestToken == "" {
+		return
+	}
+
+	if err := controller.MergeGuestCart(ctx, router.cartClient, router.jwtManager, userID, guestToken); err != nil {
+		router.logger.Error("failed to merge guest cart into user %s cart: %s", userID, err)
+	}
+}
+
 // User
 
 func (router *gatewayRoutes) GetUser(ctx context.Context, req *pbUser.GetUserRequest) (*pbUser.UserResponse, error) {
@@ -96,6 +111,10 @@ func (router *gatewayRoutes) GetOrder(ctx context.Context, req *pbOrder.GetOrder
 	return router.orderClient.GetOrder(ctx, req)
 }
 
+func (router *gatewayRoutes) WatchOrder(req *pbOrder.WatchOrderRequest, stream pbGateway.Gateway_WatchOrderServer) error {
+	return controller.WatchOrder(stream.Context(), router.orderClient, req, stream)
+}
+
 func (router *gatewayRoutes) GetOrders(ctx context.Context, req *pbOrder.GetOrdersRequest) (*pbOrder.OrdersResponse, error) {
 	return router.orderClient.GetOrders(ctx, req)
 }
@@ -126,12 +145,61 @@ func (router *gatewayRoutes) DeleteOrderline(ctx context.Context, req *pbOrder.D
 	return router.orderClient.DeleteOrderline(ctx, req)
 }
 
+func (router *gatewayRoutes) CreatePromoCode(ctx context.Context, req *pbOrder.CreatePromoCodeRequest) (*pbOrder.PromoCodeResponse, error) {
+	return router.orderClient.CreatePromoCode(ctx, req)
+}
+
+func (router *gatewayRoutes) GetPromoCode(ctx context.Context, req *pbOrder.GetPromoCodeRequest) (*pbOrder.PromoCodeResponse, error) {
+	return router.orderClient.GetPromoCode(ctx, req)
+}
+
+func (router *gatewayRoutes) GetSellerLedger(ctx context.Context, req *pbOrder.GetSellerLedgerRequest) (*pbOrder.SellerLedgerResponse, error) {
+	return router.orderClient.GetSellerLedger(ctx, req)
+}
+
+func (router *gatewayRoutes) CreatePayout(ctx context.Context, req *pbOrder.CreatePayoutRequest) (*pbOrder.PayoutsResponse, error) {
+	return router.orderClient.CreatePayout(ctx, req)
+}
+
+func (router *gatewayRoutes) GetSellerSalesReport(ctx context.Context, req *pbOrder.GetSalesReportRequest) (*pbOrder.SalesReportResponse, error) {
+	return router.orderClient.GetSalesReport(ctx, req)
+}
+
+// Reports
+
+func (router *gatewayRoutes) GetSalesReport(ctx context.Context, req *pbOrder.GetSalesReportRequest) (*pbOrder.SalesReportResponse, error) {
+	return router.orderClient.GetSalesReport(ctx, req)
+}
+
+func (router *gatewayRoutes) GetConversionReport(
+	ctx context.Context,
+	req *pbOrder.GetConversionReportRequest,
+) (*pbOrder.ConversionReportResponse, error) {
+	return router.orderClient.GetConversionReport(ctx, req)
+}
+
 // Cart
 
+func (router *gatewayRoutes) CreateGuestCart(ctx context.Context, req *pbGateway.CreateGuestCartRequest) (*pbGateway.CreateGuestCartResponse, error) {
+	resp, err := controller.CreateGuestCart(ctx, router.cartClient, router.jwtManager)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbGateway.CreateGuestCartResponse{
+		GuestId:    resp.ID,
+		GuestToken: resp.Token,
+	}, nil
+}
+
 func (router *gatewayRoutes) GetUserCart(ctx context.Context, req *pbCart.GetUserCartRequest) (*pbCart.CartResponse, error) {
 	return router.cartClient.GetUserCart(ctx, req)
 }
 
+func (router *gatewayRoutes) GetCartSummary(ctx context.Context, req *pbCart.GetCartSummaryRequest) (*pbCart.CartSummaryResponse, error) {
+	return router.cartClient.GetCartSummary(ctx, req)
+}
+
 func (router *gatewayRoutes) CreateCartline(ctx context.Context, req *pbCart.CreateCartlineRequest) (*pbCart.CartlineResponse, error) {
 	return router.cartClient.CreateCartline(ctx, req)
 }
@@ -140,6 +208,10 @@ func (router *gatewayRoutes) UpdateCartline(ctx context.Context, req *pbCart.Upd
 	return router.cartClient.UpdateCartline(ctx, req)
 }
 
+func (router *gatewayRoutes) BatchUpdateCart(ctx context.Context, req *pbCart.BatchUpdateCartRequest) (*pbCart.CartResponse, error) {
+	return router.cartClient.BatchUpdateCart(ctx, req)
+}
+
 func (router *gatewayRoutes) DeleteCartline(ctx context.Context, req *pbCart.DeleteCartlineRequest) (*pbCart.DeleteCartlineResponse, error) {
 	return router.cartClient.DeleteCartline(ctx, req)
 }
@@ -148,6 +220,18 @@ func (router *gatewayRoutes) DeleteCartCartlines(ctx context.Context, req *pbCar
 	return router.cartClient.DeleteCartCartlines(ctx, req)
 }
 
+func (router *gatewayRoutes) ApplyPromoCode(ctx context.Context, req *pbCart.ApplyPromoCodeRequest) (*pbCart.ApplyPromoCodeResponse, error) {
+	return router.cartClient.ApplyPromoCode(ctx, req)
+}
+
+func (router *gatewayRoutes) RestoreAbandonedCart(ctx context.Context, req *pbCart.RestoreAbandonedCartRequest) (*pbCart.CartResponse, error) {
+	return router.cartClient.RestoreAbandonedCart(ctx, req)
+}
+
+func (router *gatewayRoutes) GetAbandonedCartMetrics(ctx context.Context, req *pbCart.GetAbandonedCartMetricsRequest) (*pbCart.AbandonedCartMetricsResponse, error) {
+	return router.cartClient.GetAbandonedCartMetrics(ctx, req)
+}
+
 // Product
 
 func (router *gatewayRoutes) GetProduct(ctx context.Context, req *pbProduct.GetProductRequest) (*pbProduct.ProductResponse, error) {
@@ -160,7 +244,9 @@ func (router *gatewayRoutes) GetProducts(ctx context.Context, req *pbProduct.Get
 
 func (router *gatewayRoutes) GetUserProducts(ctx context.Context, req *pbGateway.GetUserProductsRequest) (*pbProduct.ProductsResponse, error) {
 	return router.productClient.GetProducts(ctx, &pbProduct.GetProductsRequest{
-		UserId: req.UserId,
+		UserId:           req.UserId,
+		ModerationStatus: req.ModerationStatus,
+		IncludeHidden:    true,
 	})
 }
 
@@ -169,15 +255,35 @@ func (router *gatewayRoutes) CreateProduct(ctx context.Context, req *pbProduct.C
 }
 
 func (router *gatewayRoutes) UpdateProduct(ctx context.Context, req *pbProduct.UpdateProductRequest) (*pbProduct.ProductResponse, error) {
-	return router.productClient.UpdateProduct(ctx, req)
+	return controller.UpdateProduct(ctx, router.productClient, req)
 }
 
 func (router *gatewayRoutes) ModerateProduct(ctx context.Context, req *pbProduct.ModerateProductRequest) (*pbProduct.ProductResponse, error) {
-	return router.productClient.ModerateProduct(ctx, req)
+	return controller.ModerateProduct(ctx, router.productClient, req)
+}
+
+func (router *gatewayRoutes) SubmitProduct(ctx context.Context, req *pbProduct.SubmitProductRequest) (*pbProduct.ProductResponse, error) {
+	return controller.SubmitProduct(ctx, router.productClient, req)
+}
+
+func (router *gatewayRoutes) GetModerationQueue(ctx context.Context, req *pbProduct.GetModerationQueueRequest) (*pbProduct.ProductsResponse, error) {
+	return router.productClient.GetModerationQueue(ctx, req)
 }
 
 func (router *gatewayRoutes) DeleteProduct(ctx context.Context, req *pbProduct.DeleteProductRequest) (*pbProduct.DeleteProductResponse, error) {
-	return router.productClient.DeleteProduct(ctx, req)
+	return controller.DeleteProduct(ctx, router.productClient, req)
+}
+
+func (router *gatewayRoutes) RestoreProduct(ctx context.Context, req *pbProduct.RestoreProductRequest) (*pbProduct.ProductResponse, error) {
+	return controller.RestoreProduct(ctx, router.productClient, req)
+}
+
+func (router *gatewayRoutes) GetProductHistory(ctx context.Context, req *pbProduct.GetProductHistoryRequest) (*pbProduct.ProductHistoryResponse, error) {
+	return router.productClient.GetProductHistory(ctx, req)
+}
+
+func (router *gatewayRoutes) GetPriceHistory(ctx context.Context, req *pbProduct.GetPriceHistoryRequest) (*pbProduct.PriceHistoryResponse, error) {
+	return router.productClient.GetPriceHistory(ctx, req)
 }
 
 // Category
@@ -199,3 +305,119 @@ func (router *gatewayRoutes) CreateDiscount(ctx context.Context, req *pbProduct.
 func (router *gatewayRoutes) DeleteDiscount(ctx context.Context, req *pbProduct.DeleteDiscountRequest) (*pbProduct.ProductResponse, error) {
 	return router.productClient.DeleteDiscount(ctx, req)
 }
+
+func (router *gatewayRoutes) CreateCategoryDiscount(ctx context.Context, req *pbProduct.CreateCategoryDiscountRequest) (*pbProduct.DiscountResponse, error) {
+	return router.productClient.CreateCategoryDiscount(ctx, req)
+}
+
+func (router *gatewayRoutes) DeleteCategoryDiscount(ctx context.Context, req *pbProduct.DeleteCategoryDiscountRequest) (*pbProduct.DeleteDiscountResponse, error) {
+	return router.productClient.DeleteCategoryDiscount(ctx, req)
+}
+
+func (router *gatewayRoutes) CreateSellerDiscount(ctx context.Context, req *pbProduct.CreateSellerDiscountRequest) (*pbProduct.DiscountResponse, error) {
+	return router.productClient.CreateSellerDiscount(ctx, req)
+}
+
+func (router *gatewayRoutes) DeleteSellerDiscount(ctx context.Context, req *pbProduct.DeleteSellerDiscountRequest) (*pbProduct.DeleteDiscountResponse, error) {
+	return router.productClient.DeleteSellerDiscount(ctx, req)
+}
+
+func (router *gatewayRoutes) GetDiscounts(ctx context.Context, req *pbProduct.GetDiscountsRequest) (*pbProduct.DiscountsResponse, error) {
+	return router.productClient.GetDiscounts(ctx, req)
+}
+
+// Review
+
+func (router *gatewayRoutes) CreateReview(ctx context.Context, req *pbProduct.CreateReviewRequest) (*pbProduct.ReviewResponse, error) {
+	return controller.CreateReview(ctx, router.productClient, req)
+}
+
+func (router *gatewayRoutes) GetProductReviews(ctx context.Context, req *pbProduct.GetProductReviewsRequest) (*pbProduct.ReviewsResponse, error) {
+	return router.productClient.GetProductReviews(ctx, req)
+}
+
+func (router *gatewayRoutes) ReplyReview(ctx context.Context, req *pbProduct.ReplyReviewRequest) (*pbProduct.ReviewResponse, error) {
+	return controller.ReplyReview(ctx, router.productClient, req)
+}
+
+// Warehouse
+
+func (router *gatewayRoutes) CreateWarehouse(ctx context.Context, req *pbProduct.CreateWarehouseRequest) (*pbProduct.WarehouseResponse, error) {
+	return controller.CreateWarehouse(ctx, router.productClient, req)
+}
+
+func (router *gatewayRoutes) GetWarehouses(ctx context.Context, req *pbProduct.GetWarehousesRequest) (*pbProduct.WarehousesResponse, error) {
+	return router.productClient.GetWarehouses(ctx, req)
+}
+
+func (router *gatewayRoutes) SetWarehouseStock(ctx context.Context, req *pbProduct.SetWarehouseStockRequest) (*pbProduct.WarehouseStockResponse, error) {
+	return controller.SetWarehouseStock(ctx, router.productClient, req)
+}
+
+func (router *gatewayRoutes) GetProductStocks(ctx context.Context, req *pbProduct.GetProductStocksRequest) (*pbProduct.WarehouseStocksResponse, error) {
+	return router.productClient.GetProductStocks(ctx, req)
+}
+
+func (router *gatewayRoutes) SetStockAlert(ctx context.Context, req *pbProduct.SetStockAlertRequest) (*pbProduct.ProductResponse, error) {
+	return controller.SetStockAlert(ctx, router.productClient, req)
+}
+
+func (router *gatewayRoutes) SetPurchaseLimit(ctx context.Context, req *pbProduct.SetPurchaseLimitRequest) (*pbProduct.ProductResponse, error) {
+	return controller.SetPurchaseLimit(ctx, router.productClient, req)
+}
+
+// Notification
+
+func (router *gatewayRoutes) GetNotifications(ctx context.Context, req *pbProduct.GetNotificationsRequest) (*pbProduct.NotificationsResponse, error) {
+	return router.productClient.GetNotifications(ctx, req)
+}
+
+// Seller
+
+func (router *gatewayRoutes) GetStorefront(ctx context.Context, req *pbProduct.GetStorefrontRequest) (*pbProduct.StorefrontResponse, error) {
+	return router.productClient.GetStorefront(ctx, req)
+}
+
+func (router *gatewayRoutes) SetSellerProfile(ctx context.Context, req *pbProduct.SetSellerProfileRequest) (*pbProduct.SellerProfileResponse, error) {
+	return router.productClient.SetSellerProfile(ctx, req)
+}
+
+// Currency
+
+func (router *gatewayRoutes) GetExchangeRates(ctx context.Context, req *pbProduct.GetExchangeRatesRequest) (*pbProduct.ExchangeRatesResponse, error) {
+	return router.productClient.GetExchangeRates(ctx, req)
+}
+
+func (router *gatewayRoutes) SetExchangeRate(ctx context.Context, req *pbProduct.SetExchangeRateRequest) (*pbProduct.ExchangeRateResponse, error) {
+	return router.productClient.SetExchangeRate(ctx, req)
+}
+
+// Wishlist
+
+func (router *gatewayRoutes) GetWishlists(ctx context.Context, req *pbProduct.GetWishlistsRequest) (*pbProduct.WishlistsResponse, error) {
+	return router.productClient.GetWishlists(ctx, req)
+}
+
+func (router *gatewayRoutes) CreateWishlist(ctx context.Context, req *pbProduct.CreateWishlistRequest) (*pbProduct.WishlistResponse, error) {
+	return router.productClient.CreateWishlist(ctx, req)
+}
+
+func (router *gatewayRoutes) DeleteWishlist(ctx context.Context, req *pbProduct.DeleteWishlistRequest) (*pbProduct.DeleteWishlistResponse, error) {
+	return router.productClient.DeleteWishlist(ctx, req)
+}
+
+func (router *gatewayRoutes) AddWishlistItem(ctx context.Context, req *pbProduct.AddWishlistItemRequest) (*pbProduct.WishlistResponse, error) {
+	return router.productClient.AddWishlistItem(ctx, req)
+}
+
+func (router *gatewayRoutes) RemoveWishlistItem(ctx context.Context, req *pbProduct.RemoveWishlistItemRequest) (*pbProduct.WishlistResponse, error) {
+	return router.productClient.RemoveWishlistItem(ctx, req)
+}
+
+func (router *gatewayRoutes) MoveWishlistItemToCart(ctx context.Context, req *pbProduct.MoveWishlistItemToCartRequest) (*pbProduct.WishlistResponse, error) {
+	return router.productClient.MoveWishlistItemToCart(ctx, req)
+}
+
+func (router *gatewayRoutes) MoveCartlineToWishlist(ctx context.Context, req *pbProduct.MoveCartlineToWishlistRequest) (*pbProduct.WishlistResponse, error) {
+	return router.productClient.MoveCartlineToWishlist(ctx, req)
+}
@@ -18,6 +18,33 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// Methods a guest token can call only on its own guest cart
+var guestCartMethods = map[string]bool{
+	"GetUserCart":     true,
+	"GetCartSummary":  true,
+	"CreateCartline":  true,
+	"UpdateCartline":  true,
+	"BatchUpdateCart": true,
+	"DeleteCartline":  true,
+}
+
+type userRequest interface {
+	GetUserId() string
+}
+
+// Methods a user can call only for their own seller account, admins can call them for any seller
+var sellerMethods = map[string]bool{
+	"GetSellerLedger":      true,
+	"GetSellerSalesReport": true,
+	"CreateSellerDiscount": true,
+	"DeleteSellerDiscount": true,
+	"SetSellerProfile":     true,
+}
+
+type sellerRequest interface {
+	GetSellerId() string
+}
+
 type interceptorManager struct {
 	rbacManager *model.RBACManager
 	jwtManager  *usecase.JWTManager
@@ -94,27 +121,129 @@ func getRequestMethod(ctx context.Context) (string, error) {
 	return methodParts[2], nil
 }
 
+// Checks the token and the role of the caller, returns the claim and the called method
+func (interceptor *interceptorManager) authorize(ctx context.Context) (*controller.UserClaim, string, error) {
+	claim, err := interceptor.getRequestUserClaim(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	method, err := getRequestMethod(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	interceptor.logger.Info("Got claim id: %s, role: %s, method: %s", claim.ID, claim.Role.String(), method)
+
+	if !interceptor.rbacManager.RBAC.IsGranted(claim.Role.String(), interceptor.rbacManager.Permissions[method], nil) {
+		return nil, "", status.Error(codes.PermissionDenied, "Not permited")
+	}
+
+	return claim, method, nil
+}
+
+// Returns the seller the request is for, seller profile requests name the seller by the user id
+func requestSellerID(req interface{}) (string, bool) {
+	if sellerReq, ok := req.(sellerRequest); ok {
+		return sellerReq.GetSellerId(), true
+	}
+
+	if userReq, ok := req.(userRequest); ok {
+		return userReq.GetUserId(), true
+	}
+
+	return "", false
+}
+
+// Checks that guests and sellers call the methods limited to them only for themselves
+func checkRequestOwner(claim *controller.UserClaim, method string, req interface{}) error {
+	if claim.Role == pbUser.UserRole_GUEST && guestCartMethods[method] {
+		userReq, ok := req.(userRequest)
+		if claim.ID == "" || !ok || userReq.GetUserId() != claim.ID {
+			return status.Error(codes.PermissionDenied, "Guest token does not match the cart")
+		}
+	}
+
+	if claim.Role == pbUser.UserRole_USER && sellerMethods[method] {
+		sellerID, ok := requestSellerID(req)
+		if !ok || sellerID != claim.ID {
+			return status.Error(codes.PermissionDenied, "Sellers can access only their own account")
+		}
+	}
+
+	return nil
+}
+
 func (interceptor *interceptorManager) AuthRequest(
 	ctx context.Context,
 	req interface{},
 	info *grpc.UnaryServerInfo,
 	handler grpc.UnaryHandler,
 ) (resp interface{}, err error) {
-	claim, err := interceptor.getRequestUserClaim(ctx)
+	claim, method, err := interceptor.authorize(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	method, err := getRequestMethod(ctx)
-	if err != nil {
+	if err = checkRequestOwner(claim, method, req); err != nil {
 		return nil, err
 	}
 
-	interceptor.logger.Info("Got claim id: %s, role: %s, method: %s", claim.ID, claim.Role.String(), method)
+	return handler(controller.ContextWithClaim(ctx, claim), req)
+}
 
-	if !interceptor.rbacManager.RBAC.IsGranted(claim.Role.String(), interceptor.rbacManager.Permissions[method], nil) {
-		return nil, status.Error(codes.PermissionDenied, "Not permited")
+// Server stream with the claim of the caller in its context. The request of a stream
+// arrives after the interceptor runs, so it is checked when the handler receives it
+type authServerStream struct {
+	grpc.ServerStream
+
+	ctx    context.Context
+	claim  *controller.UserClaim
+	method string
+}
+
+func (stream *authServerStream) Context() context.Context {
+	return stream.ctx
+}
+
+func (stream *authServerStream) RecvMsg(m interface{}) error {
+	if err := stream.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	return checkRequestOwner(stream.claim, stream.method, m)
+}
+
+func (interceptor *interceptorManager) LogStream(
+	srv interface{},
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	start := time.Now()
+	md, _ := metadata.FromIncomingContext(stream.Context())
+	err := handler(srv, stream)
+	interceptor.logger.Info("Stream method: %s, Time: %v, Metadata: %v, Err: %v", info.FullMethod, time.Since(start), md, err)
+
+	return err
+}
+
+// Counterpart of AuthRequest for the stream methods
+func (interceptor *interceptorManager) AuthStream(
+	srv interface{},
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	claim, method, err := interceptor.authorize(stream.Context())
+	if err != nil {
+		return err
 	}
 
-	return handler(ctx, req)
+	return handler(srv, &authServerStream{
+		ServerStream: stream,
+		ctx:          controller.ContextWithClaim(stream.Context(), claim),
+		claim:        claim,
+		method:       method,
+	})
 }
@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/Go-Marketplace/backend/gateway/internal/api/grpc/controller"
+	"github.com/Go-Marketplace/backend/gateway/internal/model"
+	"github.com/Go-Marketplace/backend/gateway/internal/usecase"
+	pbUser "github.com/Go-Marketplace/backend/proto/gen/user"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/mitchellh/mapstructure"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Does the same checks as the AuthRequest interceptor for a plain HTTP request
+func authorizeRequest(
+	r *http.Request,
+	jwtManager *usecase.JWTManager,
+	rbacManager *model.RBACManager,
+	method string,
+) (*controller.UserClaim, error) {
+	claim := controller.UserClaim{
+		Role: pbUser.UserRole_GUEST,
+	}
+
+	if header := r.Header.Get("Authorization"); header != "" {
+		scheme, token, found := strings.Cut(header, " ")
+		if !found || !strings.EqualFold(scheme, "bearer") {
+			return nil, status.Errorf(codes.Unauthenticated, "Bad authorization string")
+		}
+
+		payload, err := jwtManager.ValidateToken(token)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "failed to validate token: %s", err)
+		}
+
+		if err = mapstructure.Decode(payload, &claim); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user claim from payload: %s", err)
+		}
+	}
+
+	if !rbacManager.RBAC.IsGranted(claim.Role.String(), rbacManager.Permissions[method], nil) {
+		return nil, status.Error(codes.PermissionDenied, "Not permited")
+	}
+
+	return &claim, nil
+}
+
+// Writes the error the same way grpc-gateway writes the errors of its routes
+func writeError(mux *runtime.ServeMux, w http.ResponseWriter, r *http.Request, err error) {
+	_, outbound := runtime.MarshalerForRequest(mux, r)
+	runtime.HTTPError(r.Context(), mux, outbound, w, r, err)
+}
@@ -0,0 +1,242 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Go-Marketplace/backend/gateway/internal/api/grpc/controller"
+	"github.com/Go-Marketplace/backend/gateway/internal/model"
+	"github.com/Go-Marketplace/backend/gateway/internal/usecase"
+	"github.com/Go-Marketplace/backend/pkg/logger"
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const importChunkSize = 32 * 1024
+
+// Serves catalog files over plain HTTP, since grpc-gateway can not
+// stream raw file bodies in and out of the product service
+type catalogRoutes struct {
+	mux           *runtime.ServeMux
+	productClient pbProduct.ProductClient
+	jwtManager    *usecase.JWTManager
+	rbacManager   *model.RBACManager
+	logger        *logger.Logger
+}
+
+func NewCatalogRoutes(
+	mux *runtime.ServeMux,
+	productClient pbProduct.ProductClient,
+	jwtManager *usecase.JWTManager,
+	rbacManager *model.RBACManager,
+	logger *logger.Logger,
+) *catalogRoutes {
+	return &catalogRoutes{
+		mux:           mux,
+		productClient: productClient,
+		jwtManager:    jwtManager,
+		rbacManager:   rbacManager,
+		logger:        logger,
+	}
+}
+
+// Registers the catalog routes in the gateway mux
+func (routes *catalogRoutes) Register() error {
+	if err := routes.mux.HandlePath(http.MethodPost, "/api/v1/product/import", routes.ImportProducts); err != nil {
+		return fmt.Errorf("failed to register import products route: %w", err)
+	}
+
+	if err := routes.mux.HandlePath(http.MethodGet, "/api/v1/product/export", routes.ExportProducts); err != nil {
+		return fmt.Errorf("failed to register export products route: %w", err)
+	}
+
+	return nil
+}
+
+func (routes *catalogRoutes) authorize(r *http.Request, method string) (*controller.UserClaim, error) {
+	return authorizeRequest(r, routes.jwtManager, routes.rbacManager, method)
+}
+
+func (routes *catalogRoutes) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	writeError(routes.mux, w, r, err)
+}
+
+func parseCatalogFormat(r *http.Request) (pbProduct.CatalogFormat, error) {
+	value := r.URL.Query().Get("format")
+	if value == "" {
+		return pbProduct.CatalogFormat_CSV, nil
+	}
+
+	format, ok := pbProduct.CatalogFormat_value[strings.ToUpper(value)]
+	if !ok {
+		return 0, status.Errorf(codes.InvalidArgument, "Unknown catalog format: %s", value)
+	}
+
+	return pbProduct.CatalogFormat(format), nil
+}
+
+// Returns the uploaded file, either the raw request body or the "file" part of a multipart form
+func uploadedFile(r *http.Request) (io.Reader, error) {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/form-data" {
+		return r.Body, nil
+	}
+
+	reader, err := r.MultipartReader()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid multipart form: %s", err)
+	}
+
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			return nil, status.Errorf(codes.InvalidArgument, "Multipart form has no file part")
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid multipart form: %s", err)
+		}
+
+		if part.FormName() == "file" {
+			return part, nil
+		}
+	}
+}
+
+func (routes *catalogRoutes) ImportProducts(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	claim, err := routes.authorize(r, "ImportProducts")
+	if err != nil {
+		routes.writeError(w, r, err)
+		return
+	}
+
+	format, err := parseCatalogFormat(r)
+	if err != nil {
+		routes.writeError(w, r, err)
+		return
+	}
+
+	upsert, _ := strconv.ParseBool(r.URL.Query().Get("upsert"))
+
+	file, err := uploadedFile(r)
+	if err != nil {
+		routes.writeError(w, r, err)
+		return
+	}
+
+	stream, err := routes.productClient.ImportProducts(r.Context())
+	if err != nil {
+		routes.writeError(w, r, err)
+		return
+	}
+
+	req := &pbProduct.ImportProductsRequest{
+		UserId: claim.ID,
+		Format: format,
+		Upsert: upsert,
+	}
+
+	buf := make([]byte, importChunkSize)
+	for {
+		n, readErr := file.Read(buf)
+		if n > 0 {
+			req.Chunk = buf[:n]
+			if err = stream.Send(req); err != nil {
+				break
+			}
+			req = &pbProduct.ImportProductsRequest{}
+		}
+
+		if errors.Is(readErr, io.EOF) {
+			break
+		}
+		if readErr != nil {
+			routes.writeError(w, r, status.Errorf(codes.InvalidArgument, "Failed to read uploaded file: %s", readErr))
+			return
+		}
+	}
+
+	// The header must be sent even for an empty file
+	if err == nil && req.UserId != "" {
+		err = stream.Send(req)
+	}
+
+	// A failed Send is reported with the real status by CloseAndRecv
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		routes.writeError(w, r, err)
+		return
+	}
+
+	_, outbound := runtime.MarshalerForRequest(routes.mux, r)
+	body, err := outbound.Marshal(resp)
+	if err != nil {
+		routes.writeError(w, r, status.Errorf(codes.Internal, "Failed to marshal import response: %s", err))
+		return
+	}
+
+	w.Header().Set("Content-Type", outbound.ContentType(resp))
+	if _, err = w.Write(body); err != nil {
+		routes.logger.Error("failed to write import response: %s", err)
+	}
+}
+
+func (routes *catalogRoutes) ExportProducts(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	claim, err := routes.authorize(r, "ExportProducts")
+	if err != nil {
+		routes.writeError(w, r, err)
+		return
+	}
+
+	format, err := parseCatalogFormat(r)
+	if err != nil {
+		routes.writeError(w, r, err)
+		return
+	}
+
+	stream, err := routes.productClient.ExportProducts(r.Context(), &pbProduct.ExportProductsRequest{
+		UserId: claim.ID,
+		Format: format,
+	})
+	if err != nil {
+		routes.writeError(w, r, err)
+		return
+	}
+
+	// Errors of the product service arrive with the first message
+	resp, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		routes.writeError(w, r, err)
+		return
+	}
+
+	contentType, filename := "text/csv", "products.csv"
+	if format == pbProduct.CatalogFormat_JSONL {
+		contentType, filename = "application/x-ndjson", "products.jsonl"
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+
+	for resp != nil {
+		if _, err = w.Write(resp.Chunk); err != nil {
+			routes.logger.Error("failed to write export chunk: %s", err)
+			return
+		}
+
+		resp, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return
+		}
+		if err != nil {
+			routes.logger.Error("failed to receive export chunk: %s", err)
+			return
+		}
+	}
+}
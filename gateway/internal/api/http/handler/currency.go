@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/Go-Marketplace/backend/gateway/internal/model"
+	"github.com/Go-Marketplace/backend/gateway/internal/usecase"
+	"github.com/Go-Marketplace/backend/pkg/logger"
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Limits the exchange rates file, it has one short row per currency
+const maxExchangeRatesFileSize = 1024 * 1024
+
+// Imports the exchange rates file over plain HTTP, since grpc-gateway
+// would expect the file content as base64 in json
+type currencyRoutes struct {
+	mux           *runtime.ServeMux
+	productClient pbProduct.ProductClient
+	jwtManager    *usecase.JWTManager
+	rbacManager   *model.RBACManager
+	logger        *logger.Logger
+}
+
+func NewCurrencyRoutes(
+	mux *runtime.ServeMux,
+	productClient pbProduct.ProductClient,
+	jwtManager *usecase.JWTManager,
+	rbacManager *model.RBACManager,
+	logger *logger.Logger,
+) *currencyRoutes {
+	return &currencyRoutes{
+		mux:           mux,
+		productClient: productClient,
+		jwtManager:    jwtManager,
+		rbacManager:   rbacManager,
+		logger:        logger,
+	}
+}
+
+// Registers the currency routes in the gateway mux
+func (routes *currencyRoutes) Register() error {
+	if err := routes.mux.HandlePath(http.MethodPost, "/api/v1/currency/rate/import", routes.ImportExchangeRates); err != nil {
+		return fmt.Errorf("failed to register import exchange rates route: %w", err)
+	}
+
+	return nil
+}
+
+func (routes *currencyRoutes) ImportExchangeRates(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	if _, err := authorizeRequest(r, routes.jwtManager, routes.rbacManager, "ImportExchangeRates"); err != nil {
+		writeError(routes.mux, w, r, err)
+		return
+	}
+
+	file, err := uploadedFile(r)
+	if err != nil {
+		writeError(routes.mux, w, r, err)
+		return
+	}
+
+	content, err := io.ReadAll(io.LimitReader(file, maxExchangeRatesFileSize+1))
+	if err != nil {
+		writeError(routes.mux, w, r, status.Errorf(codes.InvalidArgument, "Failed to read uploaded file: %s", err))
+		return
+	}
+
+	if len(content) > maxExchangeRatesFileSize {
+		writeError(routes.mux, w, r, status.Errorf(codes.InvalidArgument, "Exchange rates file is larger than %d bytes", maxExchangeRatesFileSize))
+		return
+	}
+
+	resp, err := routes.productClient.ImportExchangeRates(r.Context(), &pbProduct.ImportExchangeRatesRequest{
+		Content: content,
+	})
+	if err != nil {
+		writeError(routes.mux, w, r, err)
+		return
+	}
+
+	_, outbound := runtime.MarshalerForRequest(routes.mux, r)
+	body, err := outbound.Marshal(resp)
+	if err != nil {
+		writeError(routes.mux, w, r, status.Errorf(codes.Internal, "Failed to marshal import response: %s", err))
+		return
+	}
+
+	w.Header().Set("Content-Type", outbound.ContentType(resp))
+	if _, err = w.Write(body); err != nil {
+		routes.logger.Error("failed to write import response: %s", err)
+	}
+}
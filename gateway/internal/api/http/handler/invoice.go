@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Go-Marketplace/backend/gateway/internal/model"
+	"github.com/Go-Marketplace/backend/gateway/internal/usecase"
+	"github.com/Go-Marketplace/backend/pkg/logger"
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Serves invoices as files, since grpc-gateway would return them as base64 in json
+type invoiceRoutes struct {
+	mux         *runtime.ServeMux
+	orderClient pbOrder.OrderClient
+	jwtManager  *usecase.JWTManager
+	rbacManager *model.RBACManager
+	logger      *logger.Logger
+}
+
+func NewInvoiceRoutes(
+	mux *runtime.ServeMux,
+	orderClient pbOrder.OrderClient,
+	jwtManager *usecase.JWTManager,
+	rbacManager *model.RBACManager,
+	logger *logger.Logger,
+) *invoiceRoutes {
+	return &invoiceRoutes{
+		mux:         mux,
+		orderClient: orderClient,
+		jwtManager:  jwtManager,
+		rbacManager: rbacManager,
+		logger:      logger,
+	}
+}
+
+// Registers the invoice routes in the gateway mux
+func (routes *invoiceRoutes) Register() error {
+	if err := routes.mux.HandlePath(
+		http.MethodGet,
+		"/api/v1/order/{order_id}/invoice/{seller_id}",
+		routes.DownloadInvoice,
+	); err != nil {
+		return fmt.Errorf("failed to register download invoice route: %w", err)
+	}
+
+	return nil
+}
+
+func parseInvoiceFormat(r *http.Request) (pbOrder.InvoiceFormat, error) {
+	value := r.URL.Query().Get("format")
+	if value == "" {
+		return pbOrder.InvoiceFormat_PDF, nil
+	}
+
+	format, ok := pbOrder.InvoiceFormat_value[strings.ToUpper(value)]
+	if !ok {
+		return 0, status.Errorf(codes.InvalidArgument, "Unknown invoice format: %s", value)
+	}
+
+	return pbOrder.InvoiceFormat(format), nil
+}
+
+// Downloads the invoice of the seller part of the order, only the buyer and the seller
+// of the order get it, admins get any invoice
+func (routes *invoiceRoutes) DownloadInvoice(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	claim, err := authorizeRequest(r, routes.jwtManager, routes.rbacManager, "GetInvoice")
+	if err != nil {
+		writeError(routes.mux, w, r, err)
+		return
+	}
+
+	format, err := parseInvoiceFormat(r)
+	if err != nil {
+		writeError(routes.mux, w, r, err)
+		return
+	}
+
+	userID := claim.ID
+	if claim.IsAdmin() {
+		userID = ""
+	}
+
+	resp, err := routes.orderClient.GetInvoice(r.Context(), &pbOrder.GetInvoiceRequest{
+		OrderId:  params["order_id"],
+		SellerId: params["seller_id"],
+		Format:   format,
+		UserId:   userID,
+	})
+	if err != nil {
+		writeError(routes.mux, w, r, err)
+		return
+	}
+
+	disposition := "attachment"
+	if format == pbOrder.InvoiceFormat_HTML {
+		disposition = "inline"
+	}
+
+	w.Header().Set("Content-Type", resp.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("%s; filename=%q", disposition, resp.FileName))
+	if _, err = w.Write(resp.Content); err != nil {
+		routes.logger.Error("failed to write invoice: %s", err)
+	}
+}
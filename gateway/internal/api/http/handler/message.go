@@ -0,0 +1,223 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/Go-Marketplace/backend/gateway/internal/model"
+	"github.com/Go-Marketplace/backend/gateway/internal/usecase"
+	"github.com/Go-Marketplace/backend/pkg/logger"
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const maxMessageRequestSize = 64 * 1024
+
+// Serves the order threads over plain HTTP, since the caller of the order service
+// is taken from the token and the live updates are streamed as newline-delimited json
+type messageRoutes struct {
+	mux         *runtime.ServeMux
+	orderClient pbOrder.OrderClient
+	jwtManager  *usecase.JWTManager
+	rbacManager *model.RBACManager
+	logger      *logger.Logger
+}
+
+func NewMessageRoutes(
+	mux *runtime.ServeMux,
+	orderClient pbOrder.OrderClient,
+	jwtManager *usecase.JWTManager,
+	rbacManager *model.RBACManager,
+	logger *logger.Logger,
+) *messageRoutes {
+	return &messageRoutes{
+		mux:         mux,
+		orderClient: orderClient,
+		jwtManager:  jwtManager,
+		rbacManager: rbacManager,
+		logger:      logger,
+	}
+}
+
+// Registers the order thread routes in the gateway mux
+func (routes *messageRoutes) Register() error {
+	if err := routes.mux.HandlePath(http.MethodGet, "/api/v1/order/{order_id}/thread", routes.GetOrderThread); err != nil {
+		return fmt.Errorf("failed to register get order thread route: %w", err)
+	}
+
+	if err := routes.mux.HandlePath(http.MethodPost, "/api/v1/order/{order_id}/thread/message", routes.CreateOrderMessage); err != nil {
+		return fmt.Errorf("failed to register create order message route: %w", err)
+	}
+
+	if err := routes.mux.HandlePath(http.MethodPost, "/api/v1/order/{order_id}/thread/read", routes.MarkOrderThreadRead); err != nil {
+		return fmt.Errorf("failed to register mark order thread read route: %w", err)
+	}
+
+	if err := routes.mux.HandlePath(http.MethodGet, "/api/v1/order/{order_id}/thread/watch", routes.WatchOrderThread); err != nil {
+		return fmt.Errorf("failed to register watch order thread route: %w", err)
+	}
+
+	return nil
+}
+
+func (routes *messageRoutes) writeResponse(w http.ResponseWriter, r *http.Request, resp proto.Message) {
+	_, outbound := runtime.MarshalerForRequest(routes.mux, r)
+	body, err := outbound.Marshal(resp)
+	if err != nil {
+		writeError(routes.mux, w, r, status.Errorf(codes.Internal, "Failed to marshal response: %s", err))
+		return
+	}
+
+	w.Header().Set("Content-Type", outbound.ContentType(resp))
+	if _, err = w.Write(body); err != nil {
+		routes.logger.Error("failed to write order thread response: %s", err)
+	}
+}
+
+func (routes *messageRoutes) GetOrderThread(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	claim, err := authorizeRequest(r, routes.jwtManager, routes.rbacManager, "GetOrderThread")
+	if err != nil {
+		writeError(routes.mux, w, r, err)
+		return
+	}
+
+	resp, err := routes.orderClient.GetOrderThread(r.Context(), &pbOrder.GetOrderThreadRequest{
+		OrderId: params["order_id"],
+		UserId:  claim.ID,
+		Admin:   claim.IsAdmin(),
+	})
+	if err != nil {
+		writeError(routes.mux, w, r, err)
+		return
+	}
+
+	routes.writeResponse(w, r, resp)
+}
+
+// Sends the message of the json body with the "body" and "attachments" fields,
+// the attachments are the URLs of the files in the media store
+func (routes *messageRoutes) CreateOrderMessage(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	claim, err := authorizeRequest(r, routes.jwtManager, routes.rbacManager, "CreateOrderMessage")
+	if err != nil {
+		writeError(routes.mux, w, r, err)
+		return
+	}
+
+	req := &pbOrder.CreateOrderMessageRequest{}
+
+	inbound, _ := runtime.MarshalerForRequest(routes.mux, r)
+	if err = inbound.NewDecoder(http.MaxBytesReader(w, r.Body, maxMessageRequestSize)).Decode(req); err != nil {
+		writeError(routes.mux, w, r, status.Errorf(codes.InvalidArgument, "Failed to read message: %s", err))
+		return
+	}
+
+	req.OrderId = params["order_id"]
+	req.UserId = claim.ID
+	req.Admin = claim.IsAdmin()
+
+	resp, err := routes.orderClient.CreateOrderMessage(r.Context(), req)
+	if err != nil {
+		writeError(routes.mux, w, r, err)
+		return
+	}
+
+	routes.writeResponse(w, r, resp)
+}
+
+func (routes *messageRoutes) MarkOrderThreadRead(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	claim, err := authorizeRequest(r, routes.jwtManager, routes.rbacManager, "MarkOrderThreadRead")
+	if err != nil {
+		writeError(routes.mux, w, r, err)
+		return
+	}
+
+	resp, err := routes.orderClient.MarkOrderThreadRead(r.Context(), &pbOrder.MarkOrderThreadReadRequest{
+		OrderId: params["order_id"],
+		UserId:  claim.ID,
+		Admin:   claim.IsAdmin(),
+	})
+	if err != nil {
+		writeError(routes.mux, w, r, err)
+		return
+	}
+
+	routes.writeResponse(w, r, resp)
+}
+
+// Streams the new messages and read receipts of the thread, one json event per line,
+// until the client disconnects. The thread is read first, so access errors are
+// returned with their status before the stream starts
+func (routes *messageRoutes) WatchOrderThread(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	claim, err := authorizeRequest(r, routes.jwtManager, routes.rbacManager, "WatchOrderThread")
+	if err != nil {
+		writeError(routes.mux, w, r, err)
+		return
+	}
+
+	if _, err = routes.orderClient.GetOrderThread(r.Context(), &pbOrder.GetOrderThreadRequest{
+		OrderId: params["order_id"],
+		UserId:  claim.ID,
+		Admin:   claim.IsAdmin(),
+	}); err != nil {
+		writeError(routes.mux, w, r, err)
+		return
+	}
+
+	stream, err := routes.orderClient.WatchOrderThread(r.Context(), &pbOrder.WatchOrderThreadRequest{
+		OrderId: params["order_id"],
+		UserId:  claim.ID,
+		Admin:   claim.IsAdmin(),
+	})
+	if err != nil {
+		writeError(routes.mux, w, r, err)
+		return
+	}
+
+	// The stream lives longer than the write timeout of the server
+	responseController := http.NewResponseController(w)
+	if err = responseController.SetWriteDeadline(time.Time{}); err != nil {
+		routes.logger.Error("failed to reset write deadline: %s", err)
+	}
+
+	_, outbound := runtime.MarshalerForRequest(routes.mux, r)
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+	if err = responseController.Flush(); err != nil {
+		routes.logger.Error("failed to flush order thread stream: %s", err)
+		return
+	}
+
+	for {
+		event, err := stream.Recv()
+		if errors.Is(err, io.EOF) || status.Code(err) == codes.Canceled {
+			return
+		}
+		if err != nil {
+			routes.logger.Error("failed to receive order thread event: %s", err)
+			return
+		}
+
+		line, err := outbound.Marshal(event)
+		if err != nil {
+			routes.logger.Error("failed to marshal order thread event: %s", err)
+			return
+		}
+
+		if _, err = w.Write(append(line, '\n')); err != nil {
+			routes.logger.Error("failed to write order thread event: %s", err)
+			return
+		}
+
+		if err = responseController.Flush(); err != nil {
+			routes.logger.Error("failed to flush order thread stream: %s", err)
+			return
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Go-Marketplace/backend/pkg/logger"
+	pbGateway "github.com/Go-Marketplace/backend/proto/gen/gateway"
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Serves the order status changes as Server-Sent Events. The stream goes through
+// the gateway grpc server like the grpc-gateway routes, so the stream interceptors
+// authorize the caller
+type orderEventsRoutes struct {
+	mux           *runtime.ServeMux
+	gatewayClient pbGateway.GatewayClient
+	logger        *logger.Logger
+}
+
+func NewOrderEventsRoutes(
+	mux *runtime.ServeMux,
+	gatewayClient pbGateway.GatewayClient,
+	logger *logger.Logger,
+) *orderEventsRoutes {
+	return &orderEventsRoutes{
+		mux:           mux,
+		gatewayClient: gatewayClient,
+		logger:        logger,
+	}
+}
+
+// Registers the order events route in the gateway mux
+func (routes *orderEventsRoutes) Register() error {
+	if err := routes.mux.HandlePath(http.MethodGet, "/api/v1/order/{order_id}/events", routes.WatchOrder); err != nil {
+		return fmt.Errorf("failed to register watch order route: %w", err)
+	}
+
+	return nil
+}
+
+// Returns the authorization of the request, EventSource clients can not set headers
+// and pass the token in the access_token query parameter instead
+func streamAuthorization(r *http.Request) string {
+	if header := r.Header.Get("Authorization"); header != "" {
+		return header
+	}
+
+	if token := r.URL.Query().Get("access_token"); token != "" {
+		return "Bearer " + token
+	}
+
+	return ""
+}
+
+// Streams the status of every orderline and then the order changes, one event per
+// change named by its kind, until the client disconnects or the order is deleted
+func (routes *orderEventsRoutes) WatchOrder(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	ctx := r.Context()
+	if authorization := streamAuthorization(r); authorization != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", authorization)
+	}
+
+	stream, err := routes.gatewayClient.WatchOrder(ctx, &pbOrder.WatchOrderRequest{
+		OrderId: params["order_id"],
+	})
+	if err != nil {
+		writeError(routes.mux, w, r, err)
+		return
+	}
+
+	// Access errors arrive with the first event, so they are returned with their status
+	event, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		writeError(routes.mux, w, r, status.Errorf(codes.NotFound, "Order not found"))
+		return
+	}
+	if err != nil {
+		writeError(routes.mux, w, r, err)
+		return
+	}
+
+	// The stream lives longer than the write timeout of the server
+	responseController := http.NewResponseController(w)
+	if err = responseController.SetWriteDeadline(time.Time{}); err != nil {
+		routes.logger.Error("failed to reset write deadline: %s", err)
+	}
+
+	_, outbound := runtime.MarshalerForRequest(routes.mux, r)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	for {
+		data, err := outbound.Marshal(event)
+		if err != nil {
+			routes.logger.Error("failed to marshal order event: %s", err)
+			return
+		}
+
+		name := strings.ToLower(event.Kind.String())
+		if _, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", name, data); err != nil {
+			routes.logger.Error("failed to write order event: %s", err)
+			return
+		}
+
+		if err = responseController.Flush(); err != nil {
+			routes.logger.Error("failed to flush order events: %s", err)
+			return
+		}
+
+		event, err = stream.Recv()
+		if errors.Is(err, io.EOF) || status.Code(err) == codes.Canceled {
+			return
+		}
+		if err != nil {
+			routes.logger.Error("failed to receive order event: %s", err)
+			return
+		}
+	}
+}
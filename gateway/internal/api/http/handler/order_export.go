@@ -0,0 +1,221 @@
+package handler
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Go-Marketplace/backend/gateway/internal/model"
+	"github.com/Go-Marketplace/backend/gateway/internal/usecase"
+	"github.com/Go-Marketplace/backend/pkg/logger"
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Serves the order export as a file download, the chunks of the export stream are
+// written to the response as they arrive, so the gateway never holds the whole file
+type orderExportRoutes struct {
+	mux         *runtime.ServeMux
+	orderClient pbOrder.OrderClient
+	jwtManager  *usecase.JWTManager
+	rbacManager *model.RBACManager
+	logger      *logger.Logger
+}
+
+func NewOrderExportRoutes(
+	mux *runtime.ServeMux,
+	orderClient pbOrder.OrderClient,
+	jwtManager *usecase.JWTManager,
+	rbacManager *model.RBACManager,
+	logger *logger.Logger,
+) *orderExportRoutes {
+	return &orderExportRoutes{
+		mux:         mux,
+		orderClient: orderClient,
+		jwtManager:  jwtManager,
+		rbacManager: rbacManager,
+		logger:      logger,
+	}
+}
+
+// Registers the order export route in the gateway mux
+func (routes *orderExportRoutes) Register() error {
+	if err := routes.mux.HandlePath(http.MethodGet, "/api/v1/order/export", routes.ExportOrders); err != nil {
+		return fmt.Errorf("failed to register export orders route: %w", err)
+	}
+
+	return nil
+}
+
+// Trailers of the order export, the status is OK when the whole export was written
+// and the code of the error otherwise
+const (
+	exportStatusTrailer = "X-Export-Status"
+	exportErrorTrailer  = "X-Export-Error"
+)
+
+func parseOrderExportFormat(value string) (pbOrder.OrderExportFormat, error) {
+	if value == "" {
+		return pbOrder.OrderExportFormat_CSV, nil
+	}
+
+	format, ok := pbOrder.OrderExportFormat_value[strings.ToUpper(value)]
+	if !ok {
+		return 0, status.Errorf(codes.InvalidArgument, "Unknown order export format: %s", value)
+	}
+
+	return pbOrder.OrderExportFormat(format), nil
+}
+
+// Reads the export filters from the query the same way as the routes of grpc-gateway,
+// the format is csv or jsonl like in the catalog export
+func getExportOrdersRequest(r *http.Request) (*pbOrder.ExportOrdersRequest, error) {
+	query := r.URL.Query()
+
+	format, err := parseOrderExportFormat(query.Get("format"))
+	if err != nil {
+		return nil, err
+	}
+	query.Del("format")
+
+	req := &pbOrder.ExportOrdersRequest{}
+	if err = runtime.PopulateQueryParameters(req, query, utilities.NewDoubleArray(nil)); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid export query: %s", err)
+	}
+	req.Format = format
+
+	return req, nil
+}
+
+// Downloads a row for every orderline of the orders matching the from, to, statuses
+// and seller_id filters of the query
+func (routes *orderExportRoutes) ExportOrders(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	if _, err := authorizeRequest(r, routes.jwtManager, routes.rbacManager, "ExportOrders"); err != nil {
+		writeError(routes.mux, w, r, err)
+		return
+	}
+
+	req, err := getExportOrdersRequest(r)
+	if err != nil {
+		writeError(routes.mux, w, r, err)
+		return
+	}
+
+	stream, err := routes.orderClient.ExportOrders(r.Context(), req)
+	if err != nil {
+		writeError(routes.mux, w, r, err)
+		return
+	}
+
+	// Errors of the order service arrive with the first message
+	resp, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		writeError(routes.mux, w, r, err)
+		return
+	}
+
+	// The download lives longer than the write timeout of the server
+	responseController := http.NewResponseController(w)
+	if err = responseController.SetWriteDeadline(time.Time{}); err != nil {
+		routes.logger.Error("failed to reset write deadline: %s", err)
+	}
+
+	contentType, filename := "text/csv", "orders.csv"
+	if req.Format == pbOrder.OrderExportFormat_JSONL {
+		contentType, filename = "application/x-ndjson", "orders.jsonl"
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	w.Header().Set("Trailer", exportStatusTrailer+", "+exportErrorTrailer)
+
+	routes.copyExport(w, req.Format, resp, stream)
+}
+
+// Writes the chunks of the export stream to the response starting with the received one
+func (routes *orderExportRoutes) copyExport(
+	w http.ResponseWriter,
+	format pbOrder.OrderExportFormat,
+	resp *pbOrder.ExportOrdersResponse,
+	stream pbOrder.Order_ExportOrdersClient,
+) {
+	responseController := http.NewResponseController(w)
+
+	// The order service cuts the file into chunks of a fixed size, so a chunk may end mid-row
+	rowOpen := false
+
+	for resp != nil {
+		if _, err := w.Write(resp.Chunk); err != nil {
+			routes.logger.Error("failed to write order export chunk: %s", err)
+			return
+		}
+
+		if len(resp.Chunk) > 0 {
+			rowOpen = resp.Chunk[len(resp.Chunk)-1] != '\n'
+		}
+
+		if err := responseController.Flush(); err != nil {
+			routes.logger.Error("failed to flush order export: %s", err)
+			return
+		}
+
+		var err error
+		resp, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			routes.logger.Error("failed to receive order export chunk: %s", err)
+			routes.writeExportError(w, format, rowOpen, err)
+			return
+		}
+	}
+
+	w.Header().Set(exportStatusTrailer, codes.OK.String())
+}
+
+// Ends the export that failed after the status was sent: the trailers carry the error
+// and the last row marks the file as incomplete for clients that don't read trailers.
+// The marker starts on a new line when the failure cut a row short
+func (routes *orderExportRoutes) writeExportError(
+	w http.ResponseWriter,
+	format pbOrder.OrderExportFormat,
+	rowOpen bool,
+	err error,
+) {
+	st := status.Convert(err)
+	w.Header().Set(exportStatusTrailer, st.Code().String())
+	w.Header().Set(exportErrorTrailer, st.Message())
+
+	if rowOpen {
+		if _, err = io.WriteString(w, "\n"); err != nil {
+			routes.logger.Error("failed to write order export error: %s", err)
+			return
+		}
+	}
+
+	message := fmt.Sprintf("export failed: %s", st.Message())
+
+	var writeErr error
+	if format == pbOrder.OrderExportFormat_JSONL {
+		writeErr = json.NewEncoder(w).Encode(map[string]string{"error": message})
+	} else {
+		csvWriter := csv.NewWriter(w)
+		if writeErr = csvWriter.Write([]string{"ERROR", message}); writeErr == nil {
+			csvWriter.Flush()
+			writeErr = csvWriter.Error()
+		}
+	}
+
+	if writeErr != nil {
+		routes.logger.Error("failed to write order export error: %s", writeErr)
+	}
+}
@@ -0,0 +1,146 @@
+package handler_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Go-Marketplace/backend/gateway/internal/api/http/handler"
+	"github.com/Go-Marketplace/backend/gateway/internal/model"
+	"github.com/Go-Marketplace/backend/pkg/logger"
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
+	pbUser "github.com/Go-Marketplace/backend/proto/gen/user"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/mikespook/gorbac"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type exportStream struct {
+	pbOrder.Order_ExportOrdersClient
+
+	chunks []string
+	err    error
+}
+
+func (stream *exportStream) Recv() (*pbOrder.ExportOrdersResponse, error) {
+	if len(stream.chunks) == 0 {
+		return nil, stream.err
+	}
+
+	chunk := stream.chunks[0]
+	stream.chunks = stream.chunks[1:]
+
+	return &pbOrder.ExportOrdersResponse{Chunk: []byte(chunk)}, nil
+}
+
+type orderClient struct {
+	pbOrder.OrderClient
+
+	stream *exportStream
+}
+
+func (client *orderClient) ExportOrders(
+	_ context.Context,
+	_ *pbOrder.ExportOrdersRequest,
+	_ ...grpc.CallOption,
+) (pbOrder.Order_ExportOrdersClient, error) {
+	return client.stream, nil
+}
+
+// Lets guests export orders, so the requests need no token
+func exportRBACManager() *model.RBACManager {
+	rbac := gorbac.New()
+	permission := gorbac.NewStdPermission("ExportOrders")
+
+	role := gorbac.NewStdRole(pbUser.UserRole_GUEST.String())
+	if err := role.Assign(permission); err != nil {
+		panic(err)
+	}
+	if err := rbac.Add(role); err != nil {
+		panic(err)
+	}
+
+	return model.NewRBACManager(rbac, gorbac.Permissions{"ExportOrders": permission})
+}
+
+func TestExportOrders(t *testing.T) {
+	t.Parallel()
+
+	exportErr := status.Errorf(codes.Unavailable, "database is down")
+
+	testcases := []struct {
+		name           string
+		format         string
+		stream         *exportStream
+		expectedBody   string
+		expectedStatus string
+		expectedError  string
+	}{
+		{
+			name:           "Whole export ends with OK status",
+			format:         "csv",
+			stream:         &exportStream{chunks: []string{"id,name\n1,fi", "sh\n"}, err: io.EOF},
+			expectedBody:   "id,name\n1,fish\n",
+			expectedStatus: codes.OK.String(),
+		},
+		{
+			name:           "Failure after a partial csv row starts the error row on a new line",
+			format:         "csv",
+			stream:         &exportStream{chunks: []string{"id,name\n1,fi"}, err: exportErr},
+			expectedBody:   "id,name\n1,fi\nERROR,export failed: database is down\n",
+			expectedStatus: codes.Unavailable.String(),
+			expectedError:  "database is down",
+		},
+		{
+			name:           "Failure after a partial jsonl row starts the error line on a new line",
+			format:         "jsonl",
+			stream:         &exportStream{chunks: []string{"{\"id\":1}\n{\"id\""}, err: exportErr},
+			expectedBody:   "{\"id\":1}\n{\"id\"\n{\"error\":\"export failed: database is down\"}\n",
+			expectedStatus: codes.Unavailable.String(),
+			expectedError:  "database is down",
+		},
+		{
+			name:           "Failure on a row boundary adds no empty line",
+			format:         "jsonl",
+			stream:         &exportStream{chunks: []string{"{\"id\":1}\n"}, err: exportErr},
+			expectedBody:   "{\"id\":1}\n{\"error\":\"export failed: database is down\"}\n",
+			expectedStatus: codes.Unavailable.String(),
+			expectedError:  "database is down",
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			routes := handler.NewOrderExportRoutes(
+				runtime.NewServeMux(),
+				&orderClient{stream: testcase.stream},
+				nil,
+				exportRBACManager(),
+				logger.New("error"),
+			)
+
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/order/export?format="+testcase.format, nil)
+
+			routes.ExportOrders(recorder, req, nil)
+
+			resp := recorder.Result()
+			body, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, testcase.expectedBody, string(body))
+			assert.Equal(t, testcase.expectedStatus, resp.Trailer.Get("X-Export-Status"))
+			assert.Equal(t, testcase.expectedError, resp.Trailer.Get("X-Export-Error"))
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/Go-Marketplace/backend/gateway/internal/model"
+	"github.com/Go-Marketplace/backend/gateway/internal/usecase"
+	"github.com/Go-Marketplace/backend/pkg/logger"
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Serves the sales reports as CSV files, since grpc-gateway would return them as base64 in json
+type reportRoutes struct {
+	mux         *runtime.ServeMux
+	orderClient pbOrder.OrderClient
+	jwtManager  *usecase.JWTManager
+	rbacManager *model.RBACManager
+	logger      *logger.Logger
+}
+
+func NewReportRoutes(
+	mux *runtime.ServeMux,
+	orderClient pbOrder.OrderClient,
+	jwtManager *usecase.JWTManager,
+	rbacManager *model.RBACManager,
+	logger *logger.Logger,
+) *reportRoutes {
+	return &reportRoutes{
+		mux:         mux,
+		orderClient: orderClient,
+		jwtManager:  jwtManager,
+		rbacManager: rbacManager,
+		logger:      logger,
+	}
+}
+
+// Registers the report routes in the gateway mux
+func (routes *reportRoutes) Register() error {
+	if err := routes.mux.HandlePath(http.MethodGet, "/api/v1/report/sales/export", routes.ExportSalesReport); err != nil {
+		return fmt.Errorf("failed to register export sales report route: %w", err)
+	}
+
+	if err := routes.mux.HandlePath(
+		http.MethodGet,
+		"/api/v1/seller/{seller_id}/report/sales/export",
+		routes.ExportSellerSalesReport,
+	); err != nil {
+		return fmt.Errorf("failed to register export seller sales report route: %w", err)
+	}
+
+	return nil
+}
+
+// Reads the report request from the query the same way as the report routes of grpc-gateway
+func getSalesReportRequest(r *http.Request) (*pbOrder.GetSalesReportRequest, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Failed to parse query: %s", err)
+	}
+
+	req := &pbOrder.GetSalesReportRequest{}
+	if err := runtime.PopulateQueryParameters(req, r.Form, utilities.NewDoubleArray(nil)); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid report query: %s", err)
+	}
+
+	return req, nil
+}
+
+// Downloads the sales of every seller, or of the seller_id of the query
+func (routes *reportRoutes) ExportSalesReport(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	if _, err := authorizeRequest(r, routes.jwtManager, routes.rbacManager, "ExportSalesReport"); err != nil {
+		writeError(routes.mux, w, r, err)
+		return
+	}
+
+	req, err := getSalesReportRequest(r)
+	if err != nil {
+		writeError(routes.mux, w, r, err)
+		return
+	}
+
+	routes.writeSalesReport(w, r, req)
+}
+
+// Downloads the sales of the seller, sellers get only their own sales and admins get any seller
+func (routes *reportRoutes) ExportSellerSalesReport(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	claim, err := authorizeRequest(r, routes.jwtManager, routes.rbacManager, "ExportSellerSalesReport")
+	if err != nil {
+		writeError(routes.mux, w, r, err)
+		return
+	}
+
+	if !claim.IsAdmin() && params["seller_id"] != claim.ID {
+		writeError(routes.mux, w, r, status.Error(codes.PermissionDenied, "Sellers can access only their own account"))
+		return
+	}
+
+	req, err := getSalesReportRequest(r)
+	if err != nil {
+		writeError(routes.mux, w, r, err)
+		return
+	}
+
+	req.SellerId = params["seller_id"]
+
+	routes.writeSalesReport(w, r, req)
+}
+
+func (routes *reportRoutes) writeSalesReport(w http.ResponseWriter, r *http.Request, req *pbOrder.GetSalesReportRequest) {
+	resp, err := routes.orderClient.ExportSalesReport(r.Context(), req)
+	if err != nil {
+		writeError(routes.mux, w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", resp.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", resp.FileName))
+	if _, err = w.Write(resp.Content); err != nil {
+		routes.logger.Error("failed to write sales report: %s", err)
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/Go-Marketplace/backend/config"
 	"github.com/Go-Marketplace/backend/gateway/internal/api/grpc/handler"
 	"github.com/Go-Marketplace/backend/gateway/internal/api/grpc/interceptors"
+	httpHandler "github.com/Go-Marketplace/backend/gateway/internal/api/http/handler"
 	"github.com/Go-Marketplace/backend/gateway/internal/model"
 	"github.com/Go-Marketplace/backend/gateway/internal/usecase"
 	"github.com/Go-Marketplace/backend/pkg/grpcserver"
@@ -182,6 +183,10 @@ func Run(cfg *config.Config) {
 			interceptor.LogRequest,
 			interceptor.AuthRequest,
 		),
+		grpc.ChainStreamInterceptor(
+			interceptor.LogStream,
+			interceptor.AuthStream,
+		),
 	)
 	if err != nil {
 		logger.Fatal(fmt.Errorf("failed to create new gateway grpc server: %w", err))
@@ -208,6 +213,51 @@ func Run(cfg *config.Config) {
 		log.Fatalf("failed to register api gateway handler from endpoint: %s", err)
 	}
 
+	catalogHandler := httpHandler.NewCatalogRoutes(gwmux, productClient, jwtManager, rbacManager, logger)
+	if err = catalogHandler.Register(); err != nil {
+		log.Fatalf("failed to register catalog routes: %s", err)
+	}
+
+	currencyHandler := httpHandler.NewCurrencyRoutes(gwmux, productClient, jwtManager, rbacManager, logger)
+	if err = currencyHandler.Register(); err != nil {
+		log.Fatalf("failed to register currency routes: %s", err)
+	}
+
+	invoiceHandler := httpHandler.NewInvoiceRoutes(gwmux, orderClient, jwtManager, rbacManager, logger)
+	if err = invoiceHandler.Register(); err != nil {
+		log.Fatalf("failed to register invoice routes: %s", err)
+	}
+
+	messageHandler := httpHandler.NewMessageRoutes(gwmux, orderClient, jwtManager, rbacManager, logger)
+	if err = messageHandler.Register(); err != nil {
+		log.Fatalf("failed to register message routes: %s", err)
+	}
+
+	reportHandler := httpHandler.NewReportRoutes(gwmux, orderClient, jwtManager, rbacManager, logger)
+	if err = reportHandler.Register(); err != nil {
+		log.Fatalf("failed to register report routes: %s", err)
+	}
+
+	orderExportHandler := httpHandler.NewOrderExportRoutes(gwmux, orderClient, jwtManager, rbacManager, logger)
+	if err = orderExportHandler.Register(); err != nil {
+		log.Fatalf("failed to register order export routes: %s", err)
+	}
+
+	// The order events are streamed through the own grpc server, so they pass its interceptors
+	gatewayConn, err := grpc.Dial(
+		fmt.Sprintf("localhost:%v", cfg.GatewayConfig.GRPC.Port),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		log.Fatalf("failed to create gatewayConn: %v", err)
+	}
+	defer gatewayConn.Close()
+
+	orderEventsHandler := httpHandler.NewOrderEventsRoutes(gwmux, pbGateway.NewGatewayClient(gatewayConn), logger)
+	if err = orderEventsHandler.Register(); err != nil {
+		log.Fatalf("failed to register order events routes: %s", err)
+	}
+
 	httpMux.Handle("/", gwmux)
 	httpMux.Handle("/api/v1/swagger/", http.StripPrefix("/api/v1/swagger", swaggerui.Handler(spec)))
 
@@ -0,0 +1,144 @@
+package controller
+
+import (
+	"bufio"
+	"context"
+	"time"
+
+	"github.com/Go-Marketplace/backend/order/internal/api/grpc/dto"
+	"github.com/Go-Marketplace/backend/order/internal/export"
+	"github.com/Go-Marketplace/backend/order/internal/model"
+	"github.com/Go-Marketplace/backend/order/internal/usecase"
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	exportChunkSize = 32 * 1024
+	// Orderlines read from the database at once, the export holds one page in memory
+	exportPageSize = 500
+)
+
+// Sends the written file to the ExportOrders stream in chunks
+type exportStreamWriter struct {
+	stream pbOrder.Order_ExportOrdersServer
+}
+
+func (writer *exportStreamWriter) Write(p []byte) (int, error) {
+	chunk := make([]byte, len(p))
+	copy(chunk, p)
+
+	if err := writer.stream.Send(&pbOrder.ExportOrdersResponse{
+		Chunk: chunk,
+	}); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+func getExportOrdersParams(req *pbOrder.ExportOrdersRequest) (dto.ExportOrdersDTO, error) {
+	if req == nil {
+		return dto.ExportOrdersDTO{}, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	to := time.Now().UTC()
+	if req.To != nil {
+		to = req.To.AsTime()
+	}
+
+	var from time.Time
+	if req.From != nil {
+		from = req.From.AsTime()
+	}
+
+	if !from.Before(to) {
+		return dto.ExportOrdersDTO{}, status.Errorf(codes.InvalidArgument, "Invalid period: from must be before to")
+	}
+
+	statuses := make([]model.OrderlineStatus, 0, len(req.Statuses))
+	for _, orderlineStatus := range req.Statuses {
+		if _, ok := pbOrder.OrderlineStatus_name[int32(orderlineStatus)]; !ok {
+			return dto.ExportOrdersDTO{}, status.Errorf(codes.InvalidArgument, "Unknown orderline status: %d", orderlineStatus)
+		}
+		statuses = append(statuses, model.OrderlineStatus(orderlineStatus))
+	}
+
+	var sellerID uuid.UUID
+	if req.SellerId != "" {
+		var err error
+		sellerID, err = uuid.Parse(req.SellerId)
+		if err != nil {
+			return dto.ExportOrdersDTO{}, status.Errorf(codes.InvalidArgument, "Invalid seller id: %s", err)
+		}
+	}
+
+	return dto.ExportOrdersDTO{
+		From:     from,
+		To:       to,
+		Statuses: statuses,
+		SellerID: sellerID,
+		Limit:    exportPageSize,
+	}, nil
+}
+
+// Streams the orderlines page by page, so the export never holds more than a page of
+// the orders and a chunk of the file. An error after the first chunk ends the stream
+// with the file cut short
+func ExportOrders(
+	ctx context.Context,
+	orderUsecase usecase.IOrderUsecase,
+	req *pbOrder.ExportOrdersRequest,
+	stream pbOrder.Order_ExportOrdersServer,
+) error {
+	searchParams, err := getExportOrdersParams(req)
+	if err != nil {
+		return err
+	}
+
+	buf := bufio.NewWriterSize(&exportStreamWriter{stream: stream}, exportChunkSize)
+
+	writer, err := export.NewWriter(req.Format, buf)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid export format: %s", err)
+	}
+
+	for {
+		orders, err := orderUsecase.GetOrdersPage(ctx, searchParams)
+		if err != nil {
+			return status.Errorf(codes.Internal, "Failed to get orders: %s", err)
+		}
+
+		var orderlines uint64
+		for _, order := range orders {
+			if err = writer.Write(order); err != nil {
+				return status.Errorf(codes.Internal, "Failed to export order: %s", err)
+			}
+			orderlines += uint64(len(order.Orderlines))
+		}
+
+		if orderlines < searchParams.Limit {
+			break
+		}
+
+		lastOrder := orders[len(orders)-1]
+		lastOrderline := lastOrder.Orderlines[len(lastOrder.Orderlines)-1]
+		searchParams.After = &dto.OrderlineCursor{
+			CreatedAt: lastOrder.CreatedAt,
+			OrderID:   lastOrder.ID,
+			ProductID: lastOrderline.ProductID,
+		}
+	}
+
+	if err = writer.Flush(); err != nil {
+		return status.Errorf(codes.Internal, "Failed to export orders: %s", err)
+	}
+
+	if err = buf.Flush(); err != nil {
+		return status.Errorf(codes.Internal, "Failed to send orders: %s", err)
+	}
+
+	return nil
+}
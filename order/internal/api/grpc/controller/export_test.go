@@ -0,0 +1,190 @@
+package controller_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Go-Marketplace/backend/order/internal/api/grpc/controller"
+	"github.com/Go-Marketplace/backend/order/internal/api/grpc/dto"
+	"github.com/Go-Marketplace/backend/order/internal/export"
+	mocks "github.com/Go-Marketplace/backend/order/internal/mocks/usecase"
+	"github.com/Go-Marketplace/backend/order/internal/model"
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type exportOrdersStream struct {
+	grpc.ServerStream
+
+	content bytes.Buffer
+}
+
+func (stream *exportOrdersStream) Send(resp *pbOrder.ExportOrdersResponse) error {
+	stream.content.Write(resp.Chunk)
+	return nil
+}
+
+func writeExport(t *testing.T, format pbOrder.OrderExportFormat, orders ...*model.Order) string {
+	t.Helper()
+
+	var content bytes.Buffer
+	writer, err := export.NewWriter(format, &content)
+	assert.NoError(t, err)
+
+	for _, order := range orders {
+		assert.NoError(t, writer.Write(order))
+	}
+	assert.NoError(t, writer.Flush())
+
+	return content.String()
+}
+
+func TestExportOrders(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sellerID := uuid.New()
+	from := time.Date(2023, time.December, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	// The first page is full, so the export asks for the page after its last orderline
+	fullOrder := &model.Order{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		CreatedAt: from.Add(time.Hour),
+	}
+	for i := 0; i < 500; i++ {
+		fullOrder.Orderlines = append(fullOrder.Orderlines, &model.Orderline{
+			OrderID:   fullOrder.ID,
+			ProductID: uuid.New(),
+			SellerID:  sellerID,
+			Price:     100,
+			Quantity:  1,
+			Status:    model.Recieved,
+		})
+	}
+	lastOrderline := fullOrder.Orderlines[len(fullOrder.Orderlines)-1]
+
+	order := &model.Order{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		CreatedAt: from.Add(2 * time.Hour),
+		Orderlines: []*model.Orderline{
+			{ProductID: uuid.New(), SellerID: sellerID, Price: 200, Quantity: 2, Status: model.Recieved},
+		},
+	}
+
+	searchParams := dto.ExportOrdersDTO{
+		From:     from,
+		To:       to,
+		Statuses: []model.OrderlineStatus{model.Recieved},
+		SellerID: sellerID,
+		Limit:    500,
+	}
+	nextParams := searchParams
+	nextParams.After = &dto.OrderlineCursor{
+		CreatedAt: fullOrder.CreatedAt,
+		OrderID:   fullOrder.ID,
+		ProductID: lastOrderline.ProductID,
+	}
+
+	req := &pbOrder.ExportOrdersRequest{
+		From:     timestamppb.New(from),
+		To:       timestamppb.New(to),
+		Statuses: []pbOrder.OrderlineStatus{pbOrder.OrderlineStatus_RECIEVED},
+		SellerId: sellerID.String(),
+	}
+	jsonlReq := &pbOrder.ExportOrdersRequest{
+		From:   timestamppb.New(from),
+		To:     timestamppb.New(to),
+		Format: pbOrder.OrderExportFormat_JSONL,
+	}
+
+	testcases := []struct {
+		name            string
+		req             *pbOrder.ExportOrdersRequest
+		mock            func(usecase *mocks.MockIOrderUsecase)
+		expectedContent string
+		expectedErr     error
+	}{
+		{
+			name: "Export reads the pages until the last one",
+			req:  req,
+			mock: func(usecase *mocks.MockIOrderUsecase) {
+				usecase.EXPECT().GetOrdersPage(ctx, searchParams).Return([]*model.Order{fullOrder}, nil).Times(1)
+				usecase.EXPECT().GetOrdersPage(ctx, nextParams).Return([]*model.Order{order}, nil).Times(1)
+			},
+			expectedContent: writeExport(t, pbOrder.OrderExportFormat_CSV, fullOrder, order),
+			expectedErr:     nil,
+		},
+		{
+			name: "Export writes JSON Lines",
+			req:  jsonlReq,
+			mock: func(usecase *mocks.MockIOrderUsecase) {
+				usecase.EXPECT().GetOrdersPage(ctx, dto.ExportOrdersDTO{
+					From:     from,
+					To:       to,
+					Statuses: []model.OrderlineStatus{},
+					Limit:    500,
+				}).Return([]*model.Order{order}, nil).Times(1)
+			},
+			expectedContent: writeExport(t, pbOrder.OrderExportFormat_JSONL, order),
+			expectedErr:     nil,
+		},
+		{
+			name: "Got error when from is after to",
+			req: &pbOrder.ExportOrdersRequest{
+				From: timestamppb.New(to),
+				To:   timestamppb.New(from),
+			},
+			mock:            func(usecase *mocks.MockIOrderUsecase) {},
+			expectedContent: "",
+			expectedErr:     status.Errorf(codes.InvalidArgument, "Invalid period: from must be before to"),
+		},
+		{
+			name: "Got error with unknown orderline status",
+			req: &pbOrder.ExportOrdersRequest{
+				From:     timestamppb.New(from),
+				To:       timestamppb.New(to),
+				Statuses: []pbOrder.OrderlineStatus{42},
+			},
+			mock:            func(usecase *mocks.MockIOrderUsecase) {},
+			expectedContent: "",
+			expectedErr:     status.Errorf(codes.InvalidArgument, "Unknown orderline status: %d", 42),
+		},
+		{
+			name: "Got error when usecase fails",
+			req:  req,
+			mock: func(usecase *mocks.MockIOrderUsecase) {
+				usecase.EXPECT().GetOrdersPage(ctx, searchParams).Return(nil, errors.New("test error")).Times(1)
+			},
+			expectedContent: "",
+			expectedErr:     status.Errorf(codes.Internal, "Failed to get orders: %s", errors.New("test error")),
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			orderUsecase := orderHelper(t)
+			testcase.mock(orderUsecase)
+
+			stream := &exportOrdersStream{}
+			actualErr := controller.ExportOrders(ctx, orderUsecase, testcase.req, stream)
+
+			assert.Equal(t, testcase.expectedErr, actualErr)
+			assert.Equal(t, testcase.expectedContent, stream.content.String())
+		})
+	}
+}
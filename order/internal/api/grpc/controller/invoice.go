@@ -0,0 +1,147 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/Go-Marketplace/backend/order/internal/invoice"
+	"github.com/Go-Marketplace/backend/order/internal/model"
+	"github.com/Go-Marketplace/backend/order/internal/usecase"
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Returns the seller display name, or the seller id when the seller has no profile
+func getSellerName(ctx context.Context, productClient pbProduct.ProductClient, sellerID uuid.UUID) (string, error) {
+	profile, err := productClient.GetSellerProfile(ctx, &pbProduct.GetSellerProfileRequest{
+		UserId: sellerID.String(),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return sellerID.String(), nil
+		}
+		return "", err
+	}
+
+	if profile.DisplayName == "" {
+		return sellerID.String(), nil
+	}
+
+	return profile.DisplayName, nil
+}
+
+// Returns the order, or a NotFound error when the order is missing or the user
+// is neither its buyer nor the seller, an empty user is trusted
+func getInvoiceOrder(ctx context.Context, orderUsecase usecase.IOrderUsecase, orderID, sellerID, userID uuid.UUID) (*model.Order, error) {
+	order, err := orderUsecase.GetOrder(ctx, orderID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get order: %s", err)
+	}
+
+	if order == nil {
+		return nil, status.Errorf(codes.NotFound, "Order not found")
+	}
+
+	if userID != uuid.Nil && userID != order.UserID && userID != sellerID {
+		return nil, status.Errorf(codes.NotFound, "Order not found")
+	}
+
+	return order, nil
+}
+
+// Renders the invoice of the seller part of the order. The invoice gets its number
+// and the snapshot of the order when it is requested for the first time
+func GetInvoice(
+	ctx context.Context,
+	orderUsecase usecase.IOrderUsecase,
+	productClient pbProduct.ProductClient,
+	req *pbOrder.GetInvoiceRequest,
+) (*model.InvoiceDocument, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	orderID, err := uuid.Parse(req.OrderId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid order id: %s", err)
+	}
+
+	sellerID, err := uuid.Parse(req.SellerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid seller id: %s", err)
+	}
+
+	if _, ok := pbOrder.InvoiceFormat_name[int32(req.Format)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "Unknown invoice format: %d", req.Format)
+	}
+
+	var userID uuid.UUID
+	if req.UserId != "" {
+		userID, err = uuid.Parse(req.UserId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid user id: %s", err)
+		}
+	}
+
+	issuedInvoice, err := orderUsecase.GetInvoice(ctx, orderID, sellerID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get invoice: %s", err)
+	}
+
+	switch {
+	case issuedInvoice == nil:
+		order, err := getInvoiceOrder(ctx, orderUsecase, orderID, sellerID, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		newInvoice := &model.Invoice{
+			ID:       uuid.New(),
+			OrderID:  orderID,
+			SellerID: sellerID,
+			IssuedAt: time.Now(),
+		}
+
+		newInvoice.FillFromOrder(order)
+		if len(newInvoice.Lines) == 0 {
+			return nil, status.Errorf(codes.NotFound, "Invoice not found")
+		}
+
+		newInvoice.SellerName, err = getSellerName(ctx, productClient, sellerID)
+		if err != nil {
+			return nil, status.Errorf(status.Code(err), "Failed to get seller profile: %s", status.Convert(err).Message())
+		}
+
+		issuedInvoice, err = orderUsecase.IssueInvoice(ctx, newInvoice)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to issue invoice: %s", err)
+		}
+	case issuedInvoice.Lines == nil:
+		// The invoice was issued before the snapshots were stored
+		order, err := getInvoiceOrder(ctx, orderUsecase, orderID, sellerID, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		issuedInvoice.FillFromOrder(order)
+	case userID != uuid.Nil && userID != issuedInvoice.UserID && userID != sellerID:
+		return nil, status.Errorf(codes.NotFound, "Order not found")
+	}
+
+	format := model.InvoiceFormat(req.Format)
+
+	var content bytes.Buffer
+	if err = invoice.Render(&content, format, issuedInvoice); err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to render invoice: %s", err)
+	}
+
+	return &model.InvoiceDocument{
+		Invoice: issuedInvoice,
+		Format:  format,
+		Content: content.Bytes(),
+	}, nil
+}
@@ -0,0 +1,192 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Go-Marketplace/backend/order/internal/api/grpc/controller"
+	mocks "github.com/Go-Marketplace/backend/order/internal/mocks/usecase"
+	"github.com/Go-Marketplace/backend/order/internal/model"
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetInvoice(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ctx context.Context
+		req *pbOrder.GetInvoiceRequest
+	}
+
+	ctx := context.Background()
+	orderID := uuid.New()
+	userID := uuid.New()
+	sellerID := uuid.New()
+
+	order := &model.Order{
+		ID:     orderID,
+		UserID: userID,
+		Orderlines: []*model.Orderline{
+			{OrderID: orderID, Name: "Fish", Price: 100, Quantity: 2, SellerID: sellerID},
+		},
+	}
+
+	issuedInvoice := &model.Invoice{
+		ID:         uuid.New(),
+		Number:     model.InvoiceNumber(sellerID, 7),
+		OrderID:    orderID,
+		SellerID:   sellerID,
+		SellerName: "Fish shop",
+	}
+
+	// Lines of the snapshot differ from the order, the snapshot is rendered without the order
+	snapshotInvoice := &model.Invoice{
+		ID:         uuid.New(),
+		Number:     model.InvoiceNumber(sellerID, 8),
+		OrderID:    orderID,
+		SellerID:   sellerID,
+		SellerName: "Fish shop",
+		UserID:     userID,
+		Lines: []*model.InvoiceLine{
+			{Name: "Fish", UnitPrice: 150, Quantity: 2},
+		},
+	}
+
+	testcases := []struct {
+		name           string
+		args           args
+		mock           func(usecase *mocks.MockIOrderUsecase)
+		expectedNumber string
+		expectedTotal  int64
+		expectedErr    error
+	}{
+		{
+			name: "Buyer gets the issued invoice",
+			args: args{
+				ctx: ctx,
+				req: &pbOrder.GetInvoiceRequest{
+					OrderId:  orderID.String(),
+					SellerId: sellerID.String(),
+					Format:   pbOrder.InvoiceFormat_HTML,
+					UserId:   userID.String(),
+				},
+			},
+			mock: func(usecase *mocks.MockIOrderUsecase) {
+				usecase.EXPECT().GetOrder(ctx, orderID).Return(order, nil).Times(1)
+				usecase.EXPECT().GetInvoice(ctx, orderID, sellerID).Return(issuedInvoice, nil).Times(1)
+			},
+			expectedNumber: issuedInvoice.Number,
+			expectedTotal:  200,
+			expectedErr:    nil,
+		},
+		{
+			name: "User is neither the buyer nor the seller",
+			args: args{
+				ctx: ctx,
+				req: &pbOrder.GetInvoiceRequest{
+					OrderId:  orderID.String(),
+					SellerId: sellerID.String(),
+					UserId:   uuid.New().String(),
+				},
+			},
+			mock: func(usecase *mocks.MockIOrderUsecase) {
+				usecase.EXPECT().GetInvoice(ctx, orderID, sellerID).Return(nil, nil).Times(1)
+				usecase.EXPECT().GetOrder(ctx, orderID).Return(order, nil).Times(1)
+			},
+			expectedErr: status.Errorf(codes.NotFound, "Order not found"),
+		},
+		{
+			name: "Buyer gets the snapshot of the issued invoice",
+			args: args{
+				ctx: ctx,
+				req: &pbOrder.GetInvoiceRequest{
+					OrderId:  orderID.String(),
+					SellerId: sellerID.String(),
+					UserId:   userID.String(),
+				},
+			},
+			mock: func(usecase *mocks.MockIOrderUsecase) {
+				usecase.EXPECT().GetInvoice(ctx, orderID, sellerID).Return(snapshotInvoice, nil).Times(1)
+			},
+			expectedNumber: snapshotInvoice.Number,
+			expectedTotal:  300,
+			expectedErr:    nil,
+		},
+		{
+			name: "User is neither the buyer nor the seller of the issued invoice",
+			args: args{
+				ctx: ctx,
+				req: &pbOrder.GetInvoiceRequest{
+					OrderId:  orderID.String(),
+					SellerId: sellerID.String(),
+					UserId:   uuid.New().String(),
+				},
+			},
+			mock: func(usecase *mocks.MockIOrderUsecase) {
+				usecase.EXPECT().GetInvoice(ctx, orderID, sellerID).Return(snapshotInvoice, nil).Times(1)
+			},
+			expectedErr: status.Errorf(codes.NotFound, "Order not found"),
+		},
+		{
+			name: "Seller has no lines in the order",
+			args: args{
+				ctx: ctx,
+				req: &pbOrder.GetInvoiceRequest{
+					OrderId:  orderID.String(),
+					SellerId: userID.String(),
+					UserId:   userID.String(),
+				},
+			},
+			mock: func(usecase *mocks.MockIOrderUsecase) {
+				usecase.EXPECT().GetInvoice(ctx, orderID, userID).Return(nil, nil).Times(1)
+				usecase.EXPECT().GetOrder(ctx, orderID).Return(order, nil).Times(1)
+			},
+			expectedErr: status.Errorf(codes.NotFound, "Invoice not found"),
+		},
+		{
+			name: "Unknown invoice format",
+			args: args{
+				ctx: ctx,
+				req: &pbOrder.GetInvoiceRequest{
+					OrderId:  orderID.String(),
+					SellerId: sellerID.String(),
+					Format:   5,
+				},
+			},
+			mock:        func(usecase *mocks.MockIOrderUsecase) {},
+			expectedErr: status.Errorf(codes.InvalidArgument, "Unknown invoice format: %d", 5),
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			orderUsecase := orderHelper(t)
+			testcase.mock(orderUsecase)
+
+			actualDocument, actualErr := controller.GetInvoice(
+				testcase.args.ctx,
+				orderUsecase,
+				nil,
+				testcase.args.req,
+			)
+
+			assert.Equal(t, testcase.expectedErr, actualErr)
+			if testcase.expectedErr != nil {
+				assert.Nil(t, actualDocument)
+				return
+			}
+
+			assert.Equal(t, testcase.expectedNumber, actualDocument.Invoice.Number)
+			assert.Equal(t, testcase.expectedTotal, actualDocument.Invoice.Total())
+			assert.Contains(t, string(actualDocument.Content), testcase.expectedNumber)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/Go-Marketplace/backend/order/internal/model"
+	"github.com/Go-Marketplace/backend/order/internal/usecase"
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func GetSellerLedger(
+	ctx context.Context,
+	orderUsecase usecase.IOrderUsecase,
+	req *pbOrder.GetSellerLedgerRequest,
+) ([]*model.LedgerEntry, []*model.Balance, error) {
+	if req == nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	sellerID, err := uuid.Parse(req.SellerId)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "Invalid seller id: %s", err)
+	}
+
+	entries, err := orderUsecase.GetSellerEntries(ctx, sellerID)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "Failed to get seller ledger entries: %s", err)
+	}
+
+	balances, err := orderUsecase.GetSellerBalances(ctx, sellerID)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "Failed to get seller balances: %s", err)
+	}
+
+	return entries, balances, nil
+}
+
+func CreatePayout(ctx context.Context, orderUsecase usecase.IOrderUsecase, req *pbOrder.CreatePayoutRequest) ([]*model.LedgerTransaction, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	sellerID, err := uuid.Parse(req.SellerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid seller id: %s", err)
+	}
+
+	payouts, err := orderUsecase.CreatePayouts(ctx, sellerID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to create payout: %s", err)
+	}
+
+	if len(payouts) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "Failed to create payout: %s", model.ErrNothingToPayOut)
+	}
+
+	return payouts, nil
+}
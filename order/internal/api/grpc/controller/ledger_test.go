@@ -0,0 +1,96 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Go-Marketplace/backend/order/internal/api/grpc/controller"
+	mocks "github.com/Go-Marketplace/backend/order/internal/mocks/usecase"
+	"github.com/Go-Marketplace/backend/order/internal/model"
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreatePayout(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sellerID := uuid.New()
+
+	payouts := []*model.LedgerTransaction{
+		model.NewPayoutTransaction(sellerID, "RUB", 1500, time.Now()),
+	}
+	expectedErrFromUsecase := errors.New("test error")
+
+	testcases := []struct {
+		name            string
+		req             *pbOrder.CreatePayoutRequest
+		mock            func(usecase *mocks.MockIOrderUsecase)
+		expectedPayouts []*model.LedgerTransaction
+		expectedErr     error
+	}{
+		{
+			name: "Successfully create payout",
+			req: &pbOrder.CreatePayoutRequest{
+				SellerId: sellerID.String(),
+			},
+			mock: func(usecase *mocks.MockIOrderUsecase) {
+				usecase.EXPECT().CreatePayouts(ctx, sellerID).Return(payouts, nil).Times(1)
+			},
+			expectedPayouts: payouts,
+			expectedErr:     nil,
+		},
+		{
+			name: "Got error when seller has no balance",
+			req: &pbOrder.CreatePayoutRequest{
+				SellerId: sellerID.String(),
+			},
+			mock: func(usecase *mocks.MockIOrderUsecase) {
+				usecase.EXPECT().CreatePayouts(ctx, sellerID).Return([]*model.LedgerTransaction{}, nil).Times(1)
+			},
+			expectedPayouts: nil,
+			expectedErr:     status.Errorf(codes.FailedPrecondition, "Failed to create payout: %s", model.ErrNothingToPayOut),
+		},
+		{
+			name: "Got error when create payouts",
+			req: &pbOrder.CreatePayoutRequest{
+				SellerId: sellerID.String(),
+			},
+			mock: func(usecase *mocks.MockIOrderUsecase) {
+				usecase.EXPECT().CreatePayouts(ctx, sellerID).Return(nil, expectedErrFromUsecase).Times(1)
+			},
+			expectedPayouts: nil,
+			expectedErr:     status.Errorf(codes.Internal, "Failed to create payout: %s", expectedErrFromUsecase),
+		},
+		{
+			name: "Got error when seller id is invalid",
+			req: &pbOrder.CreatePayoutRequest{
+				SellerId: "invalid",
+			},
+			mock:            func(usecase *mocks.MockIOrderUsecase) {},
+			expectedPayouts: nil,
+			expectedErr:     status.Errorf(codes.InvalidArgument, "Invalid seller id: %s", errors.New("invalid UUID length: 7")),
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			orderUsecase := orderHelper(t)
+			testcase.mock(orderUsecase)
+
+			actualPayouts, actualErr := controller.CreatePayout(ctx, orderUsecase, testcase.req)
+
+			assert.Equal(t, testcase.expectedPayouts, actualPayouts)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
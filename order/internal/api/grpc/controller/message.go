@@ -0,0 +1,133 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/Go-Marketplace/backend/order/internal/model"
+	"github.com/Go-Marketplace/backend/order/internal/usecase"
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func GetOrderThread(ctx context.Context, orderUsecase usecase.IOrderUsecase, req *pbOrder.GetOrderThreadRequest) (*model.OrderThread, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	order, _, _, err := authorizeParticipant(ctx, orderUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	thread, err := orderUsecase.GetOrderThread(ctx, order.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get order thread: %s", err)
+	}
+
+	return thread, nil
+}
+
+// Sends the message to the order thread, the attachments must be files of the media store under mediaBaseURL
+func CreateOrderMessage(
+	ctx context.Context,
+	orderUsecase usecase.IOrderUsecase,
+	mediaBaseURL string,
+	req *pbOrder.CreateOrderMessageRequest,
+) (*model.OrderMessage, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	order, userID, role, err := authorizeParticipant(ctx, orderUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	message := &model.OrderMessage{
+		ID:          uuid.New(),
+		OrderID:     order.ID,
+		AuthorID:    userID,
+		AuthorRole:  role,
+		Body:        strings.TrimSpace(req.Body),
+		Attachments: req.Attachments,
+		CreatedAt:   time.Now(),
+	}
+
+	if err = message.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid message: %s", err)
+	}
+
+	if err = message.CheckAttachments(mediaBaseURL); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid message: %s", err)
+	}
+
+	if err = orderUsecase.CreateOrderMessage(ctx, message); err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to create order message: %s", err)
+	}
+
+	return message, nil
+}
+
+// Marks every message of the thread sent until now as read by the caller
+func MarkOrderThreadRead(ctx context.Context, orderUsecase usecase.IOrderUsecase, req *pbOrder.MarkOrderThreadReadRequest) (*model.ReadReceipt, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	order, userID, _, err := authorizeParticipant(ctx, orderUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	receipt := &model.ReadReceipt{
+		OrderID: order.ID,
+		UserID:  userID,
+		ReadAt:  time.Now(),
+	}
+
+	if err = orderUsecase.MarkThreadRead(ctx, receipt); err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to mark order thread read: %s", err)
+	}
+
+	return receipt, nil
+}
+
+// Sends the new messages and read receipts of the thread until the client leaves.
+// A stream that falls behind is closed, the client gets the thread and watches it again
+func WatchOrderThread(
+	ctx context.Context,
+	orderUsecase usecase.IOrderUsecase,
+	req *pbOrder.WatchOrderThreadRequest,
+	stream pbOrder.Order_WatchOrderThreadServer,
+) error {
+	if req == nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	order, _, _, err := authorizeParticipant(ctx, orderUsecase, req)
+	if err != nil {
+		return err
+	}
+
+	events, unsubscribe := orderUsecase.WatchOrderThread(order.ID)
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return status.Errorf(codes.Unavailable, "Order thread stream fell behind, watch it again")
+			}
+
+			if err = stream.Send(event.ToProto()); err != nil {
+				return status.Errorf(codes.Unavailable, "Failed to send order thread event: %s", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/Go-Marketplace/backend/order/internal/api/grpc/controller"
+	mocks "github.com/Go-Marketplace/backend/order/internal/mocks/usecase"
+	"github.com/Go-Marketplace/backend/order/internal/model"
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateOrderMessage(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	orderID := uuid.New()
+	buyerID := uuid.New()
+	sellerID := uuid.New()
+	adminID := uuid.New()
+
+	order := &model.Order{
+		ID:     orderID,
+		UserID: buyerID,
+		Orderlines: []*model.Orderline{
+			{OrderID: orderID, SellerID: sellerID},
+		},
+	}
+	expectedErrFromUsecase := errors.New("test error")
+	mediaBaseURL := "https://media.example.com/orders/"
+
+	testcases := []struct {
+		name         string
+		req          *pbOrder.CreateOrderMessageRequest
+		mock         func(usecase *mocks.MockIOrderUsecase)
+		expectedRole model.AuthorRole
+		expectedErr  error
+	}{
+		{
+			name: "Buyer sends message",
+			req: &pbOrder.CreateOrderMessageRequest{
+				OrderId: orderID.String(),
+				UserId:  buyerID.String(),
+				Body:    " Where is my order? ",
+			},
+			mock: func(usecase *mocks.MockIOrderUsecase) {
+				usecase.EXPECT().GetOrder(ctx, orderID).Return(order, nil).Times(1)
+				usecase.EXPECT().CreateOrderMessage(ctx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, message *model.OrderMessage) error {
+						assert.Equal(t, "Where is my order?", message.Body)
+						assert.Equal(t, buyerID, message.AuthorID)
+						return nil
+					},
+				).Times(1)
+			},
+			expectedRole: model.BuyerAuthor,
+			expectedErr:  nil,
+		},
+		{
+			name: "Seller sends message",
+			req: &pbOrder.CreateOrderMessageRequest{
+				OrderId: orderID.String(),
+				UserId:  sellerID.String(),
+				Body:    "It is on the way",
+			},
+			mock: func(usecase *mocks.MockIOrderUsecase) {
+				usecase.EXPECT().GetOrder(ctx, orderID).Return(order, nil).Times(1)
+				usecase.EXPECT().CreateOrderMessage(ctx, gomock.Any()).Return(nil).Times(1)
+			},
+			expectedRole: model.SellerAuthor,
+			expectedErr:  nil,
+		},
+		{
+			name: "Admin sends message",
+			req: &pbOrder.CreateOrderMessageRequest{
+				OrderId: orderID.String(),
+				UserId:  adminID.String(),
+				Admin:   true,
+				Body:    "We are looking into it",
+			},
+			mock: func(usecase *mocks.MockIOrderUsecase) {
+				usecase.EXPECT().GetOrder(ctx, orderID).Return(order, nil).Times(1)
+				usecase.EXPECT().CreateOrderMessage(ctx, gomock.Any()).Return(nil).Times(1)
+			},
+			expectedRole: model.AdminAuthor,
+			expectedErr:  nil,
+		},
+		{
+			name: "Got error when user is not a participant",
+			req: &pbOrder.CreateOrderMessageRequest{
+				OrderId: orderID.String(),
+				UserId:  uuid.New().String(),
+				Body:    "Hello",
+			},
+			mock: func(usecase *mocks.MockIOrderUsecase) {
+				usecase.EXPECT().GetOrder(ctx, orderID).Return(order, nil).Times(1)
+			},
+			expectedErr: status.Errorf(codes.NotFound, "Order not found"),
+		},
+		{
+			name: "Got error when order not found",
+			req: &pbOrder.CreateOrderMessageRequest{
+				OrderId: orderID.String(),
+				UserId:  buyerID.String(),
+				Body:    "Hello",
+			},
+			mock: func(usecase *mocks.MockIOrderUsecase) {
+				usecase.EXPECT().GetOrder(ctx, orderID).Return(nil, nil).Times(1)
+			},
+			expectedErr: status.Errorf(codes.NotFound, "Order not found"),
+		},
+		{
+			name: "Got error when message is empty",
+			req: &pbOrder.CreateOrderMessageRequest{
+				OrderId: orderID.String(),
+				UserId:  buyerID.String(),
+				Body:    " ",
+			},
+			mock: func(usecase *mocks.MockIOrderUsecase) {
+				usecase.EXPECT().GetOrder(ctx, orderID).Return(order, nil).Times(1)
+			},
+			expectedErr: status.Errorf(codes.InvalidArgument, "Invalid message: %s", model.ErrEmptyMessage),
+		},
+		{
+			name: "Buyer sends attachment from the media store",
+			req: &pbOrder.CreateOrderMessageRequest{
+				OrderId:     orderID.String(),
+				UserId:      buyerID.String(),
+				Attachments: []string{"https://media.example.com/orders/photo.jpg"},
+			},
+			mock: func(usecase *mocks.MockIOrderUsecase) {
+				usecase.EXPECT().GetOrder(ctx, orderID).Return(order, nil).Times(1)
+				usecase.EXPECT().CreateOrderMessage(ctx, gomock.Any()).Return(nil).Times(1)
+			},
+			expectedRole: model.BuyerAuthor,
+			expectedErr:  nil,
+		},
+		{
+			name: "Got error when attachment is not in the media store",
+			req: &pbOrder.CreateOrderMessageRequest{
+				OrderId:     orderID.String(),
+				UserId:      buyerID.String(),
+				Attachments: []string{"https://evil.example.com/orders/photo.jpg"},
+			},
+			mock: func(usecase *mocks.MockIOrderUsecase) {
+				usecase.EXPECT().GetOrder(ctx, orderID).Return(order, nil).Times(1)
+			},
+			expectedErr: status.Errorf(
+				codes.InvalidArgument,
+				"Invalid message: %s",
+				fmt.Errorf("%w: %s", model.ErrForeignAttachment, "https://evil.example.com/orders/photo.jpg"),
+			),
+		},
+		{
+			name: "Got error when create message",
+			req: &pbOrder.CreateOrderMessageRequest{
+				OrderId: orderID.String(),
+				UserId:  buyerID.String(),
+				Body:    "Hello",
+			},
+			mock: func(usecase *mocks.MockIOrderUsecase) {
+				usecase.EXPECT().GetOrder(ctx, orderID).Return(order, nil).Times(1)
+				usecase.EXPECT().CreateOrderMessage(ctx, gomock.Any()).Return(expectedErrFromUsecase).Times(1)
+			},
+			expectedErr: status.Errorf(codes.Internal, "Failed to create order message: %s", expectedErrFromUsecase),
+		},
+		{
+			name: "Got error when user id is invalid",
+			req: &pbOrder.CreateOrderMessageRequest{
+				OrderId: orderID.String(),
+				UserId:  "invalid",
+				Body:    "Hello",
+			},
+			mock:        func(usecase *mocks.MockIOrderUsecase) {},
+			expectedErr: status.Errorf(codes.InvalidArgument, "Invalid user id: %s", errors.New("invalid UUID length: 7")),
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			orderUsecase := orderHelper(t)
+			testcase.mock(orderUsecase)
+
+			actualMessage, actualErr := controller.CreateOrderMessage(ctx, orderUsecase, mediaBaseURL, testcase.req)
+
+			assert.Equal(t, testcase.expectedErr, actualErr)
+			if testcase.expectedErr == nil {
+				assert.Equal(t, testcase.expectedRole, actualMessage.AuthorRole)
+				assert.Equal(t, orderID, actualMessage.OrderID)
+			}
+		})
+	}
+}
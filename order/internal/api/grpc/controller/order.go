@@ -3,14 +3,18 @@ package controller
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Go-Marketplace/backend/order/internal/api/grpc/dto"
 	"github.com/Go-Marketplace/backend/order/internal/model"
+	"github.com/Go-Marketplace/backend/order/internal/tax"
 	"github.com/Go-Marketplace/backend/order/internal/usecase"
+	"github.com/Go-Marketplace/backend/pkg/money"
 	pbCart "github.com/Go-Marketplace/backend/proto/gen/cart"
 	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
 	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+	pbUser "github.com/Go-Marketplace/backend/proto/gen/user"
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -79,11 +83,47 @@ func getProducts(ctx context.Context, productClient pbProduct.ProductClient, pro
 	return products, nil
 }
 
+// Returns the promo lines with the prices in the base currency, the promo code values are in it
+func promoLines(
+	cartlines []*pbCart.CartlineResponse,
+	products []*pbProduct.ProductResponse,
+	baseCurrency string,
+	rates money.ExchangeRates,
+) ([]model.PromoLine, error) {
+	lines := make([]model.PromoLine, 0, len(cartlines))
+	for i, cartline := range cartlines {
+		if products[i] == nil {
+			continue
+		}
+
+		sellerID, err := uuid.Parse(products[i].UserId)
+		if err != nil {
+			return nil, fmt.Errorf("invalid seller id: %w", err)
+		}
+
+		rate, err := rates.Rate(money.ProductCurrency(products[i], baseCurrency), baseCurrency)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert product %s price: %w", products[i].ProductId, err)
+		}
+
+		lines = append(lines, model.PromoLine{
+			CategoryID: products[i].CategoryId,
+			SellerID:   sellerID,
+			Price:      money.ConvertAmount(products[i].Price, rate),
+			Quantity:   cartline.Quantity,
+		})
+	}
+
+	return lines, nil
+}
+
 func CreateOrder(
 	ctx context.Context,
 	orderUsecase usecase.IOrderUsecase,
 	cartClient pbCart.CartClient,
 	productClient pbProduct.ProductClient,
+	userClient pbUser.UserClient,
+	taxCalculator tax.TaxCalculator,
 	req *pbOrder.CreateOrderRequest,
 ) (*model.Order, error) {
 	cartResp, err := cartClient.GetUserCart(ctx, &pbCart.GetUserCartRequest{
@@ -103,17 +143,52 @@ func CreateOrder(
 		return nil, status.Errorf(codes.Internal, "Failed to get products: %s", err)
 	}
 
+	baseCurrency, rates, err := money.GetExchangeRates(ctx, productClient)
+	if err != nil {
+		return nil, err
+	}
+
+	currency := money.NormalizeCurrency(req.Currency)
+	if currency == "" {
+		currency = baseCurrency
+	}
+
+	if _, ok := rates[currency]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "Unknown currency: %s", currency)
+	}
+
 	userID, err := uuid.Parse(cartResp.UserId)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid user id: %s", err)
 	}
 
+	user, err := userClient.GetUser(ctx, &pbUser.GetUserRequest{
+		UserId: cartResp.UserId,
+	})
+	if err != nil {
+		return nil, status.Errorf(status.Code(err), "Failed to get user: %s", status.Convert(err).Message())
+	}
+
+	shippingAddress := req.ShippingAddress
+	if shippingAddress == "" {
+		shippingAddress = user.Address
+	}
+
 	newOrder := &model.Order{
-		ID:         uuid.New(),
-		UserID:     userID,
-		Orderlines: make([]*model.Orderline, 0, len(cartResp.Cartlines)),
-		CreatedAt:  time.Now(),
-		UpdatedAt:  time.Now(),
+		ID:              uuid.New(),
+		UserID:          userID,
+		BuyerName:       strings.TrimSpace(user.FirstName + " " + user.LastName),
+		BuyerEmail:      user.Email,
+		ShippingAddress: shippingAddress,
+		ShippingRegion:  tax.NormalizeRegion(req.ShippingRegion),
+		Currency:        currency,
+		Orderlines:      make([]*model.Orderline, 0, len(cartResp.Cartlines)),
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+	}
+
+	if err = newOrder.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid order request: %s", err)
 	}
 
 	for i, cartline := range cartResp.Cartlines {
@@ -126,22 +201,85 @@ func CreateOrder(
 			return nil, status.Errorf(codes.InvalidArgument, "Invalid product id: %s", err)
 		}
 
+		warehouseID, err := uuid.Parse(cartline.WarehouseId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid warehouse id: %s", err)
+		}
+
+		sellerID, err := uuid.Parse(products[i].UserId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Invalid seller id: %s", err)
+		}
+
+		sellerCurrency := money.ProductCurrency(products[i], baseCurrency)
+		exchangeRate, err := rates.Rate(sellerCurrency, currency)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to convert product %s price: %s", productID, err)
+		}
+
+		var discountPercent float32
+		if money.DiscountActiveAt(products[i].Discount, newOrder.CreatedAt) {
+			discountPercent = products[i].Discount.Percent
+		}
+
 		orderline := &model.Orderline{
-			OrderID:   newOrder.ID,
-			ProductID: productID,
-			Name:      products[i].Name,
-			Quantity:  cartline.Quantity,
-			Price:     products[i].Price,
-			Status:    model.PendingPayment,
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
+			OrderID:         newOrder.ID,
+			ProductID:       productID,
+			Name:            products[i].Name,
+			Quantity:        cartline.Quantity,
+			WarehouseID:     warehouseID,
+			SellerID:        sellerID,
+			CategoryID:      products[i].CategoryId,
+			Price:           products[i].Price,
+			DiscountPercent: discountPercent,
+			Currency:        sellerCurrency,
+			ExchangeRate:    exchangeRate,
+			Status:          model.PendingPayment,
+			CreatedAt:       time.Now(),
+			UpdatedAt:       time.Now(),
 		}
 
+		lineTax := taxCalculator.Calculate(newOrder.ShippingRegion, tax.Line{
+			CategoryID: products[i].CategoryId,
+			Amount:     orderline.TaxableAmount(),
+		})
+		orderline.TaxRate = lineTax.Rate
+		orderline.Tax = lineTax.Amount
+
 		newOrder.Orderlines = append(newOrder.Orderlines, orderline)
 	}
 
+	promoCode := req.PromoCode
+	if promoCode == "" {
+		promoCode = cartResp.PromoCode
+	}
+
+	if promoCode != "" {
+		lines, err := promoLines(cartResp.Cartlines, products, baseCurrency, rates)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to get promo lines: %s", err)
+		}
+
+		newOrder.PromoCode = strings.ToUpper(promoCode)
+		promoDiscount, err := applyPromoCode(ctx, orderUsecase, newOrder.PromoCode, userID, lines)
+		if err != nil {
+			return nil, err
+		}
+
+		promoRate, err := rates.Rate(baseCurrency, currency)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to convert promo discount: %s", err)
+		}
+		newOrder.PromoDiscount = money.ConvertAmount(promoDiscount, promoRate)
+	}
+
+	newOrder.CalculateTotals()
+
 	order, err := orderUsecase.CreateOrder(ctx, newOrder)
 	if err != nil {
+		if isRedemptionError(err) {
+			return nil, status.Errorf(codes.FailedPrecondition, "Failed to redeem promo code: %s", err)
+		}
 		return nil, status.Errorf(codes.Internal, "Failed to create order: %s", err)
 	}
 
@@ -157,6 +295,18 @@ func CreateOrder(
 	return order, nil
 }
 
+// Received orderlines are credited to the sellers, only canceling them books the refund,
+// so they can't be deleted by the buyer
+func checkDeletableOrderlines(orderlines ...*model.Orderline) error {
+	for _, orderline := range orderlines {
+		if orderline.Status == model.Recieved {
+			return status.Errorf(codes.FailedPrecondition, "Failed to delete orderline: %s", model.ErrReceivedOrderline)
+		}
+	}
+
+	return nil
+}
+
 func returnProducts(ctx context.Context, productClient pbProduct.ProductClient, orderlines ...*model.Orderline) error {
 	productIDs := make([]string, 0, len(orderlines))
 	for _, orderline := range orderlines {
@@ -168,21 +318,18 @@ func returnProducts(ctx context.Context, productClient pbProduct.ProductClient,
 		return fmt.Errorf("failed to get products: %w", err)
 	}
 
-	updateProductRequests := make([]*pbProduct.UpdateProductRequest, 0, len(orderlines))
 	for i, orderline := range orderlines {
-		if products[i] != nil {
-			newQuantity := products[i].Quantity + orderline.Quantity
-			updateProductRequests = append(updateProductRequests, &pbProduct.UpdateProductRequest{
-				ProductId: orderline.ProductID.String(),
-				Quantity:  &newQuantity,
-			})
+		if products[i] == nil {
+			continue
 		}
-	}
 
-	if _, err := productClient.UpdateProducts(ctx, &pbProduct.UpdateProductsRequest{
-		Products: updateProductRequests,
-	}); err != nil {
-		return fmt.Errorf("failed to update products: %w", err)
+		if _, err = productClient.ReleaseProduct(ctx, &pbProduct.ReleaseProductRequest{
+			ProductId:   orderline.ProductID.String(),
+			Quantity:    orderline.Quantity,
+			WarehouseId: orderline.WarehouseID.String(),
+		}); err != nil {
+			return fmt.Errorf("failed to release product %s: %w", orderline.ProductID, err)
+		}
 	}
 
 	return nil
@@ -204,6 +351,14 @@ func DeleteOrder(
 		return status.Errorf(codes.Internal, "Failed to get order: %s", err)
 	}
 
+	if order == nil {
+		return status.Errorf(codes.NotFound, "Order not found")
+	}
+
+	if err = checkDeletableOrderlines(order.Orderlines...); err != nil {
+		return err
+	}
+
 	if err = orderUsecase.DeleteOrder(ctx, orderID); err != nil {
 		return status.Errorf(codes.Internal, "Failed to delete order: %s", err)
 	}
@@ -218,6 +373,8 @@ func DeleteOrder(
 	return nil
 }
 
+// Removes the orders of a deleted account, the ledger keeps the sales of the received
+// orderlines since the sellers were already credited for them
 func DeleteUserOrders(
 	ctx context.Context,
 	orderUsecase usecase.IOrderUsecase,
@@ -299,6 +456,10 @@ func UpdateOrderline(ctx context.Context, orderUsecase usecase.IOrderUsecase, re
 		return nil, status.Errorf(codes.Internal, "Failed to update orderline: %s", err)
 	}
 
+	if orderline == nil {
+		return nil, status.Errorf(codes.NotFound, "Orderline not found")
+	}
+
 	return orderline, nil
 }
 
@@ -323,6 +484,14 @@ func DeleteOrderline(
 		return status.Errorf(codes.Internal, "Failed to get orderline: %s", err)
 	}
 
+	if orderline == nil {
+		return status.Errorf(codes.NotFound, "Orderline not found")
+	}
+
+	if err = checkDeletableOrderlines(orderline); err != nil {
+		return err
+	}
+
 	if err = orderUsecase.DeleteOrderline(ctx, orderID, productID); err != nil {
 		return status.Errorf(codes.Internal, "Failed to delete orderline: %s", err)
 	}
@@ -336,3 +505,150 @@ func DeleteOrderline(
 
 	return nil
 }
+
+func HasReceivedProduct(ctx context.Context, orderUsecase usecase.IOrderUsecase, req *pbOrder.HasReceivedProductRequest) (bool, error) {
+	if req == nil {
+		return false, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return false, status.Errorf(codes.InvalidArgument, "Invalid user id: %s", err)
+	}
+
+	productID, err := uuid.Parse(req.ProductId)
+	if err != nil {
+		return false, status.Errorf(codes.InvalidArgument, "Invalid product id: %s", err)
+	}
+
+	received, err := orderUsecase.HasReceivedProduct(ctx, userID, productID)
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "Failed to check received product: %s", err)
+	}
+
+	return received, nil
+}
+
+func GetPurchasedQuantity(ctx context.Context, orderUsecase usecase.IOrderUsecase, req *pbOrder.GetPurchasedQuantityRequest) (int64, error) {
+	if req == nil {
+		return 0, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "Invalid user id: %s", err)
+	}
+
+	productID, err := uuid.Parse(req.ProductId)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "Invalid product id: %s", err)
+	}
+
+	var since time.Time
+	if req.Since != nil {
+		since = req.Since.AsTime()
+	}
+
+	quantity, err := orderUsecase.GetPurchasedQuantity(ctx, userID, productID, since)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "Failed to get purchased quantity: %s", err)
+	}
+
+	return quantity, nil
+}
+
+type participantRequest interface {
+	GetOrderId() string
+	GetUserId() string
+	GetAdmin() bool
+}
+
+// Returns the order with the caller and their role in it. Only the buyer and the sellers
+// of the order get its thread and watch it, admins get any order. Other users
+// get not found, so they can not tell which orders exist
+func authorizeParticipant(
+	ctx context.Context,
+	orderUsecase usecase.IOrderUsecase,
+	req participantRequest,
+) (*model.Order, uuid.UUID, model.AuthorRole, error) {
+	orderID, err := uuid.Parse(req.GetOrderId())
+	if err != nil {
+		return nil, uuid.Nil, 0, status.Errorf(codes.InvalidArgument, "Invalid order id: %s", err)
+	}
+
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, uuid.Nil, 0, status.Errorf(codes.InvalidArgument, "Invalid user id: %s", err)
+	}
+
+	order, err := orderUsecase.GetOrder(ctx, orderID)
+	if err != nil {
+		return nil, uuid.Nil, 0, status.Errorf(codes.Internal, "Failed to get order: %s", err)
+	}
+
+	if order == nil {
+		return nil, uuid.Nil, 0, status.Errorf(codes.NotFound, "Order not found")
+	}
+
+	role, ok := order.ParticipantRole(userID)
+	if !ok {
+		if !req.GetAdmin() {
+			return nil, uuid.Nil, 0, status.Errorf(codes.NotFound, "Order not found")
+		}
+		role = model.AdminAuthor
+	}
+
+	return order, userID, role, nil
+}
+
+// Sends the status of every orderline and then the changes of the order until the client
+// leaves or the order is deleted. The stream subscribes before it reads the order, so no
+// change is lost between them. A stream that falls behind is closed to be watched again
+func WatchOrder(
+	ctx context.Context,
+	orderUsecase usecase.IOrderUsecase,
+	req *pbOrder.WatchOrderRequest,
+	stream pbOrder.Order_WatchOrderServer,
+) error {
+	if req == nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	orderID, err := uuid.Parse(req.OrderId)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid order id: %s", err)
+	}
+
+	events, unsubscribe := orderUsecase.WatchOrder(orderID)
+	defer unsubscribe()
+
+	order, _, _, err := authorizeParticipant(ctx, orderUsecase, req)
+	if err != nil {
+		return err
+	}
+
+	for _, orderline := range order.Orderlines {
+		if err = stream.Send(model.NewOrderlineStatusEvent(orderline).ToProto()); err != nil {
+			return status.Errorf(codes.Unavailable, "Failed to send order event: %s", err)
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return status.Errorf(codes.Unavailable, "Order stream fell behind, watch it again")
+			}
+
+			if err = stream.Send(event.ToProto()); err != nil {
+				return status.Errorf(codes.Unavailable, "Failed to send order event: %s", err)
+			}
+
+			if event.Kind == model.OrderDeletedEvent {
+				return nil
+			}
+		}
+	}
+}
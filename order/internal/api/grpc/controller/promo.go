@@ -0,0 +1,175 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/Go-Marketplace/backend/order/internal/model"
+	"github.com/Go-Marketplace/backend/order/internal/usecase"
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Reports whether the order could not redeem its promo code
+func isRedemptionError(err error) bool {
+	return errors.Is(err, model.ErrPromoUsesExceeded) ||
+		errors.Is(err, model.ErrPromoUserLimitUsed) ||
+		errors.Is(err, model.ErrPromoCodeNotExists)
+}
+
+// Calculates the promo code discount for the user lines and checks that the
+// usage limits are not reached yet. The limits are checked again atomically
+// when the order redeems the code
+func applyPromoCode(
+	ctx context.Context,
+	orderUsecase usecase.IOrderUsecase,
+	code string,
+	userID uuid.UUID,
+	lines []model.PromoLine,
+) (int64, error) {
+	promo, err := orderUsecase.GetPromoCode(ctx, code)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "Failed to get promo code: %s", err)
+	}
+
+	if promo == nil {
+		return 0, status.Errorf(codes.NotFound, "Promo code not found")
+	}
+
+	discount, err := promo.Discount(lines, time.Now())
+	if err != nil {
+		return 0, status.Errorf(codes.FailedPrecondition, "Failed to apply promo code: %s", err)
+	}
+
+	if promo.MaxUses != 0 && promo.Used >= promo.MaxUses {
+		return 0, status.Errorf(codes.FailedPrecondition, "Failed to apply promo code: %s", model.ErrPromoUsesExceeded)
+	}
+
+	if promo.PerUserLimit != 0 {
+		used, err := orderUsecase.CountUserRedemptions(ctx, code, userID)
+		if err != nil {
+			return 0, status.Errorf(codes.Internal, "Failed to count promo code redemptions: %s", err)
+		}
+
+		if used >= promo.PerUserLimit {
+			return 0, status.Errorf(codes.FailedPrecondition, "Failed to apply promo code: %s", model.ErrPromoUserLimitUsed)
+		}
+	}
+
+	return discount, nil
+}
+
+func CreatePromoCode(ctx context.Context, orderUsecase usecase.IOrderUsecase, req *pbOrder.CreatePromoCodeRequest) (*model.PromoCode, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	if req.EndsAt == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid promo code request: ends_at is required")
+	}
+
+	sellerIDs := make([]uuid.UUID, 0, len(req.SellerIds))
+	for _, id := range req.SellerIds {
+		sellerID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid seller id: %s", err)
+		}
+		sellerIDs = append(sellerIDs, sellerID)
+	}
+
+	categoryIDs := req.CategoryIds
+	if categoryIDs == nil {
+		categoryIDs = make([]int32, 0)
+	}
+
+	now := time.Now()
+	promo := model.PromoCode{
+		Code:         strings.ToUpper(req.Code),
+		Kind:         model.PromoKind(req.Kind),
+		Value:        req.Value,
+		MinTotal:     req.MinTotal,
+		MaxUses:      req.MaxUses,
+		PerUserLimit: req.PerUserLimit,
+		CategoryIDs:  categoryIDs,
+		SellerIDs:    sellerIDs,
+		StartsAt:     now,
+		EndsAt:       req.EndsAt.AsTime(),
+		CreatedAt:    now,
+	}
+
+	if req.StartsAt != nil {
+		promo.StartsAt = req.StartsAt.AsTime()
+	}
+
+	if err := promo.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid promo code request: %s", err)
+	}
+
+	oldPromo, err := orderUsecase.GetPromoCode(ctx, promo.Code)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get promo code: %s", err)
+	}
+
+	if oldPromo != nil {
+		return nil, status.Errorf(codes.AlreadyExists, "Promo code already exists")
+	}
+
+	newPromo, err := orderUsecase.CreatePromoCode(ctx, promo)
+	if err != nil {
+		if errors.Is(err, model.ErrPromoCodeExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "Promo code already exists")
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to create promo code: %s", err)
+	}
+
+	return newPromo, nil
+}
+
+func GetPromoCode(ctx context.Context, orderUsecase usecase.IOrderUsecase, req *pbOrder.GetPromoCodeRequest) (*model.PromoCode, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	promo, err := orderUsecase.GetPromoCode(ctx, strings.ToUpper(req.Code))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get promo code: %s", err)
+	}
+
+	if promo == nil {
+		return nil, status.Errorf(codes.NotFound, "Promo code not found")
+	}
+
+	return promo, nil
+}
+
+func ValidatePromoCode(ctx context.Context, orderUsecase usecase.IOrderUsecase, req *pbOrder.ValidatePromoCodeRequest) (int64, error) {
+	if req == nil {
+		return 0, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "Invalid user id: %s", err)
+	}
+
+	lines := make([]model.PromoLine, 0, len(req.Lines))
+	for _, line := range req.Lines {
+		sellerID, err := uuid.Parse(line.SellerId)
+		if err != nil {
+			return 0, status.Errorf(codes.InvalidArgument, "Invalid seller id: %s", err)
+		}
+
+		lines = append(lines, model.PromoLine{
+			CategoryID: line.CategoryId,
+			SellerID:   sellerID,
+			Price:      line.Price,
+			Quantity:   line.Quantity,
+		})
+	}
+
+	return applyPromoCode(ctx, orderUsecase, strings.ToUpper(req.Code), userID, lines)
+}
@@ -0,0 +1,96 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Go-Marketplace/backend/order/internal/api/grpc/controller"
+	mocks "github.com/Go-Marketplace/backend/order/internal/mocks/usecase"
+	"github.com/Go-Marketplace/backend/order/internal/model"
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCreatePromoCode(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	req := &pbOrder.CreatePromoCodeRequest{
+		Code:   "winter",
+		Kind:   pbOrder.PromoKind(model.PercentPromo),
+		Value:  10,
+		EndsAt: timestamppb.New(time.Now().Add(time.Hour)),
+	}
+
+	promo := &model.PromoCode{
+		Code:  "WINTER",
+		Kind:  model.PercentPromo,
+		Value: 10,
+	}
+	expectedErrFromUsecase := errors.New("test error")
+
+	testcases := []struct {
+		name          string
+		mock          func(usecase *mocks.MockIOrderUsecase)
+		expectedPromo *model.PromoCode
+		expectedErr   error
+	}{
+		{
+			name: "Successfully create promo code",
+			mock: func(usecase *mocks.MockIOrderUsecase) {
+				usecase.EXPECT().GetPromoCode(ctx, "WINTER").Return(nil, nil).Times(1)
+				usecase.EXPECT().CreatePromoCode(ctx, gomock.Any()).Return(promo, nil).Times(1)
+			},
+			expectedPromo: promo,
+			expectedErr:   nil,
+		},
+		{
+			name: "Promo code already exists",
+			mock: func(usecase *mocks.MockIOrderUsecase) {
+				usecase.EXPECT().GetPromoCode(ctx, "WINTER").Return(promo, nil).Times(1)
+			},
+			expectedPromo: nil,
+			expectedErr:   status.Errorf(codes.AlreadyExists, "Promo code already exists"),
+		},
+		{
+			name: "Promo code is created concurrently",
+			mock: func(usecase *mocks.MockIOrderUsecase) {
+				usecase.EXPECT().GetPromoCode(ctx, "WINTER").Return(nil, nil).Times(1)
+				usecase.EXPECT().CreatePromoCode(ctx, gomock.Any()).Return(nil, model.ErrPromoCodeExists).Times(1)
+			},
+			expectedPromo: nil,
+			expectedErr:   status.Errorf(codes.AlreadyExists, "Promo code already exists"),
+		},
+		{
+			name: "Got error when create promo code",
+			mock: func(usecase *mocks.MockIOrderUsecase) {
+				usecase.EXPECT().GetPromoCode(ctx, "WINTER").Return(nil, nil).Times(1)
+				usecase.EXPECT().CreatePromoCode(ctx, gomock.Any()).Return(nil, expectedErrFromUsecase).Times(1)
+			},
+			expectedPromo: nil,
+			expectedErr:   status.Errorf(codes.Internal, "Failed to create promo code: %s", expectedErrFromUsecase),
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			orderUsecase := orderHelper(t)
+			testcase.mock(orderUsecase)
+
+			actualPromo, actualErr := controller.CreatePromoCode(ctx, orderUsecase, req)
+
+			assert.Equal(t, testcase.expectedPromo, actualPromo)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
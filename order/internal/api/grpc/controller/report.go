@@ -0,0 +1,188 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Go-Marketplace/backend/order/internal/api/grpc/dto"
+	"github.com/Go-Marketplace/backend/order/internal/model"
+	"github.com/Go-Marketplace/backend/order/internal/report"
+	"github.com/Go-Marketplace/backend/order/internal/usecase"
+	pbCart "github.com/Go-Marketplace/backend/proto/gen/cart"
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const defaultReportPeriod = 30 * 24 * time.Hour
+
+// Returns the days of the report, the last 30 days by default. The sales are stored
+// by days, so the report starts at the beginning of the day of from
+func getReportDays(from, to *timestamppb.Timestamp) (time.Time, time.Time, error) {
+	end := time.Now().UTC()
+	if to != nil {
+		end = to.AsTime()
+	}
+
+	start := end.Add(-defaultReportPeriod)
+	if from != nil {
+		start = from.AsTime()
+	}
+
+	if !start.Before(end) {
+		return time.Time{}, time.Time{}, status.Errorf(codes.InvalidArgument, "Invalid period: from must be before to")
+	}
+
+	return model.DayPeriod.Start(start), end, nil
+}
+
+func getReportPeriod(period pbOrder.ReportPeriod) (model.ReportPeriod, error) {
+	if _, ok := pbOrder.ReportPeriod_name[int32(period)]; !ok {
+		return 0, status.Errorf(codes.InvalidArgument, "Unknown report period: %d", period)
+	}
+
+	return model.ReportPeriod(period), nil
+}
+
+func getSalesReportParams(req *pbOrder.GetSalesReportRequest) (dto.SalesReportDTO, error) {
+	if req == nil {
+		return dto.SalesReportDTO{}, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	from, to, err := getReportDays(req.From, req.To)
+	if err != nil {
+		return dto.SalesReportDTO{}, err
+	}
+
+	period, err := getReportPeriod(req.Period)
+	if err != nil {
+		return dto.SalesReportDTO{}, err
+	}
+
+	if _, ok := pbOrder.SalesGrouping_name[int32(req.GroupBy)]; !ok {
+		return dto.SalesReportDTO{}, status.Errorf(codes.InvalidArgument, "Unknown sales grouping: %d", req.GroupBy)
+	}
+
+	var sellerID uuid.UUID
+	if req.SellerId != "" {
+		sellerID, err = uuid.Parse(req.SellerId)
+		if err != nil {
+			return dto.SalesReportDTO{}, status.Errorf(codes.InvalidArgument, "Invalid seller id: %s", err)
+		}
+	}
+
+	return dto.SalesReportDTO{
+		From:     from,
+		To:       to,
+		Period:   period,
+		GroupBy:  model.SalesGrouping(req.GroupBy),
+		SellerID: sellerID,
+	}, nil
+}
+
+func GetSalesReport(
+	ctx context.Context,
+	orderUsecase usecase.IOrderUsecase,
+	req *pbOrder.GetSalesReportRequest,
+) ([]*model.SalesReportRow, error) {
+	searchParams, err := getSalesReportParams(req)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := orderUsecase.GetSalesReport(ctx, searchParams)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get sales report: %s", err)
+	}
+
+	return rows, nil
+}
+
+func ExportSalesReport(
+	ctx context.Context,
+	orderUsecase usecase.IOrderUsecase,
+	req *pbOrder.GetSalesReportRequest,
+) (*model.ReportFile, error) {
+	searchParams, err := getSalesReportParams(req)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := orderUsecase.GetSalesReport(ctx, searchParams)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get sales report: %s", err)
+	}
+
+	var content bytes.Buffer
+	if err = report.WriteSalesCSV(&content, searchParams.GroupBy, rows); err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to write sales report: %s", err)
+	}
+
+	return &model.ReportFile{
+		FileName: fmt.Sprintf(
+			"sales_%s_%s_%s.csv",
+			searchParams.Period,
+			searchParams.From.Format(time.DateOnly),
+			searchParams.To.Format(time.DateOnly),
+		),
+		Content: content.Bytes(),
+	}, nil
+}
+
+// Compares the orders with the carts abandoned in the same periods. Without the category
+// the cart service counts the carts by the categories of their products, so a cart with
+// products of several categories counts once for each of them
+func GetConversionReport(
+	ctx context.Context,
+	orderUsecase usecase.IOrderUsecase,
+	cartClient pbCart.CartClient,
+	req *pbOrder.GetConversionReportRequest,
+) ([]*model.ConversionReportRow, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	from, to, err := getReportDays(req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	period, err := getReportPeriod(req.Period)
+	if err != nil {
+		return nil, err
+	}
+
+	orders, err := orderUsecase.GetConversionOrders(ctx, dto.ConversionReportDTO{
+		From:       from,
+		To:         to,
+		Period:     period,
+		CategoryID: req.CategoryId,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get orders: %s", err)
+	}
+
+	metrics, err := cartClient.GetAbandonedCartMetrics(ctx, &pbCart.GetAbandonedCartMetricsRequest{
+		From:       timestamppb.New(from),
+		To:         timestamppb.New(to),
+		CategoryId: req.CategoryId,
+	})
+	if err != nil {
+		return nil, status.Errorf(status.Code(err), "Failed to get abandoned cart metrics: %s", status.Convert(err).Message())
+	}
+
+	carts := make([]model.CartMetric, 0, len(metrics.Metrics))
+	for _, metric := range metrics.Metrics {
+		carts = append(carts, model.CartMetric{
+			Day:            metric.Day.AsTime(),
+			AbandonedCarts: metric.Carts,
+			RestoredCarts:  metric.RestoredCarts,
+		})
+	}
+
+	return model.NewConversionReport(period, orders, carts), nil
+}
@@ -0,0 +1,131 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Go-Marketplace/backend/order/internal/api/grpc/controller"
+	"github.com/Go-Marketplace/backend/order/internal/api/grpc/dto"
+	mocks "github.com/Go-Marketplace/backend/order/internal/mocks/usecase"
+	"github.com/Go-Marketplace/backend/order/internal/model"
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGetSalesReport(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sellerID := uuid.New()
+
+	from := time.Date(2023, time.December, 1, 15, 0, 0, 0, time.UTC)
+	to := time.Date(2023, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+	rows := []*model.SalesReportRow{
+		{
+			PeriodStart: time.Date(2023, time.November, 27, 0, 0, 0, 0, time.UTC),
+			SellerID:    sellerID,
+			ProductID:   uuid.New(),
+			Currency:    "RUB",
+			Orders:      2,
+			Units:       3,
+			Revenue:     900,
+		},
+	}
+	expectedErrFromUsecase := errors.New("test error")
+
+	testcases := []struct {
+		name         string
+		req          *pbOrder.GetSalesReportRequest
+		mock         func(usecase *mocks.MockIOrderUsecase)
+		expectedRows []*model.SalesReportRow
+		expectedErr  error
+	}{
+		{
+			name: "Successfully get seller sales from the start of the day",
+			req: &pbOrder.GetSalesReportRequest{
+				From:     timestamppb.New(from),
+				To:       timestamppb.New(to),
+				Period:   pbOrder.ReportPeriod_WEEK,
+				GroupBy:  pbOrder.SalesGrouping_BY_PRODUCT,
+				SellerId: sellerID.String(),
+			},
+			mock: func(usecase *mocks.MockIOrderUsecase) {
+				usecase.EXPECT().GetSalesReport(ctx, dto.SalesReportDTO{
+					From:     time.Date(2023, time.December, 1, 0, 0, 0, 0, time.UTC),
+					To:       to,
+					Period:   model.WeekPeriod,
+					GroupBy:  model.SalesByProduct,
+					SellerID: sellerID,
+				}).Return(rows, nil).Times(1)
+			},
+			expectedRows: rows,
+			expectedErr:  nil,
+		},
+		{
+			name: "Got error when get sales report",
+			req: &pbOrder.GetSalesReportRequest{
+				From: timestamppb.New(from),
+				To:   timestamppb.New(to),
+			},
+			mock: func(usecase *mocks.MockIOrderUsecase) {
+				usecase.EXPECT().GetSalesReport(ctx, dto.SalesReportDTO{
+					From: time.Date(2023, time.December, 1, 0, 0, 0, 0, time.UTC),
+					To:   to,
+				}).Return(nil, expectedErrFromUsecase).Times(1)
+			},
+			expectedRows: nil,
+			expectedErr:  status.Errorf(codes.Internal, "Failed to get sales report: %s", expectedErrFromUsecase),
+		},
+		{
+			name: "Got error when from is after to",
+			req: &pbOrder.GetSalesReportRequest{
+				From: timestamppb.New(to),
+				To:   timestamppb.New(from),
+			},
+			mock:         func(usecase *mocks.MockIOrderUsecase) {},
+			expectedRows: nil,
+			expectedErr:  status.Errorf(codes.InvalidArgument, "Invalid period: from must be before to"),
+		},
+		{
+			name: "Got error when grouping is unknown",
+			req: &pbOrder.GetSalesReportRequest{
+				GroupBy: pbOrder.SalesGrouping(10),
+			},
+			mock:         func(usecase *mocks.MockIOrderUsecase) {},
+			expectedRows: nil,
+			expectedErr:  status.Errorf(codes.InvalidArgument, "Unknown sales grouping: %d", 10),
+		},
+		{
+			name: "Got error when seller id is invalid",
+			req: &pbOrder.GetSalesReportRequest{
+				SellerId: "invalid",
+			},
+			mock:         func(usecase *mocks.MockIOrderUsecase) {},
+			expectedRows: nil,
+			expectedErr:  status.Errorf(codes.InvalidArgument, "Invalid seller id: %s", errors.New("invalid UUID length: 7")),
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			orderUsecase := orderHelper(t)
+			testcase.mock(orderUsecase)
+
+			actualRows, actualErr := controller.GetSalesReport(ctx, orderUsecase, testcase.req)
+
+			assert.Equal(t, testcase.expectedRows, actualRows)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
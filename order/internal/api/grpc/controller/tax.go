@@ -0,0 +1,29 @@
+package controller
+
+import (
+	"github.com/Go-Marketplace/backend/order/internal/tax"
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Calculates the taxes the lines would get at checkout, in the order of the request lines
+func CalculateTax(taxCalculator tax.TaxCalculator, req *pbOrder.CalculateTaxRequest) ([]tax.Tax, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	taxes := make([]tax.Tax, 0, len(req.Lines))
+	for _, line := range req.Lines {
+		if line.Amount < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid amount of product %s: %d", line.ProductId, line.Amount)
+		}
+
+		taxes = append(taxes, taxCalculator.Calculate(req.ShippingRegion, tax.Line{
+			CategoryID: line.CategoryId,
+			Amount:     line.Amount,
+		}))
+	}
+
+	return taxes, nil
+}
@@ -0,0 +1,136 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Go-Marketplace/backend/order/internal/api/grpc/controller"
+	mocks "github.com/Go-Marketplace/backend/order/internal/mocks/usecase"
+	"github.com/Go-Marketplace/backend/order/internal/model"
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type orderEventStream struct {
+	grpc.ServerStream
+
+	events []*pbOrder.OrderEvent
+}
+
+func (stream *orderEventStream) Send(event *pbOrder.OrderEvent) error {
+	stream.events = append(stream.events, event)
+	return nil
+}
+
+func TestWatchOrder(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	orderID := uuid.New()
+	productID := uuid.New()
+	buyerID := uuid.New()
+	now := time.Now()
+
+	orderline := &model.Orderline{
+		OrderID:   orderID,
+		ProductID: productID,
+		Status:    model.PendingPayment,
+		UpdatedAt: now,
+	}
+	order := &model.Order{
+		ID:         orderID,
+		UserID:     buyerID,
+		Orderlines: []*model.Orderline{orderline},
+	}
+
+	updated := *orderline
+	updated.Status = model.Delivery
+	deleted := &model.OrderEvent{
+		Kind:       model.OrderDeletedEvent,
+		OrderID:    orderID,
+		OccurredAt: now,
+	}
+
+	testcases := []struct {
+		name           string
+		req            *pbOrder.WatchOrderRequest
+		mock           func(usecase *mocks.MockIOrderUsecase)
+		expectedEvents []*pbOrder.OrderEvent
+		expectedErr    error
+	}{
+		{
+			name: "Buyer gets the statuses and the changes until the order is deleted",
+			req: &pbOrder.WatchOrderRequest{
+				OrderId: orderID.String(),
+				UserId:  buyerID.String(),
+			},
+			mock: func(usecase *mocks.MockIOrderUsecase) {
+				events := make(chan *model.OrderEvent, 2)
+				events <- model.NewOrderlineStatusEvent(&updated)
+				events <- deleted
+
+				usecase.EXPECT().WatchOrder(orderID).Return((<-chan *model.OrderEvent)(events), func() {}).Times(1)
+				usecase.EXPECT().GetOrder(ctx, orderID).Return(order, nil).Times(1)
+			},
+			expectedEvents: []*pbOrder.OrderEvent{
+				model.NewOrderlineStatusEvent(orderline).ToProto(),
+				model.NewOrderlineStatusEvent(&updated).ToProto(),
+				deleted.ToProto(),
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Got error when stream falls behind",
+			req: &pbOrder.WatchOrderRequest{
+				OrderId: orderID.String(),
+				UserId:  buyerID.String(),
+			},
+			mock: func(usecase *mocks.MockIOrderUsecase) {
+				events := make(chan *model.OrderEvent)
+				close(events)
+
+				usecase.EXPECT().WatchOrder(orderID).Return((<-chan *model.OrderEvent)(events), func() {}).Times(1)
+				usecase.EXPECT().GetOrder(ctx, orderID).Return(order, nil).Times(1)
+			},
+			expectedEvents: []*pbOrder.OrderEvent{
+				model.NewOrderlineStatusEvent(orderline).ToProto(),
+			},
+			expectedErr: status.Errorf(codes.Unavailable, "Order stream fell behind, watch it again"),
+		},
+		{
+			name: "Got error when user is not a participant",
+			req: &pbOrder.WatchOrderRequest{
+				OrderId: orderID.String(),
+				UserId:  uuid.New().String(),
+			},
+			mock: func(usecase *mocks.MockIOrderUsecase) {
+				usecase.EXPECT().WatchOrder(orderID).Return(make(<-chan *model.OrderEvent), func() {}).Times(1)
+				usecase.EXPECT().GetOrder(ctx, orderID).Return(order, nil).Times(1)
+			},
+			expectedEvents: nil,
+			expectedErr:    status.Errorf(codes.NotFound, "Order not found"),
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			orderUsecase := orderHelper(t)
+			testcase.mock(orderUsecase)
+
+			stream := &orderEventStream{}
+			actualErr := controller.WatchOrder(ctx, orderUsecase, testcase.req, stream)
+
+			assert.Equal(t, testcase.expectedErr, actualErr)
+			assert.Equal(t, testcase.expectedEvents, stream.events)
+		})
+	}
+}
@@ -1,7 +1,47 @@
 package dto
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/Go-Marketplace/backend/order/internal/model"
+	"github.com/google/uuid"
+)
 
 type SearchOrderDTO struct {
 	UserID uuid.UUID
 }
+
+// Sales of the days in [From, To), nil SellerID gets every seller
+type SalesReportDTO struct {
+	From     time.Time
+	To       time.Time
+	Period   model.ReportPeriod
+	GroupBy  model.SalesGrouping
+	SellerID uuid.UUID
+}
+
+// Orders of the days in [From, To), zero CategoryID gets every category
+type ConversionReportDTO struct {
+	From       time.Time
+	To         time.Time
+	Period     model.ReportPeriod
+	CategoryID int32
+}
+
+// Last orderline of the previous page of the exported orders
+type OrderlineCursor struct {
+	CreatedAt time.Time
+	OrderID   uuid.UUID
+	ProductID uuid.UUID
+}
+
+// Page of the orderlines of the orders created in [From, To), ordered by the creation time.
+// Empty Statuses and nil SellerID get every orderline, nil After gets the first page
+type ExportOrdersDTO struct {
+	From     time.Time
+	To       time.Time
+	Statuses []model.OrderlineStatus
+	SellerID uuid.UUID
+	After    *OrderlineCursor
+	Limit    uint64
+}
@@ -2,13 +2,16 @@ package handler
 
 import (
 	"context"
+	"strings"
 
 	"github.com/Go-Marketplace/backend/order/internal/api/grpc/controller"
+	"github.com/Go-Marketplace/backend/order/internal/tax"
 	"github.com/Go-Marketplace/backend/order/internal/usecase"
 	"github.com/Go-Marketplace/backend/pkg/logger"
 	pbCart "github.com/Go-Marketplace/backend/proto/gen/cart"
 	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
 	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+	pbUser "github.com/Go-Marketplace/backend/proto/gen/user"
 )
 
 type orderRoutes struct {
@@ -17,6 +20,9 @@ type orderRoutes struct {
 	orderUsecase  *usecase.OrderUsecase
 	cartClient    pbCart.CartClient
 	productClient pbProduct.ProductClient
+	userClient    pbUser.UserClient
+	taxCalculator tax.TaxCalculator
+	mediaBaseURL  string
 	logger        *logger.Logger
 }
 
@@ -24,12 +30,18 @@ func NewOrderRoutes(
 	orderUsecase *usecase.OrderUsecase,
 	cartClient pbCart.CartClient,
 	productClient pbProduct.ProductClient,
+	userClient pbUser.UserClient,
+	taxCalculator tax.TaxCalculator,
+	mediaBaseURL string,
 	logger *logger.Logger,
 ) *orderRoutes {
 	return &orderRoutes{
 		orderUsecase:  orderUsecase,
 		cartClient:    cartClient,
 		productClient: productClient,
+		userClient:    userClient,
+		taxCalculator: taxCalculator,
+		mediaBaseURL:  mediaBaseURL,
 		logger:        logger,
 	}
 }
@@ -67,6 +79,8 @@ func (router *orderRoutes) CreateOrder(ctx context.Context, req *pbOrder.CreateO
 		router.orderUsecase,
 		router.cartClient,
 		router.productClient,
+		router.userClient,
+		router.taxCalculator,
 		req,
 	)
 	if err != nil {
@@ -117,3 +131,215 @@ func (router *orderRoutes) DeleteOrderline(ctx context.Context, req *pbOrder.Del
 
 	return &pbOrder.DeleteOrderlineResponse{}, nil
 }
+
+func (router *orderRoutes) HasReceivedProduct(ctx context.Context, req *pbOrder.HasReceivedProductRequest) (*pbOrder.HasReceivedProductResponse, error) {
+	received, err := controller.HasReceivedProduct(ctx, router.orderUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbOrder.HasReceivedProductResponse{
+		Received: received,
+	}, nil
+}
+
+func (router *orderRoutes) GetPurchasedQuantity(ctx context.Context, req *pbOrder.GetPurchasedQuantityRequest) (*pbOrder.PurchasedQuantityResponse, error) {
+	quantity, err := controller.GetPurchasedQuantity(ctx, router.orderUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbOrder.PurchasedQuantityResponse{
+		Quantity: quantity,
+	}, nil
+}
+
+func (router *orderRoutes) GetInvoice(ctx context.Context, req *pbOrder.GetInvoiceRequest) (*pbOrder.InvoiceResponse, error) {
+	document, err := controller.GetInvoice(ctx, router.orderUsecase, router.productClient, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return document.ToProto(), nil
+}
+
+func (router *orderRoutes) CreatePromoCode(ctx context.Context, req *pbOrder.CreatePromoCodeRequest) (*pbOrder.PromoCodeResponse, error) {
+	promo, err := controller.CreatePromoCode(ctx, router.orderUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return promo.ToProto(), nil
+}
+
+func (router *orderRoutes) GetPromoCode(ctx context.Context, req *pbOrder.GetPromoCodeRequest) (*pbOrder.PromoCodeResponse, error) {
+	promo, err := controller.GetPromoCode(ctx, router.orderUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return promo.ToProto(), nil
+}
+
+func (router *orderRoutes) ValidatePromoCode(ctx context.Context, req *pbOrder.ValidatePromoCodeRequest) (*pbOrder.ValidatePromoCodeResponse, error) {
+	discount, err := controller.ValidatePromoCode(ctx, router.orderUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbOrder.ValidatePromoCodeResponse{
+		Code:     strings.ToUpper(req.Code),
+		Discount: discount,
+	}, nil
+}
+
+func (router *orderRoutes) CalculateTax(ctx context.Context, req *pbOrder.CalculateTaxRequest) (*pbOrder.CalculateTaxResponse, error) {
+	taxes, err := controller.CalculateTax(router.taxCalculator, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pbOrder.CalculateTaxResponse{
+		ShippingRegion: tax.NormalizeRegion(req.ShippingRegion),
+		Lines:          make([]*pbOrder.LineTaxResponse, 0, len(taxes)),
+	}
+
+	for i, lineTax := range taxes {
+		resp.Lines = append(resp.Lines, &pbOrder.LineTaxResponse{
+			ProductId: req.Lines[i].ProductId,
+			Rate:      lineTax.Rate,
+			Tax:       lineTax.Amount,
+		})
+		resp.Tax += lineTax.Amount
+	}
+
+	return resp, nil
+}
+
+func (router *orderRoutes) GetSellerLedger(ctx context.Context, req *pbOrder.GetSellerLedgerRequest) (*pbOrder.SellerLedgerResponse, error) {
+	entries, balances, err := controller.GetSellerLedger(ctx, router.orderUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pbOrder.SellerLedgerResponse{
+		SellerId: req.SellerId,
+		Entries:  make([]*pbOrder.LedgerEntryResponse, 0, len(entries)),
+		Balances: make([]*pbOrder.BalanceResponse, 0, len(balances)),
+	}
+
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, entry.ToProto())
+	}
+
+	for _, balance := range balances {
+		resp.Balances = append(resp.Balances, balance.ToProto())
+	}
+
+	return resp, nil
+}
+
+func (router *orderRoutes) CreatePayout(ctx context.Context, req *pbOrder.CreatePayoutRequest) (*pbOrder.PayoutsResponse, error) {
+	payouts, err := controller.CreatePayout(ctx, router.orderUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pbOrder.PayoutsResponse{
+		Payouts: make([]*pbOrder.PayoutResponse, 0, len(payouts)),
+	}
+
+	for _, payout := range payouts {
+		resp.Payouts = append(resp.Payouts, payout.ToPayoutProto())
+	}
+
+	return resp, nil
+}
+
+func (router *orderRoutes) GetOrderThread(ctx context.Context, req *pbOrder.GetOrderThreadRequest) (*pbOrder.OrderThreadResponse, error) {
+	thread, err := controller.GetOrderThread(ctx, router.orderUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return thread.ToProto(), nil
+}
+
+func (router *orderRoutes) CreateOrderMessage(ctx context.Context, req *pbOrder.CreateOrderMessageRequest) (*pbOrder.OrderMessageResponse, error) {
+	message, err := controller.CreateOrderMessage(ctx, router.orderUsecase, router.mediaBaseURL, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return message.ToProto(nil), nil
+}
+
+func (router *orderRoutes) MarkOrderThreadRead(ctx context.Context, req *pbOrder.MarkOrderThreadReadRequest) (*pbOrder.ReadReceiptResponse, error) {
+	receipt, err := controller.MarkOrderThreadRead(ctx, router.orderUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return receipt.ToProto(), nil
+}
+
+func (router *orderRoutes) WatchOrderThread(req *pbOrder.WatchOrderThreadRequest, stream pbOrder.Order_WatchOrderThreadServer) error {
+	return controller.WatchOrderThread(stream.Context(), router.orderUsecase, req, stream)
+}
+
+func (router *orderRoutes) WatchOrder(req *pbOrder.WatchOrderRequest, stream pbOrder.Order_WatchOrderServer) error {
+	return controller.WatchOrder(stream.Context(), router.orderUsecase, req, stream)
+}
+
+func (router *orderRoutes) GetSalesReport(ctx context.Context, req *pbOrder.GetSalesReportRequest) (*pbOrder.SalesReportResponse, error) {
+	rows, err := controller.GetSalesReport(ctx, router.orderUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pbOrder.SalesReportResponse{
+		Period:  req.Period,
+		GroupBy: req.GroupBy,
+		Rows:    make([]*pbOrder.SalesReportRow, 0, len(rows)),
+	}
+
+	for _, row := range rows {
+		resp.Rows = append(resp.Rows, row.ToProto())
+	}
+
+	return resp, nil
+}
+
+func (router *orderRoutes) ExportSalesReport(ctx context.Context, req *pbOrder.GetSalesReportRequest) (*pbOrder.ReportFileResponse, error) {
+	file, err := controller.ExportSalesReport(ctx, router.orderUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return file.ToProto(), nil
+}
+
+func (router *orderRoutes) GetConversionReport(
+	ctx context.Context,
+	req *pbOrder.GetConversionReportRequest,
+) (*pbOrder.ConversionReportResponse, error) {
+	rows, err := controller.GetConversionReport(ctx, router.orderUsecase, router.cartClient, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pbOrder.ConversionReportResponse{
+		Period: req.Period,
+		Rows:   make([]*pbOrder.ConversionReportRow, 0, len(rows)),
+	}
+
+	for _, row := range rows {
+		resp.Rows = append(resp.Rows, row.ToProto())
+	}
+
+	return resp, nil
+}
+
+func (router *orderRoutes) ExportOrders(req *pbOrder.ExportOrdersRequest, stream pbOrder.Order_ExportOrdersServer) error {
+	return controller.ExportOrders(stream.Context(), router.orderUsecase, req, stream)
+}
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -10,14 +11,20 @@ import (
 	"github.com/Go-Marketplace/backend/config"
 	"github.com/Go-Marketplace/backend/order/internal/api/grpc/handler"
 	"github.com/Go-Marketplace/backend/order/internal/api/grpc/interceptors"
+	"github.com/Go-Marketplace/backend/order/internal/broker"
 	"github.com/Go-Marketplace/backend/order/internal/infrastructure/repository"
+	"github.com/Go-Marketplace/backend/order/internal/model"
+	"github.com/Go-Marketplace/backend/order/internal/tax"
 	"github.com/Go-Marketplace/backend/order/internal/usecase"
+	"github.com/Go-Marketplace/backend/order/internal/worker"
 	"github.com/Go-Marketplace/backend/pkg/grpcserver"
 	"github.com/Go-Marketplace/backend/pkg/logger"
 	"github.com/Go-Marketplace/backend/pkg/postgres"
 	pbCart "github.com/Go-Marketplace/backend/proto/gen/cart"
 	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
 	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+	pbUser "github.com/Go-Marketplace/backend/proto/gen/user"
+	"github.com/xiam/to"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
@@ -26,6 +33,8 @@ import (
 func Run(cfg *config.Config) {
 	logger := logger.New(cfg.OrderConfig.Level)
 
+	ctx := context.Background()
+
 	pg, err := postgres.New(cfg.OrderConfig.PG.URL)
 	if err != nil {
 		log.Fatalf("failed to run postgres.New: %s", err)
@@ -56,9 +65,73 @@ func Run(cfg *config.Config) {
 
 	cartClient := pbCart.NewCartClient(cartConn)
 
+	// Create user client
+	userConn, err := grpc.Dial(
+		fmt.Sprintf("%s:%v", cfg.UserConfig.GRPC.Host, cfg.UserConfig.GRPC.Port),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		log.Fatalf("failed to create userConn: %v", err)
+	}
+	defer userConn.Close()
+
+	userClient := pbUser.NewUserClient(userConn)
+
+	taxRules := make([]tax.Rule, 0, len(cfg.TaxConfig.Rules))
+	for _, rule := range cfg.TaxConfig.Rules {
+		taxRules = append(taxRules, tax.Rule{
+			Region:     rule.Region,
+			CategoryID: rule.CategoryID,
+			Rate:       rule.Rate,
+		})
+	}
+
+	taxCalculator, err := tax.NewRuleCalculator(cfg.TaxConfig.DefaultRate, taxRules)
+	if err != nil {
+		log.Fatalf("failed to run tax.NewRuleCalculator: %s", err)
+	}
+
+	categoryCommissions := make([]model.CategoryCommission, 0, len(cfg.CommissionConfig.Categories))
+	for _, category := range cfg.CommissionConfig.Categories {
+		categoryCommissions = append(categoryCommissions, model.CategoryCommission{
+			CategoryID: category.CategoryID,
+			Rate:       category.Rate,
+		})
+	}
+
+	commissionRates, err := model.NewCommissionRates(cfg.CommissionConfig.DefaultRate, categoryCommissions)
+	if err != nil {
+		log.Fatalf("failed to run model.NewCommissionRates: %s", err)
+	}
+
 	orderRepo := repository.NewOrderRepo(pg, logger)
-	orderUseCase := usecase.NewOrderUsecase(orderRepo)
-	orderHandler := handler.NewOrderRoutes(orderUseCase, cartClient, productClient, logger)
+	promoRepo := repository.NewPromoRepo(pg, logger)
+	invoiceRepo := repository.NewInvoiceRepo(pg, logger)
+	ledgerRepo := repository.NewLedgerRepo(pg, logger)
+	messageRepo := repository.NewMessageRepo(pg, logger)
+	reportRepo := repository.NewReportRepo(pg, logger)
+	threadBroker := broker.New[*model.ThreadEvent](0)
+	orderBroker := broker.New[*model.OrderEvent](0)
+	orderUseCase := usecase.NewOrderUsecase(
+		orderRepo,
+		promoRepo,
+		invoiceRepo,
+		ledgerRepo,
+		messageRepo,
+		reportRepo,
+		commissionRates,
+		threadBroker,
+		orderBroker,
+	)
+	orderHandler := handler.NewOrderRoutes(
+		orderUseCase,
+		cartClient,
+		productClient,
+		userClient,
+		taxCalculator,
+		cfg.OrderConfig.Messages.MediaBaseURL,
+		logger,
+	)
 
 	interceptor := interceptors.NewInterceptorManager(logger)
 	grpcServer, err := grpcserver.New(
@@ -79,6 +152,20 @@ func Run(cfg *config.Config) {
 	defer grpcServer.Shutdown()
 	logger.Info("GRPC server started")
 
+	salesWorker := worker.NewSalesWorker(
+		orderUseCase,
+		worker.SalesWorkerConfig{
+			SalesWorkerInterval: to.Duration(cfg.OrderConfig.SalesWorker.Interval),
+		},
+		logger,
+	)
+	salesWorker.Run(ctx)
+	defer func() {
+		if err = salesWorker.Stop(); err != nil {
+			logger.Error("failed to stop sales worker: %w", err)
+		}
+	}()
+
 	// Waiting signal
 	interrupt := make(chan os.Signal, 1)
 	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
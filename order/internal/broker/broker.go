@@ -0,0 +1,82 @@
+// Package broker delivers the live events of an order to the streams watching it
+package broker
+
+import (
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+const defaultBufferSize = 16
+
+// Broker fans the events published for an order out to its subscribers. A subscriber
+// that does not keep up is dropped with its channel closed, so a slow stream never
+// blocks the publisher and can watch the order again
+type Broker[T any] struct {
+	mu          sync.Mutex
+	bufferSize  int
+	subscribers map[uuid.UUID]map[chan T]struct{}
+}
+
+func New[T any](bufferSize int) *Broker[T] {
+	if bufferSize <= 0 {
+		bufferSize = defaultBufferSize
+	}
+
+	return &Broker[T]{
+		bufferSize:  bufferSize,
+		subscribers: make(map[uuid.UUID]map[chan T]struct{}),
+	}
+}
+
+// Subscribe returns the channel of the order events and the function
+// that unsubscribes, it is safe to call more than once
+func (broker *Broker[T]) Subscribe(orderID uuid.UUID) (<-chan T, func()) {
+	broker.mu.Lock()
+	defer broker.mu.Unlock()
+
+	events := make(chan T, broker.bufferSize)
+	if broker.subscribers[orderID] == nil {
+		broker.subscribers[orderID] = make(map[chan T]struct{})
+	}
+	broker.subscribers[orderID][events] = struct{}{}
+
+	return events, func() {
+		broker.mu.Lock()
+		defer broker.mu.Unlock()
+
+		broker.remove(orderID, events)
+	}
+}
+
+// Publish sends the event to every subscriber of the order without waiting
+func (broker *Broker[T]) Publish(orderID uuid.UUID, event T) {
+	broker.mu.Lock()
+	defer broker.mu.Unlock()
+
+	for events := range broker.subscribers[orderID] {
+		select {
+		case events <- event:
+		default:
+			broker.remove(orderID, events)
+		}
+	}
+}
+
+func (broker *Broker[T]) remove(orderID uuid.UUID, events chan T) {
+	subscribers, ok := broker.subscribers[orderID]
+	if !ok {
+		return
+	}
+
+	if _, ok = subscribers[events]; !ok {
+		return
+	}
+
+	delete(subscribers, events)
+	close(events)
+
+	if len(subscribers) == 0 {
+		delete(broker.subscribers, orderID)
+	}
+}
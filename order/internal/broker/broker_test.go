@@ -0,0 +1,76 @@
+package broker_test
+
+import (
+	"testing"
+
+	"github.com/Go-Marketplace/backend/order/internal/broker"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublish(t *testing.T) {
+	t.Parallel()
+
+	orderID := uuid.New()
+	otherOrderID := uuid.New()
+
+	eventBroker := broker.New[int](2)
+
+	first, unsubscribeFirst := eventBroker.Subscribe(orderID)
+	defer unsubscribeFirst()
+
+	second, unsubscribeSecond := eventBroker.Subscribe(orderID)
+	defer unsubscribeSecond()
+
+	other, unsubscribeOther := eventBroker.Subscribe(otherOrderID)
+	defer unsubscribeOther()
+
+	eventBroker.Publish(orderID, 1)
+
+	assert.Equal(t, 1, <-first)
+	assert.Equal(t, 1, <-second)
+	assert.Len(t, other, 0)
+}
+
+func TestUnsubscribe(t *testing.T) {
+	t.Parallel()
+
+	orderID := uuid.New()
+
+	eventBroker := broker.New[int](2)
+
+	events, unsubscribe := eventBroker.Subscribe(orderID)
+	unsubscribe()
+	unsubscribe()
+
+	eventBroker.Publish(orderID, 1)
+
+	_, ok := <-events
+	assert.False(t, ok)
+}
+
+func TestSlowSubscriberIsDropped(t *testing.T) {
+	t.Parallel()
+
+	orderID := uuid.New()
+
+	eventBroker := broker.New[int](1)
+
+	slow, unsubscribeSlow := eventBroker.Subscribe(orderID)
+	defer unsubscribeSlow()
+
+	eventBroker.Publish(orderID, 1)
+	eventBroker.Publish(orderID, 2)
+
+	assert.Equal(t, 1, <-slow)
+
+	_, ok := <-slow
+	assert.False(t, ok)
+
+	fresh, unsubscribeFresh := eventBroker.Subscribe(orderID)
+	defer unsubscribeFresh()
+
+	eventBroker.Publish(orderID, 3)
+
+	assert.Equal(t, 3, <-fresh)
+}
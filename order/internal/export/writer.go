@@ -0,0 +1,211 @@
+// Package export writes the orders as accounting files with a row for every orderline
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/Go-Marketplace/backend/order/internal/model"
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
+)
+
+var csvHeader = []string{
+	"order_id",
+	"user_id",
+	"order_created_at",
+	"order_currency",
+	"promo_code",
+	"promo_discount",
+	"order_subtotal",
+	"order_discount",
+	"order_tax",
+	"order_total",
+	"product_id",
+	"name",
+	"seller_id",
+	"category_id",
+	"price",
+	"quantity",
+	"subtotal",
+	"discount_percent",
+	"discount",
+	"tax_rate",
+	"tax",
+	"total",
+	"currency",
+	"exchange_rate",
+	"buyer_total",
+	"status",
+	"updated_at",
+}
+
+// Represents how the orderline is written to an exported file. The order amounts are
+// in the order currency and the orderline amounts in the seller currency, except
+// the buyer total that is in the order currency
+type exportRow struct {
+	OrderID         string  `json:"order_id"`
+	UserID          string  `json:"user_id"`
+	OrderCreatedAt  string  `json:"order_created_at"`
+	OrderCurrency   string  `json:"order_currency"`
+	PromoCode       string  `json:"promo_code"`
+	PromoDiscount   int64   `json:"promo_discount"`
+	OrderSubtotal   int64   `json:"order_subtotal"`
+	OrderDiscount   int64   `json:"order_discount"`
+	OrderTax        int64   `json:"order_tax"`
+	OrderTotal      int64   `json:"order_total"`
+	ProductID       string  `json:"product_id"`
+	Name            string  `json:"name"`
+	SellerID        string  `json:"seller_id"`
+	CategoryID      int32   `json:"category_id"`
+	Price           int64   `json:"price"`
+	Quantity        int64   `json:"quantity"`
+	Subtotal        int64   `json:"subtotal"`
+	DiscountPercent float32 `json:"discount_percent"`
+	Discount        int64   `json:"discount"`
+	TaxRate         float32 `json:"tax_rate"`
+	Tax             int64   `json:"tax"`
+	Total           int64   `json:"total"`
+	Currency        string  `json:"currency"`
+	ExchangeRate    float64 `json:"exchange_rate"`
+	BuyerTotal      int64   `json:"buyer_total"`
+	Status          string  `json:"status"`
+	UpdatedAt       string  `json:"updated_at"`
+}
+
+func newExportRow(order *model.Order, orderline *model.Orderline) exportRow {
+	return exportRow{
+		OrderID:         order.ID.String(),
+		UserID:          order.UserID.String(),
+		OrderCreatedAt:  order.CreatedAt.UTC().Format(time.RFC3339),
+		OrderCurrency:   order.Currency,
+		PromoCode:       order.PromoCode,
+		PromoDiscount:   order.PromoDiscount,
+		OrderSubtotal:   order.Subtotal,
+		OrderDiscount:   order.Discount,
+		OrderTax:        order.Tax,
+		OrderTotal:      order.Total,
+		ProductID:       orderline.ProductID.String(),
+		Name:            orderline.Name,
+		SellerID:        orderline.SellerID.String(),
+		CategoryID:      orderline.CategoryID,
+		Price:           orderline.Price,
+		Quantity:        orderline.Quantity,
+		Subtotal:        orderline.Subtotal(),
+		DiscountPercent: orderline.DiscountPercent,
+		Discount:        orderline.DiscountAmount(),
+		TaxRate:         orderline.TaxRate,
+		Tax:             orderline.Tax,
+		Total:           orderline.Total(),
+		Currency:        orderline.Currency,
+		ExchangeRate:    orderline.ExchangeRate,
+		BuyerTotal:      orderline.BuyerTotal,
+		Status:          pbOrder.OrderlineStatus(orderline.Status).String(),
+		UpdatedAt:       orderline.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+type Writer interface {
+	Write(order *model.Order) error
+	Flush() error
+}
+
+func NewWriter(format pbOrder.OrderExportFormat, w io.Writer) (Writer, error) {
+	switch format {
+	case pbOrder.OrderExportFormat_CSV:
+		return &csvWriter{writer: csv.NewWriter(w)}, nil
+	case pbOrder.OrderExportFormat_JSONL:
+		return &jsonlWriter{encoder: json.NewEncoder(w)}, nil
+	default:
+		return nil, fmt.Errorf("unknown order export format: %s", format)
+	}
+}
+
+type csvWriter struct {
+	writer        *csv.Writer
+	headerWritten bool
+}
+
+func (writer *csvWriter) writeHeader() error {
+	if writer.headerWritten {
+		return nil
+	}
+	writer.headerWritten = true
+
+	return writer.writer.Write(csvHeader)
+}
+
+func (writer *csvWriter) Write(order *model.Order) error {
+	if err := writer.writeHeader(); err != nil {
+		return err
+	}
+
+	for _, orderline := range order.Orderlines {
+		row := newExportRow(order, orderline)
+
+		if err := writer.writer.Write([]string{
+			row.OrderID,
+			row.UserID,
+			row.OrderCreatedAt,
+			row.OrderCurrency,
+			row.PromoCode,
+			strconv.FormatInt(row.PromoDiscount, 10),
+			strconv.FormatInt(row.OrderSubtotal, 10),
+			strconv.FormatInt(row.OrderDiscount, 10),
+			strconv.FormatInt(row.OrderTax, 10),
+			strconv.FormatInt(row.OrderTotal, 10),
+			row.ProductID,
+			row.Name,
+			row.SellerID,
+			strconv.FormatInt(int64(row.CategoryID), 10),
+			strconv.FormatInt(row.Price, 10),
+			strconv.FormatInt(row.Quantity, 10),
+			strconv.FormatInt(row.Subtotal, 10),
+			strconv.FormatFloat(float64(row.DiscountPercent), 'f', -1, 32),
+			strconv.FormatInt(row.Discount, 10),
+			strconv.FormatFloat(float64(row.TaxRate), 'f', -1, 32),
+			strconv.FormatInt(row.Tax, 10),
+			strconv.FormatInt(row.Total, 10),
+			row.Currency,
+			strconv.FormatFloat(row.ExchangeRate, 'f', -1, 64),
+			strconv.FormatInt(row.BuyerTotal, 10),
+			row.Status,
+			row.UpdatedAt,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return writer.writer.Error()
+}
+
+func (writer *csvWriter) Flush() error {
+	// An empty export is still written with the header
+	if err := writer.writeHeader(); err != nil {
+		return err
+	}
+
+	writer.writer.Flush()
+	return writer.writer.Error()
+}
+
+type jsonlWriter struct {
+	encoder *json.Encoder
+}
+
+func (writer *jsonlWriter) Write(order *model.Order) error {
+	for _, orderline := range order.Orderlines {
+		if err := writer.encoder.Encode(newExportRow(order, orderline)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (writer *jsonlWriter) Flush() error {
+	return nil
+}
@@ -0,0 +1,128 @@
+package export_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/Go-Marketplace/backend/order/internal/export"
+	"github.com/Go-Marketplace/backend/order/internal/model"
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+const exportHeader = "order_id,user_id,order_created_at,order_currency,promo_code,promo_discount," +
+	"order_subtotal,order_discount,order_tax,order_total,product_id,name,seller_id,category_id," +
+	"price,quantity,subtotal,discount_percent,discount,tax_rate,tax,total,currency,exchange_rate," +
+	"buyer_total,status,updated_at\n"
+
+func TestWriter(t *testing.T) {
+	t.Parallel()
+
+	orderID := uuid.MustParse("efb5b1a0-3333-4106-a2bc-577bd4b287d1")
+	userID := uuid.MustParse("efb5b1a0-3333-4106-a2bc-577bd4b287d2")
+	productID := uuid.MustParse("efb5b1a0-3333-4106-a2bc-577bd4b287d3")
+	sellerID := uuid.MustParse("efb5b1a0-3333-4106-a2bc-577bd4b287d4")
+	createdAt := time.Date(2023, time.December, 25, 10, 0, 0, 0, time.UTC)
+
+	order := &model.Order{
+		ID:            orderID,
+		UserID:        userID,
+		CreatedAt:     createdAt,
+		Currency:      "RUB",
+		PromoCode:     "WINTER",
+		PromoDiscount: 100,
+		Subtotal:      2000,
+		Discount:      300,
+		Tax:           170,
+		Total:         1870,
+		Orderlines: []*model.Orderline{
+			{
+				OrderID:         orderID,
+				ProductID:       productID,
+				Name:            "Fish, smoked",
+				Price:           1000,
+				Quantity:        2,
+				SellerID:        sellerID,
+				CategoryID:      3,
+				DiscountPercent: 10,
+				TaxRate:         10,
+				Tax:             180,
+				Currency:        "RUB",
+				BuyerTotal:      1980,
+				Status:          model.Delivery,
+				UpdatedAt:       createdAt.Add(time.Hour),
+			},
+		},
+	}
+
+	testcases := []struct {
+		name     string
+		format   pbOrder.OrderExportFormat
+		orders   []*model.Order
+		expected string
+	}{
+		{
+			name:   "CSV has a row for every orderline",
+			format: pbOrder.OrderExportFormat_CSV,
+			orders: []*model.Order{order},
+			expected: exportHeader +
+				"efb5b1a0-3333-4106-a2bc-577bd4b287d1,efb5b1a0-3333-4106-a2bc-577bd4b287d2,2023-12-25T10:00:00Z," +
+				"RUB,WINTER,100,2000,300,170,1870,efb5b1a0-3333-4106-a2bc-577bd4b287d3,\"Fish, smoked\"," +
+				"efb5b1a0-3333-4106-a2bc-577bd4b287d4,3,1000,2,2000,10,200,10,180,1980,RUB,0,1980,DELIVERY," +
+				"2023-12-25T11:00:00Z\n",
+		},
+		{
+			name:     "Empty CSV has the header",
+			format:   pbOrder.OrderExportFormat_CSV,
+			orders:   nil,
+			expected: exportHeader,
+		},
+		{
+			name:   "JSON Lines has an object for every orderline",
+			format: pbOrder.OrderExportFormat_JSONL,
+			orders: []*model.Order{order},
+			expected: `{"order_id":"efb5b1a0-3333-4106-a2bc-577bd4b287d1","user_id":"efb5b1a0-3333-4106-a2bc-577bd4b287d2",` +
+				`"order_created_at":"2023-12-25T10:00:00Z","order_currency":"RUB","promo_code":"WINTER","promo_discount":100,` +
+				`"order_subtotal":2000,"order_discount":300,"order_tax":170,"order_total":1870,` +
+				`"product_id":"efb5b1a0-3333-4106-a2bc-577bd4b287d3","name":"Fish, smoked",` +
+				`"seller_id":"efb5b1a0-3333-4106-a2bc-577bd4b287d4","category_id":3,"price":1000,"quantity":2,` +
+				`"subtotal":2000,"discount_percent":10,"discount":200,"tax_rate":10,"tax":180,"total":1980,` +
+				`"currency":"RUB","exchange_rate":0,"buyer_total":1980,"status":"DELIVERY",` +
+				`"updated_at":"2023-12-25T11:00:00Z"}` + "\n",
+		},
+		{
+			name:     "Empty JSON Lines is empty",
+			format:   pbOrder.OrderExportFormat_JSONL,
+			orders:   nil,
+			expected: "",
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var actual bytes.Buffer
+			writer, err := export.NewWriter(testcase.format, &actual)
+			assert.NoError(t, err)
+
+			for _, order := range testcase.orders {
+				assert.NoError(t, writer.Write(order))
+			}
+			assert.NoError(t, writer.Flush())
+
+			assert.Equal(t, testcase.expected, actual.String())
+		})
+	}
+}
+
+func TestNewWriterUnknownFormat(t *testing.T) {
+	t.Parallel()
+
+	_, err := export.NewWriter(pbOrder.OrderExportFormat(42), &bytes.Buffer{})
+	assert.Error(t, err)
+}
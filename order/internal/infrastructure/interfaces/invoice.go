@@ -0,0 +1,13 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/Go-Marketplace/backend/order/internal/model"
+	"github.com/google/uuid"
+)
+
+type InvoiceRepo interface {
+	GetInvoice(ctx context.Context, orderID, sellerID uuid.UUID) (*model.Invoice, error)
+	IssueInvoice(ctx context.Context, invoice *model.Invoice) (*model.Invoice, error)
+}
@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/Go-Marketplace/backend/order/internal/model"
+	"github.com/google/uuid"
+)
+
+type LedgerRepo interface {
+	GetOrderlineTransaction(ctx context.Context, kind model.LedgerKind, orderID, productID uuid.UUID) (*model.LedgerTransaction, error)
+	CreateTransaction(ctx context.Context, transaction *model.LedgerTransaction) (bool, error)
+	GetAccountEntries(ctx context.Context, account string) ([]*model.LedgerEntry, error)
+	GetAccountBalances(ctx context.Context, account string) ([]*model.Balance, error)
+	CreatePayouts(ctx context.Context, sellerID uuid.UUID, createdAt time.Time) ([]*model.LedgerTransaction, error)
+}
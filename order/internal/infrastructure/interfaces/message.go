@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/Go-Marketplace/backend/order/internal/model"
+	"github.com/google/uuid"
+)
+
+type MessageRepo interface {
+	GetOrderMessages(ctx context.Context, orderID uuid.UUID) ([]*model.OrderMessage, error)
+	CreateOrderMessage(ctx context.Context, message *model.OrderMessage) error
+	GetReadReceipts(ctx context.Context, orderID uuid.UUID) ([]*model.ReadReceipt, error)
+	SetReadReceipt(ctx context.Context, receipt *model.ReadReceipt) error
+}
@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/Go-Marketplace/backend/order/internal/api/grpc/dto"
 	"github.com/Go-Marketplace/backend/order/internal/model"
@@ -11,12 +12,16 @@ import (
 type OrderRepo interface {
 	GetOrder(ctx context.Context, orderID uuid.UUID) (*model.Order, error)
 	GetOrders(ctx context.Context, searchParams dto.SearchOrderDTO) ([]*model.Order, error)
+	GetOrdersPage(ctx context.Context, searchParams dto.ExportOrdersDTO) ([]*model.Order, error)
 	CreateOrder(ctx context.Context, order *model.Order) error
 	DeleteOrder(ctx context.Context, orderID uuid.UUID) error
-	DeleteUserOrders(ctx context.Context, userID uuid.UUID) error
+	DeleteUserOrders(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
 
 	CreateOrderline(ctx context.Context, orderline *model.Orderline) error
 	GetOrderline(ctx context.Context, orderID, productID uuid.UUID) (*model.Orderline, error)
-	UpdateOrderline(ctx context.Context, order *model.Orderline) error
+	UpdateOrderline(ctx context.Context, orderline *model.Orderline, transaction *model.LedgerTransaction) error
 	DeleteOrderline(ctx context.Context, orderID, productID uuid.UUID) error
+
+	HasReceivedProduct(ctx context.Context, userID, productID uuid.UUID) (bool, error)
+	GetPurchasedQuantity(ctx context.Context, userID, productID uuid.UUID, since time.Time) (int64, error)
 }
@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/Go-Marketplace/backend/order/internal/model"
+	"github.com/google/uuid"
+)
+
+type PromoRepo interface {
+	GetPromoCode(ctx context.Context, code string) (*model.PromoCode, error)
+	CreatePromoCode(ctx context.Context, promo model.PromoCode) error
+	CountUserRedemptions(ctx context.Context, code string, userID uuid.UUID) (int64, error)
+}
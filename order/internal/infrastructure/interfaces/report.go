@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/Go-Marketplace/backend/order/internal/api/grpc/dto"
+	"github.com/Go-Marketplace/backend/order/internal/model"
+)
+
+type ReportRepo interface {
+	GetSalesReport(ctx context.Context, searchParams dto.SalesReportDTO) ([]*model.SalesReportRow, error)
+	GetConversionOrders(ctx context.Context, searchParams dto.ConversionReportDTO) ([]*model.ConversionReportRow, error)
+	RefreshSales(ctx context.Context) error
+}
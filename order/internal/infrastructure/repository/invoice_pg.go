@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Go-Marketplace/backend/order/internal/model"
+	"github.com/Go-Marketplace/backend/pkg/logger"
+	"github.com/Go-Marketplace/backend/pkg/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type InvoiceRepo struct {
+	pg     *postgres.Postgres
+	logger *logger.Logger
+}
+
+func NewInvoiceRepo(pg *postgres.Postgres, logger *logger.Logger) *InvoiceRepo {
+	return &InvoiceRepo{
+		pg:     pg,
+		logger: logger,
+	}
+}
+
+func scanInvoice(rows pgx.Rows, invoice *model.Invoice) error {
+	return rows.Scan(
+		&invoice.ID,
+		&invoice.Number,
+		&invoice.OrderID,
+		&invoice.SellerID,
+		&invoice.SellerName,
+		&invoice.IssuedAt,
+		&invoice.UserID,
+		&invoice.BuyerName,
+		&invoice.BuyerEmail,
+		&invoice.ShippingAddress,
+		&invoice.OrderedAt,
+		&invoice.Currency,
+		&invoice.PromoDiscount,
+		&invoice.Lines,
+	)
+}
+
+// Returns the invoice of the rows or nil when there is none
+func collectInvoice(rows pgx.Rows) (*model.Invoice, error) {
+	defer rows.Close()
+
+	invoice := &model.Invoice{}
+	found := false
+	for rows.Next() {
+		if err := scanInvoice(rows, invoice); err != nil {
+			return nil, fmt.Errorf("failed to scan invoice: %w", err)
+		}
+		found = true
+	}
+
+	if !found {
+		return nil, nil
+	}
+
+	return invoice, nil
+}
+
+func (repo *InvoiceRepo) GetInvoice(ctx context.Context, orderID, sellerID uuid.UUID) (*model.Invoice, error) {
+	sqlQuery, args, err := getInvoiceQuery(orderID, sellerID).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Query getInvoice: %w", err)
+	}
+
+	return collectInvoice(rows)
+}
+
+func getInvoiceInTx(ctx context.Context, tx pgx.Tx, orderID, sellerID uuid.UUID) (*model.Invoice, error) {
+	sqlQuery, args, err := getInvoiceQuery(orderID, sellerID).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Query getInvoice: %w", err)
+	}
+
+	return collectInvoice(rows)
+}
+
+// Gives the invoice the next number of the seller and stores it. The seller counter row
+// stays locked until the transaction ends, so numbers have no gaps and an invoice issued
+// concurrently for the same order is returned instead of a second one
+func (repo *InvoiceRepo) IssueInvoice(ctx context.Context, invoice *model.Invoice) (*model.Invoice, error) {
+	conn, err := repo.pg.Pool.Acquire(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Acquire in IssueInvoice: %w", err)
+	}
+	defer conn.Release()
+
+	tx, err := conn.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin IssueInvoice transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				repo.logger.Error("failed to rollback transaction", err)
+			}
+		} else {
+			if err := tx.Commit(ctx); err != nil {
+				repo.logger.Error("failed to commit transaction", err)
+			}
+		}
+	}()
+
+	sqlQuery, args, err := createInvoiceCounterQuery(invoice.SellerID).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+		return nil, fmt.Errorf("failed to Exec createInvoiceCounter: %w", err)
+	}
+
+	sqlQuery, args, err = getInvoiceCounterForUpdateQuery(invoice.SellerID).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	var lastNumber int64
+	if err = tx.QueryRow(ctx, sqlQuery, args...).Scan(&lastNumber); err != nil {
+		return nil, fmt.Errorf("failed to QueryRow getInvoiceCounterForUpdate: %w", err)
+	}
+
+	issuedInvoice, err := getInvoiceInTx(ctx, tx, invoice.OrderID, invoice.SellerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invoice in transaction: %w", err)
+	}
+
+	if issuedInvoice != nil {
+		return issuedInvoice, nil
+	}
+
+	invoice.Number = model.InvoiceNumber(invoice.SellerID, lastNumber+1)
+
+	sqlQuery, args, err = updateInvoiceCounterQuery(invoice.SellerID, lastNumber+1).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+		return nil, fmt.Errorf("failed to Exec updateInvoiceCounter: %w", err)
+	}
+
+	sqlQuery, args, err = createInvoiceQuery(invoice).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+		return nil, fmt.Errorf("failed to Exec createInvoice: %w", err)
+	}
+
+	return invoice, nil
+}
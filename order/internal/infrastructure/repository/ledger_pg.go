@@ -0,0 +1,284 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Go-Marketplace/backend/order/internal/model"
+	"github.com/Go-Marketplace/backend/pkg/logger"
+	"github.com/Go-Marketplace/backend/pkg/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type LedgerRepo struct {
+	pg     *postgres.Postgres
+	logger *logger.Logger
+}
+
+func NewLedgerRepo(pg *postgres.Postgres, logger *logger.Logger) *LedgerRepo {
+	return &LedgerRepo{
+		pg:     pg,
+		logger: logger,
+	}
+}
+
+func scanLedgerEntry(rows pgx.Rows, entry *model.LedgerEntry) error {
+	return rows.Scan(
+		&entry.ID,
+		&entry.TransactionID,
+		&entry.Account,
+		&entry.Debit,
+		&entry.Credit,
+		&entry.Kind,
+		&entry.SellerID,
+		&entry.OrderID,
+		&entry.ProductID,
+		&entry.Currency,
+		&entry.CreatedAt,
+	)
+}
+
+func collectLedgerEntries(rows pgx.Rows) ([]*model.LedgerEntry, error) {
+	defer rows.Close()
+
+	entries := make([]*model.LedgerEntry, 0)
+	for rows.Next() {
+		entry := &model.LedgerEntry{}
+		if err := scanLedgerEntry(rows, entry); err != nil {
+			return nil, fmt.Errorf("failed to scan ledger entry: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func (repo *LedgerRepo) GetOrderlineTransaction(
+	ctx context.Context,
+	kind model.LedgerKind,
+	orderID uuid.UUID,
+	productID uuid.UUID,
+) (*model.LedgerTransaction, error) {
+	sqlQuery, args, err := getOrderlineTransactionEntriesQuery(kind, orderID, productID).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Query getOrderlineTransactionEntries: %w", err)
+	}
+
+	entries, err := collectLedgerEntries(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	return &model.LedgerTransaction{
+		ID:        entries[0].TransactionID,
+		Kind:      entries[0].Kind,
+		SellerID:  entries[0].SellerID,
+		OrderID:   entries[0].OrderID,
+		ProductID: entries[0].ProductID,
+		Currency:  entries[0].Currency,
+		CreatedAt: entries[0].CreatedAt,
+		Entries:   entries,
+	}, nil
+}
+
+// Stores the transaction with its entries, returns false when the orderline
+// already has a transaction of the kind and nothing is stored
+func createTransactionInTx(ctx context.Context, tx pgx.Tx, transaction *model.LedgerTransaction) (bool, error) {
+	for _, entry := range transaction.Entries {
+		sqlQuery, args, err := createLedgerAccountQuery(entry.Account).ToSql()
+		if err != nil {
+			return false, fmt.Errorf("failed to get sql query: %w", err)
+		}
+
+		if _, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+			return false, fmt.Errorf("failed to Exec createLedgerAccount: %w", err)
+		}
+	}
+
+	sqlQuery, args, err := createLedgerTransactionQuery(transaction).ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	tag, err := tx.Exec(ctx, sqlQuery, args...)
+	if err != nil {
+		return false, fmt.Errorf("failed to Exec createLedgerTransaction: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	for _, entry := range transaction.Entries {
+		sqlQuery, args, err = createLedgerEntryQuery(entry).ToSql()
+		if err != nil {
+			return false, fmt.Errorf("failed to get sql query: %w", err)
+		}
+
+		if _, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+			return false, fmt.Errorf("failed to Exec createLedgerEntry: %w", err)
+		}
+	}
+
+	return true, nil
+}
+
+func (repo *LedgerRepo) CreateTransaction(ctx context.Context, transaction *model.LedgerTransaction) (created bool, err error) {
+	conn, err := repo.pg.Pool.Acquire(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to Acquire in CreateTransaction: %w", err)
+	}
+	defer conn.Release()
+
+	tx, err := conn.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return false, fmt.Errorf("failed to begin CreateTransaction transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				repo.logger.Error("failed to rollback transaction", err)
+			}
+		} else {
+			if err := tx.Commit(ctx); err != nil {
+				repo.logger.Error("failed to commit transaction", err)
+			}
+		}
+	}()
+
+	return createTransactionInTx(ctx, tx, transaction)
+}
+
+func (repo *LedgerRepo) GetAccountEntries(ctx context.Context, account string) ([]*model.LedgerEntry, error) {
+	sqlQuery, args, err := getAccountEntriesQuery(account).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Query getAccountEntries: %w", err)
+	}
+
+	return collectLedgerEntries(rows)
+}
+
+func collectBalances(rows pgx.Rows) ([]*model.Balance, error) {
+	defer rows.Close()
+
+	balances := make([]*model.Balance, 0)
+	for rows.Next() {
+		balance := &model.Balance{}
+		if err := rows.Scan(&balance.Currency, &balance.Amount); err != nil {
+			return nil, fmt.Errorf("failed to scan balance: %w", err)
+		}
+		balances = append(balances, balance)
+	}
+
+	return balances, nil
+}
+
+func (repo *LedgerRepo) GetAccountBalances(ctx context.Context, account string) ([]*model.Balance, error) {
+	sqlQuery, args, err := getAccountBalancesQuery(account).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Query getAccountBalances: %w", err)
+	}
+
+	return collectBalances(rows)
+}
+
+func getAccountBalancesInTx(ctx context.Context, tx pgx.Tx, account string) ([]*model.Balance, error) {
+	sqlQuery, args, err := getAccountBalancesQuery(account).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Query getAccountBalances: %w", err)
+	}
+
+	return collectBalances(rows)
+}
+
+// Pays out the positive balance of the seller in every currency. The seller account row
+// stays locked until the transaction ends, so concurrent payouts can not pay a balance twice
+func (repo *LedgerRepo) CreatePayouts(ctx context.Context, sellerID uuid.UUID, createdAt time.Time) ([]*model.LedgerTransaction, error) {
+	conn, err := repo.pg.Pool.Acquire(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Acquire in CreatePayouts: %w", err)
+	}
+	defer conn.Release()
+
+	tx, err := conn.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin CreatePayouts transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				repo.logger.Error("failed to rollback transaction", err)
+			}
+		} else {
+			if err := tx.Commit(ctx); err != nil {
+				repo.logger.Error("failed to commit transaction", err)
+			}
+		}
+	}()
+
+	account := model.SellerAccount(sellerID)
+
+	sqlQuery, args, err := createLedgerAccountQuery(account).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+		return nil, fmt.Errorf("failed to Exec createLedgerAccount: %w", err)
+	}
+
+	sqlQuery, args, err = lockLedgerAccountQuery(account).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+		return nil, fmt.Errorf("failed to Exec lockLedgerAccount: %w", err)
+	}
+
+	balances, err := getAccountBalancesInTx(ctx, tx, account)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balances in transaction: %w", err)
+	}
+
+	payouts := make([]*model.LedgerTransaction, 0, len(balances))
+	for _, balance := range balances {
+		if balance.Amount <= 0 {
+			continue
+		}
+
+		payout := model.NewPayoutTransaction(sellerID, balance.Currency, balance.Amount, createdAt)
+		if _, err = createTransactionInTx(ctx, tx, payout); err != nil {
+			return nil, fmt.Errorf("failed to create payout in transaction: %w", err)
+		}
+		payouts = append(payouts, payout)
+	}
+
+	return payouts, nil
+}
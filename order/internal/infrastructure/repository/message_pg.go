@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Go-Marketplace/backend/order/internal/model"
+	"github.com/Go-Marketplace/backend/pkg/logger"
+	"github.com/Go-Marketplace/backend/pkg/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type MessageRepo struct {
+	pg     *postgres.Postgres
+	logger *logger.Logger
+}
+
+func NewMessageRepo(pg *postgres.Postgres, logger *logger.Logger) *MessageRepo {
+	return &MessageRepo{
+		pg:     pg,
+		logger: logger,
+	}
+}
+
+func scanOrderMessage(rows pgx.Rows, message *model.OrderMessage) error {
+	return rows.Scan(
+		&message.ID,
+		&message.OrderID,
+		&message.AuthorID,
+		&message.AuthorRole,
+		&message.Body,
+		&message.Attachments,
+		&message.CreatedAt,
+	)
+}
+
+func (repo *MessageRepo) GetOrderMessages(ctx context.Context, orderID uuid.UUID) ([]*model.OrderMessage, error) {
+	sqlQuery, args, err := getOrderMessagesQuery(orderID).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Query getOrderMessages: %w", err)
+	}
+	defer rows.Close()
+
+	messages := make([]*model.OrderMessage, 0)
+	for rows.Next() {
+		message := &model.OrderMessage{}
+		if err = scanOrderMessage(rows, message); err != nil {
+			return nil, fmt.Errorf("failed to scan order message: %w", err)
+		}
+		messages = append(messages, message)
+	}
+
+	return messages, nil
+}
+
+func (repo *MessageRepo) CreateOrderMessage(ctx context.Context, message *model.OrderMessage) error {
+	sqlQuery, args, err := createOrderMessageQuery(message).ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = repo.pg.Pool.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec createOrderMessage: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *MessageRepo) GetReadReceipts(ctx context.Context, orderID uuid.UUID) ([]*model.ReadReceipt, error) {
+	sqlQuery, args, err := getReadReceiptsQuery(orderID).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Query getReadReceipts: %w", err)
+	}
+	defer rows.Close()
+
+	receipts := make([]*model.ReadReceipt, 0)
+	for rows.Next() {
+		receipt := &model.ReadReceipt{}
+		if err = rows.Scan(&receipt.OrderID, &receipt.UserID, &receipt.ReadAt); err != nil {
+			return nil, fmt.Errorf("failed to scan read receipt: %w", err)
+		}
+		receipts = append(receipts, receipt)
+	}
+
+	return receipts, nil
+}
+
+// Sets the receipt to the stored read time, which stays the latest one
+func (repo *MessageRepo) SetReadReceipt(ctx context.Context, receipt *model.ReadReceipt) error {
+	sqlQuery, args, err := setReadReceiptQuery(receipt).ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if err = repo.pg.Pool.QueryRow(ctx, sqlQuery, args...).Scan(&receipt.ReadAt); err != nil {
+		return fmt.Errorf("failed to QueryRow setReadReceipt: %w", err)
+	}
+
+	return nil
+}
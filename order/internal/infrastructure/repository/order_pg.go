@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/Go-Marketplace/backend/order/internal/api/grpc/dto"
 	"github.com/Go-Marketplace/backend/order/internal/model"
@@ -30,11 +31,31 @@ func scanFullOrder(rows pgx.Rows, order *model.Order, orderline *model.Orderline
 		&order.UserID,
 		&order.CreatedAt,
 		&order.UpdatedAt,
+		&order.PromoCode,
+		&order.PromoDiscount,
+		&order.BuyerName,
+		&order.BuyerEmail,
+		&order.ShippingAddress,
+		&order.ShippingRegion,
+		&order.Subtotal,
+		&order.Discount,
+		&order.Tax,
+		&order.Total,
+		&order.Currency,
 		&orderline.OrderID,
 		&orderline.ProductID,
 		&orderline.Name,
 		&orderline.Price,
 		&orderline.Quantity,
+		&orderline.WarehouseID,
+		&orderline.SellerID,
+		&orderline.DiscountPercent,
+		&orderline.TaxRate,
+		&orderline.Tax,
+		&orderline.Currency,
+		&orderline.ExchangeRate,
+		&orderline.BuyerTotal,
+		&orderline.CategoryID,
 		&orderline.Status,
 		&orderline.CreatedAt,
 		&orderline.UpdatedAt,
@@ -48,6 +69,15 @@ func scanOrderline(rows pgx.Rows, orderline *model.Orderline) error {
 		&orderline.Name,
 		&orderline.Price,
 		&orderline.Quantity,
+		&orderline.WarehouseID,
+		&orderline.SellerID,
+		&orderline.DiscountPercent,
+		&orderline.TaxRate,
+		&orderline.Tax,
+		&orderline.Currency,
+		&orderline.ExchangeRate,
+		&orderline.BuyerTotal,
+		&orderline.CategoryID,
 		&orderline.Status,
 		&orderline.CreatedAt,
 		&orderline.UpdatedAt,
@@ -175,6 +205,48 @@ func (repo *OrderRepo) GetOrders(ctx context.Context, searchParams dto.SearchOrd
 	return orders, nil
 }
 
+// Returns the orders of the page with the orderlines in it, an order whose orderlines
+// are split between pages is returned in both of them with its part of the orderlines
+func (repo *OrderRepo) GetOrdersPage(ctx context.Context, searchParams dto.ExportOrdersDTO) ([]*model.Order, error) {
+	sqlQuery, args, err := getOrdersPageQuery(searchParams).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Query getOrdersPage: %w", err)
+	}
+	defer rows.Close()
+
+	orders := make([]*model.Order, 0)
+	for rows.Next() {
+		order := &model.Order{}
+		orderline := &model.Orderline{}
+
+		if err = scanFullOrder(rows, order, orderline); err != nil {
+			return nil, fmt.Errorf("failed to scan full order: %w", err)
+		}
+
+		// The rows of an order follow each other
+		if len(orders) != 0 && orders[len(orders)-1].ID == order.ID {
+			order = orders[len(orders)-1]
+		} else {
+			order.Orderlines = make([]*model.Orderline, 0)
+			orders = append(orders, order)
+		}
+
+		order.Orderlines = append(order.Orderlines, orderline)
+	}
+
+	// A page cut short would end the export early
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read getOrdersPage rows: %w", err)
+	}
+
+	return orders, nil
+}
+
 func createOrderlinesInTx(ctx context.Context, tx pgx.Tx, orderlines []*model.Orderline) error {
 	batch := &pgx.Batch{}
 
@@ -231,6 +303,12 @@ func (repo *OrderRepo) CreateOrder(ctx context.Context, order *model.Order) erro
 		return fmt.Errorf("failed to create orderlines in tx: %w", err)
 	}
 
+	if order.PromoCode != "" {
+		if err = redeemPromoCodeInTx(ctx, tx, order); err != nil {
+			return fmt.Errorf("failed to redeem promo code in tx: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -264,19 +342,35 @@ func (repo *OrderRepo) DeleteOrder(ctx context.Context, orderID uuid.UUID) error
 	return nil
 }
 
-func (repo *OrderRepo) DeleteUserOrders(ctx context.Context, userID uuid.UUID) error {
+// Returns the ids of the deleted orders
+func (repo *OrderRepo) DeleteUserOrders(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
 	query := deleteUserOrdersQuery(userID)
 
 	sqlQuery, args, err := query.ToSql()
 	if err != nil {
-		return fmt.Errorf("failed to get sql query: %w", err)
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
 	}
 
-	if _, err := repo.pg.Pool.Exec(ctx, sqlQuery, args...); err != nil {
-		return fmt.Errorf("failed to Exec deleteUserOrders: %w", err)
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Query deleteUserOrders: %w", err)
 	}
+	defer rows.Close()
 
-	return nil
+	orderIDs := make([]uuid.UUID, 0)
+	for rows.Next() {
+		var orderID uuid.UUID
+		if err = rows.Scan(&orderID); err != nil {
+			return nil, fmt.Errorf("failed to scan order id: %w", err)
+		}
+		orderIDs = append(orderIDs, orderID)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read deleted orders: %w", err)
+	}
+
+	return orderIDs, nil
 }
 
 func (repo *OrderRepo) GetOrderline(ctx context.Context, orderID, productID uuid.UUID) (*model.Orderline, error) {
@@ -347,7 +441,9 @@ func (repo *OrderRepo) CreateOrderline(ctx context.Context, orderline *model.Ord
 	return nil
 }
 
-func (repo *OrderRepo) UpdateOrderline(ctx context.Context, orderline *model.Orderline) error {
+// Stores the orderline status and the ledger transaction it books in one transaction,
+// the transaction is skipped when the orderline is already credited or refunded
+func (repo *OrderRepo) UpdateOrderline(ctx context.Context, orderline *model.Orderline, transaction *model.LedgerTransaction) error {
 	conn, err := repo.pg.Pool.Acquire(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to Acquire in UpdateOrderline: %w", err)
@@ -385,6 +481,12 @@ func (repo *OrderRepo) UpdateOrderline(ctx context.Context, orderline *model.Ord
 		return fmt.Errorf("failed to update Order in Tx: %w", err)
 	}
 
+	if transaction != nil {
+		if _, err = createTransactionInTx(ctx, tx, transaction); err != nil {
+			return fmt.Errorf("failed to create ledger transaction in Tx: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -428,3 +530,41 @@ func (repo *OrderRepo) DeleteOrderline(ctx context.Context, orderID, productID u
 
 	return nil
 }
+
+func (repo *OrderRepo) HasReceivedProduct(ctx context.Context, userID, productID uuid.UUID) (bool, error) {
+	query := hasReceivedProductQuery(userID, productID)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return false, fmt.Errorf("failed to Query hasReceivedProduct: %w", err)
+	}
+	defer rows.Close()
+
+	found := rows.Next()
+	if err = rows.Err(); err != nil {
+		return false, fmt.Errorf("failed to read hasReceivedProduct rows: %w", err)
+	}
+
+	return found, nil
+}
+
+func (repo *OrderRepo) GetPurchasedQuantity(ctx context.Context, userID, productID uuid.UUID, since time.Time) (int64, error) {
+	query := getPurchasedQuantityQuery(userID, productID, since)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	var quantity int64
+	if err = repo.pg.Pool.QueryRow(ctx, sqlQuery, args...).Scan(&quantity); err != nil {
+		return 0, fmt.Errorf("failed to QueryRow getPurchasedQuantity: %w", err)
+	}
+
+	return quantity, nil
+}
@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Go-Marketplace/backend/order/internal/model"
+	"github.com/Go-Marketplace/backend/pkg/logger"
+	"github.com/Go-Marketplace/backend/pkg/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// SQLSTATE of the unique constraint violation
+const uniqueViolation = "23505"
+
+type PromoRepo struct {
+	pg     *postgres.Postgres
+	logger *logger.Logger
+}
+
+func NewPromoRepo(pg *postgres.Postgres, logger *logger.Logger) *PromoRepo {
+	return &PromoRepo{
+		pg:     pg,
+		logger: logger,
+	}
+}
+
+func scanPromoCode(rows pgx.Rows, promo *model.PromoCode) error {
+	return rows.Scan(
+		&promo.Code,
+		&promo.Kind,
+		&promo.Value,
+		&promo.MinTotal,
+		&promo.MaxUses,
+		&promo.PerUserLimit,
+		&promo.CategoryIDs,
+		&promo.SellerIDs,
+		&promo.StartsAt,
+		&promo.EndsAt,
+		&promo.CreatedAt,
+		&promo.Used,
+	)
+}
+
+func (repo *PromoRepo) GetPromoCode(ctx context.Context, code string) (*model.PromoCode, error) {
+	query := getPromoCodeQuery(code)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Query getPromoCode: %w", err)
+	}
+	defer rows.Close()
+
+	promo := &model.PromoCode{}
+	found := false
+	for rows.Next() {
+		if err = scanPromoCode(rows, promo); err != nil {
+			return nil, fmt.Errorf("failed to scan promo code: %w", err)
+		}
+		found = true
+	}
+
+	if !found {
+		return nil, nil
+	}
+
+	return promo, nil
+}
+
+func (repo *PromoRepo) CreatePromoCode(ctx context.Context, promo model.PromoCode) error {
+	query := createPromoCodeQuery(promo)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = repo.pg.Pool.Exec(ctx, sqlQuery, args...); err != nil {
+		// The code was created concurrently after the controller checked it
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return model.ErrPromoCodeExists
+		}
+		return fmt.Errorf("failed to Exec createPromoCode: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *PromoRepo) CountUserRedemptions(ctx context.Context, code string, userID uuid.UUID) (int64, error) {
+	query := countPromoRedemptionsQuery(code, userID)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	var total, userTotal int64
+	if err = repo.pg.Pool.QueryRow(ctx, sqlQuery, args...).Scan(&total, &userTotal); err != nil {
+		return 0, fmt.Errorf("failed to QueryRow countPromoRedemptions: %w", err)
+	}
+
+	return userTotal, nil
+}
+
+// Records the order promo code redemption. The promo code row stays locked
+// until the transaction ends, so the usage limits cannot be exceeded
+// by concurrent orders
+func redeemPromoCodeInTx(ctx context.Context, tx pgx.Tx, order *model.Order) error {
+	query := lockPromoCodeQuery(order.PromoCode)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	var maxUses, perUserLimit int64
+	if err = tx.QueryRow(ctx, sqlQuery, args...).Scan(&maxUses, &perUserLimit); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.ErrPromoCodeNotExists
+		}
+		return fmt.Errorf("failed to QueryRow lockPromoCode: %w", err)
+	}
+
+	countQuery := countPromoRedemptionsQuery(order.PromoCode, order.UserID)
+
+	sqlQuery, args, err = countQuery.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	var total, userTotal int64
+	if err = tx.QueryRow(ctx, sqlQuery, args...).Scan(&total, &userTotal); err != nil {
+		return fmt.Errorf("failed to QueryRow countPromoRedemptions: %w", err)
+	}
+
+	if maxUses != 0 && total >= maxUses {
+		return model.ErrPromoUsesExceeded
+	}
+
+	if perUserLimit != 0 && userTotal >= perUserLimit {
+		return model.ErrPromoUserLimitUsed
+	}
+
+	insertQuery := createPromoRedemptionQuery(order)
+
+	sqlQuery, args, err = insertQuery.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec createPromoRedemption: %w", err)
+	}
+
+	return nil
+}
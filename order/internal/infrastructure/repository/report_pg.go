@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Go-Marketplace/backend/order/internal/api/grpc/dto"
+	"github.com/Go-Marketplace/backend/order/internal/model"
+	"github.com/Go-Marketplace/backend/pkg/logger"
+	"github.com/Go-Marketplace/backend/pkg/postgres"
+)
+
+type ReportRepo struct {
+	pg     *postgres.Postgres
+	logger *logger.Logger
+}
+
+func NewReportRepo(pg *postgres.Postgres, logger *logger.Logger) *ReportRepo {
+	return &ReportRepo{
+		pg:     pg,
+		logger: logger,
+	}
+}
+
+func (repo *ReportRepo) GetSalesReport(ctx context.Context, searchParams dto.SalesReportDTO) ([]*model.SalesReportRow, error) {
+	sqlQuery, args, err := getSalesReportQuery(searchParams).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Query getSalesReport: %w", err)
+	}
+	defer rows.Close()
+
+	report := make([]*model.SalesReportRow, 0)
+	for rows.Next() {
+		row := &model.SalesReportRow{}
+		if err = rows.Scan(
+			&row.PeriodStart,
+			&row.SellerID,
+			&row.CategoryID,
+			&row.ProductID,
+			&row.Name,
+			&row.Currency,
+			&row.Orders,
+			&row.Units,
+			&row.Revenue,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan sales report row: %w", err)
+		}
+		report = append(report, row)
+	}
+
+	return report, nil
+}
+
+func (repo *ReportRepo) GetConversionOrders(
+	ctx context.Context,
+	searchParams dto.ConversionReportDTO,
+) ([]*model.ConversionReportRow, error) {
+	sqlQuery, args, err := getConversionOrdersQuery(searchParams).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Query getConversionOrders: %w", err)
+	}
+	defer rows.Close()
+
+	report := make([]*model.ConversionReportRow, 0)
+	for rows.Next() {
+		row := &model.ConversionReportRow{}
+		if err = rows.Scan(&row.PeriodStart, &row.Orders); err != nil {
+			return nil, fmt.Errorf("failed to scan conversion report row: %w", err)
+		}
+		report = append(report, row)
+	}
+
+	return report, nil
+}
+
+func (repo *ReportRepo) RefreshSales(ctx context.Context) error {
+	if _, err := repo.pg.Pool.Exec(ctx, refreshSalesQuery); err != nil {
+		return fmt.Errorf("failed to Exec refreshSales: %w", err)
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/Go-Marketplace/backend/order/internal/api/grpc/dto"
@@ -17,11 +18,31 @@ func getFullOrdersQuery() sq.SelectBuilder {
 		"orders.user_id",
 		"orders.created_at",
 		"orders.updated_at",
+		"orders.promo_code",
+		"orders.promo_discount",
+		"orders.buyer_name",
+		"orders.buyer_email",
+		"orders.shipping_address",
+		"orders.shipping_region",
+		"orders.subtotal",
+		"orders.discount",
+		"orders.tax",
+		"orders.total",
+		"orders.currency",
 		"orderlines.order_id",
 		"orderlines.product_id",
 		"orderlines.name",
 		"orderlines.price",
 		"orderlines.quantity",
+		"orderlines.warehouse_id",
+		"orderlines.seller_id",
+		"orderlines.discount_percent",
+		"orderlines.tax_rate",
+		"orderlines.tax",
+		"orderlines.currency",
+		"orderlines.exchange_rate",
+		"orderlines.buyer_total",
+		"orderlines.category_id",
 		"orderlines.status",
 		"orderlines.created_at",
 		"orderlines.updated_at",
@@ -49,6 +70,41 @@ func searchOrdersQuery(searchParams dto.SearchOrderDTO) sq.SelectBuilder {
 	return query
 }
 
+func getOrdersPageQuery(searchParams dto.ExportOrdersDTO) sq.SelectBuilder {
+	query := getFullOrdersQuery().
+		Where(sq.GtOrEq{
+			"orders.created_at": searchParams.From,
+		}).
+		Where(sq.Lt{
+			"orders.created_at": searchParams.To,
+		})
+
+	if len(searchParams.Statuses) != 0 {
+		query = query.Where(sq.Eq{
+			"orderlines.status": searchParams.Statuses,
+		})
+	}
+
+	if searchParams.SellerID != uuid.Nil {
+		query = query.Where(sq.Eq{
+			"orderlines.seller_id": searchParams.SellerID,
+		})
+	}
+
+	if searchParams.After != nil {
+		query = query.Where(
+			"(orders.created_at, orders.order_id, orderlines.product_id) > (?, ?, ?)",
+			searchParams.After.CreatedAt,
+			searchParams.After.OrderID,
+			searchParams.After.ProductID,
+		)
+	}
+
+	return query.
+		OrderBy("orders.created_at", "orders.order_id", "orderlines.product_id").
+		Limit(searchParams.Limit)
+}
+
 func createOrderQuery(order *model.Order) sq.InsertBuilder {
 	return psql.Insert("orders").
 		Columns(
@@ -56,12 +112,34 @@ func createOrderQuery(order *model.Order) sq.InsertBuilder {
 			"user_id",
 			"created_at",
 			"updated_at",
+			"promo_code",
+			"promo_discount",
+			"buyer_name",
+			"buyer_email",
+			"shipping_address",
+			"shipping_region",
+			"subtotal",
+			"discount",
+			"tax",
+			"total",
+			"currency",
 		).
 		Values(
 			order.ID,
 			order.UserID,
 			order.CreatedAt,
 			order.UpdatedAt,
+			order.PromoCode,
+			order.PromoDiscount,
+			order.BuyerName,
+			order.BuyerEmail,
+			order.ShippingAddress,
+			order.ShippingRegion,
+			order.Subtotal,
+			order.Discount,
+			order.Tax,
+			order.Total,
+			order.Currency,
 		)
 }
 
@@ -84,7 +162,8 @@ func deleteUserOrdersQuery(userID uuid.UUID) sq.DeleteBuilder {
 	return psql.Delete("orders").
 		Where(sq.Eq{
 			"user_id": userID,
-		})
+		}).
+		Suffix("RETURNING id")
 }
 
 func getOrderlinesQuery() sq.SelectBuilder {
@@ -94,6 +173,15 @@ func getOrderlinesQuery() sq.SelectBuilder {
 		"name",
 		"price",
 		"quantity",
+		"warehouse_id",
+		"seller_id",
+		"discount_percent",
+		"tax_rate",
+		"tax",
+		"currency",
+		"exchange_rate",
+		"buyer_total",
+		"category_id",
 		"status",
 		"created_at",
 		"updated_at",
@@ -121,6 +209,15 @@ func createOrderlineQuery(orderline *model.Orderline) sq.InsertBuilder {
 			"name",
 			"price",
 			"quantity",
+			"warehouse_id",
+			"seller_id",
+			"discount_percent",
+			"tax_rate",
+			"tax",
+			"currency",
+			"exchange_rate",
+			"buyer_total",
+			"category_id",
 			"status",
 			"created_at",
 			"updated_at",
@@ -131,6 +228,15 @@ func createOrderlineQuery(orderline *model.Orderline) sq.InsertBuilder {
 			orderline.Name,
 			orderline.Price,
 			orderline.Quantity,
+			orderline.WarehouseID,
+			orderline.SellerID,
+			orderline.DiscountPercent,
+			orderline.TaxRate,
+			orderline.Tax,
+			orderline.Currency,
+			orderline.ExchangeRate,
+			orderline.BuyerTotal,
+			orderline.CategoryID,
 			orderline.Status,
 			orderline.CreatedAt,
 			orderline.UpdatedAt,
@@ -162,3 +268,505 @@ func deleteOrderlineQuery(orderID, productID uuid.UUID) sq.DeleteBuilder {
 			},
 		})
 }
+
+func hasReceivedProductQuery(userID, productID uuid.UUID) sq.SelectBuilder {
+	return psql.Select("1").
+		From("orders").
+		Join("orderlines USING (order_id)").
+		Where(sq.Eq{
+			"orders.user_id":        userID,
+			"orderlines.product_id": productID,
+			"orderlines.status":     model.Recieved,
+		}).
+		Limit(1)
+}
+
+func getPurchasedQuantityQuery(userID, productID uuid.UUID, since time.Time) sq.SelectBuilder {
+	return psql.Select("COALESCE(SUM(orderlines.quantity), 0)::BIGINT").
+		From("orders").
+		Join("orderlines USING (order_id)").
+		Where(sq.Eq{
+			"orders.user_id":        userID,
+			"orderlines.product_id": productID,
+		}).
+		Where(sq.NotEq{
+			"orderlines.status": model.Canceled,
+		}).
+		Where(sq.GtOrEq{
+			"orders.created_at": since,
+		})
+}
+
+func getPromoCodesQuery() sq.SelectBuilder {
+	return psql.Select(
+		"code",
+		"kind",
+		"value",
+		"min_total",
+		"max_uses",
+		"per_user_limit",
+		"category_ids",
+		"seller_ids",
+		"starts_at",
+		"ends_at",
+		"created_at",
+		"(SELECT COUNT(*) FROM promo_redemptions WHERE promo_redemptions.code = promo_codes.code)",
+	).
+		From("promo_codes")
+}
+
+func getPromoCodeQuery(code string) sq.SelectBuilder {
+	return getPromoCodesQuery().
+		Where(sq.Eq{
+			"code": code,
+		})
+}
+
+// Locks the promo code row until the end of the transaction,
+// so concurrent redemptions of the same code are serialized
+func lockPromoCodeQuery(code string) sq.SelectBuilder {
+	return psql.Select(
+		"max_uses",
+		"per_user_limit",
+	).
+		From("promo_codes").
+		Where(sq.Eq{
+			"code": code,
+		}).
+		Suffix("FOR UPDATE")
+}
+
+func createPromoCodeQuery(promo model.PromoCode) sq.InsertBuilder {
+	return psql.Insert("promo_codes").
+		Columns(
+			"code",
+			"kind",
+			"value",
+			"min_total",
+			"max_uses",
+			"per_user_limit",
+			"category_ids",
+			"seller_ids",
+			"starts_at",
+			"ends_at",
+			"created_at",
+		).
+		Values(
+			promo.Code,
+			promo.Kind,
+			promo.Value,
+			promo.MinTotal,
+			promo.MaxUses,
+			promo.PerUserLimit,
+			promo.CategoryIDs,
+			promo.SellerIDs,
+			promo.StartsAt,
+			promo.EndsAt,
+			promo.CreatedAt,
+		)
+}
+
+func countPromoRedemptionsQuery(code string, userID uuid.UUID) sq.SelectBuilder {
+	return psql.Select("COUNT(*)").
+		Column(sq.Expr("COUNT(*) FILTER (WHERE user_id = ?)", userID)).
+		From("promo_redemptions").
+		Where(sq.Eq{
+			"code": code,
+		})
+}
+
+func createPromoRedemptionQuery(order *model.Order) sq.InsertBuilder {
+	return psql.Insert("promo_redemptions").
+		Columns(
+			"order_id",
+			"code",
+			"user_id",
+			"amount",
+			"created_at",
+		).
+		Values(
+			order.ID,
+			order.PromoCode,
+			order.UserID,
+			order.PromoDiscount,
+			order.CreatedAt,
+		)
+}
+
+func getInvoiceQuery(orderID, sellerID uuid.UUID) sq.SelectBuilder {
+	return psql.Select(
+		"invoice_id",
+		"number",
+		"order_id",
+		"seller_id",
+		"seller_name",
+		"issued_at",
+		"user_id",
+		"buyer_name",
+		"buyer_email",
+		"shipping_address",
+		"ordered_at",
+		"currency",
+		"promo_discount",
+		"lines",
+	).
+		From("invoices").
+		Where(sq.Eq{
+			"order_id":  orderID,
+			"seller_id": sellerID,
+		})
+}
+
+func createInvoiceCounterQuery(sellerID uuid.UUID) sq.InsertBuilder {
+	return psql.Insert("invoice_counters").
+		Columns(
+			"seller_id",
+			"last_number",
+		).
+		Values(
+			sellerID,
+			0,
+		).
+		Suffix("ON CONFLICT (seller_id) DO NOTHING")
+}
+
+func getInvoiceCounterForUpdateQuery(sellerID uuid.UUID) sq.SelectBuilder {
+	return psql.Select("last_number").
+		From("invoice_counters").
+		Where(sq.Eq{
+			"seller_id": sellerID,
+		}).
+		Suffix("FOR UPDATE")
+}
+
+func updateInvoiceCounterQuery(sellerID uuid.UUID, lastNumber int64) sq.UpdateBuilder {
+	return psql.Update("invoice_counters").
+		Set("last_number", lastNumber).
+		Where(sq.Eq{
+			"seller_id": sellerID,
+		})
+}
+
+func createInvoiceQuery(invoice *model.Invoice) sq.InsertBuilder {
+	return psql.Insert("invoices").
+		Columns(
+			"invoice_id",
+			"number",
+			"order_id",
+			"seller_id",
+			"seller_name",
+			"issued_at",
+			"user_id",
+			"buyer_name",
+			"buyer_email",
+			"shipping_address",
+			"ordered_at",
+			"currency",
+			"promo_discount",
+			"lines",
+		).
+		Values(
+			invoice.ID,
+			invoice.Number,
+			invoice.OrderID,
+			invoice.SellerID,
+			invoice.SellerName,
+			invoice.IssuedAt,
+			invoice.UserID,
+			invoice.BuyerName,
+			invoice.BuyerEmail,
+			invoice.ShippingAddress,
+			invoice.OrderedAt,
+			invoice.Currency,
+			invoice.PromoDiscount,
+			invoice.Lines,
+		)
+}
+
+func createLedgerAccountQuery(account string) sq.InsertBuilder {
+	return psql.Insert("ledger_accounts").
+		Columns("account").
+		Values(account).
+		Suffix("ON CONFLICT (account) DO NOTHING")
+}
+
+// Locks the account row until the end of the transaction,
+// so concurrent payouts of the same account are serialized
+func lockLedgerAccountQuery(account string) sq.SelectBuilder {
+	return psql.Select("account").
+		From("ledger_accounts").
+		Where(sq.Eq{
+			"account": account,
+		}).
+		Suffix("FOR UPDATE")
+}
+
+// Skips the transaction when the orderline already has one of its kind
+func createLedgerTransactionQuery(transaction *model.LedgerTransaction) sq.InsertBuilder {
+	return psql.Insert("ledger_transactions").
+		Columns(
+			"transaction_id",
+			"kind",
+			"seller_id",
+			"order_id",
+			"product_id",
+			"currency",
+			"created_at",
+		).
+		Values(
+			transaction.ID,
+			transaction.Kind,
+			transaction.SellerID,
+			transaction.OrderID,
+			transaction.ProductID,
+			transaction.Currency,
+			transaction.CreatedAt,
+		).
+		Suffix("ON CONFLICT DO NOTHING")
+}
+
+func createLedgerEntryQuery(entry *model.LedgerEntry) sq.InsertBuilder {
+	return psql.Insert("ledger_entries").
+		Columns(
+			"entry_id",
+			"transaction_id",
+			"account",
+			"debit",
+			"credit",
+		).
+		Values(
+			entry.ID,
+			entry.TransactionID,
+			entry.Account,
+			entry.Debit,
+			entry.Credit,
+		)
+}
+
+func getLedgerEntriesQuery() sq.SelectBuilder {
+	return psql.Select(
+		"ledger_entries.entry_id",
+		"ledger_entries.transaction_id",
+		"ledger_entries.account",
+		"ledger_entries.debit",
+		"ledger_entries.credit",
+		"ledger_transactions.kind",
+		"ledger_transactions.seller_id",
+		"ledger_transactions.order_id",
+		"ledger_transactions.product_id",
+		"ledger_transactions.currency",
+		"ledger_transactions.created_at",
+	).
+		From("ledger_entries").
+		Join("ledger_transactions USING (transaction_id)")
+}
+
+func getOrderlineTransactionEntriesQuery(kind model.LedgerKind, orderID, productID uuid.UUID) sq.SelectBuilder {
+	return getLedgerEntriesQuery().
+		Where(sq.Eq{
+			"ledger_transactions.kind":       kind,
+			"ledger_transactions.order_id":   orderID,
+			"ledger_transactions.product_id": productID,
+		})
+}
+
+func getAccountEntriesQuery(account string) sq.SelectBuilder {
+	return getLedgerEntriesQuery().
+		Where(sq.Eq{
+			"ledger_entries.account": account,
+		}).
+		OrderBy("ledger_transactions.created_at", "ledger_entries.entry_id")
+}
+
+func getAccountBalancesQuery(account string) sq.SelectBuilder {
+	return psql.Select(
+		"ledger_transactions.currency",
+		"SUM(ledger_entries.credit - ledger_entries.debit)::BIGINT",
+	).
+		From("ledger_entries").
+		Join("ledger_transactions USING (transaction_id)").
+		Where(sq.Eq{
+			"ledger_entries.account": account,
+		}).
+		GroupBy("ledger_transactions.currency").
+		OrderBy("ledger_transactions.currency")
+}
+
+func getOrderMessagesQuery(orderID uuid.UUID) sq.SelectBuilder {
+	return psql.Select(
+		"message_id",
+		"order_id",
+		"author_id",
+		"author_role",
+		"body",
+		"attachments",
+		"created_at",
+	).
+		From("order_messages").
+		Where(sq.Eq{
+			"order_id": orderID,
+		}).
+		OrderBy("created_at", "message_id")
+}
+
+func createOrderMessageQuery(message *model.OrderMessage) sq.InsertBuilder {
+	attachments := message.Attachments
+	if attachments == nil {
+		attachments = make([]string, 0)
+	}
+
+	return psql.Insert("order_messages").
+		Columns(
+			"message_id",
+			"order_id",
+			"author_id",
+			"author_role",
+			"body",
+			"attachments",
+			"created_at",
+		).
+		Values(
+			message.ID,
+			message.OrderID,
+			message.AuthorID,
+			message.AuthorRole,
+			message.Body,
+			attachments,
+			message.CreatedAt,
+		)
+}
+
+func getReadReceiptsQuery(orderID uuid.UUID) sq.SelectBuilder {
+	return psql.Select(
+		"order_id",
+		"user_id",
+		"read_at",
+	).
+		From("order_message_reads").
+		Where(sq.Eq{
+			"order_id": orderID,
+		}).
+		OrderBy("user_id")
+}
+
+// Keeps the latest read time, so a delayed receipt does not mark messages unread
+func setReadReceiptQuery(receipt *model.ReadReceipt) sq.InsertBuilder {
+	return psql.Insert("order_message_reads").
+		Columns(
+			"order_id",
+			"user_id",
+			"read_at",
+		).
+		Values(
+			receipt.OrderID,
+			receipt.UserID,
+			receipt.ReadAt,
+		).
+		Suffix(`ON CONFLICT (order_id, user_id) DO UPDATE SET
+			read_at = GREATEST(order_message_reads.read_at, EXCLUDED.read_at)
+			RETURNING read_at`)
+}
+
+// Values of the grouping_id of sales_daily, a set bit means the column is summed over
+const (
+	salesByProduct        = 0b0000
+	salesBySellerCategory = 0b0001
+	salesBySeller         = 0b0011
+	salesByCategory       = 0b0101
+	salesTotal            = 0b0111
+	ordersByCategory      = 0b1101
+	ordersTotal           = 0b1111
+)
+
+// Returns the sales_daily grouping of the report, the reports of one seller
+// read the groupings by the seller
+func salesGroupingID(searchParams dto.SalesReportDTO) int {
+	switch searchParams.GroupBy {
+	case model.SalesBySeller:
+		return salesBySeller
+	case model.SalesByCategory:
+		if searchParams.SellerID != uuid.Nil {
+			return salesBySellerCategory
+		}
+		return salesByCategory
+	case model.SalesByProduct:
+		return salesByProduct
+	default:
+		if searchParams.SellerID != uuid.Nil {
+			return salesBySeller
+		}
+		return salesTotal
+	}
+}
+
+func getSalesReportQuery(searchParams dto.SalesReportDTO) sq.SelectBuilder {
+	query := psql.Select(
+		fmt.Sprintf("date_trunc('%s', sales_daily.day) AS period_start", searchParams.Period),
+		"sales_daily.seller_id",
+		"sales_daily.category_id",
+		"sales_daily.product_id",
+		"MAX(sales_daily.name)",
+		"sales_daily.currency",
+		"SUM(sales_daily.orders)::BIGINT",
+		"SUM(sales_daily.units)::BIGINT",
+		"SUM(sales_daily.revenue)::BIGINT AS revenue",
+	).
+		From("sales_daily").
+		Where(sq.Eq{
+			"sales_daily.grouping_id": salesGroupingID(searchParams),
+		}).
+		Where(sq.GtOrEq{
+			"sales_daily.day": searchParams.From,
+		}).
+		Where(sq.Lt{
+			"sales_daily.day": searchParams.To,
+		})
+
+	if searchParams.SellerID != uuid.Nil {
+		query = query.Where(sq.Eq{
+			"sales_daily.seller_id": searchParams.SellerID,
+		})
+	}
+
+	return query.
+		GroupBy(
+			"period_start",
+			"sales_daily.seller_id",
+			"sales_daily.category_id",
+			"sales_daily.product_id",
+			"sales_daily.currency",
+		).
+		OrderBy("period_start", "revenue DESC", "sales_daily.currency")
+}
+
+func getConversionOrdersQuery(searchParams dto.ConversionReportDTO) sq.SelectBuilder {
+	query := psql.Select(
+		fmt.Sprintf("date_trunc('%s', sales_daily.day) AS period_start", searchParams.Period),
+		"SUM(sales_daily.orders)::BIGINT",
+	).
+		From("sales_daily").
+		Where(sq.GtOrEq{
+			"sales_daily.day": searchParams.From,
+		}).
+		Where(sq.Lt{
+			"sales_daily.day": searchParams.To,
+		})
+
+	if searchParams.CategoryID != 0 {
+		query = query.Where(sq.Eq{
+			"sales_daily.grouping_id": ordersByCategory,
+			"sales_daily.category_id": searchParams.CategoryID,
+		})
+	} else {
+		query = query.Where(sq.Eq{
+			"sales_daily.grouping_id": ordersTotal,
+		})
+	}
+
+	return query.
+		GroupBy("period_start").
+		OrderBy("period_start")
+}
+
+// Refreshes the sales without locking out the reports reading them
+const refreshSalesQuery = "REFRESH MATERIALIZED VIEW CONCURRENTLY sales_daily"
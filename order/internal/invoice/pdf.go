@@ -0,0 +1,120 @@
+package invoice
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// A4 page in points with the text in 9pt Courier, whose glyphs are 0.6 of the size wide
+const (
+	pdfPageWidth  = 595
+	pdfPageHeight = 842
+	pdfMargin     = 50
+	pdfFontSize   = 9
+	pdfLeading    = 12
+	pdfLineChars  = 90
+	pdfPageLines  = (pdfPageHeight - 2*pdfMargin) / pdfLeading
+)
+
+// Writes the text as a PDF document of as many pages as it needs. The document only uses
+// the standard Courier font, so characters outside of printable ASCII are replaced with "?"
+func writePDF(w io.Writer, text string) error {
+	pages := paginate(wrapLines(text))
+
+	var buf bytes.Buffer
+	offsets := make([]int, 0, 3+2*len(pages))
+	object := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	buf.WriteString("%PDF-1.4\n")
+
+	// Objects 1-3 are the catalog, the page tree and the font, then every page is followed by its content
+	kids := make([]string, 0, len(pages))
+	for i := range pages {
+		kids = append(kids, fmt.Sprintf("%d 0 R", 4+2*i))
+	}
+
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>")
+
+	for i, lines := range pages {
+		object(fmt.Sprintf(
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>",
+			pdfPageWidth,
+			pdfPageHeight,
+			5+2*i,
+		))
+
+		content := pageContent(lines)
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// Splits the text into lines that fit the page width
+func wrapLines(text string) []string {
+	lines := make([]string, 0)
+	for _, line := range strings.Split(strings.TrimRight(text, "\n"), "\n") {
+		runes := []rune(line)
+		for len(runes) > pdfLineChars {
+			lines = append(lines, string(runes[:pdfLineChars]))
+			runes = runes[pdfLineChars:]
+		}
+		lines = append(lines, string(runes))
+	}
+
+	return lines
+}
+
+func paginate(lines []string) [][]string {
+	pages := make([][]string, 0, len(lines)/pdfPageLines+1)
+	for len(lines) > pdfPageLines {
+		pages = append(pages, lines[:pdfPageLines])
+		lines = lines[pdfPageLines:]
+	}
+
+	return append(pages, lines)
+}
+
+func pageContent(lines []string) string {
+	var content strings.Builder
+	fmt.Fprintf(&content, "BT\n/F1 %d Tf\n%d TL\n%d %d Td\n", pdfFontSize, pdfLeading, pdfMargin, pdfPageHeight-pdfMargin)
+	for _, line := range lines {
+		fmt.Fprintf(&content, "(%s) Tj\nT*\n", escapePDF(line))
+	}
+	content.WriteString("ET")
+
+	return content.String()
+}
+
+// Escapes the string for a PDF literal string
+func escapePDF(line string) string {
+	var escaped strings.Builder
+	for _, r := range line {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			escaped.WriteRune('\\')
+			escaped.WriteRune(r)
+		case r < 0x20 || r > 0x7e:
+			escaped.WriteRune('?')
+		default:
+			escaped.WriteRune(r)
+		}
+	}
+
+	return escaped.String()
+}
@@ -0,0 +1,58 @@
+package invoice
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmlTemplate "html/template"
+	"io"
+	"strconv"
+	"strings"
+	textTemplate "text/template"
+	"time"
+
+	"github.com/Go-Marketplace/backend/order/internal/model"
+)
+
+//go:embed templates
+var templates embed.FS
+
+var funcs = map[string]any{
+	"date": func(moment time.Time) string {
+		return moment.Format("2006-01-02")
+	},
+	"percent": func(percent float32) string {
+		if percent == 0 {
+			return "-"
+		}
+		return strconv.FormatFloat(float64(percent), 'f', -1, 32) + "%"
+	},
+	"neg": func(value int64) int64 {
+		return -value
+	},
+	"rule": func() string {
+		return strings.Repeat("-", pdfLineChars-1)
+	},
+}
+
+var (
+	htmlInvoice = htmlTemplate.Must(htmlTemplate.New("invoice.html").Funcs(funcs).ParseFS(templates, "templates/invoice.html"))
+	textInvoice = textTemplate.Must(textTemplate.New("invoice.txt").Funcs(funcs).ParseFS(templates, "templates/invoice.txt"))
+)
+
+// Renders the invoice to the format, the PDF is the plain text template
+// laid out in a monospace font
+func Render(w io.Writer, format model.InvoiceFormat, invoice *model.Invoice) error {
+	switch format {
+	case model.HTMLInvoice:
+		return htmlInvoice.Execute(w, invoice)
+	case model.PDFInvoice:
+		var text bytes.Buffer
+		if err := textInvoice.Execute(&text, invoice); err != nil {
+			return err
+		}
+		return writePDF(w, text.String())
+	default:
+		return fmt.Errorf("unknown invoice format: %d", format)
+	}
+}
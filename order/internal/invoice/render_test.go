@@ -0,0 +1,103 @@
+package invoice_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Go-Marketplace/backend/order/internal/invoice"
+	"github.com/Go-Marketplace/backend/order/internal/model"
+)
+
+func testInvoice(lines int) *model.Invoice {
+	testInvoice := &model.Invoice{
+		ID:              uuid.New(),
+		Number:          "INV-EFB5B1A0-000001",
+		OrderID:         uuid.New(),
+		SellerID:        uuid.New(),
+		SellerName:      "Fish & Chips (Ltd)",
+		IssuedAt:        time.Date(2023, 12, 23, 10, 0, 0, 0, time.UTC),
+		BuyerName:       "<John>",
+		ShippingAddress: "Main street 1",
+	}
+
+	for i := 0; i < lines; i++ {
+		testInvoice.Lines = append(testInvoice.Lines, &model.InvoiceLine{
+			Name:      "Fish",
+			Quantity:  1,
+			UnitPrice: 100,
+		})
+	}
+
+	return testInvoice
+}
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name             string
+		format           model.InvoiceFormat
+		invoice          *model.Invoice
+		expectedContains []string
+		expectedPages    int
+	}{
+		{
+			name:    "HTML escapes the invoice fields",
+			format:  model.HTMLInvoice,
+			invoice: testInvoice(1),
+			expectedContains: []string{
+				"Invoice INV-EFB5B1A0-000001",
+				"Fish &amp; Chips (Ltd)",
+				"&lt;John&gt;",
+				"2023-12-23",
+			},
+		},
+		{
+			name:    "PDF with one page",
+			format:  model.PDFInvoice,
+			invoice: testInvoice(1),
+			expectedContains: []string{
+				"%PDF-1.4",
+				"(INVOICE INV-EFB5B1A0-000001) Tj",
+				"Fish & Chips \\(Ltd\\)",
+				"%%EOF",
+			},
+			expectedPages: 1,
+		},
+		{
+			name:    "PDF with many lines is split into pages",
+			format:  model.PDFInvoice,
+			invoice: testInvoice(150),
+			expectedContains: []string{
+				"/Count 3",
+			},
+			expectedPages: 3,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var content bytes.Buffer
+			err := invoice.Render(&content, testcase.format, testcase.invoice)
+			require.NoError(t, err)
+
+			for _, expected := range testcase.expectedContains {
+				assert.Contains(t, content.String(), expected)
+			}
+
+			if testcase.format == model.PDFInvoice {
+				assert.Equal(t, testcase.expectedPages, strings.Count(content.String(), "/Type /Page /Parent"))
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: order/internal/infrastructure/interfaces/invoice.go
+
+// Package mock_interfaces is a generated GoMock package.
+package mock_interfaces
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/Go-Marketplace/backend/order/internal/model"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockInvoiceRepo is a mock of InvoiceRepo interface.
+type MockInvoiceRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvoiceRepoMockRecorder
+}
+
+// MockInvoiceRepoMockRecorder is the mock recorder for MockInvoiceRepo.
+type MockInvoiceRepoMockRecorder struct {
+	mock *MockInvoiceRepo
+}
+
+// NewMockInvoiceRepo creates a new mock instance.
+func NewMockInvoiceRepo(ctrl *gomock.Controller) *MockInvoiceRepo {
+	mock := &MockInvoiceRepo{ctrl: ctrl}
+	mock.recorder = &MockInvoiceRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvoiceRepo) EXPECT() *MockInvoiceRepoMockRecorder {
+	return m.recorder
+}
+
+// GetInvoice mocks base method.
+func (m *MockInvoiceRepo) GetInvoice(ctx context.Context, orderID, sellerID uuid.UUID) (*model.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvoice", ctx, orderID, sellerID)
+	ret0, _ := ret[0].(*model.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInvoice indicates an expected call of GetInvoice.
+func (mr *MockInvoiceRepoMockRecorder) GetInvoice(ctx, orderID, sellerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvoice", reflect.TypeOf((*MockInvoiceRepo)(nil).GetInvoice), ctx, orderID, sellerID)
+}
+
+// IssueInvoice mocks base method.
+func (m *MockInvoiceRepo) IssueInvoice(ctx context.Context, invoice *model.Invoice) (*model.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueInvoice", ctx, invoice)
+	ret0, _ := ret[0].(*model.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueInvoice indicates an expected call of IssueInvoice.
+func (mr *MockInvoiceRepoMockRecorder) IssueInvoice(ctx, invoice interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueInvoice", reflect.TypeOf((*MockInvoiceRepo)(nil).IssueInvoice), ctx, invoice)
+}
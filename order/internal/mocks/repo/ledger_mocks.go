@@ -0,0 +1,113 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: order/internal/infrastructure/interfaces/ledger.go
+
+// Package mock_interfaces is a generated GoMock package.
+package mock_interfaces
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/Go-Marketplace/backend/order/internal/model"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockLedgerRepo is a mock of LedgerRepo interface.
+type MockLedgerRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockLedgerRepoMockRecorder
+}
+
+// MockLedgerRepoMockRecorder is the mock recorder for MockLedgerRepo.
+type MockLedgerRepoMockRecorder struct {
+	mock *MockLedgerRepo
+}
+
+// NewMockLedgerRepo creates a new mock instance.
+func NewMockLedgerRepo(ctrl *gomock.Controller) *MockLedgerRepo {
+	mock := &MockLedgerRepo{ctrl: ctrl}
+	mock.recorder = &MockLedgerRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLedgerRepo) EXPECT() *MockLedgerRepoMockRecorder {
+	return m.recorder
+}
+
+// CreatePayouts mocks base method.
+func (m *MockLedgerRepo) CreatePayouts(ctx context.Context, sellerID uuid.UUID, createdAt time.Time) ([]*model.LedgerTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePayouts", ctx, sellerID, createdAt)
+	ret0, _ := ret[0].([]*model.LedgerTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePayouts indicates an expected call of CreatePayouts.
+func (mr *MockLedgerRepoMockRecorder) CreatePayouts(ctx, sellerID, createdAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePayouts", reflect.TypeOf((*MockLedgerRepo)(nil).CreatePayouts), ctx, sellerID, createdAt)
+}
+
+// CreateTransaction mocks base method.
+func (m *MockLedgerRepo) CreateTransaction(ctx context.Context, transaction *model.LedgerTransaction) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransaction", ctx, transaction)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransaction indicates an expected call of CreateTransaction.
+func (mr *MockLedgerRepoMockRecorder) CreateTransaction(ctx, transaction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransaction", reflect.TypeOf((*MockLedgerRepo)(nil).CreateTransaction), ctx, transaction)
+}
+
+// GetAccountBalances mocks base method.
+func (m *MockLedgerRepo) GetAccountBalances(ctx context.Context, account string) ([]*model.Balance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountBalances", ctx, account)
+	ret0, _ := ret[0].([]*model.Balance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountBalances indicates an expected call of GetAccountBalances.
+func (mr *MockLedgerRepoMockRecorder) GetAccountBalances(ctx, account interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountBalances", reflect.TypeOf((*MockLedgerRepo)(nil).GetAccountBalances), ctx, account)
+}
+
+// GetAccountEntries mocks base method.
+func (m *MockLedgerRepo) GetAccountEntries(ctx context.Context, account string) ([]*model.LedgerEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountEntries", ctx, account)
+	ret0, _ := ret[0].([]*model.LedgerEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountEntries indicates an expected call of GetAccountEntries.
+func (mr *MockLedgerRepoMockRecorder) GetAccountEntries(ctx, account interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountEntries", reflect.TypeOf((*MockLedgerRepo)(nil).GetAccountEntries), ctx, account)
+}
+
+// GetOrderlineTransaction mocks base method.
+func (m *MockLedgerRepo) GetOrderlineTransaction(ctx context.Context, kind model.LedgerKind, orderID, productID uuid.UUID) (*model.LedgerTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderlineTransaction", ctx, kind, orderID, productID)
+	ret0, _ := ret[0].(*model.LedgerTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderlineTransaction indicates an expected call of GetOrderlineTransaction.
+func (mr *MockLedgerRepoMockRecorder) GetOrderlineTransaction(ctx, kind, orderID, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderlineTransaction", reflect.TypeOf((*MockLedgerRepo)(nil).GetOrderlineTransaction), ctx, kind, orderID, productID)
+}
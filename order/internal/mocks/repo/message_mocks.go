@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: order/internal/infrastructure/interfaces/message.go
+
+// Package mock_interfaces is a generated GoMock package.
+package mock_interfaces
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/Go-Marketplace/backend/order/internal/model"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockMessageRepo is a mock of MessageRepo interface.
+type MockMessageRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockMessageRepoMockRecorder
+}
+
+// MockMessageRepoMockRecorder is the mock recorder for MockMessageRepo.
+type MockMessageRepoMockRecorder struct {
+	mock *MockMessageRepo
+}
+
+// NewMockMessageRepo creates a new mock instance.
+func NewMockMessageRepo(ctrl *gomock.Controller) *MockMessageRepo {
+	mock := &MockMessageRepo{ctrl: ctrl}
+	mock.recorder = &MockMessageRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMessageRepo) EXPECT() *MockMessageRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateOrderMessage mocks base method.
+func (m *MockMessageRepo) CreateOrderMessage(ctx context.Context, message *model.OrderMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrderMessage", ctx, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrderMessage indicates an expected call of CreateOrderMessage.
+func (mr *MockMessageRepoMockRecorder) CreateOrderMessage(ctx, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrderMessage", reflect.TypeOf((*MockMessageRepo)(nil).CreateOrderMessage), ctx, message)
+}
+
+// GetOrderMessages mocks base method.
+func (m *MockMessageRepo) GetOrderMessages(ctx context.Context, orderID uuid.UUID) ([]*model.OrderMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderMessages", ctx, orderID)
+	ret0, _ := ret[0].([]*model.OrderMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderMessages indicates an expected call of GetOrderMessages.
+func (mr *MockMessageRepoMockRecorder) GetOrderMessages(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderMessages", reflect.TypeOf((*MockMessageRepo)(nil).GetOrderMessages), ctx, orderID)
+}
+
+// GetReadReceipts mocks base method.
+func (m *MockMessageRepo) GetReadReceipts(ctx context.Context, orderID uuid.UUID) ([]*model.ReadReceipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReadReceipts", ctx, orderID)
+	ret0, _ := ret[0].([]*model.ReadReceipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReadReceipts indicates an expected call of GetReadReceipts.
+func (mr *MockMessageRepoMockRecorder) GetReadReceipts(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadReceipts", reflect.TypeOf((*MockMessageRepo)(nil).GetReadReceipts), ctx, orderID)
+}
+
+// SetReadReceipt mocks base method.
+func (m *MockMessageRepo) SetReadReceipt(ctx context.Context, receipt *model.ReadReceipt) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReadReceipt", ctx, receipt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetReadReceipt indicates an expected call of SetReadReceipt.
+func (mr *MockMessageRepoMockRecorder) SetReadReceipt(ctx, receipt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadReceipt", reflect.TypeOf((*MockMessageRepo)(nil).SetReadReceipt), ctx, receipt)
+}
@@ -7,6 +7,7 @@ package mock_interfaces
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	dto "github.com/Go-Marketplace/backend/order/internal/api/grpc/dto"
 	model "github.com/Go-Marketplace/backend/order/internal/model"
@@ -94,11 +95,12 @@ func (mr *MockOrderRepoMockRecorder) DeleteOrderline(ctx, orderID, productID int
 }
 
 // DeleteUserOrders mocks base method.
-func (m *MockOrderRepo) DeleteUserOrders(ctx context.Context, userID uuid.UUID) error {
+func (m *MockOrderRepo) DeleteUserOrders(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteUserOrders", ctx, userID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // DeleteUserOrders indicates an expected call of DeleteUserOrders.
@@ -152,16 +154,61 @@ func (mr *MockOrderRepoMockRecorder) GetOrders(ctx, searchParams interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrders", reflect.TypeOf((*MockOrderRepo)(nil).GetOrders), ctx, searchParams)
 }
 
+// GetOrdersPage mocks base method.
+func (m *MockOrderRepo) GetOrdersPage(ctx context.Context, searchParams dto.ExportOrdersDTO) ([]*model.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrdersPage", ctx, searchParams)
+	ret0, _ := ret[0].([]*model.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrdersPage indicates an expected call of GetOrdersPage.
+func (mr *MockOrderRepoMockRecorder) GetOrdersPage(ctx, searchParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrdersPage", reflect.TypeOf((*MockOrderRepo)(nil).GetOrdersPage), ctx, searchParams)
+}
+
+// GetPurchasedQuantity mocks base method.
+func (m *MockOrderRepo) GetPurchasedQuantity(ctx context.Context, userID, productID uuid.UUID, since time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPurchasedQuantity", ctx, userID, productID, since)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPurchasedQuantity indicates an expected call of GetPurchasedQuantity.
+func (mr *MockOrderRepoMockRecorder) GetPurchasedQuantity(ctx, userID, productID, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPurchasedQuantity", reflect.TypeOf((*MockOrderRepo)(nil).GetPurchasedQuantity), ctx, userID, productID, since)
+}
+
+// HasReceivedProduct mocks base method.
+func (m *MockOrderRepo) HasReceivedProduct(ctx context.Context, userID, productID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasReceivedProduct", ctx, userID, productID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasReceivedProduct indicates an expected call of HasReceivedProduct.
+func (mr *MockOrderRepoMockRecorder) HasReceivedProduct(ctx, userID, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasReceivedProduct", reflect.TypeOf((*MockOrderRepo)(nil).HasReceivedProduct), ctx, userID, productID)
+}
+
 // UpdateOrderline mocks base method.
-func (m *MockOrderRepo) UpdateOrderline(ctx context.Context, order *model.Orderline) error {
+func (m *MockOrderRepo) UpdateOrderline(ctx context.Context, orderline *model.Orderline, transaction *model.LedgerTransaction) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateOrderline", ctx, order)
+	ret := m.ctrl.Call(m, "UpdateOrderline", ctx, orderline, transaction)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateOrderline indicates an expected call of UpdateOrderline.
-func (mr *MockOrderRepoMockRecorder) UpdateOrderline(ctx, order interface{}) *gomock.Call {
+func (mr *MockOrderRepoMockRecorder) UpdateOrderline(ctx, orderline, transaction interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrderline", reflect.TypeOf((*MockOrderRepo)(nil).UpdateOrderline), ctx, order)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrderline", reflect.TypeOf((*MockOrderRepo)(nil).UpdateOrderline), ctx, orderline, transaction)
 }
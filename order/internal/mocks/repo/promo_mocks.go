@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: order/internal/infrastructure/interfaces/promo.go
+
+// Package mock_interfaces is a generated GoMock package.
+package mock_interfaces
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/Go-Marketplace/backend/order/internal/model"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockPromoRepo is a mock of PromoRepo interface.
+type MockPromoRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockPromoRepoMockRecorder
+}
+
+// MockPromoRepoMockRecorder is the mock recorder for MockPromoRepo.
+type MockPromoRepoMockRecorder struct {
+	mock *MockPromoRepo
+}
+
+// NewMockPromoRepo creates a new mock instance.
+func NewMockPromoRepo(ctrl *gomock.Controller) *MockPromoRepo {
+	mock := &MockPromoRepo{ctrl: ctrl}
+	mock.recorder = &MockPromoRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPromoRepo) EXPECT() *MockPromoRepoMockRecorder {
+	return m.recorder
+}
+
+// CountUserRedemptions mocks base method.
+func (m *MockPromoRepo) CountUserRedemptions(ctx context.Context, code string, userID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUserRedemptions", ctx, code, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUserRedemptions indicates an expected call of CountUserRedemptions.
+func (mr *MockPromoRepoMockRecorder) CountUserRedemptions(ctx, code, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUserRedemptions", reflect.TypeOf((*MockPromoRepo)(nil).CountUserRedemptions), ctx, code, userID)
+}
+
+// CreatePromoCode mocks base method.
+func (m *MockPromoRepo) CreatePromoCode(ctx context.Context, promo model.PromoCode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePromoCode", ctx, promo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePromoCode indicates an expected call of CreatePromoCode.
+func (mr *MockPromoRepoMockRecorder) CreatePromoCode(ctx, promo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePromoCode", reflect.TypeOf((*MockPromoRepo)(nil).CreatePromoCode), ctx, promo)
+}
+
+// GetPromoCode mocks base method.
+func (m *MockPromoRepo) GetPromoCode(ctx context.Context, code string) (*model.PromoCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPromoCode", ctx, code)
+	ret0, _ := ret[0].(*model.PromoCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPromoCode indicates an expected call of GetPromoCode.
+func (mr *MockPromoRepoMockRecorder) GetPromoCode(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromoCode", reflect.TypeOf((*MockPromoRepo)(nil).GetPromoCode), ctx, code)
+}
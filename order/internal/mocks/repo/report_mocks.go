@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: order/internal/infrastructure/interfaces/report.go
+
+// Package mock_interfaces is a generated GoMock package.
+package mock_interfaces
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/Go-Marketplace/backend/order/internal/api/grpc/dto"
+	model "github.com/Go-Marketplace/backend/order/internal/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReportRepo is a mock of ReportRepo interface.
+type MockReportRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportRepoMockRecorder
+}
+
+// MockReportRepoMockRecorder is the mock recorder for MockReportRepo.
+type MockReportRepoMockRecorder struct {
+	mock *MockReportRepo
+}
+
+// NewMockReportRepo creates a new mock instance.
+func NewMockReportRepo(ctrl *gomock.Controller) *MockReportRepo {
+	mock := &MockReportRepo{ctrl: ctrl}
+	mock.recorder = &MockReportRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportRepo) EXPECT() *MockReportRepoMockRecorder {
+	return m.recorder
+}
+
+// GetConversionOrders mocks base method.
+func (m *MockReportRepo) GetConversionOrders(ctx context.Context, searchParams dto.ConversionReportDTO) ([]*model.ConversionReportRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConversionOrders", ctx, searchParams)
+	ret0, _ := ret[0].([]*model.ConversionReportRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConversionOrders indicates an expected call of GetConversionOrders.
+func (mr *MockReportRepoMockRecorder) GetConversionOrders(ctx, searchParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConversionOrders", reflect.TypeOf((*MockReportRepo)(nil).GetConversionOrders), ctx, searchParams)
+}
+
+// GetSalesReport mocks base method.
+func (m *MockReportRepo) GetSalesReport(ctx context.Context, searchParams dto.SalesReportDTO) ([]*model.SalesReportRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSalesReport", ctx, searchParams)
+	ret0, _ := ret[0].([]*model.SalesReportRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSalesReport indicates an expected call of GetSalesReport.
+func (mr *MockReportRepoMockRecorder) GetSalesReport(ctx, searchParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSalesReport", reflect.TypeOf((*MockReportRepo)(nil).GetSalesReport), ctx, searchParams)
+}
+
+// RefreshSales mocks base method.
+func (m *MockReportRepo) RefreshSales(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshSales", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshSales indicates an expected call of RefreshSales.
+func (mr *MockReportRepoMockRecorder) RefreshSales(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshSales", reflect.TypeOf((*MockReportRepo)(nil).RefreshSales), ctx)
+}
@@ -7,6 +7,7 @@ package mock_usecase
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	dto "github.com/Go-Marketplace/backend/order/internal/api/grpc/dto"
 	model "github.com/Go-Marketplace/backend/order/internal/model"
@@ -37,6 +38,21 @@ func (m *MockIOrderUsecase) EXPECT() *MockIOrderUsecaseMockRecorder {
 	return m.recorder
 }
 
+// CountUserRedemptions mocks base method.
+func (m *MockIOrderUsecase) CountUserRedemptions(ctx context.Context, code string, userID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUserRedemptions", ctx, code, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUserRedemptions indicates an expected call of CountUserRedemptions.
+func (mr *MockIOrderUsecaseMockRecorder) CountUserRedemptions(ctx, code, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUserRedemptions", reflect.TypeOf((*MockIOrderUsecase)(nil).CountUserRedemptions), ctx, code, userID)
+}
+
 // CreateOrder mocks base method.
 func (m *MockIOrderUsecase) CreateOrder(ctx context.Context, order *model.Order) (*model.Order, error) {
 	m.ctrl.T.Helper()
@@ -52,6 +68,20 @@ func (mr *MockIOrderUsecaseMockRecorder) CreateOrder(ctx, order interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrder", reflect.TypeOf((*MockIOrderUsecase)(nil).CreateOrder), ctx, order)
 }
 
+// CreateOrderMessage mocks base method.
+func (m *MockIOrderUsecase) CreateOrderMessage(ctx context.Context, message *model.OrderMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrderMessage", ctx, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrderMessage indicates an expected call of CreateOrderMessage.
+func (mr *MockIOrderUsecaseMockRecorder) CreateOrderMessage(ctx, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrderMessage", reflect.TypeOf((*MockIOrderUsecase)(nil).CreateOrderMessage), ctx, message)
+}
+
 // CreateOrderline mocks base method.
 func (m *MockIOrderUsecase) CreateOrderline(ctx context.Context, orderline *model.Orderline) error {
 	m.ctrl.T.Helper()
@@ -66,6 +96,36 @@ func (mr *MockIOrderUsecaseMockRecorder) CreateOrderline(ctx, orderline interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrderline", reflect.TypeOf((*MockIOrderUsecase)(nil).CreateOrderline), ctx, orderline)
 }
 
+// CreatePayouts mocks base method.
+func (m *MockIOrderUsecase) CreatePayouts(ctx context.Context, sellerID uuid.UUID) ([]*model.LedgerTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePayouts", ctx, sellerID)
+	ret0, _ := ret[0].([]*model.LedgerTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePayouts indicates an expected call of CreatePayouts.
+func (mr *MockIOrderUsecaseMockRecorder) CreatePayouts(ctx, sellerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePayouts", reflect.TypeOf((*MockIOrderUsecase)(nil).CreatePayouts), ctx, sellerID)
+}
+
+// CreatePromoCode mocks base method.
+func (m *MockIOrderUsecase) CreatePromoCode(ctx context.Context, promo model.PromoCode) (*model.PromoCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePromoCode", ctx, promo)
+	ret0, _ := ret[0].(*model.PromoCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePromoCode indicates an expected call of CreatePromoCode.
+func (mr *MockIOrderUsecaseMockRecorder) CreatePromoCode(ctx, promo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePromoCode", reflect.TypeOf((*MockIOrderUsecase)(nil).CreatePromoCode), ctx, promo)
+}
+
 // DeleteOrder mocks base method.
 func (m *MockIOrderUsecase) DeleteOrder(ctx context.Context, orderID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -108,6 +168,36 @@ func (mr *MockIOrderUsecaseMockRecorder) DeleteUserOrders(ctx, userID interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserOrders", reflect.TypeOf((*MockIOrderUsecase)(nil).DeleteUserOrders), ctx, userID)
 }
 
+// GetConversionOrders mocks base method.
+func (m *MockIOrderUsecase) GetConversionOrders(ctx context.Context, searchParams dto.ConversionReportDTO) ([]*model.ConversionReportRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConversionOrders", ctx, searchParams)
+	ret0, _ := ret[0].([]*model.ConversionReportRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConversionOrders indicates an expected call of GetConversionOrders.
+func (mr *MockIOrderUsecaseMockRecorder) GetConversionOrders(ctx, searchParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConversionOrders", reflect.TypeOf((*MockIOrderUsecase)(nil).GetConversionOrders), ctx, searchParams)
+}
+
+// GetInvoice mocks base method.
+func (m *MockIOrderUsecase) GetInvoice(ctx context.Context, orderID, sellerID uuid.UUID) (*model.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvoice", ctx, orderID, sellerID)
+	ret0, _ := ret[0].(*model.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInvoice indicates an expected call of GetInvoice.
+func (mr *MockIOrderUsecaseMockRecorder) GetInvoice(ctx, orderID, sellerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvoice", reflect.TypeOf((*MockIOrderUsecase)(nil).GetInvoice), ctx, orderID, sellerID)
+}
+
 // GetOrder mocks base method.
 func (m *MockIOrderUsecase) GetOrder(ctx context.Context, orderID uuid.UUID) (*model.Order, error) {
 	m.ctrl.T.Helper()
@@ -123,6 +213,21 @@ func (mr *MockIOrderUsecaseMockRecorder) GetOrder(ctx, orderID interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrder", reflect.TypeOf((*MockIOrderUsecase)(nil).GetOrder), ctx, orderID)
 }
 
+// GetOrderThread mocks base method.
+func (m *MockIOrderUsecase) GetOrderThread(ctx context.Context, orderID uuid.UUID) (*model.OrderThread, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderThread", ctx, orderID)
+	ret0, _ := ret[0].(*model.OrderThread)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderThread indicates an expected call of GetOrderThread.
+func (mr *MockIOrderUsecaseMockRecorder) GetOrderThread(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderThread", reflect.TypeOf((*MockIOrderUsecase)(nil).GetOrderThread), ctx, orderID)
+}
+
 // GetOrderline mocks base method.
 func (m *MockIOrderUsecase) GetOrderline(ctx context.Context, orderID, productID uuid.UUID) (*model.Orderline, error) {
 	m.ctrl.T.Helper()
@@ -153,6 +258,154 @@ func (mr *MockIOrderUsecaseMockRecorder) GetOrders(ctx, searchParams interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrders", reflect.TypeOf((*MockIOrderUsecase)(nil).GetOrders), ctx, searchParams)
 }
 
+// GetOrdersPage mocks base method.
+func (m *MockIOrderUsecase) GetOrdersPage(ctx context.Context, searchParams dto.ExportOrdersDTO) ([]*model.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrdersPage", ctx, searchParams)
+	ret0, _ := ret[0].([]*model.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrdersPage indicates an expected call of GetOrdersPage.
+func (mr *MockIOrderUsecaseMockRecorder) GetOrdersPage(ctx, searchParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrdersPage", reflect.TypeOf((*MockIOrderUsecase)(nil).GetOrdersPage), ctx, searchParams)
+}
+
+// GetPromoCode mocks base method.
+func (m *MockIOrderUsecase) GetPromoCode(ctx context.Context, code string) (*model.PromoCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPromoCode", ctx, code)
+	ret0, _ := ret[0].(*model.PromoCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPromoCode indicates an expected call of GetPromoCode.
+func (mr *MockIOrderUsecaseMockRecorder) GetPromoCode(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromoCode", reflect.TypeOf((*MockIOrderUsecase)(nil).GetPromoCode), ctx, code)
+}
+
+// GetPurchasedQuantity mocks base method.
+func (m *MockIOrderUsecase) GetPurchasedQuantity(ctx context.Context, userID, productID uuid.UUID, since time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPurchasedQuantity", ctx, userID, productID, since)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPurchasedQuantity indicates an expected call of GetPurchasedQuantity.
+func (mr *MockIOrderUsecaseMockRecorder) GetPurchasedQuantity(ctx, userID, productID, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPurchasedQuantity", reflect.TypeOf((*MockIOrderUsecase)(nil).GetPurchasedQuantity), ctx, userID, productID, since)
+}
+
+// GetSalesReport mocks base method.
+func (m *MockIOrderUsecase) GetSalesReport(ctx context.Context, searchParams dto.SalesReportDTO) ([]*model.SalesReportRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSalesReport", ctx, searchParams)
+	ret0, _ := ret[0].([]*model.SalesReportRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSalesReport indicates an expected call of GetSalesReport.
+func (mr *MockIOrderUsecaseMockRecorder) GetSalesReport(ctx, searchParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSalesReport", reflect.TypeOf((*MockIOrderUsecase)(nil).GetSalesReport), ctx, searchParams)
+}
+
+// GetSellerBalances mocks base method.
+func (m *MockIOrderUsecase) GetSellerBalances(ctx context.Context, sellerID uuid.UUID) ([]*model.Balance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSellerBalances", ctx, sellerID)
+	ret0, _ := ret[0].([]*model.Balance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSellerBalances indicates an expected call of GetSellerBalances.
+func (mr *MockIOrderUsecaseMockRecorder) GetSellerBalances(ctx, sellerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSellerBalances", reflect.TypeOf((*MockIOrderUsecase)(nil).GetSellerBalances), ctx, sellerID)
+}
+
+// GetSellerEntries mocks base method.
+func (m *MockIOrderUsecase) GetSellerEntries(ctx context.Context, sellerID uuid.UUID) ([]*model.LedgerEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSellerEntries", ctx, sellerID)
+	ret0, _ := ret[0].([]*model.LedgerEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSellerEntries indicates an expected call of GetSellerEntries.
+func (mr *MockIOrderUsecaseMockRecorder) GetSellerEntries(ctx, sellerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSellerEntries", reflect.TypeOf((*MockIOrderUsecase)(nil).GetSellerEntries), ctx, sellerID)
+}
+
+// HasReceivedProduct mocks base method.
+func (m *MockIOrderUsecase) HasReceivedProduct(ctx context.Context, userID, productID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasReceivedProduct", ctx, userID, productID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasReceivedProduct indicates an expected call of HasReceivedProduct.
+func (mr *MockIOrderUsecaseMockRecorder) HasReceivedProduct(ctx, userID, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasReceivedProduct", reflect.TypeOf((*MockIOrderUsecase)(nil).HasReceivedProduct), ctx, userID, productID)
+}
+
+// IssueInvoice mocks base method.
+func (m *MockIOrderUsecase) IssueInvoice(ctx context.Context, invoice *model.Invoice) (*model.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueInvoice", ctx, invoice)
+	ret0, _ := ret[0].(*model.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueInvoice indicates an expected call of IssueInvoice.
+func (mr *MockIOrderUsecaseMockRecorder) IssueInvoice(ctx, invoice interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueInvoice", reflect.TypeOf((*MockIOrderUsecase)(nil).IssueInvoice), ctx, invoice)
+}
+
+// MarkThreadRead mocks base method.
+func (m *MockIOrderUsecase) MarkThreadRead(ctx context.Context, receipt *model.ReadReceipt) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkThreadRead", ctx, receipt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkThreadRead indicates an expected call of MarkThreadRead.
+func (mr *MockIOrderUsecaseMockRecorder) MarkThreadRead(ctx, receipt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkThreadRead", reflect.TypeOf((*MockIOrderUsecase)(nil).MarkThreadRead), ctx, receipt)
+}
+
+// RefreshSales mocks base method.
+func (m *MockIOrderUsecase) RefreshSales(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshSales", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshSales indicates an expected call of RefreshSales.
+func (mr *MockIOrderUsecaseMockRecorder) RefreshSales(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshSales", reflect.TypeOf((*MockIOrderUsecase)(nil).RefreshSales), ctx)
+}
+
 // UpdateOrderline mocks base method.
 func (m *MockIOrderUsecase) UpdateOrderline(ctx context.Context, orderline *model.Orderline) (*model.Orderline, error) {
 	m.ctrl.T.Helper()
@@ -167,3 +420,33 @@ func (mr *MockIOrderUsecaseMockRecorder) UpdateOrderline(ctx, orderline interfac
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrderline", reflect.TypeOf((*MockIOrderUsecase)(nil).UpdateOrderline), ctx, orderline)
 }
+
+// WatchOrder mocks base method.
+func (m *MockIOrderUsecase) WatchOrder(orderID uuid.UUID) (<-chan *model.OrderEvent, func()) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchOrder", orderID)
+	ret0, _ := ret[0].(<-chan *model.OrderEvent)
+	ret1, _ := ret[1].(func())
+	return ret0, ret1
+}
+
+// WatchOrder indicates an expected call of WatchOrder.
+func (mr *MockIOrderUsecaseMockRecorder) WatchOrder(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchOrder", reflect.TypeOf((*MockIOrderUsecase)(nil).WatchOrder), orderID)
+}
+
+// WatchOrderThread mocks base method.
+func (m *MockIOrderUsecase) WatchOrderThread(orderID uuid.UUID) (<-chan *model.ThreadEvent, func()) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchOrderThread", orderID)
+	ret0, _ := ret[0].(<-chan *model.ThreadEvent)
+	ret1, _ := ret[1].(func())
+	return ret0, ret1
+}
+
+// WatchOrderThread indicates an expected call of WatchOrderThread.
+func (mr *MockIOrderUsecaseMockRecorder) WatchOrderThread(orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchOrderThread", reflect.TypeOf((*MockIOrderUsecase)(nil).WatchOrderThread), orderID)
+}
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"time"
+
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type OrderEventKind int32
+
+const (
+	OrderlineStatusEvent OrderEventKind = iota
+	OrderlineDeletedEvent
+	OrderDeletedEvent
+)
+
+// Live update of the order for the streams watching it
+type OrderEvent struct {
+	Kind       OrderEventKind  `json:"kind"`
+	OrderID    uuid.UUID       `json:"order_id"`
+	ProductID  uuid.UUID       `json:"product_id"`
+	Status     OrderlineStatus `json:"status"`
+	OccurredAt time.Time       `json:"occurred_at"`
+}
+
+func NewOrderlineStatusEvent(orderline *Orderline) *OrderEvent {
+	return &OrderEvent{
+		Kind:       OrderlineStatusEvent,
+		OrderID:    orderline.OrderID,
+		ProductID:  orderline.ProductID,
+		Status:     orderline.Status,
+		OccurredAt: orderline.UpdatedAt,
+	}
+}
+
+func (event *OrderEvent) ToProto() *pbOrder.OrderEvent {
+	productID := ""
+	if event.ProductID != uuid.Nil {
+		productID = event.ProductID.String()
+	}
+
+	return &pbOrder.OrderEvent{
+		Kind:       pbOrder.OrderEventKind(event.Kind),
+		OrderId:    event.OrderID.String(),
+		ProductId:  productID,
+		Status:     pbOrder.OrderlineStatus(event.Status),
+		OccurredAt: timestamppb.New(event.OccurredAt),
+	}
+}
@@ -0,0 +1,191 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type InvoiceFormat int32
+
+const (
+	HTMLInvoice InvoiceFormat = iota
+	PDFInvoice
+)
+
+func (format InvoiceFormat) ContentType() string {
+	if format == PDFInvoice {
+		return "application/pdf"
+	}
+
+	return "text/html; charset=utf-8"
+}
+
+func (format InvoiceFormat) Extension() string {
+	if format == PDFInvoice {
+		return "pdf"
+	}
+
+	return "html"
+}
+
+// Represents the invoice of the seller part of an order. The buyer, the lines and the promo
+// discount share are stored when the invoice is issued, so the invoice stays the same when
+// the order changes or is deleted. Invoices issued before that have no stored lines
+type Invoice struct {
+	ID         uuid.UUID `json:"invoice_id"`
+	Number     string    `json:"number"`
+	OrderID    uuid.UUID `json:"order_id"`
+	SellerID   uuid.UUID `json:"seller_id"`
+	SellerName string    `json:"seller_name"`
+	IssuedAt   time.Time `json:"issued_at"`
+
+	// Buyer of the order, who can get the invoice together with the seller
+	UserID          uuid.UUID `json:"user_id"`
+	BuyerName       string    `json:"buyer_name"`
+	BuyerEmail      string    `json:"buyer_email"`
+	ShippingAddress string    `json:"shipping_address"`
+	OrderedAt       time.Time `json:"ordered_at"`
+	// Currency of the seller lines, the amounts of the invoice are in it
+	Currency string `json:"currency"`
+	// Share of the order promo discount that falls on the seller lines
+	PromoDiscount int64 `json:"promo_discount"`
+
+	Lines []*InvoiceLine `json:"lines"`
+}
+
+// Formats the invoice number from the sequential number of the seller invoice
+func InvoiceNumber(sellerID uuid.UUID, number int64) string {
+	return fmt.Sprintf("INV-%s-%06d", strings.ToUpper(sellerID.String()[:8]), number)
+}
+
+// Fills the buyer and the lines of the seller from the order, the promo discount
+// of the order is split between the lines in proportion to their totals in the order
+// currency and converted back to the seller currency
+func (invoice *Invoice) FillFromOrder(order *Order) {
+	invoice.UserID = order.UserID
+	invoice.BuyerName = order.BuyerName
+	invoice.BuyerEmail = order.BuyerEmail
+	invoice.ShippingAddress = order.ShippingAddress
+	invoice.OrderedAt = order.CreatedAt
+	invoice.Currency = order.Currency
+	invoice.PromoDiscount = 0
+	invoice.Lines = make([]*InvoiceLine, 0)
+
+	var orderTotal int64
+	for _, orderline := range order.Orderlines {
+		orderTotal += orderline.BuyerAmount(orderline.TaxableAmount())
+	}
+
+	for _, orderline := range order.Orderlines {
+		if orderline.SellerID != invoice.SellerID {
+			continue
+		}
+
+		if orderline.Currency != "" {
+			invoice.Currency = orderline.Currency
+		}
+
+		if orderTotal > 0 && order.PromoDiscount > 0 {
+			share := order.PromoDiscount * orderline.BuyerAmount(orderline.TaxableAmount()) / orderTotal
+			invoice.PromoDiscount += orderline.SellerAmount(share)
+		}
+
+		invoice.Lines = append(invoice.Lines, &InvoiceLine{
+			ProductID:       orderline.ProductID,
+			Name:            orderline.Name,
+			Quantity:        orderline.Quantity,
+			UnitPrice:       orderline.Price,
+			DiscountPercent: orderline.DiscountPercent,
+			Discount:        orderline.DiscountAmount(),
+			TaxRate:         orderline.TaxRate,
+			Tax:             orderline.Tax,
+		})
+	}
+}
+
+func (invoice *Invoice) Subtotal() int64 {
+	var subtotal int64
+	for _, line := range invoice.Lines {
+		subtotal += line.Subtotal()
+	}
+
+	return subtotal
+}
+
+// Sums the product discounts of the lines and the promo discount share
+func (invoice *Invoice) DiscountTotal() int64 {
+	discount := invoice.PromoDiscount
+	for _, line := range invoice.Lines {
+		discount += line.Discount
+	}
+
+	return discount
+}
+
+func (invoice *Invoice) TaxTotal() int64 {
+	var tax int64
+	for _, line := range invoice.Lines {
+		tax += line.Tax
+	}
+
+	return tax
+}
+
+func (invoice *Invoice) Total() int64 {
+	return invoice.Subtotal() - invoice.DiscountTotal() + invoice.TaxTotal()
+}
+
+func (invoice *Invoice) FileName(format InvoiceFormat) string {
+	return fmt.Sprintf("%s.%s", invoice.Number, format.Extension())
+}
+
+type InvoiceLine struct {
+	ProductID       uuid.UUID `json:"product_id"`
+	Name            string    `json:"name"`
+	Quantity        int64     `json:"quantity"`
+	UnitPrice       int64     `json:"unit_price"`
+	DiscountPercent float32   `json:"discount_percent"`
+	Discount        int64     `json:"discount"`
+	TaxRate         float32   `json:"tax_rate"`
+	Tax             int64     `json:"tax"`
+}
+
+func (line *InvoiceLine) Subtotal() int64 {
+	return line.UnitPrice * line.Quantity
+}
+
+func (line *InvoiceLine) Total() int64 {
+	return line.Subtotal() - line.Discount + line.Tax
+}
+
+// Represents the invoice rendered to one of the formats
+type InvoiceDocument struct {
+	Invoice *Invoice
+	Format  InvoiceFormat
+	Content []byte
+}
+
+func (document *InvoiceDocument) ToProto() *pbOrder.InvoiceResponse {
+	invoice := document.Invoice
+
+	return &pbOrder.InvoiceResponse{
+		InvoiceId:   invoice.ID.String(),
+		Number:      invoice.Number,
+		OrderId:     invoice.OrderID.String(),
+		SellerId:    invoice.SellerID.String(),
+		IssuedAt:    timestamppb.New(invoice.IssuedAt),
+		Subtotal:    invoice.Subtotal(),
+		Discount:    invoice.DiscountTotal(),
+		Tax:         invoice.TaxTotal(),
+		Total:       invoice.Total(),
+		Format:      pbOrder.InvoiceFormat(document.Format),
+		ContentType: document.Format.ContentType(),
+		FileName:    invoice.FileName(document.Format),
+		Content:     document.Content,
+	}
+}
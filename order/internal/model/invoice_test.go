@@ -0,0 +1,95 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Go-Marketplace/backend/order/internal/model"
+)
+
+func TestFillInvoiceFromOrder(t *testing.T) {
+	t.Parallel()
+
+	sellerID := uuid.New()
+	otherSellerID := uuid.New()
+
+	order := &model.Order{
+		BuyerName:       "John Doe",
+		ShippingAddress: "Main street 1",
+		PromoDiscount:   100,
+		Orderlines: []*model.Orderline{
+			{Name: "Fish", Price: 100, Quantity: 2, SellerID: sellerID, DiscountPercent: 10},
+			{Name: "Meat", Price: 200, Quantity: 1, SellerID: sellerID},
+			{Name: "Milk", Price: 420, Quantity: 1, SellerID: otherSellerID, TaxRate: 20, Tax: 84},
+		},
+	}
+
+	testcases := []struct {
+		name                  string
+		sellerID              uuid.UUID
+		expectedLines         int
+		expectedSubtotal      int64
+		expectedPromoDiscount int64
+		expectedTax           int64
+		expectedTotal         int64
+	}{
+		{
+			name:                  "Seller with two lines gets a share of the promo discount",
+			sellerID:              sellerID,
+			expectedLines:         2,
+			expectedSubtotal:      400,
+			expectedPromoDiscount: 47,
+			expectedTax:           0,
+			expectedTotal:         333,
+		},
+		{
+			name:                  "Other seller gets the rest of the promo discount",
+			sellerID:              otherSellerID,
+			expectedLines:         1,
+			expectedSubtotal:      420,
+			expectedPromoDiscount: 52,
+			expectedTax:           84,
+			expectedTotal:         452,
+		},
+		{
+			name:                  "Seller without lines",
+			sellerID:              uuid.New(),
+			expectedLines:         0,
+			expectedSubtotal:      0,
+			expectedPromoDiscount: 0,
+			expectedTax:           0,
+			expectedTotal:         0,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			invoice := &model.Invoice{
+				SellerID: testcase.sellerID,
+			}
+			invoice.FillFromOrder(order)
+
+			assert.Equal(t, order.BuyerName, invoice.BuyerName)
+			assert.Equal(t, order.ShippingAddress, invoice.ShippingAddress)
+			assert.Len(t, invoice.Lines, testcase.expectedLines)
+			assert.Equal(t, testcase.expectedSubtotal, invoice.Subtotal())
+			assert.Equal(t, testcase.expectedPromoDiscount, invoice.PromoDiscount)
+			assert.Equal(t, testcase.expectedTax, invoice.TaxTotal())
+			assert.Equal(t, testcase.expectedTotal, invoice.Total())
+		})
+	}
+}
+
+func TestInvoiceNumber(t *testing.T) {
+	t.Parallel()
+
+	sellerID := uuid.MustParse("efb5b1a0-2222-4106-a2bc-577bd4b287d1")
+
+	assert.Equal(t, "INV-EFB5B1A0-000042", model.InvoiceNumber(sellerID, 42))
+}
@@ -0,0 +1,273 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrUnbalancedTransaction = errors.New("ledger transaction debits and credits are not equal")
+	ErrNothingToPayOut       = errors.New("seller has no balance to pay out")
+	ErrReceivedOrderline     = errors.New("received orderline is credited to the seller, cancel it before deletion")
+)
+
+type LedgerKind int32
+
+const (
+	SaleTransaction LedgerKind = iota
+	RefundTransaction
+	PayoutTransaction
+)
+
+// Accounts of the platform, the buyer payments are debited to the clearing account
+// and the payouts are credited to the payout account
+const (
+	ClearingAccount   = "platform:clearing"
+	CommissionAccount = "platform:commission"
+	TaxAccount        = "platform:tax"
+	PayoutAccount     = "platform:payout"
+)
+
+// Returns the account of the seller, its balance is what the platform owes the seller
+func SellerAccount(sellerID uuid.UUID) string {
+	return "seller:" + sellerID.String()
+}
+
+// Represents one side of a ledger transaction on an account, either the debit or the credit is set.
+// The transaction fields are filled when the entries of an account are listed
+type LedgerEntry struct {
+	ID            uuid.UUID  `json:"entry_id"`
+	TransactionID uuid.UUID  `json:"transaction_id"`
+	Account       string     `json:"account"`
+	Debit         int64      `json:"debit"`
+	Credit        int64      `json:"credit"`
+	Kind          LedgerKind `json:"kind"`
+	SellerID      uuid.UUID  `json:"seller_id"`
+	OrderID       uuid.UUID  `json:"order_id"`
+	ProductID     uuid.UUID  `json:"product_id"`
+	Currency      string     `json:"currency"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+func (entry *LedgerEntry) ToProto() *pbOrder.LedgerEntryResponse {
+	return &pbOrder.LedgerEntryResponse{
+		EntryId:       entry.ID.String(),
+		TransactionId: entry.TransactionID.String(),
+		Kind:          pbOrder.LedgerTransactionKind(entry.Kind),
+		OrderId:       entry.OrderID.String(),
+		ProductId:     entry.ProductID.String(),
+		Currency:      entry.Currency,
+		Debit:         entry.Debit,
+		Credit:        entry.Credit,
+		CreatedAt:     timestamppb.New(entry.CreatedAt),
+	}
+}
+
+// Represents how the double-entry transaction is stored in the database, the sale and
+// the refund belong to an orderline and the payout has nil order and product ids
+type LedgerTransaction struct {
+	ID        uuid.UUID      `json:"transaction_id"`
+	Kind      LedgerKind     `json:"kind"`
+	SellerID  uuid.UUID      `json:"seller_id"`
+	OrderID   uuid.UUID      `json:"order_id"`
+	ProductID uuid.UUID      `json:"product_id"`
+	Currency  string         `json:"currency"`
+	CreatedAt time.Time      `json:"created_at"`
+	Entries   []*LedgerEntry `json:"entries"`
+}
+
+// Adds the entry unless its amount is zero
+func (transaction *LedgerTransaction) addEntry(account string, debit, credit int64) {
+	if debit == 0 && credit == 0 {
+		return
+	}
+
+	transaction.Entries = append(transaction.Entries, &LedgerEntry{
+		ID:            uuid.New(),
+		TransactionID: transaction.ID,
+		Account:       account,
+		Debit:         debit,
+		Credit:        credit,
+		Kind:          transaction.Kind,
+		SellerID:      transaction.SellerID,
+		OrderID:       transaction.OrderID,
+		ProductID:     transaction.ProductID,
+		Currency:      transaction.Currency,
+		CreatedAt:     transaction.CreatedAt,
+	})
+}
+
+// Checks that the transaction has both sides and its debits equal its credits
+func (transaction *LedgerTransaction) Validate() error {
+	if len(transaction.Entries) < 2 {
+		return fmt.Errorf("ledger transaction has %d entries, at least 2 are required", len(transaction.Entries))
+	}
+
+	var debit, credit int64
+	for _, entry := range transaction.Entries {
+		if entry.Debit < 0 || entry.Credit < 0 || (entry.Debit != 0 && entry.Credit != 0) {
+			return fmt.Errorf("invalid ledger entry of account %s", entry.Account)
+		}
+
+		debit += entry.Debit
+		credit += entry.Credit
+	}
+
+	if debit != credit {
+		return ErrUnbalancedTransaction
+	}
+
+	return nil
+}
+
+// Returns the amount the transaction credits to the account minus the amount it debits
+func (transaction *LedgerTransaction) Amount(account string) int64 {
+	var amount int64
+	for _, entry := range transaction.Entries {
+		if entry.Account == account {
+			amount += entry.Credit - entry.Debit
+		}
+	}
+
+	return amount
+}
+
+// Credits the seller with the discounted line total minus the commission. The buyer
+// payment of the line with the tax is debited to the clearing account, the promo
+// discount is paid by the platform and does not lower the seller share
+func NewSaleTransaction(orderline *Orderline, commission int64, createdAt time.Time) *LedgerTransaction {
+	transaction := &LedgerTransaction{
+		ID:        uuid.New(),
+		Kind:      SaleTransaction,
+		SellerID:  orderline.SellerID,
+		OrderID:   orderline.OrderID,
+		ProductID: orderline.ProductID,
+		Currency:  orderline.Currency,
+		CreatedAt: createdAt,
+	}
+
+	transaction.addEntry(ClearingAccount, orderline.Total(), 0)
+	transaction.addEntry(SellerAccount(orderline.SellerID), 0, orderline.TaxableAmount()-commission)
+	transaction.addEntry(CommissionAccount, 0, commission)
+	transaction.addEntry(TaxAccount, 0, orderline.Tax)
+
+	return transaction
+}
+
+// Returns the refund of the sale, it reverses every entry of the sale
+func (transaction *LedgerTransaction) Refund(createdAt time.Time) *LedgerTransaction {
+	refund := &LedgerTransaction{
+		ID:        uuid.New(),
+		Kind:      RefundTransaction,
+		SellerID:  transaction.SellerID,
+		OrderID:   transaction.OrderID,
+		ProductID: transaction.ProductID,
+		Currency:  transaction.Currency,
+		CreatedAt: createdAt,
+	}
+
+	for _, entry := range transaction.Entries {
+		refund.addEntry(entry.Account, entry.Credit, entry.Debit)
+	}
+
+	return refund
+}
+
+// Debits the seller account with the amount paid out to the seller
+func NewPayoutTransaction(sellerID uuid.UUID, currency string, amount int64, createdAt time.Time) *LedgerTransaction {
+	transaction := &LedgerTransaction{
+		ID:        uuid.New(),
+		Kind:      PayoutTransaction,
+		SellerID:  sellerID,
+		Currency:  currency,
+		CreatedAt: createdAt,
+	}
+
+	transaction.addEntry(SellerAccount(sellerID), amount, 0)
+	transaction.addEntry(PayoutAccount, 0, amount)
+
+	return transaction
+}
+
+func (transaction *LedgerTransaction) ToPayoutProto() *pbOrder.PayoutResponse {
+	return &pbOrder.PayoutResponse{
+		TransactionId: transaction.ID.String(),
+		SellerId:      transaction.SellerID.String(),
+		Currency:      transaction.Currency,
+		Amount:        -transaction.Amount(SellerAccount(transaction.SellerID)),
+		CreatedAt:     timestamppb.New(transaction.CreatedAt),
+	}
+}
+
+// Balance of an account in one currency, the credits minus the debits
+type Balance struct {
+	Currency string `json:"currency"`
+	Amount   int64  `json:"amount"`
+}
+
+func (balance *Balance) ToProto() *pbOrder.BalanceResponse {
+	return &pbOrder.BalanceResponse{
+		Currency: balance.Currency,
+		Amount:   balance.Amount,
+	}
+}
+
+// Commission rate of the platform in percent for a category
+type CategoryCommission struct {
+	CategoryID int32
+	Rate       float32
+}
+
+// Takes the commission by the rate of the line category or the default rate
+type CommissionRates struct {
+	defaultRate float32
+	categories  map[int32]float32
+}
+
+func NewCommissionRates(defaultRate float32, categories []CategoryCommission) (*CommissionRates, error) {
+	if defaultRate < 0 || defaultRate > 100 {
+		return nil, fmt.Errorf("invalid default commission rate: %v", defaultRate)
+	}
+
+	rates := &CommissionRates{
+		defaultRate: defaultRate,
+		categories:  make(map[int32]float32, len(categories)),
+	}
+
+	for _, category := range categories {
+		if category.Rate < 0 || category.Rate > 100 {
+			return nil, fmt.Errorf("invalid commission rate of category %d: %v", category.CategoryID, category.Rate)
+		}
+
+		if _, ok := rates.categories[category.CategoryID]; ok {
+			return nil, fmt.Errorf("duplicate commission rate of category %d", category.CategoryID)
+		}
+
+		rates.categories[category.CategoryID] = category.Rate
+	}
+
+	return rates, nil
+}
+
+func (rates *CommissionRates) Rate(categoryID int32) float32 {
+	if rate, ok := rates.categories[categoryID]; ok {
+		return rate
+	}
+
+	return rates.defaultRate
+}
+
+// Returns the commission of the amount rounded to the nearest minor unit
+func (rates *CommissionRates) Commission(categoryID int32, amount int64) int64 {
+	if amount <= 0 {
+		return 0
+	}
+
+	return int64(math.Round(float64(amount) * float64(rates.Rate(categoryID)) / 100))
+}
@@ -0,0 +1,179 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Go-Marketplace/backend/order/internal/model"
+)
+
+func TestNewSaleTransaction(t *testing.T) {
+	t.Parallel()
+
+	sellerID := uuid.New()
+	sellerAccount := model.SellerAccount(sellerID)
+	now := time.Now()
+
+	testcases := []struct {
+		name               string
+		orderline          *model.Orderline
+		commission         int64
+		expectedSeller     int64
+		expectedCommission int64
+		expectedTax        int64
+		expectedClearing   int64
+		expectedEntries    int
+	}{
+		{
+			name:               "Seller gets the discounted total minus the commission",
+			orderline:          &model.Orderline{SellerID: sellerID, Price: 1000, Quantity: 2, DiscountPercent: 10, Tax: 360},
+			commission:         180,
+			expectedSeller:     1620,
+			expectedCommission: 180,
+			expectedTax:        360,
+			expectedClearing:   -2160,
+			expectedEntries:    4,
+		},
+		{
+			name:               "Zero commission and tax have no entries",
+			orderline:          &model.Orderline{SellerID: sellerID, Price: 500, Quantity: 1},
+			commission:         0,
+			expectedSeller:     500,
+			expectedCommission: 0,
+			expectedTax:        0,
+			expectedClearing:   -500,
+			expectedEntries:    2,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			sale := model.NewSaleTransaction(testcase.orderline, testcase.commission, now)
+
+			assert.NoError(t, sale.Validate())
+			assert.Equal(t, model.SaleTransaction, sale.Kind)
+			assert.Len(t, sale.Entries, testcase.expectedEntries)
+			assert.Equal(t, testcase.expectedSeller, sale.Amount(sellerAccount))
+			assert.Equal(t, testcase.expectedCommission, sale.Amount(model.CommissionAccount))
+			assert.Equal(t, testcase.expectedTax, sale.Amount(model.TaxAccount))
+			assert.Equal(t, testcase.expectedClearing, sale.Amount(model.ClearingAccount))
+
+			refund := sale.Refund(now)
+
+			assert.NoError(t, refund.Validate())
+			assert.Equal(t, model.RefundTransaction, refund.Kind)
+			assert.Equal(t, sale.OrderID, refund.OrderID)
+			assert.Equal(t, -testcase.expectedSeller, refund.Amount(sellerAccount))
+			assert.Equal(t, -testcase.expectedCommission, refund.Amount(model.CommissionAccount))
+			assert.Equal(t, -testcase.expectedTax, refund.Amount(model.TaxAccount))
+			assert.Equal(t, -testcase.expectedClearing, refund.Amount(model.ClearingAccount))
+		})
+	}
+}
+
+func TestNewPayoutTransaction(t *testing.T) {
+	t.Parallel()
+
+	sellerID := uuid.New()
+
+	payout := model.NewPayoutTransaction(sellerID, "RUB", 1500, time.Now())
+
+	assert.NoError(t, payout.Validate())
+	assert.Equal(t, int64(-1500), payout.Amount(model.SellerAccount(sellerID)))
+	assert.Equal(t, int64(1500), payout.Amount(model.PayoutAccount))
+	assert.Equal(t, int64(1500), payout.ToPayoutProto().Amount)
+	assert.Equal(t, uuid.Nil, payout.OrderID)
+}
+
+func TestValidateLedgerTransaction(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		transaction *model.LedgerTransaction
+		wasError    bool
+	}{
+		{
+			name: "Balanced transaction",
+			transaction: &model.LedgerTransaction{
+				Entries: []*model.LedgerEntry{
+					{Account: model.ClearingAccount, Debit: 100},
+					{Account: model.TaxAccount, Credit: 20},
+					{Account: model.CommissionAccount, Credit: 80},
+				},
+			},
+			wasError: false,
+		},
+		{
+			name: "Unbalanced transaction",
+			transaction: &model.LedgerTransaction{
+				Entries: []*model.LedgerEntry{
+					{Account: model.ClearingAccount, Debit: 100},
+					{Account: model.TaxAccount, Credit: 20},
+				},
+			},
+			wasError: true,
+		},
+		{
+			name: "Entry with both debit and credit",
+			transaction: &model.LedgerTransaction{
+				Entries: []*model.LedgerEntry{
+					{Account: model.ClearingAccount, Debit: 100, Credit: 100},
+					{Account: model.TaxAccount, Debit: 20, Credit: 20},
+				},
+			},
+			wasError: true,
+		},
+		{
+			name: "Single entry",
+			transaction: &model.LedgerTransaction{
+				Entries: []*model.LedgerEntry{
+					{Account: model.ClearingAccount},
+				},
+			},
+			wasError: true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testcase.wasError, testcase.transaction.Validate() != nil)
+		})
+	}
+}
+
+func TestCommissionRates(t *testing.T) {
+	t.Parallel()
+
+	rates, err := model.NewCommissionRates(10, []model.CategoryCommission{
+		{CategoryID: 3, Rate: 15},
+		{CategoryID: 4, Rate: 0},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, int64(150), rates.Commission(3, 1000))
+	assert.Equal(t, int64(0), rates.Commission(4, 1000))
+	assert.Equal(t, int64(100), rates.Commission(1, 1000))
+	assert.Equal(t, int64(11), rates.Commission(1, 105))
+	assert.Equal(t, int64(0), rates.Commission(1, 0))
+
+	_, err = model.NewCommissionRates(101, nil)
+	assert.Error(t, err)
+
+	_, err = model.NewCommissionRates(10, []model.CategoryCommission{{CategoryID: 3, Rate: -1}})
+	assert.Error(t, err)
+
+	_, err = model.NewCommissionRates(10, []model.CategoryCommission{{CategoryID: 3, Rate: 5}, {CategoryID: 3, Rate: 7}})
+	assert.Error(t, err)
+}
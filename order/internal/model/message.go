@@ -0,0 +1,175 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrEmptyMessage      = errors.New("message has neither body nor attachments")
+	ErrForeignAttachment = errors.New("attachment is not a file of the media store")
+)
+
+type AuthorRole int32
+
+const (
+	BuyerAuthor AuthorRole = iota
+	SellerAuthor
+	AdminAuthor
+)
+
+// Returns the role of the user in the order thread, false when the user
+// is neither the buyer nor a seller of the order
+func (order *Order) ParticipantRole(userID uuid.UUID) (AuthorRole, bool) {
+	if userID == order.UserID {
+		return BuyerAuthor, true
+	}
+
+	for _, orderline := range order.Orderlines {
+		if orderline.SellerID == userID {
+			return SellerAuthor, true
+		}
+	}
+
+	return 0, false
+}
+
+// Represents how the message of the order thread is stored in the database,
+// the attachments are the URLs of the files in the media store
+type OrderMessage struct {
+	ID          uuid.UUID  `json:"message_id"`
+	OrderID     uuid.UUID  `json:"order_id"`
+	AuthorID    uuid.UUID  `json:"author_id"`
+	AuthorRole  AuthorRole `json:"author_role" validate:"min=0,max=2"`
+	Body        string     `json:"body" validate:"max=4000"`
+	Attachments []string   `json:"attachments" validate:"max=5,dive,url,max=512"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+func (message *OrderMessage) Validate() error {
+	if strings.TrimSpace(message.Body) == "" && len(message.Attachments) == 0 {
+		return ErrEmptyMessage
+	}
+
+	validate := validator.New()
+	return validate.Struct(message)
+}
+
+// Checks that every attachment is a file under the base URL of the media store, so messages
+// cannot link to other sites. The path is cleaned first, so dot segments cannot leave the base path
+func (message *OrderMessage) CheckAttachments(mediaBaseURL string) error {
+	if len(message.Attachments) == 0 {
+		return nil
+	}
+
+	base, err := url.Parse(mediaBaseURL)
+	if err != nil || base.Host == "" {
+		return fmt.Errorf("%w: media store is not configured", ErrForeignAttachment)
+	}
+	basePath := strings.TrimSuffix(base.Path, "/") + "/"
+
+	for _, attachment := range message.Attachments {
+		attachmentURL, err := url.Parse(attachment)
+		if err != nil ||
+			attachmentURL.Scheme != base.Scheme ||
+			!strings.EqualFold(attachmentURL.Host, base.Host) ||
+			attachmentURL.User != nil ||
+			!strings.HasPrefix(path.Clean(attachmentURL.Path), basePath) {
+			return fmt.Errorf("%w: %s", ErrForeignAttachment, attachment)
+		}
+	}
+
+	return nil
+}
+
+// Returns the message with the other participants whose receipts show they have read it
+func (message *OrderMessage) ToProto(receipts []*ReadReceipt) *pbOrder.OrderMessageResponse {
+	readBy := make([]string, 0)
+	for _, receipt := range receipts {
+		if receipt.UserID != message.AuthorID && !receipt.ReadAt.Before(message.CreatedAt) {
+			readBy = append(readBy, receipt.UserID.String())
+		}
+	}
+
+	attachments := message.Attachments
+	if attachments == nil {
+		attachments = make([]string, 0)
+	}
+
+	return &pbOrder.OrderMessageResponse{
+		MessageId:   message.ID.String(),
+		OrderId:     message.OrderID.String(),
+		AuthorId:    message.AuthorID.String(),
+		AuthorRole:  pbOrder.MessageAuthorRole(message.AuthorRole),
+		Body:        message.Body,
+		Attachments: attachments,
+		CreatedAt:   timestamppb.New(message.CreatedAt),
+		ReadBy:      readBy,
+	}
+}
+
+// Marks that the participant has read every message of the thread sent until ReadAt
+type ReadReceipt struct {
+	OrderID uuid.UUID `json:"order_id"`
+	UserID  uuid.UUID `json:"user_id"`
+	ReadAt  time.Time `json:"read_at"`
+}
+
+func (receipt *ReadReceipt) ToProto() *pbOrder.ReadReceiptResponse {
+	return &pbOrder.ReadReceiptResponse{
+		OrderId: receipt.OrderID.String(),
+		UserId:  receipt.UserID.String(),
+		ReadAt:  timestamppb.New(receipt.ReadAt),
+	}
+}
+
+type OrderThread struct {
+	OrderID  uuid.UUID       `json:"order_id"`
+	Messages []*OrderMessage `json:"messages"`
+	Receipts []*ReadReceipt  `json:"receipts"`
+}
+
+func (thread *OrderThread) ToProto() *pbOrder.OrderThreadResponse {
+	messages := make([]*pbOrder.OrderMessageResponse, 0, len(thread.Messages))
+	for _, message := range thread.Messages {
+		messages = append(messages, message.ToProto(thread.Receipts))
+	}
+
+	receipts := make([]*pbOrder.ReadReceiptResponse, 0, len(thread.Receipts))
+	for _, receipt := range thread.Receipts {
+		receipts = append(receipts, receipt.ToProto())
+	}
+
+	return &pbOrder.OrderThreadResponse{
+		OrderId:  thread.OrderID.String(),
+		Messages: messages,
+		Receipts: receipts,
+	}
+}
+
+// Live update of the order thread, either a new message or a new read receipt
+type ThreadEvent struct {
+	Message *OrderMessage
+	Receipt *ReadReceipt
+}
+
+func (event *ThreadEvent) ToProto() *pbOrder.OrderThreadEvent {
+	if event.Receipt != nil {
+		return &pbOrder.OrderThreadEvent{
+			Event: &pbOrder.OrderThreadEvent_Receipt{Receipt: event.Receipt.ToProto()},
+		}
+	}
+
+	return &pbOrder.OrderThreadEvent{
+		Event: &pbOrder.OrderThreadEvent_Message{Message: event.Message.ToProto(nil)},
+	}
+}
@@ -0,0 +1,193 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Go-Marketplace/backend/order/internal/model"
+)
+
+func TestValidateOrderMessage(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		message  *model.OrderMessage
+		wasError bool
+	}{
+		{
+			name:     "Message with body",
+			message:  &model.OrderMessage{Body: "Where is my order?"},
+			wasError: false,
+		},
+		{
+			name:     "Message with attachment only",
+			message:  &model.OrderMessage{Attachments: []string{"https://media.example.com/photo.jpg"}},
+			wasError: false,
+		},
+		{
+			name:     "Empty message",
+			message:  &model.OrderMessage{Body: "  "},
+			wasError: true,
+		},
+		{
+			name:     "Too long body",
+			message:  &model.OrderMessage{Body: strings.Repeat("a", 4001)},
+			wasError: true,
+		},
+		{
+			name:     "Invalid attachment url",
+			message:  &model.OrderMessage{Body: "Photo", Attachments: []string{"photo.jpg"}},
+			wasError: true,
+		},
+		{
+			name: "Too many attachments",
+			message: &model.OrderMessage{
+				Body: "Photos",
+				Attachments: []string{
+					"https://media.example.com/1.jpg",
+					"https://media.example.com/2.jpg",
+					"https://media.example.com/3.jpg",
+					"https://media.example.com/4.jpg",
+					"https://media.example.com/5.jpg",
+					"https://media.example.com/6.jpg",
+				},
+			},
+			wasError: true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testcase.wasError, testcase.message.Validate() != nil)
+		})
+	}
+}
+
+func TestCheckMessageAttachments(t *testing.T) {
+	t.Parallel()
+
+	mediaBaseURL := "https://media.example.com/orders"
+
+	testcases := []struct {
+		name         string
+		attachments  []string
+		mediaBaseURL string
+		wasError     bool
+	}{
+		{
+			name:         "Attachment in the media store",
+			attachments:  []string{"https://media.example.com/orders/photo.jpg"},
+			mediaBaseURL: mediaBaseURL,
+			wasError:     false,
+		},
+		{
+			name:         "Message without attachments needs no media store",
+			attachments:  nil,
+			mediaBaseURL: "",
+			wasError:     false,
+		},
+		{
+			name:         "Attachment on another host",
+			attachments:  []string{"https://media.example.com.evil.com/orders/photo.jpg"},
+			mediaBaseURL: mediaBaseURL,
+			wasError:     true,
+		},
+		{
+			name:         "Attachment with another scheme",
+			attachments:  []string{"http://media.example.com/orders/photo.jpg"},
+			mediaBaseURL: mediaBaseURL,
+			wasError:     true,
+		},
+		{
+			name:         "Attachment outside of the base path",
+			attachments:  []string{"https://media.example.com/orders-private/photo.jpg"},
+			mediaBaseURL: mediaBaseURL,
+			wasError:     true,
+		},
+		{
+			name:         "Attachment leaving the base path with dot segments",
+			attachments:  []string{"https://media.example.com/orders/../admin/photo.jpg"},
+			mediaBaseURL: mediaBaseURL,
+			wasError:     true,
+		},
+		{
+			name:         "Media store is not configured",
+			attachments:  []string{"https://media.example.com/orders/photo.jpg"},
+			mediaBaseURL: "",
+			wasError:     true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			message := &model.OrderMessage{Attachments: testcase.attachments}
+			assert.Equal(t, testcase.wasError, message.CheckAttachments(testcase.mediaBaseURL) != nil)
+		})
+	}
+}
+
+func TestParticipantRole(t *testing.T) {
+	t.Parallel()
+
+	buyerID := uuid.New()
+	sellerID := uuid.New()
+
+	order := &model.Order{
+		UserID: buyerID,
+		Orderlines: []*model.Orderline{
+			{SellerID: uuid.New()},
+			{SellerID: sellerID},
+		},
+	}
+
+	role, ok := order.ParticipantRole(buyerID)
+	assert.True(t, ok)
+	assert.Equal(t, model.BuyerAuthor, role)
+
+	role, ok = order.ParticipantRole(sellerID)
+	assert.True(t, ok)
+	assert.Equal(t, model.SellerAuthor, role)
+
+	_, ok = order.ParticipantRole(uuid.New())
+	assert.False(t, ok)
+}
+
+func TestOrderMessageReadBy(t *testing.T) {
+	t.Parallel()
+
+	authorID := uuid.New()
+	readerID := uuid.New()
+	laggardID := uuid.New()
+	sentAt := time.Now()
+
+	message := &model.OrderMessage{
+		ID:        uuid.New(),
+		AuthorID:  authorID,
+		Body:      "Hello",
+		CreatedAt: sentAt,
+	}
+
+	receipts := []*model.ReadReceipt{
+		{UserID: authorID, ReadAt: sentAt.Add(time.Minute)},
+		{UserID: readerID, ReadAt: sentAt},
+		{UserID: laggardID, ReadAt: sentAt.Add(-time.Minute)},
+	}
+
+	resp := message.ToProto(receipts)
+
+	assert.Equal(t, []string{readerID.String()}, resp.ReadBy)
+	assert.Equal(t, []string{}, resp.Attachments)
+}
@@ -3,6 +3,7 @@ package model
 import (
 	"time"
 
+	"github.com/Go-Marketplace/backend/pkg/money"
 	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
@@ -16,9 +17,58 @@ type Order struct {
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 
+	// Promo code redeemed by the order and the amount it took off
+	PromoCode     string `json:"promo_code"`
+	PromoDiscount int64  `json:"promo_discount"`
+
+	// Buyer and the address the order is shipped to at checkout
+	BuyerName       string `json:"buyer_name"`
+	BuyerEmail      string `json:"buyer_email"`
+	ShippingAddress string `json:"shipping_address" validate:"max=512"`
+	// Region code the taxes of the order were calculated for
+	ShippingRegion string `json:"shipping_region" validate:"max=64"`
+
+	// Currency the buyer pays in, the totals and the promo discount are in it
+	Currency string `json:"currency" validate:"omitempty,iso4217"`
+
+	// Totals at checkout, the discount includes the product discounts and the promo discount
+	Subtotal int64 `json:"subtotal"`
+	Discount int64 `json:"discount"`
+	Tax      int64 `json:"tax"`
+	Total    int64 `json:"total"`
+
 	Orderlines []*Orderline `json:"orderlines"`
 }
 
+func (order *Order) Validate() error {
+	validate := validator.New()
+	return validate.Struct(order)
+}
+
+// Sums the orderlines converted to the order currency into the order totals,
+// the promo discount does not lower the taxes
+func (order *Order) CalculateTotals() {
+	order.Subtotal = 0
+	order.Discount = order.PromoDiscount
+	order.Tax = 0
+	for _, orderline := range order.Orderlines {
+		subtotal := orderline.BuyerAmount(orderline.Subtotal())
+		discount := orderline.BuyerAmount(orderline.DiscountAmount())
+		tax := orderline.BuyerAmount(orderline.Tax)
+
+		orderline.BuyerTotal = subtotal - discount + tax
+
+		order.Subtotal += subtotal
+		order.Discount += discount
+		order.Tax += tax
+	}
+
+	order.Total = order.Subtotal - order.Discount + order.Tax
+	if order.Total < 0 {
+		order.Total = 0
+	}
+}
+
 func (order *Order) ToProto() *pbOrder.OrderResponse {
 	var pbOrderlines []*pbOrder.OrderlineResponse
 	if order.Orderlines != nil {
@@ -31,11 +81,22 @@ func (order *Order) ToProto() *pbOrder.OrderResponse {
 	}
 
 	return &pbOrder.OrderResponse{
-		OrderId:    order.ID.String(),
-		UserId:     order.UserID.String(),
-		Orderlines: pbOrderlines,
-		CreatedAt:  timestamppb.New(order.CreatedAt),
-		UpdatedAt:  timestamppb.New(order.UpdatedAt),
+		OrderId:         order.ID.String(),
+		UserId:          order.UserID.String(),
+		Orderlines:      pbOrderlines,
+		CreatedAt:       timestamppb.New(order.CreatedAt),
+		UpdatedAt:       timestamppb.New(order.UpdatedAt),
+		PromoCode:       order.PromoCode,
+		PromoDiscount:   order.PromoDiscount,
+		BuyerName:       order.BuyerName,
+		BuyerEmail:      order.BuyerEmail,
+		ShippingAddress: order.ShippingAddress,
+		ShippingRegion:  order.ShippingRegion,
+		Subtotal:        order.Subtotal,
+		Discount:        order.Discount,
+		Tax:             order.Tax,
+		Total:           order.Total,
+		Currency:        order.Currency,
 	}
 }
 
@@ -50,14 +111,28 @@ const (
 
 // Represents one line with a product in a order in the database
 type Orderline struct {
-	OrderID   uuid.UUID       `json:"order_id"`
-	ProductID uuid.UUID       `json:"product_id"`
-	Name      string          `json:"name" validate:"max=128"`
-	Price     int64           `json:"price" validate:"min=0,max=1000000000"`
-	Quantity  int64           `json:"quantity" validate:"min=0,max=10000000"`
-	Status    OrderlineStatus `json:"status" validate:"min=0,max=3"`
-	CreatedAt time.Time       `json:"created_at"`
-	UpdatedAt time.Time       `json:"updated_at"`
+	OrderID     uuid.UUID `json:"order_id"`
+	ProductID   uuid.UUID `json:"product_id"`
+	Name        string    `json:"name" validate:"max=128"`
+	Price       int64     `json:"price" validate:"min=0,max=1000000000"`
+	Quantity    int64     `json:"quantity" validate:"min=0,max=10000000"`
+	WarehouseID uuid.UUID `json:"warehouse_id"`
+	SellerID    uuid.UUID `json:"seller_id"`
+	// Category at checkout, the platform commission is taken by it
+	CategoryID int32 `json:"category_id"`
+	// Product discount active at checkout, the price is the one before the discount
+	DiscountPercent float32 `json:"discount_percent" validate:"min=0,max=100"`
+	// Tax rate in percent and the tax added to the discounted line total
+	TaxRate float32 `json:"tax_rate" validate:"min=0,max=100"`
+	Tax     int64   `json:"tax" validate:"min=0"`
+	// Currency of the seller the price and the tax are in, the exchange rate to the
+	// order currency at checkout and the line total after the tax in the order currency
+	Currency     string          `json:"currency" validate:"omitempty,iso4217"`
+	ExchangeRate float64         `json:"exchange_rate" validate:"min=0"`
+	BuyerTotal   int64           `json:"buyer_total"`
+	Status       OrderlineStatus `json:"status" validate:"min=0,max=3"`
+	CreatedAt    time.Time       `json:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at"`
 }
 
 func (orderline *Orderline) Validate() error {
@@ -65,15 +140,60 @@ func (orderline *Orderline) Validate() error {
 	return validate.Struct(orderline)
 }
 
+func (orderline *Orderline) Subtotal() int64 {
+	return orderline.Price * orderline.Quantity
+}
+
+func (orderline *Orderline) DiscountAmount() int64 {
+	return int64(float64(orderline.Subtotal()) * float64(orderline.DiscountPercent) / 100)
+}
+
+// Returns the line total after the product discount, the tax is calculated from it
+func (orderline *Orderline) TaxableAmount() int64 {
+	return orderline.Subtotal() - orderline.DiscountAmount()
+}
+
+func (orderline *Orderline) Total() int64 {
+	return orderline.TaxableAmount() + orderline.Tax
+}
+
+// Converts the amount in the seller currency to the order currency,
+// a line without the exchange rate is already in the order currency
+func (orderline *Orderline) BuyerAmount(amount int64) int64 {
+	if orderline.ExchangeRate == 0 {
+		return amount
+	}
+
+	return money.ConvertAmount(amount, orderline.ExchangeRate)
+}
+
+// Converts the amount in the order currency back to the seller currency
+func (orderline *Orderline) SellerAmount(amount int64) int64 {
+	if orderline.ExchangeRate == 0 {
+		return amount
+	}
+
+	return money.ConvertAmount(amount, 1/orderline.ExchangeRate)
+}
+
 func (orderline *Orderline) ToProto() *pbOrder.OrderlineResponse {
 	return &pbOrder.OrderlineResponse{
-		OrderId:   orderline.OrderID.String(),
-		ProductId: orderline.ProductID.String(),
-		Name:      orderline.Name,
-		Price:     orderline.Price,
-		Quantity:  orderline.Quantity,
-		Status:    pbOrder.OrderlineStatus(orderline.Status),
-		CreatedAt: timestamppb.New(orderline.CreatedAt),
-		UpdatedAt: timestamppb.New(orderline.UpdatedAt),
+		OrderId:         orderline.OrderID.String(),
+		ProductId:       orderline.ProductID.String(),
+		Name:            orderline.Name,
+		Price:           orderline.Price,
+		Quantity:        orderline.Quantity,
+		WarehouseId:     orderline.WarehouseID.String(),
+		SellerId:        orderline.SellerID.String(),
+		DiscountPercent: orderline.DiscountPercent,
+		TaxRate:         orderline.TaxRate,
+		Tax:             orderline.Tax,
+		Currency:        orderline.Currency,
+		ExchangeRate:    orderline.ExchangeRate,
+		BuyerTotal:      orderline.BuyerTotal,
+		CategoryId:      orderline.CategoryID,
+		Status:          pbOrder.OrderlineStatus(orderline.Status),
+		CreatedAt:       timestamppb.New(orderline.CreatedAt),
+		UpdatedAt:       timestamppb.New(orderline.UpdatedAt),
 	}
 }
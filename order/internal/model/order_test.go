@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/Go-Marketplace/backend/order/internal/model"
+	"github.com/Go-Marketplace/backend/pkg/money"
 )
 
 func TestValidateOrderline(t *testing.T) {
@@ -81,3 +82,133 @@ func TestValidateOrderline(t *testing.T) {
 		})
 	}
 }
+
+func TestCalculateOrderTotals(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name             string
+		order            *model.Order
+		expectedSubtotal int64
+		expectedDiscount int64
+		expectedTax      int64
+		expectedTotal    int64
+	}{
+		{
+			name: "Discounts and taxes",
+			order: &model.Order{
+				PromoDiscount: 100,
+				Orderlines: []*model.Orderline{
+					{Price: 1000, Quantity: 2, DiscountPercent: 10, TaxRate: 20, Tax: 360},
+					{Price: 500, Quantity: 1},
+				},
+			},
+			expectedSubtotal: 2500,
+			expectedDiscount: 300,
+			expectedTax:      360,
+			expectedTotal:    2560,
+		},
+		{
+			name: "Lines in other currencies are converted to the order currency",
+			order: &model.Order{
+				Currency:      "USD",
+				PromoDiscount: 10,
+				Orderlines: []*model.Orderline{
+					{Price: 9000, Quantity: 1, Currency: "RUB", ExchangeRate: 1.0 / 90, TaxRate: 20, Tax: 1800},
+					{Price: 100, Quantity: 2, Currency: "EUR", ExchangeRate: 1.1, DiscountPercent: 10},
+				},
+			},
+			expectedSubtotal: 320,
+			expectedDiscount: 32,
+			expectedTax:      20,
+			expectedTotal:    308,
+		},
+		{
+			name: "Total is not negative",
+			order: &model.Order{
+				PromoDiscount: 1000,
+				Orderlines: []*model.Orderline{
+					{Price: 500, Quantity: 1},
+				},
+			},
+			expectedSubtotal: 500,
+			expectedDiscount: 1000,
+			expectedTax:      0,
+			expectedTotal:    0,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			testcase.order.CalculateTotals()
+
+			assert.Equal(t, testcase.expectedSubtotal, testcase.order.Subtotal)
+			assert.Equal(t, testcase.expectedDiscount, testcase.order.Discount)
+			assert.Equal(t, testcase.expectedTax, testcase.order.Tax)
+			assert.Equal(t, testcase.expectedTotal, testcase.order.Total)
+		})
+	}
+}
+
+func TestExchangeRate(t *testing.T) {
+	t.Parallel()
+
+	rates := money.ExchangeRates{
+		"RUB": 1,
+		"USD": 90,
+		"EUR": 99,
+	}
+
+	testcases := []struct {
+		name         string
+		from         string
+		to           string
+		expectedRate float64
+		wasError     bool
+	}{
+		{
+			name:         "To the base currency",
+			from:         "USD",
+			to:           "RUB",
+			expectedRate: 90,
+			wasError:     false,
+		},
+		{
+			name:         "From the base currency",
+			from:         "RUB",
+			to:           "USD",
+			expectedRate: 1.0 / 90,
+			wasError:     false,
+		},
+		{
+			name:         "Between two other currencies",
+			from:         "EUR",
+			to:           "USD",
+			expectedRate: 1.1,
+			wasError:     false,
+		},
+		{
+			name:     "Unknown currency",
+			from:     "GBP",
+			to:       "USD",
+			wasError: true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualRate, actualErr := rates.Rate(testcase.from, testcase.to)
+
+			assert.Equal(t, testcase.wasError, actualErr != nil)
+			assert.InDelta(t, testcase.expectedRate, actualRate, 1e-9)
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrPromoInactive      = errors.New("promo code is not active")
+	ErrPromoMinTotal      = errors.New("order total is less than the promo code minimum")
+	ErrPromoNotApplicable = errors.New("promo code does not apply to any product in the order")
+	ErrPromoUsesExceeded  = errors.New("promo code usage limit is reached")
+	ErrPromoUserLimitUsed = errors.New("promo code usage limit for the user is reached")
+	ErrPromoCodeNotExists = errors.New("promo code does not exist")
+	ErrPromoCodeExists    = errors.New("promo code already exists")
+)
+
+type PromoKind int32
+
+const (
+	PercentPromo PromoKind = iota
+	FixedPromo
+)
+
+// Represents how the promo code is stored in the database.
+// Zero MaxUses and PerUserLimit mean that the code has no such limit,
+// empty CategoryIDs and SellerIDs mean that the code applies to any product
+type PromoCode struct {
+	Code         string      `json:"code" validate:"required,max=32,alphanum"`
+	Kind         PromoKind   `json:"kind" validate:"min=0,max=1"`
+	Value        int64       `json:"value" validate:"min=1,max=1000000000"`
+	MinTotal     int64       `json:"min_total" validate:"min=0,max=1000000000"`
+	MaxUses      int64       `json:"max_uses" validate:"min=0"`
+	PerUserLimit int64       `json:"per_user_limit" validate:"min=0"`
+	CategoryIDs  []int32     `json:"category_ids"`
+	SellerIDs    []uuid.UUID `json:"seller_ids"`
+	StartsAt     time.Time   `json:"starts_at"`
+	EndsAt       time.Time   `json:"ends_at" validate:"gtfield=StartsAt"`
+	CreatedAt    time.Time   `json:"created_at"`
+	Used         int64       `json:"used"`
+}
+
+func (promo *PromoCode) Validate() error {
+	validate := validator.New()
+	if err := validate.Struct(promo); err != nil {
+		return err
+	}
+
+	if promo.Kind == PercentPromo && promo.Value > 100 {
+		return errors.New("percent promo code value must be at most 100")
+	}
+
+	return nil
+}
+
+// Checks whether the promo code can be redeemed at the given time
+func (promo *PromoCode) ActiveAt(at time.Time) bool {
+	return !at.Before(promo.StartsAt) && at.Before(promo.EndsAt)
+}
+
+// Returns true if the product of the given category and seller
+// falls under the promo code restrictions
+func (promo *PromoCode) AppliesTo(categoryID int32, sellerID uuid.UUID) bool {
+	if len(promo.CategoryIDs) != 0 {
+		found := false
+		for _, id := range promo.CategoryIDs {
+			if id == categoryID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if len(promo.SellerIDs) != 0 {
+		for _, id := range promo.SellerIDs {
+			if id == sellerID {
+				return true
+			}
+		}
+		return false
+	}
+
+	return true
+}
+
+// Represents one order line the promo code is applied to
+type PromoLine struct {
+	CategoryID int32
+	SellerID   uuid.UUID
+	Price      int64
+	Quantity   int64
+}
+
+// Calculates the discount the promo code gives for the given lines.
+// Usage limits are not checked here, they are enforced on redemption
+func (promo *PromoCode) Discount(lines []PromoLine, at time.Time) (int64, error) {
+	if !promo.ActiveAt(at) {
+		return 0, ErrPromoInactive
+	}
+
+	var total, eligible int64
+	for _, line := range lines {
+		amount := line.Price * line.Quantity
+		total += amount
+
+		if promo.AppliesTo(line.CategoryID, line.SellerID) {
+			eligible += amount
+		}
+	}
+
+	if total < promo.MinTotal {
+		return 0, ErrPromoMinTotal
+	}
+
+	if eligible == 0 {
+		return 0, ErrPromoNotApplicable
+	}
+
+	if promo.Kind == PercentPromo {
+		return eligible * promo.Value / 100, nil
+	}
+
+	if promo.Value > eligible {
+		return eligible, nil
+	}
+
+	return promo.Value, nil
+}
+
+func (promo *PromoCode) ToProto() *pbOrder.PromoCodeResponse {
+	sellerIDs := make([]string, 0, len(promo.SellerIDs))
+	for _, sellerID := range promo.SellerIDs {
+		sellerIDs = append(sellerIDs, sellerID.String())
+	}
+
+	return &pbOrder.PromoCodeResponse{
+		Code:         promo.Code,
+		Kind:         pbOrder.PromoKind(promo.Kind),
+		Value:        promo.Value,
+		MinTotal:     promo.MinTotal,
+		MaxUses:      promo.MaxUses,
+		PerUserLimit: promo.PerUserLimit,
+		CategoryIds:  promo.CategoryIDs,
+		SellerIds:    sellerIDs,
+		StartsAt:     timestamppb.New(promo.StartsAt),
+		EndsAt:       timestamppb.New(promo.EndsAt),
+		CreatedAt:    timestamppb.New(promo.CreatedAt),
+		Used:         promo.Used,
+	}
+}
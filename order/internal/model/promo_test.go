@@ -0,0 +1,182 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Go-Marketplace/backend/order/internal/model"
+)
+
+func TestValidatePromoCode(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	testcases := []struct {
+		name     string
+		promo    *model.PromoCode
+		wasError bool
+	}{
+		{
+			name: "Promo code is valid",
+			promo: &model.PromoCode{
+				Code:     "SALE10",
+				Kind:     model.PercentPromo,
+				Value:    10,
+				StartsAt: now,
+				EndsAt:   now.Add(time.Hour),
+			},
+			wasError: false,
+		},
+		{
+			name: "Empty code",
+			promo: &model.PromoCode{
+				Kind:     model.FixedPromo,
+				Value:    100,
+				StartsAt: now,
+				EndsAt:   now.Add(time.Hour),
+			},
+			wasError: true,
+		},
+		{
+			name: "Too big percent",
+			promo: &model.PromoCode{
+				Code:     "SALE200",
+				Kind:     model.PercentPromo,
+				Value:    200,
+				StartsAt: now,
+				EndsAt:   now.Add(time.Hour),
+			},
+			wasError: true,
+		},
+		{
+			name: "Ends before start",
+			promo: &model.PromoCode{
+				Code:     "SALE10",
+				Kind:     model.PercentPromo,
+				Value:    10,
+				StartsAt: now,
+				EndsAt:   now.Add(-time.Hour),
+			},
+			wasError: true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualErr := testcase.promo.Validate()
+
+			assert.Equal(t, testcase.wasError, actualErr != nil)
+		})
+	}
+}
+
+func TestPromoCodeDiscount(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	sellerID := uuid.New()
+
+	lines := []model.PromoLine{
+		{CategoryID: 1, SellerID: sellerID, Price: 100, Quantity: 2},
+		{CategoryID: 2, SellerID: uuid.New(), Price: 300, Quantity: 1},
+	}
+
+	testcases := []struct {
+		name             string
+		promo            *model.PromoCode
+		expectedDiscount int64
+		expectedErr      error
+	}{
+		{
+			name: "Percent off the whole order",
+			promo: &model.PromoCode{
+				Kind:     model.PercentPromo,
+				Value:    10,
+				StartsAt: now.Add(-time.Hour),
+				EndsAt:   now.Add(time.Hour),
+			},
+			expectedDiscount: 50,
+			expectedErr:      nil,
+		},
+		{
+			name: "Fixed amount limited by eligible category",
+			promo: &model.PromoCode{
+				Kind:        model.FixedPromo,
+				Value:       1000,
+				CategoryIDs: []int32{1},
+				StartsAt:    now.Add(-time.Hour),
+				EndsAt:      now.Add(time.Hour),
+			},
+			expectedDiscount: 200,
+			expectedErr:      nil,
+		},
+		{
+			name: "Percent restricted to seller",
+			promo: &model.PromoCode{
+				Kind:      model.PercentPromo,
+				Value:     50,
+				SellerIDs: []uuid.UUID{sellerID},
+				StartsAt:  now.Add(-time.Hour),
+				EndsAt:    now.Add(time.Hour),
+			},
+			expectedDiscount: 100,
+			expectedErr:      nil,
+		},
+		{
+			name: "Promo code is expired",
+			promo: &model.PromoCode{
+				Kind:     model.PercentPromo,
+				Value:    10,
+				StartsAt: now.Add(-2 * time.Hour),
+				EndsAt:   now.Add(-time.Hour),
+			},
+			expectedDiscount: 0,
+			expectedErr:      model.ErrPromoInactive,
+		},
+		{
+			name: "Order total is too small",
+			promo: &model.PromoCode{
+				Kind:     model.FixedPromo,
+				Value:    100,
+				MinTotal: 1000,
+				StartsAt: now.Add(-time.Hour),
+				EndsAt:   now.Add(time.Hour),
+			},
+			expectedDiscount: 0,
+			expectedErr:      model.ErrPromoMinTotal,
+		},
+		{
+			name: "No eligible products",
+			promo: &model.PromoCode{
+				Kind:        model.FixedPromo,
+				Value:       100,
+				CategoryIDs: []int32{3},
+				StartsAt:    now.Add(-time.Hour),
+				EndsAt:      now.Add(time.Hour),
+			},
+			expectedDiscount: 0,
+			expectedErr:      model.ErrPromoNotApplicable,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualDiscount, actualErr := testcase.promo.Discount(lines, now)
+
+			assert.Equal(t, testcase.expectedDiscount, actualDiscount)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package model
+
+import (
+	"sort"
+	"time"
+
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ReportPeriod int32
+
+const (
+	DayPeriod ReportPeriod = iota
+	WeekPeriod
+	MonthPeriod
+)
+
+// Returns the date_trunc precision of the period
+func (period ReportPeriod) String() string {
+	switch period {
+	case WeekPeriod:
+		return "week"
+	case MonthPeriod:
+		return "month"
+	default:
+		return "day"
+	}
+}
+
+// Returns the start of the period the time is in, weeks start on Monday like in postgres
+func (period ReportPeriod) Start(t time.Time) time.Time {
+	year, month, day := t.Date()
+
+	switch period {
+	case WeekPeriod:
+		weekday := (int(t.Weekday()) + 6) % 7
+		return time.Date(year, month, day-weekday, 0, 0, 0, 0, t.Location())
+	case MonthPeriod:
+		return time.Date(year, month, 1, 0, 0, 0, 0, t.Location())
+	default:
+		return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+	}
+}
+
+type SalesGrouping int32
+
+const (
+	TotalSales SalesGrouping = iota
+	SalesBySeller
+	SalesByCategory
+	SalesByProduct
+)
+
+// Represents the sales of a period, the fields the report is not grouped by are zero.
+// The revenue is after the product discounts and before the taxes, in the row currency
+type SalesReportRow struct {
+	PeriodStart time.Time `json:"period_start"`
+	SellerID    uuid.UUID `json:"seller_id"`
+	CategoryID  int32     `json:"category_id"`
+	ProductID   uuid.UUID `json:"product_id"`
+	Name        string    `json:"name"`
+	Currency    string    `json:"currency"`
+	Orders      int64     `json:"orders"`
+	Units       int64     `json:"units"`
+	Revenue     int64     `json:"revenue"`
+}
+
+func (row *SalesReportRow) ToProto() *pbOrder.SalesReportRow {
+	sellerID := ""
+	if row.SellerID != uuid.Nil {
+		sellerID = row.SellerID.String()
+	}
+
+	productID := ""
+	if row.ProductID != uuid.Nil {
+		productID = row.ProductID.String()
+	}
+
+	return &pbOrder.SalesReportRow{
+		PeriodStart: timestamppb.New(row.PeriodStart),
+		SellerId:    sellerID,
+		CategoryId:  row.CategoryID,
+		ProductId:   productID,
+		Name:        row.Name,
+		Currency:    row.Currency,
+		Orders:      row.Orders,
+		Units:       row.Units,
+		Revenue:     row.Revenue,
+	}
+}
+
+// Abandoned carts of a day by the cart service
+type CartMetric struct {
+	Day            time.Time
+	AbandonedCarts int64
+	RestoredCarts  int64
+}
+
+// Represents the orders and the abandoned carts of a period
+type ConversionReportRow struct {
+	PeriodStart    time.Time `json:"period_start"`
+	Orders         int64     `json:"orders"`
+	AbandonedCarts int64     `json:"abandoned_carts"`
+	RestoredCarts  int64     `json:"restored_carts"`
+}
+
+// Returns the share of the orders in the orders and the abandoned carts
+func (row *ConversionReportRow) ConversionRate() float64 {
+	carts := row.Orders + row.AbandonedCarts
+	if carts == 0 {
+		return 0
+	}
+
+	return float64(row.Orders) / float64(carts)
+}
+
+func (row *ConversionReportRow) ToProto() *pbOrder.ConversionReportRow {
+	return &pbOrder.ConversionReportRow{
+		PeriodStart:    timestamppb.New(row.PeriodStart),
+		Orders:         row.Orders,
+		AbandonedCarts: row.AbandonedCarts,
+		RestoredCarts:  row.RestoredCarts,
+		ConversionRate: row.ConversionRate(),
+	}
+}
+
+// Joins the orders of the periods with the abandoned carts summed up to the same periods,
+// the rows are ordered by the period
+func NewConversionReport(period ReportPeriod, orders []*ConversionReportRow, carts []CartMetric) []*ConversionReportRow {
+	rowsByPeriod := make(map[int64]*ConversionReportRow)
+	getRow := func(periodStart time.Time) *ConversionReportRow {
+		row, ok := rowsByPeriod[periodStart.Unix()]
+		if !ok {
+			row = &ConversionReportRow{
+				PeriodStart: periodStart,
+			}
+			rowsByPeriod[periodStart.Unix()] = row
+		}
+
+		return row
+	}
+
+	for _, order := range orders {
+		getRow(period.Start(order.PeriodStart)).Orders += order.Orders
+	}
+
+	for _, cart := range carts {
+		row := getRow(period.Start(cart.Day))
+		row.AbandonedCarts += cart.AbandonedCarts
+		row.RestoredCarts += cart.RestoredCarts
+	}
+
+	rows := make([]*ConversionReportRow, 0, len(rowsByPeriod))
+	for _, row := range rowsByPeriod {
+		rows = append(rows, row)
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].PeriodStart.Before(rows[j].PeriodStart)
+	})
+
+	return rows
+}
+
+// Represents the report exported as a CSV file
+type ReportFile struct {
+	FileName string
+	Content  []byte
+}
+
+func (file *ReportFile) ToProto() *pbOrder.ReportFileResponse {
+	return &pbOrder.ReportFileResponse{
+		ContentType: "text/csv",
+		FileName:    file.FileName,
+		Content:     file.Content,
+	}
+}
@@ -0,0 +1,87 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Go-Marketplace/backend/order/internal/model"
+)
+
+func TestReportPeriodStart(t *testing.T) {
+	t.Parallel()
+
+	// Thursday
+	moment := time.Date(2023, time.December, 28, 15, 30, 0, 0, time.UTC)
+
+	testcases := []struct {
+		name     string
+		period   model.ReportPeriod
+		expected time.Time
+	}{
+		{
+			name:     "Day starts at midnight",
+			period:   model.DayPeriod,
+			expected: time.Date(2023, time.December, 28, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Week starts on Monday",
+			period:   model.WeekPeriod,
+			expected: time.Date(2023, time.December, 25, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Month starts on the first day",
+			period:   model.MonthPeriod,
+			expected: time.Date(2023, time.December, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testcase.expected, testcase.period.Start(moment))
+		})
+	}
+}
+
+func TestReportPeriodStartOfSunday(t *testing.T) {
+	t.Parallel()
+
+	sunday := time.Date(2023, time.December, 31, 23, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Date(2023, time.December, 25, 0, 0, 0, 0, time.UTC), model.WeekPeriod.Start(sunday))
+}
+
+func TestNewConversionReport(t *testing.T) {
+	t.Parallel()
+
+	monday := time.Date(2023, time.December, 25, 0, 0, 0, 0, time.UTC)
+	nextMonday := monday.AddDate(0, 0, 7)
+
+	orders := []*model.ConversionReportRow{
+		{PeriodStart: monday, Orders: 3},
+		{PeriodStart: nextMonday, Orders: 1},
+	}
+	carts := []model.CartMetric{
+		{Day: monday.AddDate(0, 0, 1), AbandonedCarts: 2, RestoredCarts: 1},
+		{Day: monday.AddDate(0, 0, 3), AbandonedCarts: 4},
+		{Day: nextMonday.AddDate(0, 0, 14), AbandonedCarts: 5},
+	}
+
+	rows := model.NewConversionReport(model.WeekPeriod, orders, carts)
+
+	assert.Equal(t, []*model.ConversionReportRow{
+		{PeriodStart: monday, Orders: 3, AbandonedCarts: 6, RestoredCarts: 1},
+		{PeriodStart: nextMonday, Orders: 1},
+		{PeriodStart: nextMonday.AddDate(0, 0, 14), AbandonedCarts: 5},
+	}, rows)
+
+	assert.InDelta(t, 1.0/3, rows[0].ConversionRate(), 1e-9)
+	assert.Equal(t, 1.0, rows[1].ConversionRate())
+	assert.Equal(t, 0.0, rows[2].ConversionRate())
+	assert.Equal(t, 0.0, (&model.ConversionReportRow{}).ConversionRate())
+}
@@ -0,0 +1,65 @@
+// Package report writes the sales reports as files
+package report
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/Go-Marketplace/backend/order/internal/model"
+	"github.com/google/uuid"
+)
+
+var salesHeader = []string{
+	"period_start",
+	"seller_id",
+	"category_id",
+	"product_id",
+	"name",
+	"currency",
+	"orders",
+	"units",
+	"revenue",
+}
+
+func formatID(id uuid.UUID) string {
+	if id == uuid.Nil {
+		return ""
+	}
+
+	return id.String()
+}
+
+// Writes the rows with a header, the fields the report is not grouped by are left empty
+func WriteSalesCSV(w io.Writer, grouping model.SalesGrouping, rows []*model.SalesReportRow) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(salesHeader); err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		categoryID := ""
+		if grouping == model.SalesByCategory || grouping == model.SalesByProduct {
+			categoryID = strconv.FormatInt(int64(row.CategoryID), 10)
+		}
+
+		if err := writer.Write([]string{
+			row.PeriodStart.Format(time.DateOnly),
+			formatID(row.SellerID),
+			categoryID,
+			formatID(row.ProductID),
+			row.Name,
+			row.Currency,
+			strconv.FormatInt(row.Orders, 10),
+			strconv.FormatInt(row.Units, 10),
+			strconv.FormatInt(row.Revenue, 10),
+		}); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
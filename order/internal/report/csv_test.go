@@ -0,0 +1,76 @@
+package report_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/Go-Marketplace/backend/order/internal/model"
+	"github.com/Go-Marketplace/backend/order/internal/report"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteSalesCSV(t *testing.T) {
+	t.Parallel()
+
+	sellerID := uuid.MustParse("efb5b1a0-2222-4106-a2bc-577bd4b287d1")
+	productID := uuid.MustParse("efb5b1a0-2222-4106-a2bc-577bd4b287d2")
+	day := time.Date(2023, time.December, 25, 0, 0, 0, 0, time.UTC)
+
+	testcases := []struct {
+		name     string
+		grouping model.SalesGrouping
+		rows     []*model.SalesReportRow
+		expected string
+	}{
+		{
+			name:     "Product rows have every field",
+			grouping: model.SalesByProduct,
+			rows: []*model.SalesReportRow{
+				{
+					PeriodStart: day,
+					SellerID:    sellerID,
+					CategoryID:  3,
+					ProductID:   productID,
+					Name:        "Fish, smoked",
+					Currency:    "RUB",
+					Orders:      2,
+					Units:       5,
+					Revenue:     4500,
+				},
+			},
+			expected: "period_start,seller_id,category_id,product_id,name,currency,orders,units,revenue\n" +
+				"2023-12-25,efb5b1a0-2222-4106-a2bc-577bd4b287d1,3,efb5b1a0-2222-4106-a2bc-577bd4b287d2,\"Fish, smoked\",RUB,2,5,4500\n",
+		},
+		{
+			name:     "Total rows leave the groupings empty",
+			grouping: model.TotalSales,
+			rows: []*model.SalesReportRow{
+				{PeriodStart: day, Currency: "USD", Orders: 1, Units: 1, Revenue: 100},
+			},
+			expected: "period_start,seller_id,category_id,product_id,name,currency,orders,units,revenue\n" +
+				"2023-12-25,,,,,USD,1,1,100\n",
+		},
+		{
+			name:     "Empty report has the header",
+			grouping: model.SalesBySeller,
+			rows:     []*model.SalesReportRow{},
+			expected: "period_start,seller_id,category_id,product_id,name,currency,orders,units,revenue\n",
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var content bytes.Buffer
+			err := report.WriteSalesCSV(&content, testcase.grouping, testcase.rows)
+
+			assert.NoError(t, err)
+			assert.Equal(t, testcase.expected, content.String())
+		})
+	}
+}
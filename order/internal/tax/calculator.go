@@ -0,0 +1,117 @@
+// Package tax calculates the taxes of the order lines by the product category and the shipping region
+package tax
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Line is the taxable part of an orderline, the amount is the line total after the product discount
+type Line struct {
+	CategoryID int32
+	Amount     int64
+}
+
+// Tax is the tax of one line, the rate is in percent
+type Tax struct {
+	Rate   float32
+	Amount int64
+}
+
+// TaxCalculator calculates the tax of the line shipped to the region, the tax is added on top of the amount
+type TaxCalculator interface {
+	Calculate(region string, line Line) Tax
+}
+
+// Rule sets the tax rate of a category in a region. An empty region matches
+// any region and a zero category id matches any category
+type Rule struct {
+	Region     string
+	CategoryID int32
+	Rate       float32
+}
+
+type ruleKey struct {
+	region     string
+	categoryID int32
+}
+
+// RuleCalculator takes the rate of the most specific rule that matches the line:
+// the region and the category, then the region, then the category, then the default rate
+type RuleCalculator struct {
+	defaultRate float32
+	rules       map[ruleKey]float32
+}
+
+func validRate(rate float32) bool {
+	return rate >= 0 && rate <= 100
+}
+
+// Normalizes the region code, so that the rules match the region in any case
+func NormalizeRegion(region string) string {
+	return strings.ToUpper(strings.TrimSpace(region))
+}
+
+func NewRuleCalculator(defaultRate float32, rules []Rule) (*RuleCalculator, error) {
+	if !validRate(defaultRate) {
+		return nil, fmt.Errorf("invalid default tax rate: %v", defaultRate)
+	}
+
+	calculator := &RuleCalculator{
+		defaultRate: defaultRate,
+		rules:       make(map[ruleKey]float32, len(rules)),
+	}
+
+	for _, rule := range rules {
+		if !validRate(rule.Rate) {
+			return nil, fmt.Errorf("invalid tax rate of region %q and category %d: %v", rule.Region, rule.CategoryID, rule.Rate)
+		}
+
+		if rule.CategoryID < 0 {
+			return nil, fmt.Errorf("invalid tax rule category: %d", rule.CategoryID)
+		}
+
+		key := ruleKey{
+			region:     NormalizeRegion(rule.Region),
+			categoryID: rule.CategoryID,
+		}
+
+		if _, ok := calculator.rules[key]; ok {
+			return nil, fmt.Errorf("duplicate tax rule of region %q and category %d", rule.Region, rule.CategoryID)
+		}
+
+		calculator.rules[key] = rule.Rate
+	}
+
+	return calculator, nil
+}
+
+func (calculator *RuleCalculator) rate(region string, categoryID int32) float32 {
+	keys := []ruleKey{
+		{region: region, categoryID: categoryID},
+		{region: region},
+		{categoryID: categoryID},
+	}
+
+	for _, key := range keys {
+		if rate, ok := calculator.rules[key]; ok {
+			return rate
+		}
+	}
+
+	return calculator.defaultRate
+}
+
+// Calculates the tax rounded to the nearest minor unit
+func (calculator *RuleCalculator) Calculate(region string, line Line) Tax {
+	rate := calculator.rate(NormalizeRegion(region), line.CategoryID)
+	if line.Amount <= 0 {
+		return Tax{Rate: rate}
+	}
+
+	return Tax{
+		Rate:   rate,
+		Amount: int64(math.Round(float64(line.Amount) * float64(rate) / 100)),
+	}
+}
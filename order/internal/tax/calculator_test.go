@@ -0,0 +1,126 @@
+package tax_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Go-Marketplace/backend/order/internal/tax"
+)
+
+func TestCalculateTax(t *testing.T) {
+	t.Parallel()
+
+	calculator, err := tax.NewRuleCalculator(5, []tax.Rule{
+		{Region: "de", Rate: 19},
+		{Region: "DE", CategoryID: 3, Rate: 7},
+		{CategoryID: 3, Rate: 0},
+		{Region: "US-OR", Rate: 0},
+	})
+	assert.NoError(t, err)
+
+	testcases := []struct {
+		name        string
+		region      string
+		line        tax.Line
+		expectedTax tax.Tax
+	}{
+		{
+			name:        "Region and category rule",
+			region:      "DE",
+			line:        tax.Line{CategoryID: 3, Amount: 1000},
+			expectedTax: tax.Tax{Rate: 7, Amount: 70},
+		},
+		{
+			name:        "Region rule",
+			region:      " de ",
+			line:        tax.Line{CategoryID: 1, Amount: 1000},
+			expectedTax: tax.Tax{Rate: 19, Amount: 190},
+		},
+		{
+			name:        "Category rule in any region",
+			region:      "FR",
+			line:        tax.Line{CategoryID: 3, Amount: 1000},
+			expectedTax: tax.Tax{Rate: 0, Amount: 0},
+		},
+		{
+			name:        "Default rate",
+			region:      "",
+			line:        tax.Line{CategoryID: 1, Amount: 1000},
+			expectedTax: tax.Tax{Rate: 5, Amount: 50},
+		},
+		{
+			name:        "Zero rate region",
+			region:      "us-or",
+			line:        tax.Line{CategoryID: 1, Amount: 1000},
+			expectedTax: tax.Tax{Rate: 0, Amount: 0},
+		},
+		{
+			name:        "Tax is rounded to the nearest unit",
+			region:      "DE",
+			line:        tax.Line{CategoryID: 1, Amount: 105},
+			expectedTax: tax.Tax{Rate: 19, Amount: 20},
+		},
+		{
+			name:        "No tax of empty amount",
+			region:      "DE",
+			line:        tax.Line{CategoryID: 1, Amount: 0},
+			expectedTax: tax.Tax{Rate: 19, Amount: 0},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testcase.expectedTax, calculator.Calculate(testcase.region, testcase.line))
+		})
+	}
+}
+
+func TestNewRuleCalculator(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		defaultRate float32
+		rules       []tax.Rule
+		expectedErr bool
+	}{
+		{
+			name:        "Valid rules",
+			defaultRate: 0,
+			rules:       []tax.Rule{{Region: "DE", Rate: 19}},
+			expectedErr: false,
+		},
+		{
+			name:        "Invalid default rate",
+			defaultRate: -1,
+			rules:       nil,
+			expectedErr: true,
+		},
+		{
+			name:        "Invalid rule rate",
+			defaultRate: 0,
+			rules:       []tax.Rule{{Region: "DE", Rate: 101}},
+			expectedErr: true,
+		},
+		{
+			name:        "Duplicate rules",
+			defaultRate: 0,
+			rules:       []tax.Rule{{Region: "DE", Rate: 19}, {Region: "de", Rate: 7}},
+			expectedErr: true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := tax.NewRuleCalculator(testcase.defaultRate, testcase.rules)
+			assert.Equal(t, testcase.expectedErr, err != nil)
+		})
+	}
+}
@@ -2,8 +2,10 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/Go-Marketplace/backend/order/internal/api/grpc/dto"
+	"github.com/Go-Marketplace/backend/order/internal/broker"
 	"github.com/Go-Marketplace/backend/order/internal/infrastructure/interfaces"
 	"github.com/Go-Marketplace/backend/order/internal/model"
 	"github.com/google/uuid"
@@ -12,6 +14,7 @@ import (
 type IOrderUsecase interface {
 	GetOrder(ctx context.Context, orderID uuid.UUID) (*model.Order, error)
 	GetOrders(ctx context.Context, searchParams dto.SearchOrderDTO) ([]*model.Order, error)
+	GetOrdersPage(ctx context.Context, searchParams dto.ExportOrdersDTO) ([]*model.Order, error)
 	CreateOrder(ctx context.Context, order *model.Order) (*model.Order, error)
 	DeleteOrder(ctx context.Context, orderID uuid.UUID) error
 	DeleteUserOrders(ctx context.Context, userID uuid.UUID) error
@@ -20,15 +23,66 @@ type IOrderUsecase interface {
 	CreateOrderline(ctx context.Context, orderline *model.Orderline) error
 	UpdateOrderline(ctx context.Context, orderline *model.Orderline) (*model.Orderline, error)
 	DeleteOrderline(ctx context.Context, orderID, productID uuid.UUID) error
+
+	HasReceivedProduct(ctx context.Context, userID, productID uuid.UUID) (bool, error)
+	GetPurchasedQuantity(ctx context.Context, userID, productID uuid.UUID, since time.Time) (int64, error)
+
+	GetInvoice(ctx context.Context, orderID, sellerID uuid.UUID) (*model.Invoice, error)
+	IssueInvoice(ctx context.Context, invoice *model.Invoice) (*model.Invoice, error)
+
+	GetPromoCode(ctx context.Context, code string) (*model.PromoCode, error)
+	CreatePromoCode(ctx context.Context, promo model.PromoCode) (*model.PromoCode, error)
+	CountUserRedemptions(ctx context.Context, code string, userID uuid.UUID) (int64, error)
+
+	GetSellerEntries(ctx context.Context, sellerID uuid.UUID) ([]*model.LedgerEntry, error)
+	GetSellerBalances(ctx context.Context, sellerID uuid.UUID) ([]*model.Balance, error)
+	CreatePayouts(ctx context.Context, sellerID uuid.UUID) ([]*model.LedgerTransaction, error)
+
+	GetOrderThread(ctx context.Context, orderID uuid.UUID) (*model.OrderThread, error)
+	CreateOrderMessage(ctx context.Context, message *model.OrderMessage) error
+	MarkThreadRead(ctx context.Context, receipt *model.ReadReceipt) error
+	WatchOrderThread(orderID uuid.UUID) (<-chan *model.ThreadEvent, func())
+
+	WatchOrder(orderID uuid.UUID) (<-chan *model.OrderEvent, func())
+
+	GetSalesReport(ctx context.Context, searchParams dto.SalesReportDTO) ([]*model.SalesReportRow, error)
+	GetConversionOrders(ctx context.Context, searchParams dto.ConversionReportDTO) ([]*model.ConversionReportRow, error)
+	RefreshSales(ctx context.Context) error
 }
 
 type OrderUsecase struct {
-	repo interfaces.OrderRepo
+	repo            interfaces.OrderRepo
+	promoRepo       interfaces.PromoRepo
+	invoiceRepo     interfaces.InvoiceRepo
+	ledgerRepo      interfaces.LedgerRepo
+	messageRepo     interfaces.MessageRepo
+	reportRepo      interfaces.ReportRepo
+	commissionRates *model.CommissionRates
+	threadBroker    *broker.Broker[*model.ThreadEvent]
+	orderBroker     *broker.Broker[*model.OrderEvent]
 }
 
-func NewOrderUsecase(repo interfaces.OrderRepo) *OrderUsecase {
+func NewOrderUsecase(
+	repo interfaces.OrderRepo,
+	promoRepo interfaces.PromoRepo,
+	invoiceRepo interfaces.InvoiceRepo,
+	ledgerRepo interfaces.LedgerRepo,
+	messageRepo interfaces.MessageRepo,
+	reportRepo interfaces.ReportRepo,
+	commissionRates *model.CommissionRates,
+	threadBroker *broker.Broker[*model.ThreadEvent],
+	orderBroker *broker.Broker[*model.OrderEvent],
+) *OrderUsecase {
 	return &OrderUsecase{
-		repo: repo,
+		repo:            repo,
+		promoRepo:       promoRepo,
+		invoiceRepo:     invoiceRepo,
+		ledgerRepo:      ledgerRepo,
+		messageRepo:     messageRepo,
+		reportRepo:      reportRepo,
+		commissionRates: commissionRates,
+		threadBroker:    threadBroker,
+		orderBroker:     orderBroker,
 	}
 }
 
@@ -40,6 +94,10 @@ func (usecase *OrderUsecase) GetOrders(ctx context.Context, searchParams dto.Sea
 	return usecase.repo.GetOrders(ctx, searchParams)
 }
 
+func (usecase *OrderUsecase) GetOrdersPage(ctx context.Context, searchParams dto.ExportOrdersDTO) ([]*model.Order, error) {
+	return usecase.repo.GetOrdersPage(ctx, searchParams)
+}
+
 func (usecase *OrderUsecase) CreateOrder(ctx context.Context, order *model.Order) (*model.Order, error) {
 	if err := usecase.repo.CreateOrder(ctx, order); err != nil {
 		return nil, err
@@ -49,11 +107,34 @@ func (usecase *OrderUsecase) CreateOrder(ctx context.Context, order *model.Order
 }
 
 func (usecase *OrderUsecase) DeleteOrder(ctx context.Context, orderID uuid.UUID) error {
-	return usecase.repo.DeleteOrder(ctx, orderID)
+	if err := usecase.repo.DeleteOrder(ctx, orderID); err != nil {
+		return err
+	}
+
+	usecase.orderBroker.Publish(orderID, &model.OrderEvent{
+		Kind:       model.OrderDeletedEvent,
+		OrderID:    orderID,
+		OccurredAt: time.Now(),
+	})
+
+	return nil
 }
 
 func (usecase *OrderUsecase) DeleteUserOrders(ctx context.Context, userID uuid.UUID) error {
-	return usecase.repo.DeleteUserOrders(ctx, userID)
+	orderIDs, err := usecase.repo.DeleteUserOrders(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, orderID := range orderIDs {
+		usecase.orderBroker.Publish(orderID, &model.OrderEvent{
+			Kind:       model.OrderDeletedEvent,
+			OrderID:    orderID,
+			OccurredAt: time.Now(),
+		})
+	}
+
+	return nil
 }
 
 func (usecase *OrderUsecase) GetOrderline(ctx context.Context, orderID, productID uuid.UUID) (*model.Orderline, error) {
@@ -61,17 +142,216 @@ func (usecase *OrderUsecase) GetOrderline(ctx context.Context, orderID, productI
 }
 
 func (usecase *OrderUsecase) CreateOrderline(ctx context.Context, orderline *model.Orderline) error {
-	return usecase.repo.CreateOrderline(ctx, orderline)
+	if err := usecase.repo.CreateOrderline(ctx, orderline); err != nil {
+		return err
+	}
+
+	usecase.orderBroker.Publish(orderline.OrderID, model.NewOrderlineStatusEvent(orderline))
+
+	return nil
 }
 
+// Changes the orderline status together with the seller ledger: a received orderline
+// credits the seller and a canceled one refunds the credit in the same transaction
 func (usecase *OrderUsecase) UpdateOrderline(ctx context.Context, orderline *model.Orderline) (*model.Orderline, error) {
-	if err := usecase.repo.UpdateOrderline(ctx, orderline); err != nil {
+	transaction, err := usecase.orderlineLedgerTransaction(ctx, orderline)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = usecase.repo.UpdateOrderline(ctx, orderline, transaction); err != nil {
+		return nil, err
+	}
+
+	updatedOrderline, err := usecase.GetOrderline(ctx, orderline.OrderID, orderline.ProductID)
+	if err != nil {
 		return nil, err
 	}
 
-	return usecase.GetOrderline(ctx, orderline.OrderID, orderline.ProductID)
+	if updatedOrderline != nil {
+		usecase.orderBroker.Publish(updatedOrderline.OrderID, model.NewOrderlineStatusEvent(updatedOrderline))
+	}
+
+	return updatedOrderline, nil
 }
 
 func (usecase *OrderUsecase) DeleteOrderline(ctx context.Context, orderID, productID uuid.UUID) error {
-	return usecase.repo.DeleteOrderline(ctx, orderID, productID)
+	if err := usecase.repo.DeleteOrderline(ctx, orderID, productID); err != nil {
+		return err
+	}
+
+	usecase.orderBroker.Publish(orderID, &model.OrderEvent{
+		Kind:       model.OrderlineDeletedEvent,
+		OrderID:    orderID,
+		ProductID:  productID,
+		OccurredAt: time.Now(),
+	})
+
+	return nil
+}
+
+func (usecase *OrderUsecase) HasReceivedProduct(ctx context.Context, userID, productID uuid.UUID) (bool, error) {
+	return usecase.repo.HasReceivedProduct(ctx, userID, productID)
+}
+
+func (usecase *OrderUsecase) GetPurchasedQuantity(ctx context.Context, userID, productID uuid.UUID, since time.Time) (int64, error) {
+	return usecase.repo.GetPurchasedQuantity(ctx, userID, productID, since)
+}
+
+func (usecase *OrderUsecase) GetInvoice(ctx context.Context, orderID, sellerID uuid.UUID) (*model.Invoice, error) {
+	return usecase.invoiceRepo.GetInvoice(ctx, orderID, sellerID)
+}
+
+// Stores the invoice with the next number of the seller, the invoice that is already
+// issued for the order and the seller is returned instead
+func (usecase *OrderUsecase) IssueInvoice(ctx context.Context, invoice *model.Invoice) (*model.Invoice, error) {
+	return usecase.invoiceRepo.IssueInvoice(ctx, invoice)
+}
+
+func (usecase *OrderUsecase) GetPromoCode(ctx context.Context, code string) (*model.PromoCode, error) {
+	return usecase.promoRepo.GetPromoCode(ctx, code)
+}
+
+func (usecase *OrderUsecase) CreatePromoCode(ctx context.Context, promo model.PromoCode) (*model.PromoCode, error) {
+	if err := usecase.promoRepo.CreatePromoCode(ctx, promo); err != nil {
+		return nil, err
+	}
+
+	return usecase.GetPromoCode(ctx, promo.Code)
+}
+
+func (usecase *OrderUsecase) CountUserRedemptions(ctx context.Context, code string, userID uuid.UUID) (int64, error) {
+	return usecase.promoRepo.CountUserRedemptions(ctx, code, userID)
+}
+
+// Returns the ledger transaction booked by the status of the orderline, nil when the status
+// books nothing or the orderline has nothing to credit or refund
+func (usecase *OrderUsecase) orderlineLedgerTransaction(
+	ctx context.Context,
+	orderline *model.Orderline,
+) (*model.LedgerTransaction, error) {
+	switch orderline.Status {
+	case model.Recieved:
+		currentOrderline, err := usecase.repo.GetOrderline(ctx, orderline.OrderID, orderline.ProductID)
+		if err != nil {
+			return nil, err
+		}
+
+		if currentOrderline == nil {
+			return nil, nil
+		}
+
+		return usecase.saleTransaction(currentOrderline)
+	case model.Canceled:
+		return usecase.refundTransaction(ctx, orderline.OrderID, orderline.ProductID)
+	default:
+		return nil, nil
+	}
+}
+
+// Credits the seller with the orderline total minus the commission of its category
+func (usecase *OrderUsecase) saleTransaction(orderline *model.Orderline) (*model.LedgerTransaction, error) {
+	if orderline.Total() <= 0 {
+		return nil, nil
+	}
+
+	commission := usecase.commissionRates.Commission(orderline.CategoryID, orderline.TaxableAmount())
+	sale := model.NewSaleTransaction(orderline, commission, time.Now())
+	if err := sale.Validate(); err != nil {
+		return nil, err
+	}
+
+	return sale, nil
+}
+
+// Reverses the sale of the orderline, nil when the orderline is not credited
+func (usecase *OrderUsecase) refundTransaction(ctx context.Context, orderID, productID uuid.UUID) (*model.LedgerTransaction, error) {
+	sale, err := usecase.ledgerRepo.GetOrderlineTransaction(ctx, model.SaleTransaction, orderID, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	if sale == nil {
+		return nil, nil
+	}
+
+	return sale.Refund(time.Now()), nil
+}
+
+func (usecase *OrderUsecase) GetSellerEntries(ctx context.Context, sellerID uuid.UUID) ([]*model.LedgerEntry, error) {
+	return usecase.ledgerRepo.GetAccountEntries(ctx, model.SellerAccount(sellerID))
+}
+
+func (usecase *OrderUsecase) GetSellerBalances(ctx context.Context, sellerID uuid.UUID) ([]*model.Balance, error) {
+	return usecase.ledgerRepo.GetAccountBalances(ctx, model.SellerAccount(sellerID))
+}
+
+func (usecase *OrderUsecase) CreatePayouts(ctx context.Context, sellerID uuid.UUID) ([]*model.LedgerTransaction, error) {
+	return usecase.ledgerRepo.CreatePayouts(ctx, sellerID, time.Now())
+}
+
+func (usecase *OrderUsecase) GetOrderThread(ctx context.Context, orderID uuid.UUID) (*model.OrderThread, error) {
+	messages, err := usecase.messageRepo.GetOrderMessages(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	receipts, err := usecase.messageRepo.GetReadReceipts(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.OrderThread{
+		OrderID:  orderID,
+		Messages: messages,
+		Receipts: receipts,
+	}, nil
+}
+
+// Stores the message and sends it to the streams watching the thread
+func (usecase *OrderUsecase) CreateOrderMessage(ctx context.Context, message *model.OrderMessage) error {
+	if err := usecase.messageRepo.CreateOrderMessage(ctx, message); err != nil {
+		return err
+	}
+
+	usecase.threadBroker.Publish(message.OrderID, &model.ThreadEvent{Message: message})
+
+	return nil
+}
+
+// Stores the read receipt and sends it to the streams watching the thread
+func (usecase *OrderUsecase) MarkThreadRead(ctx context.Context, receipt *model.ReadReceipt) error {
+	if err := usecase.messageRepo.SetReadReceipt(ctx, receipt); err != nil {
+		return err
+	}
+
+	usecase.threadBroker.Publish(receipt.OrderID, &model.ThreadEvent{Receipt: receipt})
+
+	return nil
+}
+
+func (usecase *OrderUsecase) WatchOrderThread(orderID uuid.UUID) (<-chan *model.ThreadEvent, func()) {
+	return usecase.threadBroker.Subscribe(orderID)
+}
+
+// Returns the status transitions of the order, the orderline changes and
+// the deletions are published when they are stored
+func (usecase *OrderUsecase) WatchOrder(orderID uuid.UUID) (<-chan *model.OrderEvent, func()) {
+	return usecase.orderBroker.Subscribe(orderID)
+}
+
+func (usecase *OrderUsecase) GetSalesReport(ctx context.Context, searchParams dto.SalesReportDTO) ([]*model.SalesReportRow, error) {
+	return usecase.reportRepo.GetSalesReport(ctx, searchParams)
+}
+
+func (usecase *OrderUsecase) GetConversionOrders(
+	ctx context.Context,
+	searchParams dto.ConversionReportDTO,
+) ([]*model.ConversionReportRow, error) {
+	return usecase.reportRepo.GetConversionOrders(ctx, searchParams)
+}
+
+// Recomputes the sales the reports read from the orders
+func (usecase *OrderUsecase) RefreshSales(ctx context.Context) error {
+	return usecase.reportRepo.RefreshSales(ctx)
 }
@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/Go-Marketplace/backend/order/internal/api/grpc/dto"
+	"github.com/Go-Marketplace/backend/order/internal/broker"
 	mocks "github.com/Go-Marketplace/backend/order/internal/mocks/repo"
 	"github.com/Go-Marketplace/backend/order/internal/model"
 	"github.com/Go-Marketplace/backend/order/internal/usecase"
@@ -14,16 +16,46 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func orderHelper(t *testing.T) (*usecase.OrderUsecase, *mocks.MockOrderRepo) {
+type orderMocks struct {
+	orderRepo   *mocks.MockOrderRepo
+	promoRepo   *mocks.MockPromoRepo
+	invoiceRepo *mocks.MockInvoiceRepo
+	ledgerRepo  *mocks.MockLedgerRepo
+	messageRepo *mocks.MockMessageRepo
+	reportRepo  *mocks.MockReportRepo
+}
+
+func orderHelper(t *testing.T) (*usecase.OrderUsecase, *orderMocks) {
 	t.Helper()
 
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
 
-	repo := mocks.NewMockOrderRepo(mockCtrl)
-	order := usecase.NewOrderUsecase(repo)
+	repos := &orderMocks{
+		orderRepo:   mocks.NewMockOrderRepo(mockCtrl),
+		promoRepo:   mocks.NewMockPromoRepo(mockCtrl),
+		invoiceRepo: mocks.NewMockInvoiceRepo(mockCtrl),
+		ledgerRepo:  mocks.NewMockLedgerRepo(mockCtrl),
+		messageRepo: mocks.NewMockMessageRepo(mockCtrl),
+		reportRepo:  mocks.NewMockReportRepo(mockCtrl),
+	}
+	order := usecase.NewOrderUsecase(
+		repos.orderRepo, repos.promoRepo, repos.invoiceRepo, repos.ledgerRepo, repos.messageRepo, repos.reportRepo,
+		testCommissionRates(t), broker.New[*model.ThreadEvent](0), broker.New[*model.OrderEvent](0),
+	)
+
+	return order, repos
+}
+
+func testCommissionRates(t *testing.T) *model.CommissionRates {
+	t.Helper()
+
+	commissionRates, err := model.NewCommissionRates(10, []model.CategoryCommission{
+		{CategoryID: 3, Rate: 5},
+	})
+	assert.NoError(t, err)
 
-	return order, repo
+	return commissionRates
 }
 
 func TestGetOrder(t *testing.T) {
@@ -81,8 +113,8 @@ func TestGetOrder(t *testing.T) {
 		t.Run(testcase.name, func(t *testing.T) {
 			t.Parallel()
 
-			orderUseCase, orderRepo := orderHelper(t)
-			testcase.mock(orderRepo)
+			orderUseCase, repos := orderHelper(t)
+			testcase.mock(repos.orderRepo)
 
 			actualOrder, actualErr := orderUseCase.GetOrder(testcase.args.ctx, testcase.args.id)
 			assert.Equal(t, actualOrder, testcase.expectedOrder)
@@ -149,8 +181,8 @@ func TestGetOrders(t *testing.T) {
 		t.Run(testcase.name, func(t *testing.T) {
 			t.Parallel()
 
-			orderUseCase, orderRepo := orderHelper(t)
-			testcase.mock(orderRepo)
+			orderUseCase, repos := orderHelper(t)
+			testcase.mock(repos.orderRepo)
 
 			actualOrder, actualErr := orderUseCase.GetOrders(testcase.args.ctx, testcase.args.searchParams)
 			assert.Equal(t, actualOrder, testcase.expectedOrders)
@@ -159,6 +191,64 @@ func TestGetOrders(t *testing.T) {
 	}
 }
 
+func TestGetOrdersPage(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	searchParams := dto.ExportOrdersDTO{
+		From:     time.Now().Add(-24 * time.Hour),
+		To:       time.Now(),
+		Statuses: []model.OrderlineStatus{model.Recieved},
+		Limit:    500,
+	}
+
+	expectedOrdersFromRepo := []*model.Order{
+		{
+			ID: uuid.New(),
+		},
+	}
+	expectedErrFromRepo := errors.New("test error")
+
+	testcases := []struct {
+		name           string
+		mock           func(repo *mocks.MockOrderRepo)
+		expectedOrders []*model.Order
+		expectedErr    error
+	}{
+		{
+			name: "Successfully get orders page",
+			mock: func(repo *mocks.MockOrderRepo) {
+				repo.EXPECT().GetOrdersPage(ctx, searchParams).Return(expectedOrdersFromRepo, nil).Times(1)
+			},
+			expectedOrders: expectedOrdersFromRepo,
+			expectedErr:    nil,
+		},
+		{
+			name: "Get repo error",
+			mock: func(repo *mocks.MockOrderRepo) {
+				repo.EXPECT().GetOrdersPage(ctx, searchParams).Return(nil, expectedErrFromRepo).Times(1)
+			},
+			expectedOrders: nil,
+			expectedErr:    expectedErrFromRepo,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			orderUseCase, repos := orderHelper(t)
+			testcase.mock(repos.orderRepo)
+
+			actualOrders, actualErr := orderUseCase.GetOrdersPage(ctx, searchParams)
+			assert.Equal(t, testcase.expectedOrders, actualOrders)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
 func TestCreateOrder(t *testing.T) {
 	t.Parallel()
 
@@ -231,8 +321,8 @@ func TestCreateOrder(t *testing.T) {
 		t.Run(testcase.name, func(t *testing.T) {
 			t.Parallel()
 
-			orderUseCase, orderRepo := orderHelper(t)
-			testcase.mock(orderRepo)
+			orderUseCase, repos := orderHelper(t)
+			testcase.mock(repos.orderRepo)
 
 			actualOrder, actualErr := orderUseCase.CreateOrder(testcase.args.ctx, testcase.args.order)
 
@@ -291,8 +381,8 @@ func TestDeleteOrder(t *testing.T) {
 		t.Run(testcase.name, func(t *testing.T) {
 			t.Parallel()
 
-			orderUseCase, orderRepo := orderHelper(t)
-			testcase.mock(orderRepo)
+			orderUseCase, repos := orderHelper(t)
+			testcase.mock(repos.orderRepo)
 
 			actualErr := orderUseCase.DeleteOrder(testcase.args.ctx, testcase.args.orderID)
 
@@ -327,7 +417,7 @@ func TestDeleteUserOrders(t *testing.T) {
 				userID: userID,
 			},
 			mock: func(repo *mocks.MockOrderRepo) {
-				repo.EXPECT().DeleteUserOrders(ctx, userID).Return(nil).Times(1)
+				repo.EXPECT().DeleteUserOrders(ctx, userID).Return([]uuid.UUID{uuid.New()}, nil).Times(1)
 			},
 			expectedErr: nil,
 		},
@@ -338,7 +428,7 @@ func TestDeleteUserOrders(t *testing.T) {
 				userID: userID,
 			},
 			mock: func(repo *mocks.MockOrderRepo) {
-				repo.EXPECT().DeleteUserOrders(ctx, userID).Return(expectedErrFromRepo).Times(1)
+				repo.EXPECT().DeleteUserOrders(ctx, userID).Return(nil, expectedErrFromRepo).Times(1)
 			},
 			expectedErr: expectedErrFromRepo,
 		},
@@ -350,8 +440,8 @@ func TestDeleteUserOrders(t *testing.T) {
 		t.Run(testcase.name, func(t *testing.T) {
 			t.Parallel()
 
-			orderUseCase, orderRepo := orderHelper(t)
-			testcase.mock(orderRepo)
+			orderUseCase, repos := orderHelper(t)
+			testcase.mock(repos.orderRepo)
 
 			actualErr := orderUseCase.DeleteUserOrders(testcase.args.ctx, testcase.args.userID)
 
@@ -420,8 +510,8 @@ func TestGetOrderline(t *testing.T) {
 		t.Run(testcase.name, func(t *testing.T) {
 			t.Parallel()
 
-			orderUseCase, orderRepo := orderHelper(t)
-			testcase.mock(orderRepo)
+			orderUseCase, repos := orderHelper(t)
+			testcase.mock(repos.orderRepo)
 
 			actualOrderline, actualErr := orderUseCase.GetOrderline(
 				testcase.args.ctx,
@@ -487,8 +577,8 @@ func TestCreateOrderline(t *testing.T) {
 		t.Run(testcase.name, func(t *testing.T) {
 			t.Parallel()
 
-			orderUseCase, orderRepo := orderHelper(t)
-			testcase.mock(orderRepo)
+			orderUseCase, repos := orderHelper(t)
+			testcase.mock(repos.orderRepo)
 
 			actualErr := orderUseCase.CreateOrderline(testcase.args.ctx, testcase.args.orderline)
 
@@ -511,11 +601,13 @@ func TestUpdateOrderline(t *testing.T) {
 	testOrderline := &model.Orderline{
 		OrderID:   orderID,
 		ProductID: productID,
+		Status:    model.Delivery,
 	}
 
 	expectedOrderlineFromRepo := &model.Orderline{
 		OrderID:   orderID,
 		ProductID: productID,
+		Status:    model.Delivery,
 	}
 	expectedErrFromRepo := errors.New("test error")
 
@@ -533,7 +625,7 @@ func TestUpdateOrderline(t *testing.T) {
 				orderline: testOrderline,
 			},
 			mock: func(repo *mocks.MockOrderRepo) {
-				repo.EXPECT().UpdateOrderline(ctx, testOrderline).Return(nil).Times(1)
+				repo.EXPECT().UpdateOrderline(ctx, testOrderline, nil).Return(nil).Times(1)
 				repo.EXPECT().GetOrderline(ctx, orderID, productID).Return(expectedOrderlineFromRepo, nil).Times(1)
 			},
 			expectedOrderline: expectedOrderlineFromRepo,
@@ -546,7 +638,7 @@ func TestUpdateOrderline(t *testing.T) {
 				orderline: testOrderline,
 			},
 			mock: func(repo *mocks.MockOrderRepo) {
-				repo.EXPECT().UpdateOrderline(ctx, testOrderline).Return(expectedErrFromRepo).Times(1)
+				repo.EXPECT().UpdateOrderline(ctx, testOrderline, nil).Return(expectedErrFromRepo).Times(1)
 			},
 			expectedOrderline: nil,
 			expectedErr:       expectedErrFromRepo,
@@ -558,7 +650,7 @@ func TestUpdateOrderline(t *testing.T) {
 				orderline: testOrderline,
 			},
 			mock: func(repo *mocks.MockOrderRepo) {
-				repo.EXPECT().UpdateOrderline(ctx, testOrderline).Return(nil).Times(1)
+				repo.EXPECT().UpdateOrderline(ctx, testOrderline, nil).Return(nil).Times(1)
 				repo.EXPECT().GetOrderline(ctx, orderID, productID).Return(nil, expectedErrFromRepo).Times(1)
 			},
 			expectedOrderline: nil,
@@ -572,8 +664,8 @@ func TestUpdateOrderline(t *testing.T) {
 		t.Run(testcase.name, func(t *testing.T) {
 			t.Parallel()
 
-			orderUseCase, orderRepo := orderHelper(t)
-			testcase.mock(orderRepo)
+			orderUseCase, repos := orderHelper(t)
+			testcase.mock(repos.orderRepo)
 
 			actualOrderline, actualErr := orderUseCase.UpdateOrderline(testcase.args.ctx, testcase.args.orderline)
 
@@ -636,8 +728,8 @@ func TestDeleteOrderline(t *testing.T) {
 		t.Run(testcase.name, func(t *testing.T) {
 			t.Parallel()
 
-			orderUseCase, orderRepo := orderHelper(t)
-			testcase.mock(orderRepo)
+			orderUseCase, repos := orderHelper(t)
+			testcase.mock(repos.orderRepo)
 
 			actualErr := orderUseCase.DeleteOrderline(
 				testcase.args.ctx,
@@ -649,3 +741,729 @@ func TestDeleteOrderline(t *testing.T) {
 		})
 	}
 }
+
+func TestHasReceivedProduct(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ctx       context.Context
+		userID    uuid.UUID
+		productID uuid.UUID
+	}
+
+	ctx := context.Background()
+	userID := uuid.New()
+	productID := uuid.New()
+
+	expectedErrFromRepo := errors.New("test error")
+
+	testcases := []struct {
+		name             string
+		args             args
+		mock             func(repo *mocks.MockOrderRepo)
+		expectedReceived bool
+		expectedErr      error
+	}{
+		{
+			name: "Product was received",
+			args: args{
+				ctx:       ctx,
+				userID:    userID,
+				productID: productID,
+			},
+			mock: func(repo *mocks.MockOrderRepo) {
+				repo.EXPECT().HasReceivedProduct(ctx, userID, productID).Return(true, nil).Times(1)
+			},
+			expectedReceived: true,
+			expectedErr:      nil,
+		},
+		{
+			name: "Product was not received",
+			args: args{
+				ctx:       ctx,
+				userID:    userID,
+				productID: productID,
+			},
+			mock: func(repo *mocks.MockOrderRepo) {
+				repo.EXPECT().HasReceivedProduct(ctx, userID, productID).Return(false, nil).Times(1)
+			},
+			expectedReceived: false,
+			expectedErr:      nil,
+		},
+		{
+			name: "Got error when check received product",
+			args: args{
+				ctx:       ctx,
+				userID:    userID,
+				productID: productID,
+			},
+			mock: func(repo *mocks.MockOrderRepo) {
+				repo.EXPECT().HasReceivedProduct(ctx, userID, productID).Return(false, expectedErrFromRepo).Times(1)
+			},
+			expectedReceived: false,
+			expectedErr:      expectedErrFromRepo,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			orderUseCase, repos := orderHelper(t)
+			testcase.mock(repos.orderRepo)
+
+			actualReceived, actualErr := orderUseCase.HasReceivedProduct(
+				testcase.args.ctx,
+				testcase.args.userID,
+				testcase.args.productID,
+			)
+
+			assert.Equal(t, testcase.expectedReceived, actualReceived)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestGetPurchasedQuantity(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ctx       context.Context
+		userID    uuid.UUID
+		productID uuid.UUID
+		since     time.Time
+	}
+
+	ctx := context.Background()
+	userID := uuid.New()
+	productID := uuid.New()
+	since := time.Now().Add(-24 * time.Hour)
+
+	expectedErrFromRepo := errors.New("test error")
+
+	testcases := []struct {
+		name             string
+		args             args
+		mock             func(repo *mocks.MockOrderRepo)
+		expectedQuantity int64
+		expectedErr      error
+	}{
+		{
+			name: "Successfully get purchased quantity",
+			args: args{
+				ctx:       ctx,
+				userID:    userID,
+				productID: productID,
+				since:     since,
+			},
+			mock: func(repo *mocks.MockOrderRepo) {
+				repo.EXPECT().GetPurchasedQuantity(ctx, userID, productID, since).Return(int64(3), nil).Times(1)
+			},
+			expectedQuantity: 3,
+			expectedErr:      nil,
+		},
+		{
+			name: "Got error when get purchased quantity",
+			args: args{
+				ctx:       ctx,
+				userID:    userID,
+				productID: productID,
+				since:     since,
+			},
+			mock: func(repo *mocks.MockOrderRepo) {
+				repo.EXPECT().GetPurchasedQuantity(ctx, userID, productID, since).Return(int64(0), expectedErrFromRepo).Times(1)
+			},
+			expectedQuantity: 0,
+			expectedErr:      expectedErrFromRepo,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			orderUseCase, repos := orderHelper(t)
+			testcase.mock(repos.orderRepo)
+
+			actualQuantity, actualErr := orderUseCase.GetPurchasedQuantity(
+				testcase.args.ctx,
+				testcase.args.userID,
+				testcase.args.productID,
+				testcase.args.since,
+			)
+
+			assert.Equal(t, testcase.expectedQuantity, actualQuantity)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestIssueInvoice(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ctx     context.Context
+		invoice *model.Invoice
+	}
+
+	ctx := context.Background()
+	orderID := uuid.New()
+	sellerID := uuid.New()
+
+	newInvoice := &model.Invoice{
+		ID:       uuid.New(),
+		OrderID:  orderID,
+		SellerID: sellerID,
+	}
+
+	issuedInvoice := &model.Invoice{
+		ID:       uuid.New(),
+		Number:   model.InvoiceNumber(sellerID, 1),
+		OrderID:  orderID,
+		SellerID: sellerID,
+	}
+
+	expectedErrFromRepo := errors.New("test error")
+
+	testcases := []struct {
+		name            string
+		args            args
+		mock            func(repo *mocks.MockInvoiceRepo)
+		expectedInvoice *model.Invoice
+		expectedErr     error
+	}{
+		{
+			name: "Successfully issue invoice",
+			args: args{
+				ctx:     ctx,
+				invoice: newInvoice,
+			},
+			mock: func(repo *mocks.MockInvoiceRepo) {
+				repo.EXPECT().IssueInvoice(ctx, newInvoice).Return(issuedInvoice, nil).Times(1)
+			},
+			expectedInvoice: issuedInvoice,
+			expectedErr:     nil,
+		},
+		{
+			name: "Got error when issue invoice",
+			args: args{
+				ctx:     ctx,
+				invoice: newInvoice,
+			},
+			mock: func(repo *mocks.MockInvoiceRepo) {
+				repo.EXPECT().IssueInvoice(ctx, newInvoice).Return(nil, expectedErrFromRepo).Times(1)
+			},
+			expectedInvoice: nil,
+			expectedErr:     expectedErrFromRepo,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			orderUseCase, repos := orderHelper(t)
+			testcase.mock(repos.invoiceRepo)
+
+			actualInvoice, actualErr := orderUseCase.IssueInvoice(
+				testcase.args.ctx,
+				testcase.args.invoice,
+			)
+
+			assert.Equal(t, testcase.expectedInvoice, actualInvoice)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestGetPromoCode(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ctx  context.Context
+		code string
+	}
+
+	ctx := context.Background()
+	code := "SALE10"
+
+	expectedPromoFromRepo := &model.PromoCode{
+		Code: code,
+	}
+	expectedErrFromRepo := errors.New("test error")
+
+	testcases := []struct {
+		name          string
+		args          args
+		mock          func(repo *mocks.MockPromoRepo)
+		expectedPromo *model.PromoCode
+		expectedErr   error
+	}{
+		{
+			name: "Successfully get promo code",
+			args: args{
+				ctx:  ctx,
+				code: code,
+			},
+			mock: func(repo *mocks.MockPromoRepo) {
+				repo.EXPECT().GetPromoCode(ctx, code).Return(expectedPromoFromRepo, nil).Times(1)
+			},
+			expectedPromo: expectedPromoFromRepo,
+			expectedErr:   nil,
+		},
+		{
+			name: "Got error when get promo code",
+			args: args{
+				ctx:  ctx,
+				code: code,
+			},
+			mock: func(repo *mocks.MockPromoRepo) {
+				repo.EXPECT().GetPromoCode(ctx, code).Return(nil, expectedErrFromRepo).Times(1)
+			},
+			expectedPromo: nil,
+			expectedErr:   expectedErrFromRepo,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			orderUseCase, repos := orderHelper(t)
+			testcase.mock(repos.promoRepo)
+
+			actualPromo, actualErr := orderUseCase.GetPromoCode(testcase.args.ctx, testcase.args.code)
+
+			assert.Equal(t, testcase.expectedPromo, actualPromo)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestCreatePromoCode(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ctx   context.Context
+		promo model.PromoCode
+	}
+
+	ctx := context.Background()
+	promo := model.PromoCode{
+		Code:  "SALE10",
+		Kind:  model.PercentPromo,
+		Value: 10,
+	}
+
+	expectedPromoFromRepo := &promo
+	expectedErrFromRepo := errors.New("test error")
+
+	testcases := []struct {
+		name          string
+		args          args
+		mock          func(repo *mocks.MockPromoRepo)
+		expectedPromo *model.PromoCode
+		expectedErr   error
+	}{
+		{
+			name: "Successfully create promo code",
+			args: args{
+				ctx:   ctx,
+				promo: promo,
+			},
+			mock: func(repo *mocks.MockPromoRepo) {
+				repo.EXPECT().CreatePromoCode(ctx, promo).Return(nil).Times(1)
+				repo.EXPECT().GetPromoCode(ctx, promo.Code).Return(expectedPromoFromRepo, nil).Times(1)
+			},
+			expectedPromo: expectedPromoFromRepo,
+			expectedErr:   nil,
+		},
+		{
+			name: "Got error when create promo code",
+			args: args{
+				ctx:   ctx,
+				promo: promo,
+			},
+			mock: func(repo *mocks.MockPromoRepo) {
+				repo.EXPECT().CreatePromoCode(ctx, promo).Return(expectedErrFromRepo).Times(1)
+			},
+			expectedPromo: nil,
+			expectedErr:   expectedErrFromRepo,
+		},
+		{
+			name: "Got error when get promo code",
+			args: args{
+				ctx:   ctx,
+				promo: promo,
+			},
+			mock: func(repo *mocks.MockPromoRepo) {
+				repo.EXPECT().CreatePromoCode(ctx, promo).Return(nil).Times(1)
+				repo.EXPECT().GetPromoCode(ctx, promo.Code).Return(nil, expectedErrFromRepo).Times(1)
+			},
+			expectedPromo: nil,
+			expectedErr:   expectedErrFromRepo,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			orderUseCase, repos := orderHelper(t)
+			testcase.mock(repos.promoRepo)
+
+			actualPromo, actualErr := orderUseCase.CreatePromoCode(testcase.args.ctx, testcase.args.promo)
+
+			assert.Equal(t, testcase.expectedPromo, actualPromo)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestCountUserRedemptions(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ctx    context.Context
+		code   string
+		userID uuid.UUID
+	}
+
+	ctx := context.Background()
+	code := "SALE10"
+	userID := uuid.New()
+
+	expectedErrFromRepo := errors.New("test error")
+
+	testcases := []struct {
+		name          string
+		args          args
+		mock          func(repo *mocks.MockPromoRepo)
+		expectedCount int64
+		expectedErr   error
+	}{
+		{
+			name: "Successfully count user redemptions",
+			args: args{
+				ctx:    ctx,
+				code:   code,
+				userID: userID,
+			},
+			mock: func(repo *mocks.MockPromoRepo) {
+				repo.EXPECT().CountUserRedemptions(ctx, code, userID).Return(int64(2), nil).Times(1)
+			},
+			expectedCount: 2,
+			expectedErr:   nil,
+		},
+		{
+			name: "Got error when count user redemptions",
+			args: args{
+				ctx:    ctx,
+				code:   code,
+				userID: userID,
+			},
+			mock: func(repo *mocks.MockPromoRepo) {
+				repo.EXPECT().CountUserRedemptions(ctx, code, userID).Return(int64(0), expectedErrFromRepo).Times(1)
+			},
+			expectedCount: 0,
+			expectedErr:   expectedErrFromRepo,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			orderUseCase, repos := orderHelper(t)
+			testcase.mock(repos.promoRepo)
+
+			actualCount, actualErr := orderUseCase.CountUserRedemptions(
+				testcase.args.ctx,
+				testcase.args.code,
+				testcase.args.userID,
+			)
+
+			assert.Equal(t, testcase.expectedCount, actualCount)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestUpdateOrderlineLedger(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sellerID := uuid.New()
+	orderID := uuid.New()
+	productID := uuid.New()
+
+	currentOrderline := func(categoryID int32, price int64) *model.Orderline {
+		return &model.Orderline{
+			OrderID:    orderID,
+			ProductID:  productID,
+			SellerID:   sellerID,
+			CategoryID: categoryID,
+			Price:      price,
+			Quantity:   1,
+			Status:     model.Delivery,
+		}
+	}
+	sale := model.NewSaleTransaction(currentOrderline(1, 1000), 100, time.Now())
+	expectedErrFromRepo := errors.New("test error")
+
+	testcases := []struct {
+		name        string
+		status      model.OrderlineStatus
+		mock        func(orderRepo *mocks.MockOrderRepo, ledgerRepo *mocks.MockLedgerRepo)
+		expectedErr error
+	}{
+		{
+			name:   "Received orderline credits the seller minus the category commission",
+			status: model.Recieved,
+			mock: func(orderRepo *mocks.MockOrderRepo, ledgerRepo *mocks.MockLedgerRepo) {
+				orderRepo.EXPECT().GetOrderline(ctx, orderID, productID).Return(currentOrderline(3, 1000), nil).Times(1)
+				orderRepo.EXPECT().UpdateOrderline(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ *model.Orderline, sale *model.LedgerTransaction) error {
+						assert.Equal(t, model.SaleTransaction, sale.Kind)
+						assert.Equal(t, int64(950), sale.Amount(model.SellerAccount(sellerID)))
+						assert.Equal(t, int64(50), sale.Amount(model.CommissionAccount))
+						return nil
+					},
+				).Times(1)
+				orderRepo.EXPECT().GetOrderline(ctx, orderID, productID).Return(currentOrderline(3, 1000), nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:   "Received orderline credits the seller minus the default commission",
+			status: model.Recieved,
+			mock: func(orderRepo *mocks.MockOrderRepo, ledgerRepo *mocks.MockLedgerRepo) {
+				orderRepo.EXPECT().GetOrderline(ctx, orderID, productID).Return(currentOrderline(1, 1000), nil).Times(1)
+				orderRepo.EXPECT().UpdateOrderline(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ *model.Orderline, sale *model.LedgerTransaction) error {
+						assert.Equal(t, int64(900), sale.Amount(model.SellerAccount(sellerID)))
+						return nil
+					},
+				).Times(1)
+				orderRepo.EXPECT().GetOrderline(ctx, orderID, productID).Return(currentOrderline(1, 1000), nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:   "Empty orderline is not credited",
+			status: model.Recieved,
+			mock: func(orderRepo *mocks.MockOrderRepo, ledgerRepo *mocks.MockLedgerRepo) {
+				orderRepo.EXPECT().GetOrderline(ctx, orderID, productID).Return(currentOrderline(1, 0), nil).Times(1)
+				orderRepo.EXPECT().UpdateOrderline(ctx, gomock.Any(), nil).Return(nil).Times(1)
+				orderRepo.EXPECT().GetOrderline(ctx, orderID, productID).Return(currentOrderline(1, 0), nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:   "Canceled orderline reverses the sale",
+			status: model.Canceled,
+			mock: func(orderRepo *mocks.MockOrderRepo, ledgerRepo *mocks.MockLedgerRepo) {
+				ledgerRepo.EXPECT().GetOrderlineTransaction(ctx, model.SaleTransaction, orderID, productID).Return(sale, nil).Times(1)
+				orderRepo.EXPECT().UpdateOrderline(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ *model.Orderline, refund *model.LedgerTransaction) error {
+						assert.Equal(t, model.RefundTransaction, refund.Kind)
+						assert.Equal(t, int64(-900), refund.Amount(model.SellerAccount(sellerID)))
+						return nil
+					},
+				).Times(1)
+				orderRepo.EXPECT().GetOrderline(ctx, orderID, productID).Return(currentOrderline(1, 1000), nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:   "Canceled orderline without sale is not refunded",
+			status: model.Canceled,
+			mock: func(orderRepo *mocks.MockOrderRepo, ledgerRepo *mocks.MockLedgerRepo) {
+				ledgerRepo.EXPECT().GetOrderlineTransaction(ctx, model.SaleTransaction, orderID, productID).Return(nil, nil).Times(1)
+				orderRepo.EXPECT().UpdateOrderline(ctx, gomock.Any(), nil).Return(nil).Times(1)
+				orderRepo.EXPECT().GetOrderline(ctx, orderID, productID).Return(currentOrderline(1, 1000), nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:   "Got error when get sale",
+			status: model.Canceled,
+			mock: func(orderRepo *mocks.MockOrderRepo, ledgerRepo *mocks.MockLedgerRepo) {
+				ledgerRepo.EXPECT().GetOrderlineTransaction(ctx, model.SaleTransaction, orderID, productID).Return(nil, expectedErrFromRepo).Times(1)
+			},
+			expectedErr: expectedErrFromRepo,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			orderUsecase, repos := orderHelper(t)
+			testcase.mock(repos.orderRepo, repos.ledgerRepo)
+
+			_, actualErr := orderUsecase.UpdateOrderline(ctx, &model.Orderline{
+				OrderID:   orderID,
+				ProductID: productID,
+				Status:    testcase.status,
+			})
+
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestOrderThreadEvents(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	orderID := uuid.New()
+
+	message := &model.OrderMessage{
+		ID:      uuid.New(),
+		OrderID: orderID,
+		Body:    "Hello",
+	}
+	receipt := &model.ReadReceipt{
+		OrderID: orderID,
+		UserID:  uuid.New(),
+		ReadAt:  time.Now(),
+	}
+	expectedErrFromRepo := errors.New("test error")
+
+	orderUsecase, repos := orderHelper(t)
+	messageRepo := repos.messageRepo
+
+	events, unsubscribe := orderUsecase.WatchOrderThread(orderID)
+	defer unsubscribe()
+
+	messageRepo.EXPECT().CreateOrderMessage(ctx, message).Return(nil).Times(1)
+	assert.NoError(t, orderUsecase.CreateOrderMessage(ctx, message))
+	assert.Equal(t, &model.ThreadEvent{Message: message}, <-events)
+
+	messageRepo.EXPECT().SetReadReceipt(ctx, receipt).Return(nil).Times(1)
+	assert.NoError(t, orderUsecase.MarkThreadRead(ctx, receipt))
+	assert.Equal(t, &model.ThreadEvent{Receipt: receipt}, <-events)
+
+	messageRepo.EXPECT().CreateOrderMessage(ctx, message).Return(expectedErrFromRepo).Times(1)
+	assert.Equal(t, expectedErrFromRepo, orderUsecase.CreateOrderMessage(ctx, message))
+	assert.Len(t, events, 0)
+}
+
+func TestWatchOrder(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	orderID := uuid.New()
+	productID := uuid.New()
+
+	orderline := &model.Orderline{
+		OrderID:   orderID,
+		ProductID: productID,
+		Status:    model.Delivery,
+		UpdatedAt: time.Now(),
+	}
+	expectedErrFromRepo := errors.New("test error")
+
+	orderUsecase, repos := orderHelper(t)
+	orderRepo := repos.orderRepo
+
+	events, unsubscribe := orderUsecase.WatchOrder(orderID)
+	defer unsubscribe()
+
+	orderRepo.EXPECT().UpdateOrderline(ctx, orderline, nil).Return(nil).Times(1)
+	orderRepo.EXPECT().GetOrderline(ctx, orderID, productID).Return(orderline, nil).Times(1)
+	_, err := orderUsecase.UpdateOrderline(ctx, orderline)
+	assert.NoError(t, err)
+	assert.Equal(t, model.NewOrderlineStatusEvent(orderline), <-events)
+
+	orderRepo.EXPECT().DeleteOrderline(ctx, orderID, productID).Return(expectedErrFromRepo).Times(1)
+	assert.Equal(t, expectedErrFromRepo, orderUsecase.DeleteOrderline(ctx, orderID, productID))
+	assert.Len(t, events, 0)
+
+	orderRepo.EXPECT().DeleteOrder(ctx, orderID).Return(nil).Times(1)
+	assert.NoError(t, orderUsecase.DeleteOrder(ctx, orderID))
+
+	event := <-events
+	assert.Equal(t, model.OrderDeletedEvent, event.Kind)
+	assert.Equal(t, orderID, event.OrderID)
+
+	userID := uuid.New()
+	orderRepo.EXPECT().DeleteUserOrders(ctx, userID).Return([]uuid.UUID{orderID}, nil).Times(1)
+	assert.NoError(t, orderUsecase.DeleteUserOrders(ctx, userID))
+
+	event = <-events
+	assert.Equal(t, model.OrderDeletedEvent, event.Kind)
+	assert.Equal(t, orderID, event.OrderID)
+}
+
+func TestGetSalesReport(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	searchParams := dto.SalesReportDTO{
+		From:     time.Now().Add(-24 * time.Hour),
+		To:       time.Now(),
+		Period:   model.MonthPeriod,
+		GroupBy:  model.SalesByCategory,
+		SellerID: uuid.New(),
+	}
+
+	expectedRowsFromRepo := []*model.SalesReportRow{
+		{CategoryID: 1, Currency: "RUB", Orders: 1, Units: 2, Revenue: 300},
+	}
+	expectedErrFromRepo := errors.New("test error")
+
+	testcases := []struct {
+		name         string
+		mock         func(repo *mocks.MockReportRepo)
+		expectedRows []*model.SalesReportRow
+		expectedErr  error
+	}{
+		{
+			name: "Successfully get sales report",
+			mock: func(repo *mocks.MockReportRepo) {
+				repo.EXPECT().GetSalesReport(ctx, searchParams).Return(expectedRowsFromRepo, nil).Times(1)
+			},
+			expectedRows: expectedRowsFromRepo,
+			expectedErr:  nil,
+		},
+		{
+			name: "Get repo error",
+			mock: func(repo *mocks.MockReportRepo) {
+				repo.EXPECT().GetSalesReport(ctx, searchParams).Return(nil, expectedErrFromRepo).Times(1)
+			},
+			expectedRows: nil,
+			expectedErr:  expectedErrFromRepo,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			orderUseCase, repos := orderHelper(t)
+			testcase.mock(repos.reportRepo)
+
+			actualRows, actualErr := orderUseCase.GetSalesReport(ctx, searchParams)
+			assert.Equal(t, testcase.expectedRows, actualRows)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/Go-Marketplace/backend/order/internal/usecase"
+	"github.com/Go-Marketplace/backend/pkg/logger"
+	"gopkg.in/tomb.v2"
+)
+
+type SalesWorkerConfig struct {
+	SalesWorkerInterval time.Duration
+}
+
+// Refreshes the sales aggregates of the reports, the reports show the orders
+// placed since the last refresh only after the next one
+type salesWorker struct {
+	tomb         tomb.Tomb
+	orderUsecase *usecase.OrderUsecase
+	config       SalesWorkerConfig
+	logger       *logger.Logger
+}
+
+func NewSalesWorker(
+	orderUsecase *usecase.OrderUsecase,
+	config SalesWorkerConfig,
+	logger *logger.Logger,
+) *salesWorker {
+	return &salesWorker{
+		tomb:         tomb.Tomb{},
+		orderUsecase: orderUsecase,
+		config:       config,
+		logger:       logger,
+	}
+}
+
+func (worker *salesWorker) Run(ctx context.Context) {
+	worker.logger.Info("Start sales worker with %v interval", worker.config.SalesWorkerInterval)
+
+	worker.tomb.Go(func() error {
+		ticker := time.NewTicker(worker.config.SalesWorkerInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-worker.tomb.Dying():
+				worker.logger.Info("Stop running sales worker")
+				return nil
+
+			case <-ticker.C:
+				if err := worker.orderUsecase.RefreshSales(ctx); err != nil {
+					worker.logger.Error("Cannot refresh sales: %s", err.Error())
+				}
+			}
+		}
+	})
+}
+
+func (worker *salesWorker) Stop() error {
+	worker.logger.Info("Stop sales worker")
+	worker.tomb.Kill(nil)
+	return worker.tomb.Wait()
+}
@@ -0,0 +1,49 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS promo_codes (
+    code TEXT NOT NULL PRIMARY KEY,
+    kind INTEGER NOT NULL,
+    value BIGINT NOT NULL,
+    min_total BIGINT NOT NULL DEFAULT 0,
+    max_uses BIGINT NOT NULL DEFAULT 0,
+    per_user_limit BIGINT NOT NULL DEFAULT 0,
+    category_ids INTEGER[] NOT NULL DEFAULT '{}',
+    seller_ids UUID[] NOT NULL DEFAULT '{}',
+    starts_at TIMESTAMP NOT NULL,
+    ends_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+
+    CHECK (value > 0),
+    CHECK (ends_at > starts_at)
+);
+
+CREATE TABLE IF NOT EXISTS promo_redemptions (
+    order_id UUID NOT NULL PRIMARY KEY,
+    code TEXT NOT NULL,
+    user_id UUID NOT NULL,
+    amount BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+
+    FOREIGN KEY (order_id) REFERENCES orders(order_id) ON DELETE CASCADE,
+    FOREIGN KEY (code) REFERENCES promo_codes(code) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS promo_redemptions_code_user_id_idx ON promo_redemptions (code, user_id);
+
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS promo_code TEXT NOT NULL DEFAULT '';
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS promo_discount BIGINT NOT NULL DEFAULT 0;
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+ALTER TABLE orders DROP COLUMN IF EXISTS promo_discount;
+ALTER TABLE orders DROP COLUMN IF EXISTS promo_code;
+
+DROP TABLE IF EXISTS promo_redemptions;
+
+DROP TABLE IF EXISTS promo_codes;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
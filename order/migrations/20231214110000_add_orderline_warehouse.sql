@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE orderlines ADD COLUMN IF NOT EXISTS warehouse_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000';
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+ALTER TABLE orderlines DROP COLUMN IF EXISTS warehouse_id;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
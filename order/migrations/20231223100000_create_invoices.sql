@@ -0,0 +1,47 @@
+-- +goose Up
+-- Buyer, shipping address, seller and discount are stored with the order,
+-- so invoices show them as they were at checkout
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS buyer_name TEXT NOT NULL DEFAULT '';
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS buyer_email TEXT NOT NULL DEFAULT '';
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS shipping_address TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE orderlines ADD COLUMN IF NOT EXISTS seller_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000';
+ALTER TABLE orderlines ADD COLUMN IF NOT EXISTS discount_percent REAL NOT NULL DEFAULT 0;
+
+-- Last invoice number of every seller, the row is locked while the next invoice is issued
+CREATE TABLE IF NOT EXISTS invoice_counters (
+    seller_id UUID NOT NULL PRIMARY KEY,
+    last_number BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS invoices (
+    invoice_id UUID NOT NULL PRIMARY KEY,
+    number TEXT NOT NULL UNIQUE,
+    order_id UUID NOT NULL,
+    seller_id UUID NOT NULL,
+    seller_name TEXT NOT NULL,
+    issued_at TIMESTAMP NOT NULL,
+
+    UNIQUE (order_id, seller_id),
+    FOREIGN KEY (order_id) REFERENCES orders(order_id) ON DELETE CASCADE
+);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE IF EXISTS invoices;
+
+DROP TABLE IF EXISTS invoice_counters;
+
+ALTER TABLE orderlines DROP COLUMN IF EXISTS discount_percent;
+ALTER TABLE orderlines DROP COLUMN IF EXISTS seller_id;
+
+ALTER TABLE orders DROP COLUMN IF EXISTS shipping_address;
+ALTER TABLE orders DROP COLUMN IF EXISTS buyer_email;
+ALTER TABLE orders DROP COLUMN IF EXISTS buyer_name;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
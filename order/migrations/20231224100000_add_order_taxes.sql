@@ -0,0 +1,29 @@
+-- +goose Up
+-- Taxes are calculated at checkout by the shipping region and stored with the order,
+-- so later changes of the tax rules do not change the placed orders
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS shipping_region TEXT NOT NULL DEFAULT '';
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS subtotal BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS discount BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS tax BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS total BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE orderlines ADD COLUMN IF NOT EXISTS tax_rate REAL NOT NULL DEFAULT 0;
+ALTER TABLE orderlines ADD COLUMN IF NOT EXISTS tax BIGINT NOT NULL DEFAULT 0;
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+ALTER TABLE orderlines DROP COLUMN IF EXISTS tax;
+ALTER TABLE orderlines DROP COLUMN IF EXISTS tax_rate;
+
+ALTER TABLE orders DROP COLUMN IF EXISTS total;
+ALTER TABLE orders DROP COLUMN IF EXISTS tax;
+ALTER TABLE orders DROP COLUMN IF EXISTS discount;
+ALTER TABLE orders DROP COLUMN IF EXISTS subtotal;
+ALTER TABLE orders DROP COLUMN IF EXISTS shipping_region;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
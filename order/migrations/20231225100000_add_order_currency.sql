@@ -0,0 +1,23 @@
+-- +goose Up
+-- Orders are paid in the currency of the buyer, the orderlines keep the prices
+-- in the currency of the seller with the exchange rate at checkout
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS currency TEXT NOT NULL DEFAULT 'RUB';
+
+ALTER TABLE orderlines ADD COLUMN IF NOT EXISTS currency TEXT NOT NULL DEFAULT 'RUB';
+ALTER TABLE orderlines ADD COLUMN IF NOT EXISTS exchange_rate DOUBLE PRECISION NOT NULL DEFAULT 1;
+ALTER TABLE orderlines ADD COLUMN IF NOT EXISTS buyer_total BIGINT NOT NULL DEFAULT 0;
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+ALTER TABLE orderlines DROP COLUMN IF EXISTS buyer_total;
+ALTER TABLE orderlines DROP COLUMN IF EXISTS exchange_rate;
+ALTER TABLE orderlines DROP COLUMN IF EXISTS currency;
+
+ALTER TABLE orders DROP COLUMN IF EXISTS currency;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
@@ -0,0 +1,57 @@
+-- +goose Up
+-- Category is stored with the orderline, so the commission is taken by the category at checkout
+ALTER TABLE orderlines ADD COLUMN IF NOT EXISTS category_id INT NOT NULL DEFAULT 0;
+
+-- Accounts of the double-entry ledger, the row of an account is locked while it is settled
+CREATE TABLE IF NOT EXISTS ledger_accounts (
+    account TEXT NOT NULL PRIMARY KEY
+);
+
+-- Transactions are not removed with the orders, the ledger keeps the whole history.
+-- Payouts have no order and product, they are stored as nil uuids
+CREATE TABLE IF NOT EXISTS ledger_transactions (
+    transaction_id UUID NOT NULL PRIMARY KEY,
+    kind INT NOT NULL,
+    seller_id UUID NOT NULL,
+    order_id UUID NOT NULL,
+    product_id UUID NOT NULL,
+    currency TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+
+-- An orderline is credited and refunded at most once
+CREATE UNIQUE INDEX IF NOT EXISTS ledger_transactions_orderline_idx
+    ON ledger_transactions (kind, order_id, product_id) WHERE kind IN (0, 1);
+
+CREATE INDEX IF NOT EXISTS ledger_transactions_seller_idx ON ledger_transactions (seller_id);
+
+CREATE TABLE IF NOT EXISTS ledger_entries (
+    entry_id UUID NOT NULL PRIMARY KEY,
+    transaction_id UUID NOT NULL,
+    account TEXT NOT NULL,
+    debit BIGINT NOT NULL DEFAULT 0 CHECK (debit >= 0),
+    credit BIGINT NOT NULL DEFAULT 0 CHECK (credit >= 0),
+
+    CHECK (debit = 0 OR credit = 0),
+    FOREIGN KEY (transaction_id) REFERENCES ledger_transactions(transaction_id) ON DELETE CASCADE,
+    FOREIGN KEY (account) REFERENCES ledger_accounts(account)
+);
+
+CREATE INDEX IF NOT EXISTS ledger_entries_account_idx ON ledger_entries (account);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE IF EXISTS ledger_entries;
+
+DROP TABLE IF EXISTS ledger_transactions;
+
+DROP TABLE IF EXISTS ledger_accounts;
+
+ALTER TABLE orderlines DROP COLUMN IF EXISTS category_id;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
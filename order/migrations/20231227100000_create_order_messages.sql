@@ -0,0 +1,39 @@
+-- +goose Up
+-- Messages of the order thread between the buyer, the sellers and admins,
+-- the attachments are the URLs of the files in the media store
+CREATE TABLE IF NOT EXISTS order_messages (
+    message_id UUID NOT NULL PRIMARY KEY,
+    order_id UUID NOT NULL,
+    author_id UUID NOT NULL,
+    author_role INT NOT NULL,
+    body TEXT NOT NULL,
+    attachments TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL,
+
+    FOREIGN KEY (order_id) REFERENCES orders(order_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS order_messages_order_idx ON order_messages (order_id, created_at);
+
+-- Last time every participant read the thread, the messages sent until then are read
+CREATE TABLE IF NOT EXISTS order_message_reads (
+    order_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    read_at TIMESTAMP NOT NULL,
+
+    PRIMARY KEY (order_id, user_id),
+    FOREIGN KEY (order_id) REFERENCES orders(order_id) ON DELETE CASCADE
+);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE IF EXISTS order_message_reads;
+
+DROP TABLE IF EXISTS order_messages;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
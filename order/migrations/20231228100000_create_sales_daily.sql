@@ -0,0 +1,56 @@
+-- +goose Up
+-- Sales of every day summed once per grouping of the sales reports, the reports sum
+-- the days up to weeks and months. Canceled orderlines are not sales. The revenue is
+-- the orderline total after the product discount and before the tax, in the currency
+-- of the seller. The grouping_id column is GROUPING(currency, seller_id, category_id, product_id),
+-- a set bit means the column is summed over and stored as its zero value. The groupings
+-- summed over the currency only count the orders for the cart conversion
+CREATE MATERIALIZED VIEW IF NOT EXISTS sales_daily AS
+SELECT
+    GROUPING(
+        orderlines.currency,
+        orderlines.seller_id,
+        orderlines.category_id,
+        orderlines.product_id
+    ) AS grouping_id,
+    date_trunc('day', orders.created_at) AS day,
+    COALESCE(orderlines.currency, '') AS currency,
+    COALESCE(orderlines.seller_id, '00000000-0000-0000-0000-000000000000') AS seller_id,
+    COALESCE(orderlines.category_id, 0) AS category_id,
+    COALESCE(orderlines.product_id, '00000000-0000-0000-0000-000000000000') AS product_id,
+    CASE WHEN GROUPING(orderlines.product_id) = 0 THEN MAX(orderlines.name) ELSE '' END AS name,
+    COUNT(DISTINCT orders.order_id) AS orders,
+    CASE WHEN GROUPING(orderlines.currency) = 0 THEN SUM(orderlines.quantity) ELSE 0 END::BIGINT AS units,
+    CASE WHEN GROUPING(orderlines.currency) = 0 THEN SUM(
+        orderlines.price * orderlines.quantity
+        - FLOOR(orderlines.price * orderlines.quantity * orderlines.discount_percent::DOUBLE PRECISION / 100)
+    ) ELSE 0 END::BIGINT AS revenue
+FROM orders
+JOIN orderlines USING (order_id)
+WHERE orderlines.status <> 0
+GROUP BY
+    date_trunc('day', orders.created_at),
+    GROUPING SETS (
+        (orderlines.currency),
+        (orderlines.currency, orderlines.seller_id),
+        (orderlines.currency, orderlines.category_id),
+        (orderlines.currency, orderlines.seller_id, orderlines.category_id),
+        (orderlines.currency, orderlines.seller_id, orderlines.category_id, orderlines.product_id),
+        (),
+        (orderlines.category_id)
+    );
+
+-- Lets the worker refresh the aggregates concurrently with the reports reading them
+CREATE UNIQUE INDEX IF NOT EXISTS sales_daily_key_idx
+    ON sales_daily (grouping_id, day, currency, seller_id, category_id, product_id);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP MATERIALIZED VIEW IF EXISTS sales_daily;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
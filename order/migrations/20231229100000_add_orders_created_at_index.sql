@@ -0,0 +1,14 @@
+-- +goose Up
+-- The order export reads the orders in pages ordered by the creation time
+CREATE INDEX IF NOT EXISTS orders_created_at_idx ON orders (created_at, order_id);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP INDEX IF EXISTS orders_created_at_idx;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
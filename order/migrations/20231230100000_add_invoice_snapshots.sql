@@ -0,0 +1,48 @@
+-- +goose Up
+-- Invoices store the order as it was when they were issued, so they outlive
+-- the changes and the deletion of the order
+ALTER TABLE invoices DROP CONSTRAINT IF EXISTS invoices_order_id_fkey;
+
+ALTER TABLE invoices ADD COLUMN IF NOT EXISTS user_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000';
+ALTER TABLE invoices ADD COLUMN IF NOT EXISTS buyer_name TEXT NOT NULL DEFAULT '';
+ALTER TABLE invoices ADD COLUMN IF NOT EXISTS buyer_email TEXT NOT NULL DEFAULT '';
+ALTER TABLE invoices ADD COLUMN IF NOT EXISTS shipping_address TEXT NOT NULL DEFAULT '';
+ALTER TABLE invoices ADD COLUMN IF NOT EXISTS ordered_at TIMESTAMP NOT NULL DEFAULT NOW();
+ALTER TABLE invoices ADD COLUMN IF NOT EXISTS currency TEXT NOT NULL DEFAULT '';
+ALTER TABLE invoices ADD COLUMN IF NOT EXISTS promo_discount BIGINT NOT NULL DEFAULT 0;
+-- NULL for the invoices issued before, their lines are still taken from the order
+ALTER TABLE invoices ADD COLUMN IF NOT EXISTS lines JSONB;
+
+UPDATE invoices
+SET user_id = orders.user_id
+FROM orders
+WHERE orders.order_id = invoices.order_id;
+
+-- Invoice numbers are sequential per seller
+ALTER TABLE invoices DROP CONSTRAINT IF EXISTS invoices_number_key;
+ALTER TABLE invoices ADD CONSTRAINT invoices_seller_id_number_key UNIQUE (seller_id, number);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+ALTER TABLE invoices DROP CONSTRAINT IF EXISTS invoices_seller_id_number_key;
+ALTER TABLE invoices ADD CONSTRAINT invoices_number_key UNIQUE (number);
+
+ALTER TABLE invoices DROP COLUMN IF EXISTS lines;
+ALTER TABLE invoices DROP COLUMN IF EXISTS promo_discount;
+ALTER TABLE invoices DROP COLUMN IF EXISTS currency;
+ALTER TABLE invoices DROP COLUMN IF EXISTS ordered_at;
+ALTER TABLE invoices DROP COLUMN IF EXISTS shipping_address;
+ALTER TABLE invoices DROP COLUMN IF EXISTS buyer_email;
+ALTER TABLE invoices DROP COLUMN IF EXISTS buyer_name;
+ALTER TABLE invoices DROP COLUMN IF EXISTS user_id;
+
+DELETE FROM invoices WHERE order_id NOT IN (SELECT order_id FROM orders);
+ALTER TABLE invoices ADD CONSTRAINT invoices_order_id_fkey
+    FOREIGN KEY (order_id) REFERENCES orders(order_id) ON DELETE CASCADE;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
@@ -0,0 +1,39 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+var ErrUnknownCurrency = errors.New("unknown currency")
+
+// Normalizes the currency code, so that codes match in any case
+func NormalizeCurrency(currency string) string {
+	return strings.ToUpper(strings.TrimSpace(currency))
+}
+
+// Prices of one unit of the currencies in the base currency of the marketplace,
+// the base currency itself has the rate 1
+type ExchangeRates map[string]float64
+
+// Returns how many units of the to currency one unit of the from currency costs
+func (rates ExchangeRates) Rate(from, to string) (float64, error) {
+	fromRate, ok := rates[from]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrUnknownCurrency, from)
+	}
+
+	toRate, ok := rates[to]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrUnknownCurrency, to)
+	}
+
+	return fromRate / toRate, nil
+}
+
+// Converts the amount in minor units with the rate, rounded to the nearest unit
+func ConvertAmount(amount int64, rate float64) int64 {
+	return int64(math.Round(float64(amount) * rate))
+}
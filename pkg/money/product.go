@@ -0,0 +1,48 @@
+package money
+
+import (
+	"context"
+	"time"
+
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+	"google.golang.org/grpc/status"
+)
+
+// Returns the base currency of the marketplace and the exchange rates of the product service
+func GetExchangeRates(ctx context.Context, productClient pbProduct.ProductClient) (string, ExchangeRates, error) {
+	ratesResp, err := productClient.GetExchangeRates(ctx, &pbProduct.GetExchangeRatesRequest{})
+	if err != nil {
+		return "", nil, status.Errorf(status.Code(err), "Failed to get exchange rates: %s", status.Convert(err).Message())
+	}
+
+	rates := ExchangeRates{
+		ratesResp.BaseCurrency: 1,
+	}
+	for _, rate := range ratesResp.Rates {
+		rates[rate.Currency] = rate.Rate
+	}
+
+	return ratesResp.BaseCurrency, rates, nil
+}
+
+// Returns the currency the product is priced in, the products without one are in the base currency
+func ProductCurrency(product *pbProduct.ProductResponse, baseCurrency string) string {
+	if currency := NormalizeCurrency(product.Currency); currency != "" {
+		return currency
+	}
+
+	return baseCurrency
+}
+
+// Reports whether the product discount is active at the moment
+func DiscountActiveAt(discount *pbProduct.DiscountResponse, moment time.Time) bool {
+	if discount == nil {
+		return false
+	}
+
+	if discount.StartsAt != nil && moment.Before(discount.StartsAt.AsTime()) {
+		return false
+	}
+
+	return discount.EndedAt == nil || moment.Before(discount.EndedAt.AsTime())
+}
@@ -0,0 +1,63 @@
+package money_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Go-Marketplace/backend/pkg/money"
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestDiscountActiveAt(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	testcases := []struct {
+		name     string
+		discount *pbProduct.DiscountResponse
+		expected bool
+	}{
+		{
+			name: "Discount is active",
+			discount: &pbProduct.DiscountResponse{
+				StartsAt: timestamppb.New(now.Add(-time.Hour)),
+				EndedAt:  timestamppb.New(now.Add(time.Hour)),
+			},
+			expected: true,
+		},
+		{
+			name: "Discount has not started",
+			discount: &pbProduct.DiscountResponse{
+				StartsAt: timestamppb.New(now.Add(time.Hour)),
+				EndedAt:  timestamppb.New(now.Add(2 * time.Hour)),
+			},
+			expected: false,
+		},
+		{
+			name: "Discount has ended",
+			discount: &pbProduct.DiscountResponse{
+				StartsAt: timestamppb.New(now.Add(-2 * time.Hour)),
+				EndedAt:  timestamppb.New(now.Add(-time.Hour)),
+			},
+			expected: false,
+		},
+		{
+			name:     "No discount",
+			discount: nil,
+			expected: false,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testcase.expected, money.DiscountActiveAt(testcase.discount, now))
+		})
+	}
+}
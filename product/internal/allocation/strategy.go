@@ -0,0 +1,97 @@
+// Package allocation chooses the warehouse a product reservation is taken from
+package allocation
+
+import (
+	"sync"
+
+	"github.com/Go-Marketplace/backend/product/internal/model"
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+)
+
+// Strategy chooses one of the product stocks that can fit the whole quantity.
+// Destination is the shipping location and may be nil
+type Strategy interface {
+	Choose(stocks []*model.WarehouseStock, quantity int64, destination *model.Location) (*model.WarehouseStock, error)
+}
+
+var (
+	mu         sync.RWMutex
+	strategies = map[pbProduct.AllocationStrategy]Strategy{
+		pbProduct.AllocationStrategy_MOST_STOCK: MostStock{},
+		pbProduct.AllocationStrategy_NEAREST:    Nearest{Fallback: MostStock{}},
+	}
+)
+
+// Register replaces the strategy used for the given kind
+func Register(kind pbProduct.AllocationStrategy, strategy Strategy) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	strategies[kind] = strategy
+}
+
+// Get returns the strategy registered for the kind, unknown kinds fall back to MostStock
+func Get(kind pbProduct.AllocationStrategy) Strategy {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	if strategy, ok := strategies[kind]; ok {
+		return strategy
+	}
+
+	return MostStock{}
+}
+
+// MostStock takes the product from the warehouse that has the most of it
+type MostStock struct{}
+
+func (MostStock) Choose(stocks []*model.WarehouseStock, quantity int64, _ *model.Location) (*model.WarehouseStock, error) {
+	var chosen *model.WarehouseStock
+	for _, stock := range stocks {
+		if stock == nil || stock.Quantity < quantity {
+			continue
+		}
+
+		if chosen == nil || stock.Quantity > chosen.Quantity {
+			chosen = stock
+		}
+	}
+
+	if chosen == nil {
+		return nil, model.ErrInsufficientStock
+	}
+
+	return chosen, nil
+}
+
+// Nearest takes the product from the closest warehouse to the destination.
+// Without a destination the Fallback strategy is used
+type Nearest struct {
+	Fallback Strategy
+}
+
+func (strategy Nearest) Choose(stocks []*model.WarehouseStock, quantity int64, destination *model.Location) (*model.WarehouseStock, error) {
+	if destination == nil {
+		return strategy.Fallback.Choose(stocks, quantity, destination)
+	}
+
+	var chosen *model.WarehouseStock
+	var chosenDistance float64
+	for _, stock := range stocks {
+		if stock == nil || stock.Quantity < quantity {
+			continue
+		}
+
+		distance := stock.Location.DistanceTo(*destination)
+		if chosen == nil || distance < chosenDistance {
+			chosen = stock
+			chosenDistance = distance
+		}
+	}
+
+	if chosen == nil {
+		return nil, model.ErrInsufficientStock
+	}
+
+	return chosen, nil
+}
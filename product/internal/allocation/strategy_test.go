@@ -0,0 +1,91 @@
+package allocation_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Go-Marketplace/backend/product/internal/allocation"
+	"github.com/Go-Marketplace/backend/product/internal/model"
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+)
+
+func TestChooseWarehouse(t *testing.T) {
+	t.Parallel()
+
+	moscow := &model.WarehouseStock{
+		WarehouseID: uuid.New(),
+		Quantity:    5,
+		Location:    model.Location{Latitude: 55.75, Longitude: 37.62},
+	}
+	kazan := &model.WarehouseStock{
+		WarehouseID: uuid.New(),
+		Quantity:    20,
+		Location:    model.Location{Latitude: 55.79, Longitude: 49.12},
+	}
+	stocks := []*model.WarehouseStock{moscow, kazan}
+
+	testcases := []struct {
+		name          string
+		kind          pbProduct.AllocationStrategy
+		quantity      int64
+		destination   *model.Location
+		expectedStock *model.WarehouseStock
+		expectedErr   error
+	}{
+		{
+			name:          "Most stock",
+			kind:          pbProduct.AllocationStrategy_MOST_STOCK,
+			quantity:      1,
+			destination:   nil,
+			expectedStock: kazan,
+			expectedErr:   nil,
+		},
+		{
+			name:          "Nearest to destination",
+			kind:          pbProduct.AllocationStrategy_NEAREST,
+			quantity:      1,
+			destination:   &model.Location{Latitude: 59.94, Longitude: 30.31},
+			expectedStock: moscow,
+			expectedErr:   nil,
+		},
+		{
+			name:          "Nearest skips warehouses without enough stock",
+			kind:          pbProduct.AllocationStrategy_NEAREST,
+			quantity:      10,
+			destination:   &model.Location{Latitude: 59.94, Longitude: 30.31},
+			expectedStock: kazan,
+			expectedErr:   nil,
+		},
+		{
+			name:          "Nearest without destination falls back to most stock",
+			kind:          pbProduct.AllocationStrategy_NEAREST,
+			quantity:      1,
+			destination:   nil,
+			expectedStock: kazan,
+			expectedErr:   nil,
+		},
+		{
+			name:          "Not enough stock",
+			kind:          pbProduct.AllocationStrategy_MOST_STOCK,
+			quantity:      100,
+			destination:   nil,
+			expectedStock: nil,
+			expectedErr:   model.ErrInsufficientStock,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualStock, actualErr := allocation.Get(testcase.kind).Choose(stocks, testcase.quantity, testcase.destination)
+
+			assert.Equal(t, testcase.expectedStock, actualStock)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
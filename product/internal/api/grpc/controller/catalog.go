@@ -0,0 +1,247 @@
+package controller
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/Go-Marketplace/backend/pkg/money"
+	"github.com/Go-Marketplace/backend/product/internal/api/grpc/dto"
+	"github.com/Go-Marketplace/backend/product/internal/catalog"
+	"github.com/Go-Marketplace/backend/product/internal/model"
+	"github.com/Go-Marketplace/backend/product/internal/usecase"
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const exportChunkSize = 32 * 1024
+
+// Turns the chunks of the ImportProducts stream into a continuous file
+type importStreamReader struct {
+	stream pbProduct.Product_ImportProductsServer
+	buf    []byte
+}
+
+func (reader *importStreamReader) Read(p []byte) (int, error) {
+	for len(reader.buf) == 0 {
+		req, err := reader.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		reader.buf = req.Chunk
+	}
+
+	n := copy(p, reader.buf)
+	reader.buf = reader.buf[n:]
+
+	return n, nil
+}
+
+// Sends everything written to it as chunks of the ExportProducts stream
+type exportStreamWriter struct {
+	stream pbProduct.Product_ExportProductsServer
+}
+
+func (writer *exportStreamWriter) Write(p []byte) (int, error) {
+	chunk := make([]byte, len(p))
+	copy(chunk, p)
+
+	if err := writer.stream.Send(&pbProduct.ExportProductsResponse{
+		Chunk: chunk,
+	}); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+func importProductRow(
+	ctx context.Context,
+	productUsecase usecase.IProductUsecase,
+	userID uuid.UUID,
+	upsert bool,
+	row *catalog.Row,
+) (*model.Product, model.ImportRowStatus, error) {
+	newProduct := model.Product{
+		ID:               uuid.New(),
+		UserID:           userID,
+		CategoryID:       row.CategoryID,
+		ExternalSKU:      row.ExternalSKU,
+		Name:             row.Name,
+		Description:      row.Description,
+		Price:            row.Price,
+		Currency:         money.NormalizeCurrency(row.Currency),
+		Quantity:         row.Quantity,
+		ModerationStatus: model.Pending,
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+		ActorID:          &userID,
+	}
+
+	if newProduct.Name == "" {
+		return nil, model.ImportFailed, errors.New("name is required")
+	}
+
+	if err := newProduct.Validate(); err != nil {
+		return nil, model.ImportFailed, err
+	}
+
+	if err := checkCurrency(ctx, productUsecase, newProduct.Currency); err != nil {
+		return nil, model.ImportFailed, errors.New(status.Convert(err).Message())
+	}
+
+	if upsert && newProduct.ExternalSKU != "" {
+		products, err := productUsecase.GetProducts(ctx, dto.SearchProductsDTO{
+			UserID:        userID,
+			ExternalSKU:   newProduct.ExternalSKU,
+			IncludeHidden: true,
+		})
+		if err != nil {
+			return nil, model.ImportFailed, fmt.Errorf("failed to get product by external sku: %w", err)
+		}
+
+		if len(products) != 0 {
+			product := products[0]
+
+			// Draft leaves the moderation status of the existing product untouched
+			newProduct.ID = product.ID
+			newProduct.ModerationStatus = model.Draft
+			if product.ModerationStatus == model.Approved && product.NeedsReview(newProduct) {
+				newProduct.ModerationStatus = model.Pending
+			}
+
+			product, err = productUsecase.UpdateProduct(ctx, newProduct)
+			if err != nil {
+				return nil, model.ImportFailed, fmt.Errorf("failed to update product: %w", err)
+			}
+
+			return product, model.ImportUpdated, nil
+		}
+	}
+
+	// Rows without a currency keep the currency of the updated product, new products get the base one
+	if newProduct.Currency == "" {
+		newProduct.Currency = model.BaseCurrency
+	}
+
+	if err := productUsecase.CreateProduct(ctx, newProduct); err != nil {
+		return nil, model.ImportFailed, fmt.Errorf("failed to create product: %w", err)
+	}
+
+	return &newProduct, model.ImportCreated, nil
+}
+
+func ImportProducts(
+	ctx context.Context,
+	productUsecase usecase.IProductUsecase,
+	stream pbProduct.Product_ImportProductsServer,
+) ([]*model.ImportRowResult, error) {
+	req, err := stream.Recv()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Failed to receive import header: %s", err)
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid user id: %s", err)
+	}
+
+	reader, err := catalog.NewReader(req.Format, &importStreamReader{
+		stream: stream,
+		buf:    req.Chunk,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid catalog file: %s", err)
+	}
+
+	results := make([]*model.ImportRowResult, 0)
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		var rowErr *catalog.RowError
+		if errors.As(err, &rowErr) {
+			results = append(results, &model.ImportRowResult{
+				Row:    rowErr.Number,
+				Status: model.ImportFailed,
+				Error:  rowErr.Err.Error(),
+			})
+			continue
+		}
+
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Failed to read catalog file: %s", err)
+		}
+
+		result := &model.ImportRowResult{
+			Row:         row.Number,
+			ExternalSKU: row.ExternalSKU,
+		}
+
+		product, rowStatus, err := importProductRow(ctx, productUsecase, userID, req.Upsert, row)
+		if err != nil {
+			result.Error = err.Error()
+		} else {
+			result.ProductID = product.ID
+		}
+		result.Status = rowStatus
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func ExportProducts(
+	ctx context.Context,
+	productUsecase usecase.IProductUsecase,
+	req *pbProduct.ExportProductsRequest,
+	stream pbProduct.Product_ExportProductsServer,
+) error {
+	if req == nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid user id: %s", err)
+	}
+
+	products, err := productUsecase.GetProducts(ctx, dto.SearchProductsDTO{
+		UserID:        userID,
+		IncludeHidden: true,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "Failed to get products: %s", err)
+	}
+
+	buf := bufio.NewWriterSize(&exportStreamWriter{stream: stream}, exportChunkSize)
+
+	writer, err := catalog.NewWriter(req.Format, buf)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid export format: %s", err)
+	}
+
+	for _, product := range products {
+		if err = writer.Write(product); err != nil {
+			return status.Errorf(codes.Internal, "Failed to export product: %s", err)
+		}
+	}
+
+	if err = writer.Flush(); err != nil {
+		return status.Errorf(codes.Internal, "Failed to export products: %s", err)
+	}
+
+	if err = buf.Flush(); err != nil {
+		return status.Errorf(codes.Internal, "Failed to send products: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/Go-Marketplace/backend/pkg/money"
+	"github.com/Go-Marketplace/backend/product/internal/model"
+	"github.com/Go-Marketplace/backend/product/internal/usecase"
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Checks that the currency has an exchange rate, so the product prices in it can be converted
+func checkCurrency(ctx context.Context, productUsecase usecase.IProductUsecase, currency string) error {
+	if currency == "" || currency == model.BaseCurrency {
+		return nil
+	}
+
+	rate, err := productUsecase.GetExchangeRate(ctx, currency)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Failed to get exchange rate: %s", err)
+	}
+
+	if rate == nil {
+		return status.Errorf(codes.InvalidArgument, "Unknown currency: %s", currency)
+	}
+
+	return nil
+}
+
+// Converts the product prices to the display currency
+func setDisplayCurrency(ctx context.Context, productUsecase usecase.IProductUsecase, currency string, products []*model.Product) error {
+	rates, err := productUsecase.GetExchangeRates(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Failed to get exchange rates: %s", err)
+	}
+
+	exchangeRates := model.NewExchangeRates(rates)
+	if _, ok := exchangeRates[currency]; !ok {
+		return status.Errorf(codes.InvalidArgument, "Unknown currency: %s", currency)
+	}
+
+	for _, product := range products {
+		if err = product.SetDisplayCurrency(exchangeRates, currency); err != nil {
+			return status.Errorf(codes.Internal, "Failed to convert product %s price: %s", product.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func GetExchangeRates(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.GetExchangeRatesRequest) ([]*model.ExchangeRate, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	rates, err := productUsecase.GetExchangeRates(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get exchange rates: %s", err)
+	}
+
+	return rates, nil
+}
+
+func SetExchangeRate(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.SetExchangeRateRequest) (*model.ExchangeRate, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	rate := &model.ExchangeRate{
+		Currency:  money.NormalizeCurrency(req.Currency),
+		Rate:      req.Rate,
+		UpdatedAt: time.Now(),
+	}
+
+	if err := rate.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid exchange rate request: %s", err)
+	}
+
+	if err := productUsecase.SetExchangeRates(ctx, []*model.ExchangeRate{rate}); err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to set exchange rate: %s", err)
+	}
+
+	return rate, nil
+}
+
+// Sets all the rates of the CSV file and returns every known rate
+func ImportExchangeRates(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.ImportExchangeRatesRequest) ([]*model.ExchangeRate, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	rates, err := model.ReadExchangeRates(bytes.NewReader(req.Content), time.Now())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid exchange rates file: %s", err)
+	}
+
+	if len(rates) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Exchange rates file has no rates")
+	}
+
+	if err = productUsecase.SetExchangeRates(ctx, rates); err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to set exchange rates: %s", err)
+	}
+
+	return GetExchangeRates(ctx, productUsecase, &pbProduct.GetExchangeRatesRequest{})
+}
@@ -0,0 +1,156 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Go-Marketplace/backend/product/internal/api/grpc/controller"
+	mocks "github.com/Go-Marketplace/backend/product/internal/mocks/usecase"
+	"github.com/Go-Marketplace/backend/product/internal/model"
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetProductsDisplayCurrency(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	rates := []*model.ExchangeRate{
+		{Currency: "USD", Rate: 90},
+	}
+	expectedErrFromUsecase := errors.New("test error")
+
+	testcases := []struct {
+		name          string
+		req           *pbProduct.GetProductsRequest
+		mock          func(usecase *mocks.MockIProductUsecase)
+		expectedPrice int64
+		expectedErr   error
+	}{
+		{
+			name: "Prices are converted to the display currency",
+			req: &pbProduct.GetProductsRequest{
+				DisplayCurrency: "usd",
+			},
+			mock: func(usecase *mocks.MockIProductUsecase) {
+				usecase.EXPECT().GetProducts(ctx, gomock.Any()).Return([]*model.Product{{Price: 18000, Currency: "RUB"}}, nil).Times(1)
+				usecase.EXPECT().GetExchangeRates(ctx).Return(rates, nil).Times(1)
+			},
+			expectedPrice: 200,
+			expectedErr:   nil,
+		},
+		{
+			name: "Got error when display currency is unknown",
+			req: &pbProduct.GetProductsRequest{
+				DisplayCurrency: "GBP",
+			},
+			mock: func(usecase *mocks.MockIProductUsecase) {
+				usecase.EXPECT().GetProducts(ctx, gomock.Any()).Return([]*model.Product{{Price: 18000, Currency: "RUB"}}, nil).Times(1)
+				usecase.EXPECT().GetExchangeRates(ctx).Return(rates, nil).Times(1)
+			},
+			expectedErr: status.Errorf(codes.InvalidArgument, "Unknown currency: GBP"),
+		},
+		{
+			name: "Got error when get exchange rates",
+			req: &pbProduct.GetProductsRequest{
+				DisplayCurrency: "USD",
+			},
+			mock: func(usecase *mocks.MockIProductUsecase) {
+				usecase.EXPECT().GetProducts(ctx, gomock.Any()).Return([]*model.Product{{Price: 18000, Currency: "RUB"}}, nil).Times(1)
+				usecase.EXPECT().GetExchangeRates(ctx).Return(nil, expectedErrFromUsecase).Times(1)
+			},
+			expectedErr: status.Errorf(codes.Internal, "Failed to get exchange rates: %s", expectedErrFromUsecase),
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			productUsecase := productHelper(t)
+			testcase.mock(productUsecase)
+
+			actualProducts, actualErr := controller.GetProducts(ctx, productUsecase, testcase.req)
+
+			assert.Equal(t, testcase.expectedErr, actualErr)
+			if testcase.expectedErr == nil {
+				assert.Equal(t, testcase.expectedPrice, actualProducts[0].DisplayPrice)
+				assert.Equal(t, "USD", actualProducts[0].DisplayCurrency)
+			}
+		})
+	}
+}
+
+func TestImportExchangeRates(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	storedRates := []*model.ExchangeRate{
+		{Currency: "EUR", Rate: 100},
+		{Currency: "USD", Rate: 90},
+	}
+
+	testcases := []struct {
+		name          string
+		req           *pbProduct.ImportExchangeRatesRequest
+		mock          func(usecase *mocks.MockIProductUsecase)
+		expectedRates []*model.ExchangeRate
+		wasError      bool
+	}{
+		{
+			name: "Successfully import exchange rates",
+			req: &pbProduct.ImportExchangeRatesRequest{
+				Content: []byte("currency,rate\nUSD,90\nEUR,100\n"),
+			},
+			mock: func(usecase *mocks.MockIProductUsecase) {
+				usecase.EXPECT().SetExchangeRates(ctx, gomock.Len(2)).Return(nil).Times(1)
+				usecase.EXPECT().GetExchangeRates(ctx).Return(storedRates, nil).Times(1)
+			},
+			expectedRates: storedRates,
+			wasError:      false,
+		},
+		{
+			name: "Got error when file is invalid",
+			req: &pbProduct.ImportExchangeRatesRequest{
+				Content: []byte("currency,rate\nUSD,abc\n"),
+			},
+			mock:     func(usecase *mocks.MockIProductUsecase) {},
+			wasError: true,
+		},
+		{
+			name: "Got error when file has no rates",
+			req: &pbProduct.ImportExchangeRatesRequest{
+				Content: []byte("currency,rate\n"),
+			},
+			mock:     func(usecase *mocks.MockIProductUsecase) {},
+			wasError: true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			productUsecase := productHelper(t)
+			testcase.mock(productUsecase)
+
+			actualRates, actualErr := controller.ImportExchangeRates(ctx, productUsecase, testcase.req)
+
+			assert.Equal(t, testcase.wasError, actualErr != nil)
+			assert.Equal(t, testcase.expectedRates, actualRates)
+			if testcase.wasError {
+				assert.Equal(t, codes.InvalidArgument, status.Code(actualErr))
+			}
+		})
+	}
+}
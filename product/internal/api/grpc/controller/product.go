@@ -2,19 +2,26 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"github.com/Go-Marketplace/backend/pkg/money"
 	"github.com/Go-Marketplace/backend/product/internal"
 	"github.com/Go-Marketplace/backend/product/internal/api/grpc/dto"
 	"github.com/Go-Marketplace/backend/product/internal/model"
 	"github.com/Go-Marketplace/backend/product/internal/usecase"
 	pbCart "github.com/Go-Marketplace/backend/proto/gen/cart"
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
 	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// Default period the lowest price is looked for in the price history
+const priceHistoryPeriod = 30 * 24 * time.Hour
+
 func GetProducts(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.GetProductsRequest) ([]*model.Product, error) {
 	var err error
 	if req == nil {
@@ -29,10 +36,20 @@ func GetProducts(ctx context.Context, productUsecase usecase.IProductUsecase, re
 		}
 	}
 
+	// Only approved products are shown unless another moderation status is requested explicitly
+	moderationStatus := req.ModerationStatus
+	if moderationStatus == nil {
+		approved := pbProduct.ModerationStatus_APPROVED
+		moderationStatus = &approved
+	}
+
 	searchParams := dto.SearchProductsDTO{
-		UserID:     userID,
-		CategoryID: req.CategoryId,
-		Moderated:  req.Moderated,
+		UserID:           userID,
+		CategoryID:       req.CategoryId,
+		ModerationStatus: moderationStatus,
+		MinRating:        req.MinRating,
+		Order:            req.Order,
+		IncludeHidden:    req.IncludeHidden,
 	}
 
 	products, err := productUsecase.GetProducts(ctx, searchParams)
@@ -40,6 +57,12 @@ func GetProducts(ctx context.Context, productUsecase usecase.IProductUsecase, re
 		return nil, status.Errorf(codes.Internal, "Internal error: %s", err)
 	}
 
+	if req.DisplayCurrency != "" {
+		if err = setDisplayCurrency(ctx, productUsecase, money.NormalizeCurrency(req.DisplayCurrency), products); err != nil {
+			return nil, err
+		}
+	}
+
 	return products, nil
 }
 
@@ -75,23 +98,40 @@ func CreateProduct(ctx context.Context, productUsecase usecase.IProductUsecase,
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid user id: %s", err)
 	}
 
+	moderationStatus := model.Pending
+	if req.Draft {
+		moderationStatus = model.Draft
+	}
+
+	currency := money.NormalizeCurrency(req.Currency)
+	if currency == "" {
+		currency = model.BaseCurrency
+	}
+
 	product := model.Product{
-		ID:          uuid.New(),
-		UserID:      userID,
-		CategoryID:  req.CategoryId,
-		Name:        req.Name,
-		Description: req.Description,
-		Price:       req.Price,
-		Quantity:    req.Quantity,
-		Moderated:   false,
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
+		ID:               uuid.New(),
+		UserID:           userID,
+		CategoryID:       req.CategoryId,
+		ExternalSKU:      req.ExternalSku,
+		Name:             req.Name,
+		Description:      req.Description,
+		Price:            req.Price,
+		Currency:         currency,
+		Quantity:         req.Quantity,
+		ModerationStatus: moderationStatus,
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+		ActorID:          &userID,
 	}
 
 	if err = product.Validate(); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid product request: %s", err)
 	}
 
+	if err = checkCurrency(ctx, productUsecase, product.Currency); err != nil {
+		return nil, err
+	}
+
 	err = productUsecase.CreateProduct(ctx, product)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Internal error: %s", err)
@@ -116,6 +156,7 @@ func UpdateProduct(ctx context.Context, productUsecase usecase.IProductUsecase,
 		Name:        internal.Unwrap(req.Name),
 		Description: internal.Unwrap(req.Description),
 		Price:       internal.Unwrap(req.Price),
+		Currency:    money.NormalizeCurrency(internal.Unwrap(req.Currency)),
 		Quantity:    internal.Unwrap(req.Quantity),
 	}
 
@@ -123,7 +164,33 @@ func UpdateProduct(ctx context.Context, productUsecase usecase.IProductUsecase,
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid product request: %s", err)
 	}
 
-	product, err := productUsecase.UpdateProduct(ctx, newProduct)
+	if err = checkCurrency(ctx, productUsecase, newProduct.Currency); err != nil {
+		return nil, err
+	}
+
+	product, err := productUsecase.GetProduct(ctx, productID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get product: %s", err)
+	}
+
+	if product == nil {
+		return nil, status.Errorf(codes.NotFound, "Product not found")
+	}
+
+	if product.ModerationStatus == model.Approved && product.NeedsReview(newProduct) {
+		newProduct.ModerationStatus = model.Pending
+	}
+
+	newProduct.ActorID = &product.UserID
+	if req.ActorId != "" {
+		actorID, err := uuid.Parse(req.ActorId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid actor id: %s", err)
+		}
+		newProduct.ActorID = &actorID
+	}
+
+	product, err = productUsecase.UpdateProduct(ctx, newProduct)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Internal error: %s", err)
 	}
@@ -150,6 +217,7 @@ func UpdateProducts(ctx context.Context, productUsecase usecase.IProductUsecase,
 			Name:        internal.Unwrap(reqProduct.Name),
 			Description: internal.Unwrap(reqProduct.Description),
 			Price:       internal.Unwrap(reqProduct.Price),
+			Currency:    money.NormalizeCurrency(internal.Unwrap(reqProduct.Currency)),
 			Quantity:    internal.Unwrap(reqProduct.Quantity),
 		}
 
@@ -157,6 +225,10 @@ func UpdateProducts(ctx context.Context, productUsecase usecase.IProductUsecase,
 			return status.Errorf(codes.InvalidArgument, "Invalid product request: %s", err)
 		}
 
+		if err = checkCurrency(ctx, productUsecase, product.Currency); err != nil {
+			return err
+		}
+
 		products = append(products, product)
 	}
 
@@ -167,6 +239,18 @@ func UpdateProducts(ctx context.Context, productUsecase usecase.IProductUsecase,
 	return nil
 }
 
+// Removes the product from the carts and releases their reserved stock while the product
+// can still be found, a deleted product can't be reserved again
+func deleteProductCartlines(ctx context.Context, cartClient pbCart.CartClient, productID uuid.UUID) error {
+	if _, err := cartClient.DeleteProductCartlines(ctx, &pbCart.DeleteProductCartlinesRequest{
+		ProductId: productID.String(),
+	}); err != nil {
+		return status.Errorf(status.Code(err), "Failed to delete product cartlines: %s", status.Convert(err).Message())
+	}
+
+	return nil
+}
+
 func DeleteProduct(
 	ctx context.Context,
 	productUsecase usecase.IProductUsecase,
@@ -191,17 +275,23 @@ func DeleteProduct(
 		return status.Errorf(codes.NotFound, "Product not found")
 	}
 
-	if err = productUsecase.DeleteProduct(ctx, productID); err != nil {
-		return status.Errorf(codes.Internal, "Internal error: %s", err)
+	actorID := product.UserID
+	if req.ActorId != "" {
+		actorID, err = uuid.Parse(req.ActorId)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "Invalid actor id: %s", err)
+		}
 	}
 
-	if _, err = cartClient.DeleteProductCartlines(ctx, &pbCart.DeleteProductCartlinesRequest{
-		ProductId: req.ProductId,
+	if err = deleteProductCartlines(ctx, cartClient, productID); err != nil {
+		return err
+	}
+
+	if err = productUsecase.DeleteProduct(ctx, model.Product{
+		ID:      productID,
+		ActorID: &actorID,
 	}); err != nil {
-		if errCreate := productUsecase.CreateProduct(ctx, *product); errCreate != nil {
-			return status.Errorf(codes.Internal, "Failed to create product: %s", err)
-		}
-		return status.Errorf(codes.Internal, "Failed to delete product cartlines: %s", err)
+		return status.Errorf(codes.Internal, "Internal error: %s", err)
 	}
 
 	return nil
@@ -223,25 +313,54 @@ func DeleteUserProducts(
 	}
 
 	products, err := productUsecase.GetProducts(ctx, dto.SearchProductsDTO{
-		UserID: userID,
+		UserID:        userID,
+		IncludeHidden: true,
 	})
 	if err != nil {
-		return status.Errorf(codes.Internal, "Failed to get products: %s", err)
+		return status.Errorf(codes.Internal, "Failed to get user products: %s", err)
+	}
+
+	for _, product := range products {
+		if err = deleteProductCartlines(ctx, cartClient, product.ID); err != nil {
+			return err
+		}
 	}
 
 	if err = productUsecase.DeleteUserProducts(ctx, userID); err != nil {
 		return status.Errorf(codes.Internal, "Failed to delete user products: %s", err)
 	}
 
-	for _, product := range products {
-		if _, err = cartClient.DeleteProductCartlines(ctx, &pbCart.DeleteProductCartlinesRequest{
-			ProductId: product.ID.String(),
-		}); err != nil {
-			return status.Errorf(codes.Internal, "Failed to delete product cartlines: %s", err)
-		}
+	return nil
+}
+
+func RestoreProduct(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.RestoreProductRequest) (*model.Product, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
 	}
 
-	return nil
+	productID, err := uuid.Parse(req.ProductId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid product id: %s", err)
+	}
+
+	adminID, err := uuid.Parse(req.AdminId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid admin id: %s", err)
+	}
+
+	product, err := productUsecase.RestoreProduct(ctx, model.Product{
+		ID:      productID,
+		ActorID: &adminID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to restore product: %s", err)
+	}
+
+	if product == nil {
+		return nil, status.Errorf(codes.NotFound, "Deleted product not found")
+	}
+
+	return product, nil
 }
 
 func ModerateProduct(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.ModerateProductRequest) (*model.Product, error) {
@@ -254,23 +373,115 @@ func ModerateProduct(ctx context.Context, productUsecase usecase.IProductUsecase
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid product id: %s", err)
 	}
 
+	reviewerID, err := uuid.Parse(req.ReviewerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid reviewer id: %s", err)
+	}
+
+	moderationStatus := model.ModerationStatus(req.ModerationStatus)
+	if moderationStatus != model.Approved && moderationStatus != model.Rejected {
+		return nil, status.Errorf(codes.InvalidArgument, "Product can only be approved or rejected")
+	}
+
+	if moderationStatus == model.Rejected && req.Reason == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Rejection reason is required")
+	}
+
+	product, err := productUsecase.GetProduct(ctx, productID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get product: %s", err)
+	}
+
+	if product == nil {
+		return nil, status.Errorf(codes.NotFound, "Product not found")
+	}
+
+	if product.ModerationStatus == model.Draft {
+		return nil, status.Errorf(codes.FailedPrecondition, "Product has not been submitted for moderation")
+	}
+
+	moderatedAt := time.Now()
 	newProduct := model.Product{
-		ID:        productID,
-		Moderated: req.Moderated,
+		ID:               productID,
+		ModerationStatus: moderationStatus,
+		RejectionReason:  req.Reason,
+		ModeratedBy:      &reviewerID,
+		ModeratedAt:      &moderatedAt,
+		ActorID:          &reviewerID,
+	}
+
+	if err = newProduct.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid moderation request: %s", err)
 	}
 
-	product, err := productUsecase.UpdateProduct(ctx, newProduct)
+	product, err = productUsecase.UpdateProduct(ctx, newProduct)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Internal error: %s", err)
 	}
 
+	return product, nil
+}
+
+func SubmitProduct(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.SubmitProductRequest) (*model.Product, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	productID, err := uuid.Parse(req.ProductId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid product id: %s", err)
+	}
+
+	product, err := productUsecase.GetProduct(ctx, productID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get product: %s", err)
+	}
+
 	if product == nil {
 		return nil, status.Errorf(codes.NotFound, "Product not found")
 	}
 
+	if !req.Admin && product.UserID.String() != req.UserId {
+		return nil, status.Errorf(codes.PermissionDenied, "Sellers can submit only their own products")
+	}
+
+	actorID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid user id: %s", err)
+	}
+
+	if product.ModerationStatus != model.Draft && product.ModerationStatus != model.Rejected {
+		return nil, status.Errorf(codes.FailedPrecondition, "Only draft or rejected products can be submitted for moderation")
+	}
+
+	product, err = productUsecase.UpdateProduct(ctx, model.Product{
+		ID:               productID,
+		ModerationStatus: model.Pending,
+		ActorID:          &actorID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Internal error: %s", err)
+	}
+
 	return product, nil
 }
 
+func GetModerationQueue(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.GetModerationQueueRequest) ([]*model.Product, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	products, err := productUsecase.GetModerationQueue(ctx, dto.ModerationQueueDTO{
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Internal error: %s", err)
+	}
+
+	return products, nil
+}
+
 func GetCategory(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.GetCategoryRequest) (*model.Category, error) {
 	if req == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
@@ -311,18 +522,24 @@ func CreateDiscount(ctx context.Context, productUsecase usecase.IProductUsecase,
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid product id: %s", err)
 	}
 
-	discount := model.Discount{
-		ProductID: productID,
-		Percent:   req.Percent,
-		CreatedAt: time.Now(),
-		EndedAt:   req.EndedAt.AsTime(),
-	}
+	discount := newDiscount(req.Percent, req.StartsAt, req.EndedAt, req.Priority)
+	discount.Scope = model.ProductDiscount
+	discount.ProductID = productID
 
 	if err = discount.Validate(); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid discount request: %s", err)
 	}
 
-	product, err := productUsecase.CreateDiscount(ctx, discount)
+	product, err := productUsecase.GetProduct(ctx, productID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get product: %s", err)
+	}
+
+	if product == nil {
+		return nil, status.Errorf(codes.NotFound, "Product not found")
+	}
+
+	product, err = productUsecase.CreateDiscount(ctx, discount)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Internal error: %s", err)
 	}
@@ -330,6 +547,26 @@ func CreateDiscount(ctx context.Context, productUsecase usecase.IProductUsecase,
 	return product, nil
 }
 
+// Creates a discount that starts right away unless startsAt is given
+func newDiscount(percent float32, startsAt, endedAt *timestamppb.Timestamp, priority int32) model.Discount {
+	now := time.Now()
+
+	discount := model.Discount{
+		ID:        uuid.New(),
+		Percent:   percent,
+		Priority:  priority,
+		CreatedAt: now,
+		StartsAt:  now,
+		EndsAt:    endedAt.AsTime(),
+	}
+
+	if startsAt != nil {
+		discount.StartsAt = startsAt.AsTime()
+	}
+
+	return discount
+}
+
 func DeleteDiscount(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.DeleteDiscountRequest) (*model.Product, error) {
 	if req == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
@@ -351,3 +588,349 @@ func DeleteDiscount(ctx context.Context, productUsecase usecase.IProductUsecase,
 
 	return product, nil
 }
+
+func CreateCategoryDiscount(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.CreateCategoryDiscountRequest) (*model.Discount, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	discount := newDiscount(req.Percent, req.StartsAt, req.EndedAt, req.Priority)
+	discount.Scope = model.CategoryDiscount
+	discount.CategoryID = req.CategoryId
+
+	if err := discount.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid discount request: %s", err)
+	}
+
+	category, err := productUsecase.GetCategory(ctx, req.CategoryId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get category: %s", err)
+	}
+
+	if category == nil {
+		return nil, status.Errorf(codes.NotFound, "Category not found")
+	}
+
+	newDiscount, err := productUsecase.CreateScopeDiscount(ctx, discount)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Internal error: %s", err)
+	}
+
+	return newDiscount, nil
+}
+
+func DeleteCategoryDiscount(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.DeleteCategoryDiscountRequest) error {
+	if req == nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	if req.CategoryId == 0 {
+		return status.Errorf(codes.InvalidArgument, "Invalid category id")
+	}
+
+	if err := productUsecase.EndDiscounts(ctx, dto.SearchDiscountsDTO{
+		Scope:      pbProduct.DiscountScope_CATEGORY_DISCOUNT,
+		CategoryID: req.CategoryId,
+	}); err != nil {
+		return status.Errorf(codes.Internal, "Internal error: %s", err)
+	}
+
+	return nil
+}
+
+func CreateSellerDiscount(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.CreateSellerDiscountRequest) (*model.Discount, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	sellerID, err := uuid.Parse(req.SellerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid seller id: %s", err)
+	}
+
+	discount := newDiscount(req.Percent, req.StartsAt, req.EndedAt, req.Priority)
+	discount.Scope = model.SellerDiscount
+	discount.SellerID = sellerID
+
+	if err = discount.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid discount request: %s", err)
+	}
+
+	newDiscount, err := productUsecase.CreateScopeDiscount(ctx, discount)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Internal error: %s", err)
+	}
+
+	return newDiscount, nil
+}
+
+func DeleteSellerDiscount(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.DeleteSellerDiscountRequest) error {
+	if req == nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	sellerID, err := uuid.Parse(req.SellerId)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid seller id: %s", err)
+	}
+
+	if err = productUsecase.EndDiscounts(ctx, dto.SearchDiscountsDTO{
+		Scope:    pbProduct.DiscountScope_SELLER_DISCOUNT,
+		SellerID: sellerID,
+	}); err != nil {
+		return status.Errorf(codes.Internal, "Internal error: %s", err)
+	}
+
+	return nil
+}
+
+func GetDiscounts(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.GetDiscountsRequest) ([]*model.Discount, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	searchParams := dto.SearchDiscountsDTO{
+		Scope:          req.Scope,
+		CategoryID:     req.CategoryId,
+		IncludeExpired: req.IncludeExpired,
+	}
+
+	var err error
+	if req.ProductId != "" {
+		searchParams.ProductID, err = uuid.Parse(req.ProductId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid product id: %s", err)
+		}
+	}
+
+	if req.SellerId != "" {
+		searchParams.SellerID, err = uuid.Parse(req.SellerId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid seller id: %s", err)
+		}
+	}
+
+	discounts, err := productUsecase.GetDiscounts(ctx, searchParams)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Internal error: %s", err)
+	}
+
+	return discounts, nil
+}
+
+func GetProductReviews(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.GetProductReviewsRequest) ([]*model.Review, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	productID, err := uuid.Parse(req.ProductId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid product id: %s", err)
+	}
+
+	reviews, err := productUsecase.GetProductReviews(ctx, dto.SearchReviewsDTO{
+		ProductID: productID,
+		Limit:     req.Limit,
+		Offset:    req.Offset,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Internal error: %s", err)
+	}
+
+	return reviews, nil
+}
+
+func CreateReview(
+	ctx context.Context,
+	productUsecase usecase.IProductUsecase,
+	orderClient pbOrder.OrderClient,
+	req *pbProduct.CreateReviewRequest,
+) (*model.Review, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	productID, err := uuid.Parse(req.ProductId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid product id: %s", err)
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid user id: %s", err)
+	}
+
+	review := model.Review{
+		ID:        uuid.New(),
+		ProductID: productID,
+		UserID:    userID,
+		Rating:    req.Rating,
+		Comment:   req.Comment,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	if err = review.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid review request: %s", err)
+	}
+
+	product, err := productUsecase.GetProduct(ctx, productID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get product: %s", err)
+	}
+
+	if product == nil {
+		return nil, status.Errorf(codes.NotFound, "Product not found")
+	}
+
+	receivedResp, err := orderClient.HasReceivedProduct(ctx, &pbOrder.HasReceivedProductRequest{
+		UserId:    req.UserId,
+		ProductId: req.ProductId,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to check received product: %s", err)
+	}
+
+	if !receivedResp.Received {
+		return nil, status.Errorf(codes.FailedPrecondition, "Only users who received the product can review it")
+	}
+
+	userReviews, err := productUsecase.GetProductReviews(ctx, dto.SearchReviewsDTO{
+		ProductID: productID,
+		UserID:    userID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get user reviews: %s", err)
+	}
+
+	if len(userReviews) != 0 {
+		return nil, status.Errorf(codes.AlreadyExists, "User has already reviewed the product")
+	}
+
+	newReview, err := productUsecase.CreateReview(ctx, review)
+	if err != nil {
+		if errors.Is(err, model.ErrReviewExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "User has already reviewed the product")
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to create review: %s", err)
+	}
+
+	return newReview, nil
+}
+
+func ReplyReview(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.ReplyReviewRequest) (*model.Review, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	reviewID, err := uuid.Parse(req.ReviewId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid review id: %s", err)
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid user id: %s", err)
+	}
+
+	review, err := productUsecase.GetReview(ctx, reviewID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get review: %s", err)
+	}
+
+	if review == nil {
+		return nil, status.Errorf(codes.NotFound, "Review not found")
+	}
+
+	product, err := productUsecase.GetProduct(ctx, review.ProductID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get product: %s", err)
+	}
+
+	if product == nil {
+		return nil, status.Errorf(codes.NotFound, "Product not found")
+	}
+
+	if product.UserID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "Only the seller of the product can reply to its reviews")
+	}
+
+	repliedAt := time.Now()
+	review.Reply = req.Reply
+	review.RepliedAt = &repliedAt
+
+	if err = review.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid reply request: %s", err)
+	}
+
+	review, err = productUsecase.ReplyReview(ctx, *review)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to reply review: %s", err)
+	}
+
+	return review, nil
+}
+
+func GetProductHistory(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.GetProductHistoryRequest) ([]*model.ProductRevision, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	productID, err := uuid.Parse(req.ProductId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid product id: %s", err)
+	}
+
+	revisions, err := productUsecase.GetProductHistory(ctx, dto.SearchRevisionsDTO{
+		ProductID: productID,
+		Limit:     req.Limit,
+		Offset:    req.Offset,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get product history: %s", err)
+	}
+
+	return revisions, nil
+}
+
+func GetPriceHistory(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.GetPriceHistoryRequest) (*model.PriceHistory, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	productID, err := uuid.Parse(req.ProductId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid product id: %s", err)
+	}
+
+	product, err := productUsecase.GetProduct(ctx, productID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get product: %s", err)
+	}
+
+	if product == nil {
+		return nil, status.Errorf(codes.NotFound, "Product not found")
+	}
+
+	prices, err := productUsecase.GetPriceHistory(ctx, productID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get price history: %s", err)
+	}
+
+	since := time.Now().Add(-priceHistoryPeriod)
+	if req.Since != nil {
+		since = req.Since.AsTime()
+	}
+
+	lowestPrice := model.LowestPrice(prices, since)
+	if len(prices) == 0 {
+		lowestPrice = product.Price
+	}
+
+	return &model.PriceHistory{
+		ProductID:    productID,
+		Prices:       prices,
+		CurrentPrice: product.Price,
+		LowestPrice:  lowestPrice,
+	}, nil
+}
@@ -6,12 +6,15 @@ import (
 	"testing"
 
 	"github.com/Go-Marketplace/backend/product/internal/api/grpc/controller"
+	"github.com/Go-Marketplace/backend/product/internal/api/grpc/dto"
 	mocks "github.com/Go-Marketplace/backend/product/internal/mocks/usecase"
 	"github.com/Go-Marketplace/backend/product/internal/model"
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
 	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -25,6 +28,20 @@ func productHelper(t *testing.T) *mocks.MockIProductUsecase {
 	return mocks.NewMockIProductUsecase(mockCtrl)
 }
 
+type orderClient struct {
+	pbOrder.OrderClient
+
+	received bool
+}
+
+func (client *orderClient) HasReceivedProduct(
+	_ context.Context,
+	_ *pbOrder.HasReceivedProductRequest,
+	_ ...grpc.CallOption,
+) (*pbOrder.HasReceivedProductResponse, error) {
+	return &pbOrder.HasReceivedProductResponse{Received: client.received}, nil
+}
+
 func TestGetProduct(t *testing.T) {
 	t.Parallel()
 
@@ -126,3 +143,159 @@ func TestGetProduct(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateReview(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	productID := uuid.New()
+	userID := uuid.New()
+
+	req := &pbProduct.CreateReviewRequest{
+		ProductId: productID.String(),
+		UserId:    userID.String(),
+		Rating:    5,
+	}
+
+	product := &model.Product{
+		ID: productID,
+	}
+	searchParams := dto.SearchReviewsDTO{
+		ProductID: productID,
+		UserID:    userID,
+	}
+	expectedErrFromUsecase := errors.New("test error")
+
+	testcases := []struct {
+		name        string
+		received    bool
+		mock        func(usecase *mocks.MockIProductUsecase)
+		expectedErr error
+	}{
+		{
+			name:     "Got error when the user has not received the product",
+			received: false,
+			mock: func(usecase *mocks.MockIProductUsecase) {
+				usecase.EXPECT().GetProduct(ctx, productID).Return(product, nil).Times(1)
+			},
+			expectedErr: status.Errorf(codes.FailedPrecondition, "Only users who received the product can review it"),
+		},
+		{
+			name:     "Got error when the user has already reviewed the product",
+			received: true,
+			mock: func(usecase *mocks.MockIProductUsecase) {
+				usecase.EXPECT().GetProduct(ctx, productID).Return(product, nil).Times(1)
+				usecase.EXPECT().GetProductReviews(ctx, searchParams).Return([]*model.Review{{}}, nil).Times(1)
+			},
+			expectedErr: status.Errorf(codes.AlreadyExists, "User has already reviewed the product"),
+		},
+		{
+			name:     "Got error when the user reviews the product concurrently",
+			received: true,
+			mock: func(usecase *mocks.MockIProductUsecase) {
+				usecase.EXPECT().GetProduct(ctx, productID).Return(product, nil).Times(1)
+				usecase.EXPECT().GetProductReviews(ctx, searchParams).Return([]*model.Review{}, nil).Times(1)
+				usecase.EXPECT().CreateReview(ctx, gomock.Any()).Return(nil, model.ErrReviewExists).Times(1)
+			},
+			expectedErr: status.Errorf(codes.AlreadyExists, "User has already reviewed the product"),
+		},
+		{
+			name:     "Got error when create review",
+			received: true,
+			mock: func(usecase *mocks.MockIProductUsecase) {
+				usecase.EXPECT().GetProduct(ctx, productID).Return(product, nil).Times(1)
+				usecase.EXPECT().GetProductReviews(ctx, searchParams).Return([]*model.Review{}, nil).Times(1)
+				usecase.EXPECT().CreateReview(ctx, gomock.Any()).Return(nil, expectedErrFromUsecase).Times(1)
+			},
+			expectedErr: status.Errorf(codes.Internal, "Failed to create review: %s", expectedErrFromUsecase),
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			productUsecase := productHelper(t)
+			testcase.mock(productUsecase)
+
+			_, actualErr := controller.CreateReview(ctx, productUsecase, &orderClient{received: testcase.received}, req)
+
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestSubmitProduct(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sellerID := uuid.New()
+	productID := uuid.New()
+
+	draftProduct := &model.Product{
+		ID:               productID,
+		UserID:           sellerID,
+		ModerationStatus: model.Draft,
+	}
+	expectedProductFromUsecase := &model.Product{
+		ID:               productID,
+		UserID:           sellerID,
+		ModerationStatus: model.Pending,
+	}
+
+	testcases := []struct {
+		name            string
+		req             *pbProduct.SubmitProductRequest
+		mock            func(usecase *mocks.MockIProductUsecase)
+		expectedProduct *model.Product
+		expectedErr     error
+	}{
+		{
+			name: "Seller submits own product",
+			req: &pbProduct.SubmitProductRequest{
+				ProductId: productID.String(),
+				UserId:    sellerID.String(),
+			},
+			mock: func(usecase *mocks.MockIProductUsecase) {
+				usecase.EXPECT().GetProduct(ctx, productID).Return(draftProduct, nil).Times(1)
+				usecase.EXPECT().UpdateProduct(ctx, model.Product{
+					ID:               productID,
+					ModerationStatus: model.Pending,
+					ActorID:          &sellerID,
+				}).Return(expectedProductFromUsecase, nil).Times(1)
+			},
+			expectedProduct: expectedProductFromUsecase,
+			expectedErr:     nil,
+		},
+		{
+			name: "Got error when user submits another seller product",
+			req: &pbProduct.SubmitProductRequest{
+				ProductId: productID.String(),
+				UserId:    uuid.NewString(),
+			},
+			mock: func(usecase *mocks.MockIProductUsecase) {
+				usecase.EXPECT().GetProduct(ctx, productID).Return(draftProduct, nil).Times(1)
+			},
+			expectedProduct: nil,
+			expectedErr:     status.Errorf(codes.PermissionDenied, "Sellers can submit only their own products"),
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			productUsecase := productHelper(t)
+			testcase.mock(productUsecase)
+
+			actualProduct, actualErr := controller.SubmitProduct(ctx, productUsecase, testcase.req)
+
+			assert.Equal(t, testcase.expectedProduct, actualProduct)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/Go-Marketplace/backend/product/internal/api/grpc/dto"
+	"github.com/Go-Marketplace/backend/product/internal/model"
+	"github.com/Go-Marketplace/backend/product/internal/usecase"
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func GetSellerProfile(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.GetSellerProfileRequest) (*model.SellerProfile, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid user id: %s", err)
+	}
+
+	profile, err := productUsecase.GetSellerProfile(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get seller profile: %s", err)
+	}
+
+	if profile == nil {
+		return nil, status.Errorf(codes.NotFound, "Seller not found")
+	}
+
+	return profile, nil
+}
+
+func SetSellerProfile(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.SetSellerProfileRequest) (*model.SellerProfile, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid user id: %s", err)
+	}
+
+	profile := model.SellerProfile{
+		UserID:       userID,
+		DisplayName:  req.DisplayName,
+		Description:  req.Description,
+		LogoURL:      req.LogoUrl,
+		ReturnPolicy: req.ReturnPolicy,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+
+	if err = profile.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid seller profile request: %s", err)
+	}
+
+	newProfile, err := productUsecase.SetSellerProfile(ctx, profile)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to set seller profile: %s", err)
+	}
+
+	return newProfile, nil
+}
+
+// Returns the seller profile with the approved products of the seller. Sellers who never
+// set up their profile get an empty one, the seller is not found only without products too
+func GetStorefront(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.GetStorefrontRequest) (*model.Storefront, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid user id: %s", err)
+	}
+
+	profile, err := productUsecase.GetSellerProfile(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get seller profile: %s", err)
+	}
+
+	approved := pbProduct.ModerationStatus_APPROVED
+	products, err := productUsecase.GetProducts(ctx, dto.SearchProductsDTO{
+		UserID:           userID,
+		ModerationStatus: &approved,
+		Order:            req.Order,
+		Limit:            req.Limit,
+		Offset:           req.Offset,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get seller products: %s", err)
+	}
+
+	if profile == nil {
+		if len(products) == 0 {
+			return nil, status.Errorf(codes.NotFound, "Seller not found")
+		}
+
+		profile = &model.SellerProfile{
+			UserID: userID,
+		}
+	}
+
+	return &model.Storefront{
+		Profile:  profile,
+		Products: products,
+	}, nil
+}
@@ -0,0 +1,165 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Go-Marketplace/backend/product/internal/api/grpc/controller"
+	"github.com/Go-Marketplace/backend/product/internal/api/grpc/dto"
+	mocks "github.com/Go-Marketplace/backend/product/internal/mocks/usecase"
+	"github.com/Go-Marketplace/backend/product/internal/model"
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetStorefront(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ctx context.Context
+		req *pbProduct.GetStorefrontRequest
+	}
+
+	ctx := context.Background()
+	userID := uuid.New()
+
+	profile := &model.SellerProfile{
+		UserID:      userID,
+		DisplayName: "test",
+	}
+	products := []*model.Product{
+		{
+			ID:     uuid.New(),
+			UserID: userID,
+		},
+	}
+
+	approved := pbProduct.ModerationStatus_APPROVED
+	searchParams := dto.SearchProductsDTO{
+		UserID:           userID,
+		ModerationStatus: &approved,
+		Limit:            10,
+		Offset:           20,
+	}
+
+	expectedErrFromUsecase := errors.New("test error")
+
+	testcases := []struct {
+		name               string
+		args               args
+		mock               func(usecase *mocks.MockIProductUsecase)
+		expectedStorefront *model.Storefront
+		expectedErr        error
+	}{
+		{
+			name: "Successfully get storefront",
+			args: args{
+				ctx: ctx,
+				req: &pbProduct.GetStorefrontRequest{
+					UserId: userID.String(),
+					Limit:  10,
+					Offset: 20,
+				},
+			},
+			mock: func(usecase *mocks.MockIProductUsecase) {
+				usecase.EXPECT().GetSellerProfile(ctx, userID).Return(profile, nil).Times(1)
+				usecase.EXPECT().GetProducts(ctx, searchParams).Return(products, nil).Times(1)
+			},
+			expectedStorefront: &model.Storefront{
+				Profile:  profile,
+				Products: products,
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Seller without profile gets an empty profile",
+			args: args{
+				ctx: ctx,
+				req: &pbProduct.GetStorefrontRequest{
+					UserId: userID.String(),
+					Limit:  10,
+					Offset: 20,
+				},
+			},
+			mock: func(usecase *mocks.MockIProductUsecase) {
+				usecase.EXPECT().GetSellerProfile(ctx, userID).Return(nil, nil).Times(1)
+				usecase.EXPECT().GetProducts(ctx, searchParams).Return(products, nil).Times(1)
+			},
+			expectedStorefront: &model.Storefront{
+				Profile:  &model.SellerProfile{UserID: userID},
+				Products: products,
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Seller has neither profile nor products",
+			args: args{
+				ctx: ctx,
+				req: &pbProduct.GetStorefrontRequest{
+					UserId: userID.String(),
+					Limit:  10,
+					Offset: 20,
+				},
+			},
+			mock: func(usecase *mocks.MockIProductUsecase) {
+				usecase.EXPECT().GetSellerProfile(ctx, userID).Return(nil, nil).Times(1)
+				usecase.EXPECT().GetProducts(ctx, searchParams).Return([]*model.Product{}, nil).Times(1)
+			},
+			expectedStorefront: nil,
+			expectedErr:        status.Errorf(codes.NotFound, "Seller not found"),
+		},
+		{
+			name: "Got error when get seller products",
+			args: args{
+				ctx: ctx,
+				req: &pbProduct.GetStorefrontRequest{
+					UserId: userID.String(),
+					Limit:  10,
+					Offset: 20,
+				},
+			},
+			mock: func(usecase *mocks.MockIProductUsecase) {
+				usecase.EXPECT().GetSellerProfile(ctx, userID).Return(profile, nil).Times(1)
+				usecase.EXPECT().GetProducts(ctx, searchParams).Return(nil, expectedErrFromUsecase).Times(1)
+			},
+			expectedStorefront: nil,
+			expectedErr:        status.Errorf(codes.Internal, "Failed to get seller products: %s", expectedErrFromUsecase),
+		},
+		{
+			name: "Got error when user id is invalid",
+			args: args{
+				ctx: ctx,
+				req: &pbProduct.GetStorefrontRequest{
+					UserId: "123",
+				},
+			},
+			mock:               func(usecase *mocks.MockIProductUsecase) {},
+			expectedStorefront: nil,
+			expectedErr:        status.Errorf(codes.InvalidArgument, "Invalid user id: invalid UUID length: 3"),
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			productUsecase := productHelper(t)
+			testcase.mock(productUsecase)
+
+			actualStorefront, actualErr := controller.GetStorefront(
+				testcase.args.ctx,
+				productUsecase,
+				testcase.args.req,
+			)
+
+			assert.Equal(t, testcase.expectedStorefront, actualStorefront)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
@@ -0,0 +1,421 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Go-Marketplace/backend/product/internal/api/grpc/dto"
+	"github.com/Go-Marketplace/backend/product/internal/model"
+	"github.com/Go-Marketplace/backend/product/internal/usecase"
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func locationFromProto(location *pbProduct.Location) *model.Location {
+	if location == nil {
+		return nil
+	}
+
+	return &model.Location{
+		Latitude:  location.Latitude,
+		Longitude: location.Longitude,
+	}
+}
+
+// Parses an optional warehouse id, an empty id means no warehouse was chosen
+func parseWarehouseID(warehouseID string) (uuid.UUID, error) {
+	if warehouseID == "" {
+		return uuid.Nil, nil
+	}
+
+	return uuid.Parse(warehouseID)
+}
+
+func CreateWarehouse(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.CreateWarehouseRequest) (*model.Warehouse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid user id: %s", err)
+	}
+
+	if !req.Admin && req.CallerId != req.UserId {
+		return nil, status.Errorf(codes.PermissionDenied, "Sellers can create warehouses only for themselves")
+	}
+
+	warehouse := model.Warehouse{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Name:      req.Name,
+		Address:   req.Address,
+		CreatedAt: time.Now(),
+	}
+
+	if location := locationFromProto(req.Location); location != nil {
+		warehouse.Location = *location
+	}
+
+	if err = warehouse.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid warehouse request: %s", err)
+	}
+
+	newWarehouse, err := productUsecase.CreateWarehouse(ctx, warehouse)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to create warehouse: %s", err)
+	}
+
+	return newWarehouse, nil
+}
+
+func GetWarehouses(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.GetWarehousesRequest) ([]*model.Warehouse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid user id: %s", err)
+	}
+
+	warehouses, err := productUsecase.GetWarehouses(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get warehouses: %s", err)
+	}
+
+	return warehouses, nil
+}
+
+func SetWarehouseStock(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.SetWarehouseStockRequest) (*model.WarehouseStock, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	warehouseID, err := uuid.Parse(req.WarehouseId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid warehouse id: %s", err)
+	}
+
+	productID, err := uuid.Parse(req.ProductId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid product id: %s", err)
+	}
+
+	stock := model.WarehouseStock{
+		WarehouseID: warehouseID,
+		ProductID:   productID,
+		Quantity:    req.Quantity,
+		UpdatedAt:   time.Now(),
+	}
+
+	if err = stock.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid stock request: %s", err)
+	}
+
+	warehouse, err := productUsecase.GetWarehouse(ctx, warehouseID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get warehouse: %s", err)
+	}
+
+	if warehouse == nil {
+		return nil, status.Errorf(codes.NotFound, "Warehouse not found")
+	}
+
+	if !req.Admin && warehouse.UserID.String() != req.UserId {
+		return nil, status.Errorf(codes.PermissionDenied, "Sellers can stock only their own warehouses")
+	}
+
+	product, err := productUsecase.GetProduct(ctx, productID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get product: %s", err)
+	}
+
+	if product == nil {
+		return nil, status.Errorf(codes.NotFound, "Product not found")
+	}
+
+	if warehouse.UserID != product.UserID {
+		return nil, status.Errorf(codes.PermissionDenied, "Warehouse and product belong to different sellers")
+	}
+
+	newStock, err := productUsecase.SetWarehouseStock(ctx, stock)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to set warehouse stock: %s", err)
+	}
+
+	return newStock, nil
+}
+
+func GetProductStocks(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.GetProductStocksRequest) ([]*model.WarehouseStock, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	productID, err := uuid.Parse(req.ProductId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid product id: %s", err)
+	}
+
+	stocks, err := productUsecase.GetProductStocks(ctx, productID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get product stocks: %s", err)
+	}
+
+	return stocks, nil
+}
+
+func ReserveProduct(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.ReserveProductRequest) (*model.Reservation, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	productID, err := uuid.Parse(req.ProductId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid product id: %s", err)
+	}
+
+	warehouseID, err := parseWarehouseID(req.WarehouseId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid warehouse id: %s", err)
+	}
+
+	reservation := model.Reservation{
+		ProductID:   productID,
+		WarehouseID: warehouseID,
+		Quantity:    req.Quantity,
+	}
+
+	if err = reservation.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid reservation request: %s", err)
+	}
+
+	destination := locationFromProto(req.ShippingLocation)
+
+	newReservation, err := productUsecase.ReserveProduct(ctx, reservation, req.Strategy, destination)
+	if err != nil {
+		if errors.Is(err, model.ErrInsufficientStock) {
+			return nil, status.Errorf(codes.FailedPrecondition, "Not enough product in stock")
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to reserve product: %s", err)
+	}
+
+	return newReservation, nil
+}
+
+func ReleaseProduct(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.ReleaseProductRequest) (*model.Reservation, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	productID, err := uuid.Parse(req.ProductId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid product id: %s", err)
+	}
+
+	warehouseID, err := parseWarehouseID(req.WarehouseId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid warehouse id: %s", err)
+	}
+
+	reservation := model.Reservation{
+		ProductID:   productID,
+		WarehouseID: warehouseID,
+		Quantity:    req.Quantity,
+	}
+
+	if err = reservation.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid release request: %s", err)
+	}
+
+	releasedReservation, err := productUsecase.ReleaseProduct(ctx, reservation)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to release product: %s", err)
+	}
+
+	return releasedReservation, nil
+}
+
+func ChangeReservations(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.ChangeReservationsRequest) ([]*model.ReservationChange, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	changes := make([]*model.ReservationChange, 0, len(req.Changes))
+	for _, protoChange := range req.Changes {
+		productID, err := uuid.Parse(protoChange.ProductId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid product id: %s", err)
+		}
+
+		warehouseID, err := parseWarehouseID(protoChange.WarehouseId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid warehouse id: %s", err)
+		}
+
+		change := &model.ReservationChange{
+			ProductID:   productID,
+			WarehouseID: warehouseID,
+			Quantity:    protoChange.Quantity,
+			Strategy:    protoChange.Strategy,
+			Destination: locationFromProto(protoChange.ShippingLocation),
+		}
+
+		if err = change.Validate(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid reservation change: %s", err)
+		}
+
+		changes = append(changes, change)
+	}
+
+	newChanges, err := productUsecase.ChangeReservations(ctx, changes)
+	if err != nil {
+		if errors.Is(err, model.ErrInsufficientStock) {
+			return nil, status.Errorf(codes.FailedPrecondition, "Not enough product in stock")
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to change reservations: %s", err)
+	}
+
+	return newChanges, nil
+}
+
+func SetStockAlert(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.SetStockAlertRequest) (*model.Product, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	productID, err := uuid.Parse(req.ProductId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid product id: %s", err)
+	}
+
+	product, err := productUsecase.GetProduct(ctx, productID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get product: %s", err)
+	}
+
+	if product == nil {
+		return nil, status.Errorf(codes.NotFound, "Product not found")
+	}
+
+	if !req.Admin && product.UserID.String() != req.UserId {
+		return nil, status.Errorf(codes.PermissionDenied, "Sellers can set stock alerts only on their own products")
+	}
+
+	actorID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid user id: %s", err)
+	}
+
+	product.LowStockThreshold = req.LowStockThreshold
+	product.HideWhenOutOfStock = req.HideWhenOutOfStock
+	product.ActorID = &actorID
+
+	if err = product.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid stock alert request: %s", err)
+	}
+
+	product, err = productUsecase.SetStockAlert(ctx, *product)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to set stock alert: %s", err)
+	}
+
+	return product, nil
+}
+
+func SetPurchaseLimit(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.SetPurchaseLimitRequest) (*model.Product, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	productID, err := uuid.Parse(req.ProductId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid product id: %s", err)
+	}
+
+	product, err := productUsecase.GetProduct(ctx, productID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get product: %s", err)
+	}
+
+	if product == nil {
+		return nil, status.Errorf(codes.NotFound, "Product not found")
+	}
+
+	if !req.Admin && product.UserID.String() != req.UserId {
+		return nil, status.Errorf(codes.PermissionDenied, "Sellers can limit only their own products")
+	}
+
+	actorID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid user id: %s", err)
+	}
+
+	product.MaxPerCustomer = req.MaxPerCustomer
+	product.ActorID = &actorID
+
+	if err = product.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid purchase limit request: %s", err)
+	}
+
+	product, err = productUsecase.SetPurchaseLimit(ctx, *product)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to set purchase limit: %s", err)
+	}
+
+	return product, nil
+}
+
+func GetNotifications(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.GetNotificationsRequest) ([]*model.Notification, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid user id: %s", err)
+	}
+
+	notifications, err := productUsecase.GetNotifications(ctx, dto.SearchNotificationsDTO{
+		UserID: userID,
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get notifications: %s", err)
+	}
+
+	return notifications, nil
+}
+
+func CreateNotification(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.CreateNotificationRequest) (*model.Notification, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid user id: %s", err)
+	}
+
+	notification := model.Notification{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Kind:      model.NotificationKind(req.Kind),
+		Message:   req.Message,
+		CreatedAt: time.Now(),
+	}
+
+	if err = notification.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid notification request: %s", err)
+	}
+
+	if err = productUsecase.CreateNotification(ctx, notification); err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to create notification: %s", err)
+	}
+
+	return &notification, nil
+}
@@ -0,0 +1,271 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Go-Marketplace/backend/product/internal/api/grpc/controller"
+	mocks "github.com/Go-Marketplace/backend/product/internal/mocks/usecase"
+	"github.com/Go-Marketplace/backend/product/internal/model"
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateWarehouse(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sellerID := uuid.New()
+	otherUserID := uuid.New()
+
+	expectedWarehouseFromUsecase := &model.Warehouse{
+		ID:     uuid.New(),
+		UserID: sellerID,
+		Name:   "Main",
+	}
+
+	testcases := []struct {
+		name              string
+		req               *pbProduct.CreateWarehouseRequest
+		mock              func(usecase *mocks.MockIProductUsecase)
+		expectedWarehouse *model.Warehouse
+		expectedErr       error
+	}{
+		{
+			name: "Seller creates own warehouse",
+			req: &pbProduct.CreateWarehouseRequest{
+				UserId:   sellerID.String(),
+				Name:     "Main",
+				Address:  "Main street",
+				CallerId: sellerID.String(),
+			},
+			mock: func(usecase *mocks.MockIProductUsecase) {
+				usecase.EXPECT().CreateWarehouse(ctx, gomock.Any()).Return(expectedWarehouseFromUsecase, nil).Times(1)
+			},
+			expectedWarehouse: expectedWarehouseFromUsecase,
+			expectedErr:       nil,
+		},
+		{
+			name: "Admin creates warehouse for seller",
+			req: &pbProduct.CreateWarehouseRequest{
+				UserId:   sellerID.String(),
+				Name:     "Main",
+				Address:  "Main street",
+				CallerId: otherUserID.String(),
+				Admin:    true,
+			},
+			mock: func(usecase *mocks.MockIProductUsecase) {
+				usecase.EXPECT().CreateWarehouse(ctx, gomock.Any()).Return(expectedWarehouseFromUsecase, nil).Times(1)
+			},
+			expectedWarehouse: expectedWarehouseFromUsecase,
+			expectedErr:       nil,
+		},
+		{
+			name: "Got error when user creates warehouse for another seller",
+			req: &pbProduct.CreateWarehouseRequest{
+				UserId:   sellerID.String(),
+				Name:     "Main",
+				Address:  "Main street",
+				CallerId: otherUserID.String(),
+			},
+			mock:              func(usecase *mocks.MockIProductUsecase) {},
+			expectedWarehouse: nil,
+			expectedErr:       status.Errorf(codes.PermissionDenied, "Sellers can create warehouses only for themselves"),
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			productUsecase := productHelper(t)
+			testcase.mock(productUsecase)
+
+			actualWarehouse, actualErr := controller.CreateWarehouse(ctx, productUsecase, testcase.req)
+
+			assert.Equal(t, testcase.expectedWarehouse, actualWarehouse)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestSetWarehouseStock(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sellerID := uuid.New()
+	otherUserID := uuid.New()
+	warehouseID := uuid.New()
+	productID := uuid.New()
+
+	warehouse := &model.Warehouse{
+		ID:     warehouseID,
+		UserID: sellerID,
+	}
+	product := &model.Product{
+		ID:     productID,
+		UserID: sellerID,
+	}
+	expectedStockFromUsecase := &model.WarehouseStock{
+		WarehouseID: warehouseID,
+		ProductID:   productID,
+		Quantity:    10,
+	}
+
+	testcases := []struct {
+		name          string
+		req           *pbProduct.SetWarehouseStockRequest
+		mock          func(usecase *mocks.MockIProductUsecase)
+		expectedStock *model.WarehouseStock
+		expectedErr   error
+	}{
+		{
+			name: "Seller stocks own warehouse",
+			req: &pbProduct.SetWarehouseStockRequest{
+				WarehouseId: warehouseID.String(),
+				ProductId:   productID.String(),
+				Quantity:    10,
+				UserId:      sellerID.String(),
+			},
+			mock: func(usecase *mocks.MockIProductUsecase) {
+				usecase.EXPECT().GetWarehouse(ctx, warehouseID).Return(warehouse, nil).Times(1)
+				usecase.EXPECT().GetProduct(ctx, productID).Return(product, nil).Times(1)
+				usecase.EXPECT().SetWarehouseStock(ctx, gomock.Any()).Return(expectedStockFromUsecase, nil).Times(1)
+			},
+			expectedStock: expectedStockFromUsecase,
+			expectedErr:   nil,
+		},
+		{
+			name: "Admin stocks seller warehouse",
+			req: &pbProduct.SetWarehouseStockRequest{
+				WarehouseId: warehouseID.String(),
+				ProductId:   productID.String(),
+				Quantity:    10,
+				UserId:      otherUserID.String(),
+				Admin:       true,
+			},
+			mock: func(usecase *mocks.MockIProductUsecase) {
+				usecase.EXPECT().GetWarehouse(ctx, warehouseID).Return(warehouse, nil).Times(1)
+				usecase.EXPECT().GetProduct(ctx, productID).Return(product, nil).Times(1)
+				usecase.EXPECT().SetWarehouseStock(ctx, gomock.Any()).Return(expectedStockFromUsecase, nil).Times(1)
+			},
+			expectedStock: expectedStockFromUsecase,
+			expectedErr:   nil,
+		},
+		{
+			name: "Got error when user stocks another seller warehouse",
+			req: &pbProduct.SetWarehouseStockRequest{
+				WarehouseId: warehouseID.String(),
+				ProductId:   productID.String(),
+				Quantity:    10,
+				UserId:      otherUserID.String(),
+			},
+			mock: func(usecase *mocks.MockIProductUsecase) {
+				usecase.EXPECT().GetWarehouse(ctx, warehouseID).Return(warehouse, nil).Times(1)
+			},
+			expectedStock: nil,
+			expectedErr:   status.Errorf(codes.PermissionDenied, "Sellers can stock only their own warehouses"),
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			productUsecase := productHelper(t)
+			testcase.mock(productUsecase)
+
+			actualStock, actualErr := controller.SetWarehouseStock(ctx, productUsecase, testcase.req)
+
+			assert.Equal(t, testcase.expectedStock, actualStock)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestSetStockAlert(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sellerID := uuid.New()
+	adminID := uuid.New()
+	productID := uuid.New()
+
+	expectedProductFromUsecase := &model.Product{
+		ID:                productID,
+		UserID:            sellerID,
+		LowStockThreshold: 5,
+	}
+
+	testcases := []struct {
+		name            string
+		req             *pbProduct.SetStockAlertRequest
+		mock            func(usecase *mocks.MockIProductUsecase)
+		expectedProduct *model.Product
+		expectedErr     error
+	}{
+		{
+			name: "Admin sets stock alert as the actor",
+			req: &pbProduct.SetStockAlertRequest{
+				ProductId:         productID.String(),
+				LowStockThreshold: 5,
+				UserId:            adminID.String(),
+				Admin:             true,
+			},
+			mock: func(usecase *mocks.MockIProductUsecase) {
+				usecase.EXPECT().GetProduct(ctx, productID).Return(&model.Product{
+					ID:     productID,
+					UserID: sellerID,
+				}, nil).Times(1)
+				usecase.EXPECT().SetStockAlert(ctx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, product model.Product) (*model.Product, error) {
+						assert.Equal(t, adminID, *product.ActorID)
+						return expectedProductFromUsecase, nil
+					},
+				).Times(1)
+			},
+			expectedProduct: expectedProductFromUsecase,
+			expectedErr:     nil,
+		},
+		{
+			name: "Got error when user sets stock alert on another seller product",
+			req: &pbProduct.SetStockAlertRequest{
+				ProductId:         productID.String(),
+				LowStockThreshold: 5,
+				UserId:            uuid.NewString(),
+			},
+			mock: func(usecase *mocks.MockIProductUsecase) {
+				usecase.EXPECT().GetProduct(ctx, productID).Return(&model.Product{
+					ID:     productID,
+					UserID: sellerID,
+				}, nil).Times(1)
+			},
+			expectedProduct: nil,
+			expectedErr:     status.Errorf(codes.PermissionDenied, "Sellers can set stock alerts only on their own products"),
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			productUsecase := productHelper(t)
+			testcase.mock(productUsecase)
+
+			actualProduct, actualErr := controller.SetStockAlert(ctx, productUsecase, testcase.req)
+
+			assert.Equal(t, testcase.expectedProduct, actualProduct)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
@@ -0,0 +1,304 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/Go-Marketplace/backend/product/internal/model"
+	"github.com/Go-Marketplace/backend/product/internal/usecase"
+	pbCart "github.com/Go-Marketplace/backend/proto/gen/cart"
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Returns the wishlist if it belongs to the user
+func getUserWishlist(ctx context.Context, productUsecase usecase.IProductUsecase, userID uuid.UUID, wishlistID string) (*model.Wishlist, error) {
+	id, err := uuid.Parse(wishlistID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid wishlist id: %s", err)
+	}
+
+	wishlist, err := productUsecase.GetWishlist(ctx, id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get wishlist: %s", err)
+	}
+
+	if wishlist == nil {
+		return nil, status.Errorf(codes.NotFound, "Wishlist not found")
+	}
+
+	if wishlist.UserID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "Wishlist belongs to another user")
+	}
+
+	return wishlist, nil
+}
+
+func createWishlist(ctx context.Context, productUsecase usecase.IProductUsecase, userID uuid.UUID, name string) (*model.Wishlist, error) {
+	wishlist := model.Wishlist{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Name:      name,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	if err := wishlist.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid wishlist request: %s", err)
+	}
+
+	existingWishlist, err := productUsecase.GetWishlistByName(ctx, userID, name)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get wishlist: %s", err)
+	}
+
+	if existingWishlist != nil {
+		return nil, status.Errorf(codes.AlreadyExists, "Wishlist with this name already exists")
+	}
+
+	newWishlist, err := productUsecase.CreateWishlist(ctx, wishlist)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to create wishlist: %s", err)
+	}
+
+	return newWishlist, nil
+}
+
+func CreateWishlist(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.CreateWishlistRequest) (*model.Wishlist, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid user id: %s", err)
+	}
+
+	return createWishlist(ctx, productUsecase, userID, req.Name)
+}
+
+func GetWishlists(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.GetWishlistsRequest) ([]*model.Wishlist, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid user id: %s", err)
+	}
+
+	wishlists, err := productUsecase.GetWishlists(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get wishlists: %s", err)
+	}
+
+	return wishlists, nil
+}
+
+func DeleteWishlist(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.DeleteWishlistRequest) error {
+	if req == nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid user id: %s", err)
+	}
+
+	wishlist, err := getUserWishlist(ctx, productUsecase, userID, req.WishlistId)
+	if err != nil {
+		return err
+	}
+
+	if err = productUsecase.DeleteWishlist(ctx, wishlist.ID); err != nil {
+		return status.Errorf(codes.Internal, "Failed to delete wishlist: %s", err)
+	}
+
+	return nil
+}
+
+func addWishlistItem(ctx context.Context, productUsecase usecase.IProductUsecase, wishlist *model.Wishlist, productID uuid.UUID) (*model.Wishlist, error) {
+	product, err := productUsecase.GetProduct(ctx, productID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get product: %s", err)
+	}
+
+	if product == nil {
+		return nil, status.Errorf(codes.NotFound, "Product not found")
+	}
+
+	newWishlist, err := productUsecase.AddWishlistItem(ctx, model.WishlistItem{
+		WishlistID: wishlist.ID,
+		ProductID:  productID,
+		AddedAt:    time.Now(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to add product to wishlist: %s", err)
+	}
+
+	return newWishlist, nil
+}
+
+func AddWishlistItem(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.AddWishlistItemRequest) (*model.Wishlist, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid user id: %s", err)
+	}
+
+	productID, err := uuid.Parse(req.ProductId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid product id: %s", err)
+	}
+
+	wishlist, err := getUserWishlist(ctx, productUsecase, userID, req.WishlistId)
+	if err != nil {
+		return nil, err
+	}
+
+	return addWishlistItem(ctx, productUsecase, wishlist, productID)
+}
+
+func RemoveWishlistItem(ctx context.Context, productUsecase usecase.IProductUsecase, req *pbProduct.RemoveWishlistItemRequest) (*model.Wishlist, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid user id: %s", err)
+	}
+
+	productID, err := uuid.Parse(req.ProductId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid product id: %s", err)
+	}
+
+	wishlist, err := getUserWishlist(ctx, productUsecase, userID, req.WishlistId)
+	if err != nil {
+		return nil, err
+	}
+
+	newWishlist, err := productUsecase.RemoveWishlistItem(ctx, wishlist.ID, productID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to remove product from wishlist: %s", err)
+	}
+
+	return newWishlist, nil
+}
+
+// The product is put in the cart first, so it stays in the wishlist if it cannot be reserved
+func MoveWishlistItemToCart(
+	ctx context.Context,
+	productUsecase usecase.IProductUsecase,
+	cartClient pbCart.CartClient,
+	req *pbProduct.MoveWishlistItemToCartRequest,
+) (*model.Wishlist, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid user id: %s", err)
+	}
+
+	productID, err := uuid.Parse(req.ProductId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid product id: %s", err)
+	}
+
+	wishlist, err := getUserWishlist(ctx, productUsecase, userID, req.WishlistId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !wishlist.Contains(productID) {
+		return nil, status.Errorf(codes.NotFound, "Product is not in the wishlist")
+	}
+
+	if _, err = cartClient.CreateCartline(ctx, &pbCart.CreateCartlineRequest{
+		UserId:           req.UserId,
+		ProductId:        req.ProductId,
+		Strategy:         req.Strategy,
+		ShippingLocation: req.ShippingLocation,
+	}); err != nil {
+		return nil, status.Errorf(status.Code(err), "Failed to create cartline: %s", status.Convert(err).Message())
+	}
+
+	newWishlist, err := productUsecase.RemoveWishlistItem(ctx, wishlist.ID, productID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to remove product from wishlist: %s", err)
+	}
+
+	return newWishlist, nil
+}
+
+// The product is put in the wishlist first, so it is not lost if the cartline cannot be deleted.
+// Without a wishlist id the product goes to the "Saved for later" wishlist, which is created on demand
+func MoveCartlineToWishlist(
+	ctx context.Context,
+	productUsecase usecase.IProductUsecase,
+	cartClient pbCart.CartClient,
+	req *pbProduct.MoveCartlineToWishlistRequest,
+) (*model.Wishlist, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid user id: %s", err)
+	}
+
+	productID, err := uuid.Parse(req.ProductId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid product id: %s", err)
+	}
+
+	var wishlist *model.Wishlist
+	if req.WishlistId != "" {
+		wishlist, err = getUserWishlist(ctx, productUsecase, userID, req.WishlistId)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		wishlist, err = productUsecase.GetWishlistByName(ctx, userID, model.SavedForLater)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to get wishlist: %s", err)
+		}
+
+		if wishlist == nil {
+			wishlist, err = createWishlist(ctx, productUsecase, userID, model.SavedForLater)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	alreadyWishlisted := wishlist.Contains(productID)
+
+	newWishlist, err := addWishlistItem(ctx, productUsecase, wishlist, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = cartClient.DeleteCartline(ctx, &pbCart.DeleteCartlineRequest{
+		UserId:    req.UserId,
+		ProductId: req.ProductId,
+	}); err != nil {
+		if !alreadyWishlisted {
+			if _, errRemove := productUsecase.RemoveWishlistItem(ctx, wishlist.ID, productID); errRemove != nil {
+				return nil, status.Errorf(codes.Internal, "Failed to remove product from wishlist: %s", errRemove)
+			}
+		}
+		return nil, status.Errorf(status.Code(err), "Failed to delete cartline: %s", status.Convert(err).Message())
+	}
+
+	return newWishlist, nil
+}
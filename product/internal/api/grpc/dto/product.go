@@ -1,9 +1,50 @@
 package dto
 
-import "github.com/google/uuid"
+import (
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+	"github.com/google/uuid"
+)
 
 type SearchProductsDTO struct {
-	UserID     uuid.UUID
-	CategoryID int32
-	Moderated  bool
+	UserID           uuid.UUID
+	CategoryID       int32
+	ExternalSKU      string
+	ModerationStatus *pbProduct.ModerationStatus
+	MinRating        float32
+	Order            pbProduct.ProductsOrder
+	IncludeHidden    bool
+	Limit            uint64
+	Offset           uint64
+}
+
+type ModerationQueueDTO struct {
+	Limit  uint64
+	Offset uint64
+}
+
+type SearchReviewsDTO struct {
+	ProductID uuid.UUID
+	UserID    uuid.UUID
+	Limit     uint64
+	Offset    uint64
+}
+
+type SearchDiscountsDTO struct {
+	Scope          pbProduct.DiscountScope
+	ProductID      uuid.UUID
+	CategoryID     int32
+	SellerID       uuid.UUID
+	IncludeExpired bool
+}
+
+type SearchNotificationsDTO struct {
+	UserID uuid.UUID
+	Limit  uint64
+	Offset uint64
+}
+
+type SearchRevisionsDTO struct {
+	ProductID uuid.UUID
+	Limit     uint64
+	Offset    uint64
 }
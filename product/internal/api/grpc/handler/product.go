@@ -5,8 +5,10 @@ import (
 
 	"github.com/Go-Marketplace/backend/pkg/logger"
 	"github.com/Go-Marketplace/backend/product/internal/api/grpc/controller"
+	"github.com/Go-Marketplace/backend/product/internal/model"
 	"github.com/Go-Marketplace/backend/product/internal/usecase"
 	pbCart "github.com/Go-Marketplace/backend/proto/gen/cart"
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
 	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
 )
 
@@ -15,17 +17,20 @@ type productRoutes struct {
 
 	productUsecase *usecase.ProductUsecase
 	cartClient     pbCart.CartClient
+	orderClient    pbOrder.OrderClient
 	logger         *logger.Logger
 }
 
 func NewProductRoutes(
 	productUsecase *usecase.ProductUsecase,
 	cartClient pbCart.CartClient,
+	orderClient pbOrder.OrderClient,
 	logger *logger.Logger,
 ) *productRoutes {
 	return &productRoutes{
 		productUsecase: productUsecase,
 		cartClient:     cartClient,
+		orderClient:    orderClient,
 		logger:         logger,
 	}
 }
@@ -101,6 +106,15 @@ func (routes *productRoutes) DeleteUserProducts(ctx context.Context, req *pbProd
 	return &pbProduct.DeleteUserProductsResponse{}, nil
 }
 
+func (routes *productRoutes) RestoreProduct(ctx context.Context, req *pbProduct.RestoreProductRequest) (*pbProduct.ProductResponse, error) {
+	product, err := controller.RestoreProduct(ctx, routes.productUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return product.ToProto(), nil
+}
+
 func (routes *productRoutes) ModerateProduct(ctx context.Context, req *pbProduct.ModerateProductRequest) (*pbProduct.ProductResponse, error) {
 	product, err := controller.ModerateProduct(ctx, routes.productUsecase, req)
 	if err != nil {
@@ -110,6 +124,63 @@ func (routes *productRoutes) ModerateProduct(ctx context.Context, req *pbProduct
 	return product.ToProto(), nil
 }
 
+func (routes *productRoutes) SubmitProduct(ctx context.Context, req *pbProduct.SubmitProductRequest) (*pbProduct.ProductResponse, error) {
+	product, err := controller.SubmitProduct(ctx, routes.productUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return product.ToProto(), nil
+}
+
+func (routes *productRoutes) GetModerationQueue(ctx context.Context, req *pbProduct.GetModerationQueueRequest) (*pbProduct.ProductsResponse, error) {
+	products, err := controller.GetModerationQueue(ctx, routes.productUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	protoProducts := make([]*pbProduct.ProductResponse, 0, len(products))
+	for _, product := range products {
+		if product != nil {
+			protoProducts = append(protoProducts, product.ToProto())
+		} else {
+			routes.logger.Warn("Get nil product in GetModerationQueue handler")
+		}
+	}
+
+	return &pbProduct.ProductsResponse{
+		Products: protoProducts,
+	}, nil
+}
+
+func (routes *productRoutes) ImportProducts(stream pbProduct.Product_ImportProductsServer) error {
+	results, err := controller.ImportProducts(stream.Context(), routes.productUsecase, stream)
+	if err != nil {
+		return err
+	}
+
+	resp := &pbProduct.ImportProductsResponse{
+		Results: make([]*pbProduct.ImportRowResult, 0, len(results)),
+	}
+	for _, result := range results {
+		switch result.Status {
+		case model.ImportCreated:
+			resp.Created++
+		case model.ImportUpdated:
+			resp.Updated++
+		default:
+			resp.Failed++
+		}
+		resp.Results = append(resp.Results, result.ToProto())
+	}
+
+	return stream.SendAndClose(resp)
+}
+
+func (routes *productRoutes) ExportProducts(req *pbProduct.ExportProductsRequest, stream pbProduct.Product_ExportProductsServer) error {
+	return controller.ExportProducts(stream.Context(), routes.productUsecase, req, stream)
+}
+
 func (routes *productRoutes) GetCategory(ctx context.Context, req *pbProduct.GetCategoryRequest) (*pbProduct.CategoryResponse, error) {
 	category, err := controller.GetCategory(ctx, routes.productUsecase, req)
 	if err != nil {
@@ -152,3 +223,374 @@ func (routes *productRoutes) DeleteDiscount(ctx context.Context, req *pbProduct.
 
 	return product.ToProto(), nil
 }
+
+func (routes *productRoutes) CreateCategoryDiscount(ctx context.Context, req *pbProduct.CreateCategoryDiscountRequest) (*pbProduct.DiscountResponse, error) {
+	discount, err := controller.CreateCategoryDiscount(ctx, routes.productUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return discount.ToProto(), nil
+}
+
+func (routes *productRoutes) DeleteCategoryDiscount(ctx context.Context, req *pbProduct.DeleteCategoryDiscountRequest) (*pbProduct.DeleteDiscountResponse, error) {
+	if err := controller.DeleteCategoryDiscount(ctx, routes.productUsecase, req); err != nil {
+		return nil, err
+	}
+
+	return &pbProduct.DeleteDiscountResponse{}, nil
+}
+
+func (routes *productRoutes) CreateSellerDiscount(ctx context.Context, req *pbProduct.CreateSellerDiscountRequest) (*pbProduct.DiscountResponse, error) {
+	discount, err := controller.CreateSellerDiscount(ctx, routes.productUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return discount.ToProto(), nil
+}
+
+func (routes *productRoutes) DeleteSellerDiscount(ctx context.Context, req *pbProduct.DeleteSellerDiscountRequest) (*pbProduct.DeleteDiscountResponse, error) {
+	if err := controller.DeleteSellerDiscount(ctx, routes.productUsecase, req); err != nil {
+		return nil, err
+	}
+
+	return &pbProduct.DeleteDiscountResponse{}, nil
+}
+
+func (routes *productRoutes) GetDiscounts(ctx context.Context, req *pbProduct.GetDiscountsRequest) (*pbProduct.DiscountsResponse, error) {
+	discounts, err := controller.GetDiscounts(ctx, routes.productUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	protoDiscounts := make([]*pbProduct.DiscountResponse, 0, len(discounts))
+	for _, discount := range discounts {
+		protoDiscounts = append(protoDiscounts, discount.ToProto())
+	}
+
+	return &pbProduct.DiscountsResponse{
+		Discounts: protoDiscounts,
+	}, nil
+}
+
+func (routes *productRoutes) CreateReview(ctx context.Context, req *pbProduct.CreateReviewRequest) (*pbProduct.ReviewResponse, error) {
+	review, err := controller.CreateReview(ctx, routes.productUsecase, routes.orderClient, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return review.ToProto(), nil
+}
+
+func (routes *productRoutes) GetProductReviews(ctx context.Context, req *pbProduct.GetProductReviewsRequest) (*pbProduct.ReviewsResponse, error) {
+	reviews, err := controller.GetProductReviews(ctx, routes.productUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	protoReviews := make([]*pbProduct.ReviewResponse, 0, len(reviews))
+	for _, review := range reviews {
+		protoReviews = append(protoReviews, review.ToProto())
+	}
+
+	return &pbProduct.ReviewsResponse{
+		Reviews: protoReviews,
+	}, nil
+}
+
+func (routes *productRoutes) ReplyReview(ctx context.Context, req *pbProduct.ReplyReviewRequest) (*pbProduct.ReviewResponse, error) {
+	review, err := controller.ReplyReview(ctx, routes.productUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return review.ToProto(), nil
+}
+
+func (routes *productRoutes) CreateWarehouse(ctx context.Context, req *pbProduct.CreateWarehouseRequest) (*pbProduct.WarehouseResponse, error) {
+	warehouse, err := controller.CreateWarehouse(ctx, routes.productUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return warehouse.ToProto(), nil
+}
+
+func (routes *productRoutes) GetWarehouses(ctx context.Context, req *pbProduct.GetWarehousesRequest) (*pbProduct.WarehousesResponse, error) {
+	warehouses, err := controller.GetWarehouses(ctx, routes.productUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	protoWarehouses := make([]*pbProduct.WarehouseResponse, 0, len(warehouses))
+	for _, warehouse := range warehouses {
+		protoWarehouses = append(protoWarehouses, warehouse.ToProto())
+	}
+
+	return &pbProduct.WarehousesResponse{
+		Warehouses: protoWarehouses,
+	}, nil
+}
+
+func (routes *productRoutes) SetWarehouseStock(ctx context.Context, req *pbProduct.SetWarehouseStockRequest) (*pbProduct.WarehouseStockResponse, error) {
+	stock, err := controller.SetWarehouseStock(ctx, routes.productUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return stock.ToProto(), nil
+}
+
+func (routes *productRoutes) GetProductStocks(ctx context.Context, req *pbProduct.GetProductStocksRequest) (*pbProduct.WarehouseStocksResponse, error) {
+	stocks, err := controller.GetProductStocks(ctx, routes.productUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	protoStocks := make([]*pbProduct.WarehouseStockResponse, 0, len(stocks))
+	for _, stock := range stocks {
+		protoStocks = append(protoStocks, stock.ToProto())
+	}
+
+	return &pbProduct.WarehouseStocksResponse{
+		Stocks: protoStocks,
+	}, nil
+}
+
+func (routes *productRoutes) ReserveProduct(ctx context.Context, req *pbProduct.ReserveProductRequest) (*pbProduct.ReservationResponse, error) {
+	reservation, err := controller.ReserveProduct(ctx, routes.productUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return reservation.ToProto(), nil
+}
+
+func (routes *productRoutes) ReleaseProduct(ctx context.Context, req *pbProduct.ReleaseProductRequest) (*pbProduct.ReservationResponse, error) {
+	reservation, err := controller.ReleaseProduct(ctx, routes.productUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return reservation.ToProto(), nil
+}
+
+func (routes *productRoutes) ChangeReservations(ctx context.Context, req *pbProduct.ChangeReservationsRequest) (*pbProduct.ReservationsResponse, error) {
+	changes, err := controller.ChangeReservations(ctx, routes.productUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	reservations := make([]*pbProduct.ReservationResponse, 0, len(changes))
+	for _, change := range changes {
+		reservations = append(reservations, change.ToProto())
+	}
+
+	return &pbProduct.ReservationsResponse{
+		Reservations: reservations,
+	}, nil
+}
+
+func (routes *productRoutes) SetStockAlert(ctx context.Context, req *pbProduct.SetStockAlertRequest) (*pbProduct.ProductResponse, error) {
+	product, err := controller.SetStockAlert(ctx, routes.productUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return product.ToProto(), nil
+}
+
+func (routes *productRoutes) SetPurchaseLimit(ctx context.Context, req *pbProduct.SetPurchaseLimitRequest) (*pbProduct.ProductResponse, error) {
+	product, err := controller.SetPurchaseLimit(ctx, routes.productUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return product.ToProto(), nil
+}
+
+func (routes *productRoutes) GetNotifications(ctx context.Context, req *pbProduct.GetNotificationsRequest) (*pbProduct.NotificationsResponse, error) {
+	notifications, err := controller.GetNotifications(ctx, routes.productUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	protoNotifications := make([]*pbProduct.NotificationResponse, 0, len(notifications))
+	for _, notification := range notifications {
+		protoNotifications = append(protoNotifications, notification.ToProto())
+	}
+
+	return &pbProduct.NotificationsResponse{
+		Notifications: protoNotifications,
+	}, nil
+}
+
+func (routes *productRoutes) CreateNotification(ctx context.Context, req *pbProduct.CreateNotificationRequest) (*pbProduct.NotificationResponse, error) {
+	notification, err := controller.CreateNotification(ctx, routes.productUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return notification.ToProto(), nil
+}
+
+func (routes *productRoutes) GetProductHistory(ctx context.Context, req *pbProduct.GetProductHistoryRequest) (*pbProduct.ProductHistoryResponse, error) {
+	revisions, err := controller.GetProductHistory(ctx, routes.productUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	protoRevisions := make([]*pbProduct.ProductRevisionResponse, 0, len(revisions))
+	for _, revision := range revisions {
+		protoRevisions = append(protoRevisions, revision.ToProto())
+	}
+
+	return &pbProduct.ProductHistoryResponse{
+		Revisions: protoRevisions,
+	}, nil
+}
+
+func (routes *productRoutes) GetPriceHistory(ctx context.Context, req *pbProduct.GetPriceHistoryRequest) (*pbProduct.PriceHistoryResponse, error) {
+	history, err := controller.GetPriceHistory(ctx, routes.productUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return history.ToProto(), nil
+}
+
+func exchangeRatesResponse(rates []*model.ExchangeRate) *pbProduct.ExchangeRatesResponse {
+	protoRates := make([]*pbProduct.ExchangeRateResponse, 0, len(rates))
+	for _, rate := range rates {
+		protoRates = append(protoRates, rate.ToProto())
+	}
+
+	return &pbProduct.ExchangeRatesResponse{
+		BaseCurrency: model.BaseCurrency,
+		Rates:        protoRates,
+	}
+}
+
+func (routes *productRoutes) GetExchangeRates(ctx context.Context, req *pbProduct.GetExchangeRatesRequest) (*pbProduct.ExchangeRatesResponse, error) {
+	rates, err := controller.GetExchangeRates(ctx, routes.productUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return exchangeRatesResponse(rates), nil
+}
+
+func (routes *productRoutes) SetExchangeRate(ctx context.Context, req *pbProduct.SetExchangeRateRequest) (*pbProduct.ExchangeRateResponse, error) {
+	rate, err := controller.SetExchangeRate(ctx, routes.productUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rate.ToProto(), nil
+}
+
+func (routes *productRoutes) ImportExchangeRates(ctx context.Context, req *pbProduct.ImportExchangeRatesRequest) (*pbProduct.ExchangeRatesResponse, error) {
+	rates, err := controller.ImportExchangeRates(ctx, routes.productUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return exchangeRatesResponse(rates), nil
+}
+
+func (routes *productRoutes) GetSellerProfile(ctx context.Context, req *pbProduct.GetSellerProfileRequest) (*pbProduct.SellerProfileResponse, error) {
+	profile, err := controller.GetSellerProfile(ctx, routes.productUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return profile.ToProto(), nil
+}
+
+func (routes *productRoutes) SetSellerProfile(ctx context.Context, req *pbProduct.SetSellerProfileRequest) (*pbProduct.SellerProfileResponse, error) {
+	profile, err := controller.SetSellerProfile(ctx, routes.productUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return profile.ToProto(), nil
+}
+
+func (routes *productRoutes) GetStorefront(ctx context.Context, req *pbProduct.GetStorefrontRequest) (*pbProduct.StorefrontResponse, error) {
+	storefront, err := controller.GetStorefront(ctx, routes.productUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return storefront.ToProto(), nil
+}
+
+func (routes *productRoutes) CreateWishlist(ctx context.Context, req *pbProduct.CreateWishlistRequest) (*pbProduct.WishlistResponse, error) {
+	wishlist, err := controller.CreateWishlist(ctx, routes.productUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return wishlist.ToProto(), nil
+}
+
+func (routes *productRoutes) GetWishlists(ctx context.Context, req *pbProduct.GetWishlistsRequest) (*pbProduct.WishlistsResponse, error) {
+	wishlists, err := controller.GetWishlists(ctx, routes.productUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	protoWishlists := make([]*pbProduct.WishlistResponse, 0, len(wishlists))
+	for _, wishlist := range wishlists {
+		protoWishlists = append(protoWishlists, wishlist.ToProto())
+	}
+
+	return &pbProduct.WishlistsResponse{
+		Wishlists: protoWishlists,
+	}, nil
+}
+
+func (routes *productRoutes) DeleteWishlist(ctx context.Context, req *pbProduct.DeleteWishlistRequest) (*pbProduct.DeleteWishlistResponse, error) {
+	if err := controller.DeleteWishlist(ctx, routes.productUsecase, req); err != nil {
+		return nil, err
+	}
+
+	return &pbProduct.DeleteWishlistResponse{}, nil
+}
+
+func (routes *productRoutes) AddWishlistItem(ctx context.Context, req *pbProduct.AddWishlistItemRequest) (*pbProduct.WishlistResponse, error) {
+	wishlist, err := controller.AddWishlistItem(ctx, routes.productUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return wishlist.ToProto(), nil
+}
+
+func (routes *productRoutes) RemoveWishlistItem(ctx context.Context, req *pbProduct.RemoveWishlistItemRequest) (*pbProduct.WishlistResponse, error) {
+	wishlist, err := controller.RemoveWishlistItem(ctx, routes.productUsecase, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return wishlist.ToProto(), nil
+}
+
+func (routes *productRoutes) MoveWishlistItemToCart(ctx context.Context, req *pbProduct.MoveWishlistItemToCartRequest) (*pbProduct.WishlistResponse, error) {
+	wishlist, err := controller.MoveWishlistItemToCart(ctx, routes.productUsecase, routes.cartClient, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return wishlist.ToProto(), nil
+}
+
+func (routes *productRoutes) MoveCartlineToWishlist(ctx context.Context, req *pbProduct.MoveCartlineToWishlistRequest) (*pbProduct.WishlistResponse, error) {
+	wishlist, err := controller.MoveCartlineToWishlist(ctx, routes.productUsecase, routes.cartClient, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return wishlist.ToProto(), nil
+}
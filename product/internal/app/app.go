@@ -17,8 +17,11 @@ import (
 	"github.com/Go-Marketplace/backend/product/internal/api/grpc/interceptors"
 	"github.com/Go-Marketplace/backend/product/internal/infrastructure/repository"
 	"github.com/Go-Marketplace/backend/product/internal/usecase"
+	"github.com/Go-Marketplace/backend/product/internal/worker"
 	pbCart "github.com/Go-Marketplace/backend/proto/gen/cart"
+	pbOrder "github.com/Go-Marketplace/backend/proto/gen/order"
 	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+	"github.com/xiam/to"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
@@ -53,10 +56,45 @@ func Run(cfg *config.Config) {
 
 	cartClient := pbCart.NewCartClient(cartConn)
 
-	discountRepo := repository.NewDiscountRepo(redis, logger)
+	// Create order client
+	orderConn, err := grpc.Dial(
+		fmt.Sprintf("%s:%v", cfg.OrderConfig.GRPC.Host, cfg.OrderConfig.GRPC.Port),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		log.Fatalf("failed to create orderConn: %v", err)
+	}
+	defer orderConn.Close()
+
+	orderClient := pbOrder.NewOrderClient(orderConn)
+
+	discountRepo := repository.NewDiscountCache(
+		redis,
+		repository.NewDiscountRepo(pg, logger),
+		to.Duration(cfg.ProductConfig.DiscountCache.TTL),
+		logger,
+	)
 	productRepo := repository.NewProductRepo(pg, logger)
-	productUsecase := usecase.NewProductUsecase(productRepo, discountRepo, logger)
-	productHandler := handler.NewProductRoutes(productUsecase, cartClient, logger)
+	reviewRepo := repository.NewReviewRepo(pg, logger)
+	warehouseRepo := repository.NewWarehouseRepo(pg, logger)
+	notificationRepo := repository.NewNotificationRepo(pg, logger)
+	revisionRepo := repository.NewRevisionRepo(pg, logger)
+	sellerRepo := repository.NewSellerRepo(pg, logger)
+	wishlistRepo := repository.NewWishlistRepo(pg, logger)
+	currencyRepo := repository.NewCurrencyRepo(pg, logger)
+	productUsecase := usecase.NewProductUsecase(
+		productRepo,
+		discountRepo,
+		reviewRepo,
+		warehouseRepo,
+		notificationRepo,
+		revisionRepo,
+		sellerRepo,
+		wishlistRepo,
+		currencyRepo,
+		logger,
+	)
+	productHandler := handler.NewProductRoutes(productUsecase, cartClient, orderClient, logger)
 
 	interceptor := interceptors.NewInterceptorManager(logger)
 	grpcServer, err := grpcserver.New(
@@ -77,6 +115,22 @@ func Run(cfg *config.Config) {
 	defer grpcServer.Shutdown()
 	logger.Info("GRPC server started")
 
+	purgeWorker := worker.NewPurgeWorker(
+		productUsecase,
+		cartClient,
+		worker.PurgeWorkerConfig{
+			Retention:           to.Duration(cfg.ProductConfig.PurgeWorker.Retention),
+			PurgeWorkerInterval: to.Duration(cfg.ProductConfig.PurgeWorker.Interval),
+		},
+		logger,
+	)
+	purgeWorker.Run(ctx)
+	defer func() {
+		if err = purgeWorker.Stop(); err != nil {
+			logger.Error("failed to stop purge worker: %w", err)
+		}
+	}()
+
 	// Waiting signal
 	interrupt := make(chan os.Signal, 1)
 	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
@@ -0,0 +1,193 @@
+package catalog
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+)
+
+const maxLineSize = 1024 * 1024
+
+// Represents one product row of an imported catalog file
+type Row struct {
+	Number      int64  `json:"-"`
+	ExternalSKU string `json:"external_sku"`
+	CategoryID  int32  `json:"category_id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Price       int64  `json:"price"`
+	Currency    string `json:"currency"`
+	Quantity    int64  `json:"quantity"`
+}
+
+// Describes a row that could not be parsed, reading can be continued after it
+type RowError struct {
+	Number int64
+	Err    error
+}
+
+func (err *RowError) Error() string {
+	return fmt.Sprintf("row %d: %s", err.Number, err.Err)
+}
+
+func (err *RowError) Unwrap() error {
+	return err.Err
+}
+
+type Reader interface {
+	// Returns the next row, *RowError for a malformed row or io.EOF when the file is over
+	Read() (*Row, error)
+}
+
+func NewReader(format pbProduct.CatalogFormat, r io.Reader) (Reader, error) {
+	switch format {
+	case pbProduct.CatalogFormat_CSV:
+		return newCSVReader(r)
+	case pbProduct.CatalogFormat_JSONL:
+		return newJSONLReader(r), nil
+	default:
+		return nil, fmt.Errorf("unknown catalog format: %s", format)
+	}
+}
+
+type csvReader struct {
+	reader  *csv.Reader
+	columns map[string]int
+	number  int64
+}
+
+func newCSVReader(r io.Reader) (*csvReader, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read csv header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		columns[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+
+	for _, column := range []string{"name", "price", "quantity"} {
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("csv header has no %s column", column)
+		}
+	}
+
+	return &csvReader{
+		reader:  reader,
+		columns: columns,
+	}, nil
+}
+
+func (reader *csvReader) field(record []string, column string) string {
+	i, ok := reader.columns[column]
+	if !ok || i >= len(record) {
+		return ""
+	}
+
+	return strings.TrimSpace(record[i])
+}
+
+func parseInt(value string, bitSize int, column string) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	number, err := strconv.ParseInt(value, 10, bitSize)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %s", column, value)
+	}
+
+	return number, nil
+}
+
+func (reader *csvReader) Read() (*Row, error) {
+	record, err := reader.reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, io.EOF
+	}
+
+	reader.number++
+
+	if err != nil {
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			return nil, &RowError{Number: reader.number, Err: err}
+		}
+		return nil, err
+	}
+
+	row := &Row{
+		Number:      reader.number,
+		ExternalSKU: reader.field(record, "external_sku"),
+		Name:        reader.field(record, "name"),
+		Description: reader.field(record, "description"),
+		Currency:    reader.field(record, "currency"),
+	}
+
+	categoryID, err := parseInt(reader.field(record, "category_id"), 32, "category_id")
+	if err != nil {
+		return nil, &RowError{Number: reader.number, Err: err}
+	}
+	row.CategoryID = int32(categoryID)
+
+	if row.Price, err = parseInt(reader.field(record, "price"), 64, "price"); err != nil {
+		return nil, &RowError{Number: reader.number, Err: err}
+	}
+
+	if row.Quantity, err = parseInt(reader.field(record, "quantity"), 64, "quantity"); err != nil {
+		return nil, &RowError{Number: reader.number, Err: err}
+	}
+
+	return row, nil
+}
+
+type jsonlReader struct {
+	scanner *bufio.Scanner
+	number  int64
+}
+
+func newJSONLReader(r io.Reader) *jsonlReader {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLineSize)
+
+	return &jsonlReader{
+		scanner: scanner,
+	}
+}
+
+func (reader *jsonlReader) Read() (*Row, error) {
+	for reader.scanner.Scan() {
+		line := strings.TrimSpace(reader.scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		reader.number++
+
+		row := &Row{}
+		if err := json.Unmarshal([]byte(line), row); err != nil {
+			return nil, &RowError{Number: reader.number, Err: err}
+		}
+		row.Number = reader.number
+
+		return row, nil
+	}
+
+	if err := reader.scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, io.EOF
+}
@@ -0,0 +1,100 @@
+package catalog_test
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/Go-Marketplace/backend/product/internal/catalog"
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+	"github.com/stretchr/testify/assert"
+)
+
+func readAll(t *testing.T, reader catalog.Reader) ([]*catalog.Row, []int64) {
+	t.Helper()
+
+	rows := make([]*catalog.Row, 0)
+	failedRows := make([]int64, 0)
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		var rowErr *catalog.RowError
+		if errors.As(err, &rowErr) {
+			failedRows = append(failedRows, rowErr.Number)
+			continue
+		}
+
+		assert.NoError(t, err)
+		rows = append(rows, row)
+	}
+
+	return rows, failedRows
+}
+
+func TestReadCatalog(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name               string
+		format             pbProduct.CatalogFormat
+		file               string
+		wasError           bool
+		expectedRows       []*catalog.Row
+		expectedFailedRows []int64
+	}{
+		{
+			name:   "Valid csv file",
+			format: pbProduct.CatalogFormat_CSV,
+			file:   "external_sku,category_id,name,description,price,currency,quantity\nsku-1,1,Phone,\"Cool, phone\",100,USD,5\n",
+			expectedRows: []*catalog.Row{
+				{Number: 1, ExternalSKU: "sku-1", CategoryID: 1, Name: "Phone", Description: "Cool, phone", Price: 100, Currency: "USD", Quantity: 5},
+			},
+			expectedFailedRows: []int64{},
+		},
+		{
+			name:               "Csv file with invalid price",
+			format:             pbProduct.CatalogFormat_CSV,
+			file:               "name,price,quantity\nPhone,abc,5\nBook,10,1\n",
+			expectedRows:       []*catalog.Row{{Number: 2, Name: "Book", Price: 10, Quantity: 1}},
+			expectedFailedRows: []int64{1},
+		},
+		{
+			name:     "Csv file without required column",
+			format:   pbProduct.CatalogFormat_CSV,
+			file:     "name,quantity\nPhone,5\n",
+			wasError: true,
+		},
+		{
+			name:   "Jsonl file with invalid line",
+			format: pbProduct.CatalogFormat_JSONL,
+			file:   "{\"name\":\"Phone\",\"price\":100,\"quantity\":5}\n\n{\"name\":\n",
+			expectedRows: []*catalog.Row{
+				{Number: 1, Name: "Phone", Price: 100, Quantity: 5},
+			},
+			expectedFailedRows: []int64{2},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			reader, err := catalog.NewReader(testcase.format, strings.NewReader(testcase.file))
+			assert.Equal(t, testcase.wasError, err != nil)
+			if err != nil {
+				return
+			}
+
+			rows, failedRows := readAll(t, reader)
+
+			assert.Equal(t, testcase.expectedRows, rows)
+			assert.Equal(t, testcase.expectedFailedRows, failedRows)
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package catalog
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/Go-Marketplace/backend/product/internal/model"
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+)
+
+var csvHeader = []string{
+	"product_id",
+	"external_sku",
+	"category_id",
+	"name",
+	"description",
+	"price",
+	"currency",
+	"quantity",
+	"moderation_status",
+}
+
+// Represents how the product is written to an exported catalog file,
+// the file can be imported back
+type exportRow struct {
+	ProductID        string `json:"product_id"`
+	ExternalSKU      string `json:"external_sku"`
+	CategoryID       int32  `json:"category_id"`
+	Name             string `json:"name"`
+	Description      string `json:"description"`
+	Price            int64  `json:"price"`
+	Currency         string `json:"currency"`
+	Quantity         int64  `json:"quantity"`
+	ModerationStatus string `json:"moderation_status"`
+}
+
+func newExportRow(product *model.Product) exportRow {
+	return exportRow{
+		ProductID:        product.ID.String(),
+		ExternalSKU:      product.ExternalSKU,
+		CategoryID:       product.CategoryID,
+		Name:             product.Name,
+		Description:      product.Description,
+		Price:            product.Price,
+		Currency:         product.Currency,
+		Quantity:         product.Quantity,
+		ModerationStatus: pbProduct.ModerationStatus(product.ModerationStatus).String(),
+	}
+}
+
+type Writer interface {
+	Write(product *model.Product) error
+	Flush() error
+}
+
+func NewWriter(format pbProduct.CatalogFormat, w io.Writer) (Writer, error) {
+	switch format {
+	case pbProduct.CatalogFormat_CSV:
+		return &csvWriter{writer: csv.NewWriter(w)}, nil
+	case pbProduct.CatalogFormat_JSONL:
+		return &jsonlWriter{encoder: json.NewEncoder(w)}, nil
+	default:
+		return nil, fmt.Errorf("unknown catalog format: %s", format)
+	}
+}
+
+type csvWriter struct {
+	writer        *csv.Writer
+	headerWritten bool
+}
+
+func (writer *csvWriter) writeHeader() error {
+	if writer.headerWritten {
+		return nil
+	}
+	writer.headerWritten = true
+
+	return writer.writer.Write(csvHeader)
+}
+
+func (writer *csvWriter) Write(product *model.Product) error {
+	if err := writer.writeHeader(); err != nil {
+		return err
+	}
+
+	row := newExportRow(product)
+
+	return writer.writer.Write([]string{
+		row.ProductID,
+		row.ExternalSKU,
+		strconv.FormatInt(int64(row.CategoryID), 10),
+		row.Name,
+		row.Description,
+		strconv.FormatInt(row.Price, 10),
+		row.Currency,
+		strconv.FormatInt(row.Quantity, 10),
+		row.ModerationStatus,
+	})
+}
+
+func (writer *csvWriter) Flush() error {
+	// An empty catalog is still exported with the header
+	if err := writer.writeHeader(); err != nil {
+		return err
+	}
+
+	writer.writer.Flush()
+	return writer.writer.Error()
+}
+
+type jsonlWriter struct {
+	encoder *json.Encoder
+}
+
+func (writer *jsonlWriter) Write(product *model.Product) error {
+	return writer.encoder.Encode(newExportRow(product))
+}
+
+func (writer *jsonlWriter) Flush() error {
+	return nil
+}
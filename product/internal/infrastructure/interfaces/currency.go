@@ -0,0 +1,13 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/Go-Marketplace/backend/product/internal/model"
+)
+
+type CurrencyRepo interface {
+	GetExchangeRates(ctx context.Context) ([]*model.ExchangeRate, error)
+	GetExchangeRate(ctx context.Context, currency string) (*model.ExchangeRate, error)
+	SetExchangeRates(ctx context.Context, rates []*model.ExchangeRate) error
+}
@@ -2,13 +2,23 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
+	"github.com/Go-Marketplace/backend/product/internal/api/grpc/dto"
 	"github.com/Go-Marketplace/backend/product/internal/model"
 	"github.com/google/uuid"
 )
 
 type DiscountRepo interface {
+	GetDiscount(ctx context.Context, discountID uuid.UUID) (*model.Discount, error)
+	GetDiscounts(ctx context.Context, searchParams dto.SearchDiscountsDTO) ([]*model.Discount, error)
+	// Returns the best discount applicable to the product right now or nil
+	GetProductDiscount(ctx context.Context, product model.Product) (*model.Discount, error)
+	// Returns when the next scheduled discount applicable to the product starts or nil
+	GetNextDiscountStart(ctx context.Context, product model.Product) (*time.Time, error)
 	CreateDiscount(ctx context.Context, discount model.Discount) error
-	GetDiscount(ctx context.Context, productID uuid.UUID) (*model.Discount, error)
-	DeleteDiscount(ctx context.Context, productID uuid.UUID) error
+	// Ends all active and scheduled discounts matching the search params
+	EndDiscounts(ctx context.Context, searchParams dto.SearchDiscountsDTO) error
+	// Drops the cached best discount of the product after the product changed
+	InvalidateProductDiscount(ctx context.Context, productID uuid.UUID) error
 }
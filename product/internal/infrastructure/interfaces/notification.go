@@ -0,0 +1,13 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/Go-Marketplace/backend/product/internal/api/grpc/dto"
+	"github.com/Go-Marketplace/backend/product/internal/model"
+)
+
+type NotificationRepo interface {
+	GetNotifications(ctx context.Context, searchParams dto.SearchNotificationsDTO) ([]*model.Notification, error)
+	CreateNotification(ctx context.Context, notification model.Notification) error
+}
@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/Go-Marketplace/backend/product/internal/api/grpc/dto"
 	"github.com/Go-Marketplace/backend/product/internal/model"
@@ -11,11 +12,17 @@ import (
 type ProductRepo interface {
 	GetProducts(ctx context.Context, searchParams dto.SearchProductsDTO) ([]*model.Product, error)
 	GetProduct(ctx context.Context, productID uuid.UUID) (*model.Product, error)
+	GetModerationQueue(ctx context.Context, queueParams dto.ModerationQueueDTO) ([]*model.Product, error)
 	CreateProduct(ctx context.Context, product model.Product) error
 	UpdateProduct(ctx context.Context, product model.Product) error
 	UpdateProducts(ctx context.Context, products []model.Product) error
-	DeleteProduct(ctx context.Context, productID uuid.UUID) error
+	SetStockAlert(ctx context.Context, product model.Product) error
+	SetPurchaseLimit(ctx context.Context, product model.Product) error
+	DeleteProduct(ctx context.Context, product model.Product) error
 	DeleteUserProducts(ctx context.Context, userID uuid.UUID) error
+	RestoreProduct(ctx context.Context, product model.Product) error
+	GetDeletedProducts(ctx context.Context, deletedBefore time.Time) ([]*model.Product, error)
+	PurgeProduct(ctx context.Context, productID uuid.UUID) error
 
 	GetAllCategories(ctx context.Context) ([]*model.Category, error)
 	GetCategory(ctx context.Context, categoryID int32) (*model.Category, error)
@@ -0,0 +1,16 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/Go-Marketplace/backend/product/internal/api/grpc/dto"
+	"github.com/Go-Marketplace/backend/product/internal/model"
+	"github.com/google/uuid"
+)
+
+type ReviewRepo interface {
+	GetReview(ctx context.Context, reviewID uuid.UUID) (*model.Review, error)
+	GetReviews(ctx context.Context, searchParams dto.SearchReviewsDTO) ([]*model.Review, error)
+	CreateReview(ctx context.Context, review model.Review) error
+	UpdateReviewReply(ctx context.Context, review model.Review) error
+}
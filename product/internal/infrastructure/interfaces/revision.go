@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/Go-Marketplace/backend/product/internal/api/grpc/dto"
+	"github.com/Go-Marketplace/backend/product/internal/model"
+	"github.com/google/uuid"
+)
+
+type RevisionRepo interface {
+	GetRevisions(ctx context.Context, searchParams dto.SearchRevisionsDTO) ([]*model.ProductRevision, error)
+	GetPriceHistory(ctx context.Context, productID uuid.UUID) ([]*model.PricePoint, error)
+}
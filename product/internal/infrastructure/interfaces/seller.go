@@ -0,0 +1,13 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/Go-Marketplace/backend/product/internal/model"
+	"github.com/google/uuid"
+)
+
+type SellerRepo interface {
+	GetSellerProfile(ctx context.Context, userID uuid.UUID) (*model.SellerProfile, error)
+	SetSellerProfile(ctx context.Context, profile model.SellerProfile) error
+}
@@ -0,0 +1,20 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/Go-Marketplace/backend/product/internal/model"
+	"github.com/google/uuid"
+)
+
+type WarehouseRepo interface {
+	GetWarehouse(ctx context.Context, warehouseID uuid.UUID) (*model.Warehouse, error)
+	GetWarehouses(ctx context.Context, userID uuid.UUID) ([]*model.Warehouse, error)
+	CreateWarehouse(ctx context.Context, warehouse model.Warehouse) error
+	GetProductStocks(ctx context.Context, productID uuid.UUID) ([]*model.WarehouseStock, error)
+	GetWarehouseStock(ctx context.Context, warehouseID, productID uuid.UUID) (*model.WarehouseStock, error)
+	SetStock(ctx context.Context, stock model.WarehouseStock) error
+	ReserveStock(ctx context.Context, reservation model.Reservation) error
+	ReleaseStock(ctx context.Context, reservation model.Reservation) (uuid.UUID, error)
+	ChangeStocks(ctx context.Context, changes []*model.ReservationChange) error
+}
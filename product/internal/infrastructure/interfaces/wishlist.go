@@ -0,0 +1,18 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/Go-Marketplace/backend/product/internal/model"
+	"github.com/google/uuid"
+)
+
+type WishlistRepo interface {
+	GetWishlist(ctx context.Context, wishlistID uuid.UUID) (*model.Wishlist, error)
+	GetWishlistByName(ctx context.Context, userID uuid.UUID, name string) (*model.Wishlist, error)
+	GetWishlists(ctx context.Context, userID uuid.UUID) ([]*model.Wishlist, error)
+	CreateWishlist(ctx context.Context, wishlist model.Wishlist) error
+	DeleteWishlist(ctx context.Context, wishlistID uuid.UUID) error
+	AddWishlistItem(ctx context.Context, item model.WishlistItem) error
+	RemoveWishlistItem(ctx context.Context, wishlistID, productID uuid.UUID) error
+}
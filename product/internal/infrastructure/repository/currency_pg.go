@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Go-Marketplace/backend/pkg/logger"
+	"github.com/Go-Marketplace/backend/pkg/postgres"
+	"github.com/Go-Marketplace/backend/product/internal/model"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+type CurrencyRepo struct {
+	pg     *postgres.Postgres
+	logger *logger.Logger
+}
+
+func NewCurrencyRepo(pg *postgres.Postgres, logger *logger.Logger) *CurrencyRepo {
+	return &CurrencyRepo{
+		pg:     pg,
+		logger: logger,
+	}
+}
+
+func scanExchangeRate(rows pgx.Rows, rate *model.ExchangeRate) error {
+	return rows.Scan(
+		&rate.Currency,
+		&rate.Rate,
+		&rate.UpdatedAt,
+	)
+}
+
+func (repo *CurrencyRepo) getExchangeRates(ctx context.Context, query sq.SelectBuilder) ([]*model.ExchangeRate, error) {
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Query getExchangeRates: %w", err)
+	}
+	defer rows.Close()
+
+	rates := make([]*model.ExchangeRate, 0)
+	for rows.Next() {
+		rate := &model.ExchangeRate{}
+		if err = scanExchangeRate(rows, rate); err != nil {
+			return nil, fmt.Errorf("failed to scan exchange rate: %w", err)
+		}
+		rates = append(rates, rate)
+	}
+
+	return rates, nil
+}
+
+func (repo *CurrencyRepo) GetExchangeRates(ctx context.Context) ([]*model.ExchangeRate, error) {
+	return repo.getExchangeRates(ctx, getExchangeRatesQuery())
+}
+
+func (repo *CurrencyRepo) GetExchangeRate(ctx context.Context, currency string) (*model.ExchangeRate, error) {
+	rates, err := repo.getExchangeRates(ctx, getExchangeRateQuery(currency))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rates) == 0 {
+		return nil, nil
+	}
+
+	return rates[0], nil
+}
+
+// Sets all the rates in one transaction, so an imported file is applied whole or not at all
+func (repo *CurrencyRepo) SetExchangeRates(ctx context.Context, rates []*model.ExchangeRate) error {
+	conn, err := repo.pg.Pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to Acquire in SetExchangeRates: %w", err)
+	}
+	defer conn.Release()
+
+	tx, err := conn.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to begin SetExchangeRates transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				repo.logger.Error("failed to rollback transaction", err)
+			}
+		} else {
+			if err := tx.Commit(ctx); err != nil {
+				repo.logger.Error("failed to commit transaction", err)
+			}
+		}
+	}()
+
+	for _, rate := range rates {
+		var sqlQuery string
+		var args []interface{}
+		sqlQuery, args, err = setExchangeRateQuery(rate).ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to get sql query: %w", err)
+		}
+
+		if _, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+			return fmt.Errorf("failed to Exec setExchangeRate: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Go-Marketplace/backend/pkg/logger"
+	"github.com/Go-Marketplace/backend/pkg/postgres"
+	"github.com/Go-Marketplace/backend/product/internal/api/grpc/dto"
+	"github.com/Go-Marketplace/backend/product/internal/model"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type DiscountRepo struct {
+	pg     *postgres.Postgres
+	logger *logger.Logger
+}
+
+func NewDiscountRepo(pg *postgres.Postgres, logger *logger.Logger) *DiscountRepo {
+	return &DiscountRepo{
+		pg:     pg,
+		logger: logger,
+	}
+}
+
+func scanDiscount(rows pgx.Rows, discount *model.Discount) error {
+	return rows.Scan(
+		&discount.ID,
+		&discount.Scope,
+		&discount.ProductID,
+		&discount.CategoryID,
+		&discount.SellerID,
+		&discount.Percent,
+		&discount.Priority,
+		&discount.CreatedAt,
+		&discount.StartsAt,
+		&discount.EndsAt,
+	)
+}
+
+func (repo *DiscountRepo) getDiscountsByQuery(ctx context.Context, query string, args ...interface{}) ([]*model.Discount, error) {
+	rows, err := repo.pg.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Query %s: %w", query, err)
+	}
+	defer rows.Close()
+
+	discounts := make([]*model.Discount, 0)
+	for rows.Next() {
+		discount := &model.Discount{}
+		if err = scanDiscount(rows, discount); err != nil {
+			return nil, fmt.Errorf("failed to scan discount: %w", err)
+		}
+		discounts = append(discounts, discount)
+	}
+
+	return discounts, nil
+}
+
+func (repo *DiscountRepo) GetDiscount(ctx context.Context, discountID uuid.UUID) (*model.Discount, error) {
+	query := getDiscountQuery(discountID)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	discounts, err := repo.getDiscountsByQuery(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(discounts) == 0 {
+		return nil, nil
+	}
+
+	return discounts[0], nil
+}
+
+func (repo *DiscountRepo) GetDiscounts(ctx context.Context, searchParams dto.SearchDiscountsDTO) ([]*model.Discount, error) {
+	query := searchDiscountsQuery(searchParams)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	return repo.getDiscountsByQuery(ctx, sqlQuery, args...)
+}
+
+func (repo *DiscountRepo) GetProductDiscount(ctx context.Context, product model.Product) (*model.Discount, error) {
+	query := getProductDiscountQuery(product)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	discounts, err := repo.getDiscountsByQuery(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(discounts) == 0 {
+		return nil, nil
+	}
+
+	return discounts[0], nil
+}
+
+func (repo *DiscountRepo) GetNextDiscountStart(ctx context.Context, product model.Product) (*time.Time, error) {
+	query := getNextDiscountStartQuery(product)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	var startsAt *time.Time
+	if err = repo.pg.Pool.QueryRow(ctx, sqlQuery, args...).Scan(&startsAt); err != nil {
+		return nil, fmt.Errorf("failed to QueryRow getNextDiscountStart: %w", err)
+	}
+
+	return startsAt, nil
+}
+
+func (repo *DiscountRepo) CreateDiscount(ctx context.Context, discount model.Discount) error {
+	query := createDiscountQuery(discount)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = repo.pg.Pool.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec createDiscount: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *DiscountRepo) EndDiscounts(ctx context.Context, searchParams dto.SearchDiscountsDTO) error {
+	query := endDiscountsQuery(searchParams)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = repo.pg.Pool.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec endDiscounts: %w", err)
+	}
+
+	return nil
+}
+
+// Discounts are read from the database every time, so there is nothing to drop
+func (repo *DiscountRepo) InvalidateProductDiscount(_ context.Context, _ uuid.UUID) error {
+	return nil
+}
@@ -4,76 +4,170 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/Go-Marketplace/backend/pkg/logger"
 	redisWrap "github.com/Go-Marketplace/backend/pkg/redis"
+	"github.com/Go-Marketplace/backend/product/internal/api/grpc/dto"
+	"github.com/Go-Marketplace/backend/product/internal/infrastructure/interfaces"
 	"github.com/Go-Marketplace/backend/product/internal/model"
 	"github.com/go-redis/redis/v8"
 	"github.com/google/uuid"
 )
 
-type DiscountRepo struct {
+// Marks a product without an applicable discount in the cache
+const noDiscount = "none"
+
+const discountKeyPattern = "discount:*"
+
+// Read-through cache in front of another DiscountRepo. The best discount
+// of every product is cached until it ends or the next scheduled discount starts,
+// but not longer than ttl
+type DiscountCache struct {
 	redis  *redisWrap.Redis
+	repo   interfaces.DiscountRepo
+	ttl    time.Duration
 	logger *logger.Logger
 }
 
-func NewDiscountRepo(redis *redisWrap.Redis, logger *logger.Logger) *DiscountRepo {
-	return &DiscountRepo{
+func NewDiscountCache(redis *redisWrap.Redis, repo interfaces.DiscountRepo, ttl time.Duration, logger *logger.Logger) *DiscountCache {
+	return &DiscountCache{
 		redis:  redis,
+		repo:   repo,
+		ttl:    ttl,
 		logger: logger,
 	}
 }
 
-func (repo *DiscountRepo) CreateDiscount(ctx context.Context, discount model.Discount) error {
-	discountBinary, err := discount.MarshalBinary()
-	if err != nil {
-		return err
+func (cache *DiscountCache) GetDiscount(ctx context.Context, discountID uuid.UUID) (*model.Discount, error) {
+	return cache.repo.GetDiscount(ctx, discountID)
+}
+
+func (cache *DiscountCache) GetDiscounts(ctx context.Context, searchParams dto.SearchDiscountsDTO) ([]*model.Discount, error) {
+	return cache.repo.GetDiscounts(ctx, searchParams)
+}
+
+func (cache *DiscountCache) GetNextDiscountStart(ctx context.Context, product model.Product) (*time.Time, error) {
+	return cache.repo.GetNextDiscountStart(ctx, product)
+}
+
+func (cache *DiscountCache) GetProductDiscount(ctx context.Context, product model.Product) (*model.Discount, error) {
+	discountBinary, err := cache.redis.Client.Get(ctx, discountKey(product.ID.String())).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, fmt.Errorf("failed to Get discount: %w", err)
 	}
 
-	expiration := discount.EndedAt.Sub(discount.CreatedAt)
-	if expiration.Seconds() <= 0 {
-		return fmt.Errorf("expirations's ended_at cannot be less or equal than created_at")
+	if err == nil {
+		if discountBinary == noDiscount {
+			return nil, nil
+		}
+
+		discount := &model.Discount{}
+		if err = discount.UnmarshalBinary([]byte(discountBinary)); err != nil {
+			return nil, err
+		}
+
+		// The cached discount may have ended between the expiration checks of redis
+		if discount.ActiveAt(time.Now()) {
+			return discount, nil
+		}
+	}
+
+	discount, err := cache.repo.GetProductDiscount(ctx, product)
+	if err != nil {
+		return nil, err
 	}
 
-	err = repo.redis.Client.SetEX(
-		ctx,
-		discountKey(discount.ProductID.String()),
-		discountBinary,
-		expiration,
-	).Err()
+	// A scheduled discount may replace the cached one or the no discount marker once it starts
+	nextStart, err := cache.repo.GetNextDiscountStart(ctx, product)
 	if err != nil {
+		return nil, err
+	}
+
+	if err = cache.setProductDiscount(ctx, product.ID, discount, nextStart); err != nil {
+		cache.logger.Error("failed to cache discount of product %s: %s", product.ID, err)
+	}
+
+	return discount, nil
+}
+
+func (cache *DiscountCache) setProductDiscount(
+	ctx context.Context,
+	productID uuid.UUID,
+	discount *model.Discount,
+	nextStart *time.Time,
+) error {
+	var value interface{} = noDiscount
+	expiration := cache.ttl
+
+	if discount != nil {
+		value = discount
+		if untilEnd := time.Until(discount.EndsAt); untilEnd < expiration {
+			expiration = untilEnd
+		}
+	}
+
+	if nextStart != nil {
+		if untilStart := time.Until(*nextStart); untilStart < expiration {
+			expiration = untilStart
+		}
+	}
+
+	if expiration <= 0 {
+		return nil
+	}
+
+	if err := cache.redis.Client.SetEX(ctx, discountKey(productID.String()), value, expiration).Err(); err != nil {
 		return fmt.Errorf("failed to SetEX discount: %w", err)
 	}
 
 	return nil
 }
 
-func (repo *DiscountRepo) GetDiscount(ctx context.Context, productID uuid.UUID) (*model.Discount, error) {
-	discountBinary, err := repo.redis.Client.Get(ctx, discountKey(productID.String())).Result()
-	if err != nil && !errors.Is(err, redis.Nil) {
-		return nil, fmt.Errorf("failed to Get discount: %w", err)
+// Drops the cached discount of the product or, for category and seller
+// discounts, of every product since they are not tracked separately
+func (cache *DiscountCache) invalidate(ctx context.Context, scope model.DiscountScope, productID uuid.UUID) error {
+	if scope == model.ProductDiscount {
+		if err := cache.redis.Client.Del(ctx, discountKey(productID.String())).Err(); err != nil {
+			return fmt.Errorf("failed to Del discount: %w", err)
+		}
+		return nil
 	}
 
-	if errors.Is(err, redis.Nil) {
-		return nil, nil
+	iter := cache.redis.Client.Scan(ctx, 0, discountKeyPattern, 0).Iterator()
+	for iter.Next(ctx) {
+		if err := cache.redis.Client.Del(ctx, iter.Val()).Err(); err != nil {
+			return fmt.Errorf("failed to Del discount: %w", err)
+		}
 	}
 
-	discount := &model.Discount{}
-	err = discount.UnmarshalBinary([]byte(discountBinary))
-	if err != nil {
-		return nil, err
+	if err := iter.Err(); err != nil {
+		return fmt.Errorf("failed to Scan discounts: %w", err)
 	}
 
-	return discount, nil
+	return nil
 }
 
-func (repo *DiscountRepo) DeleteDiscount(ctx context.Context, productID uuid.UUID) error {
-	err := repo.redis.Client.Del(ctx, discountKey(productID.String())).Err()
-	if err != nil {
-		return fmt.Errorf("failed to Del discount: %w", err)
+func (cache *DiscountCache) CreateDiscount(ctx context.Context, discount model.Discount) error {
+	if err := cache.repo.CreateDiscount(ctx, discount); err != nil {
+		return err
 	}
 
-	return nil
+	return cache.invalidate(ctx, discount.Scope, discount.ProductID)
+}
+
+func (cache *DiscountCache) EndDiscounts(ctx context.Context, searchParams dto.SearchDiscountsDTO) error {
+	if err := cache.repo.EndDiscounts(ctx, searchParams); err != nil {
+		return err
+	}
+
+	return cache.invalidate(ctx, model.DiscountScope(searchParams.Scope), searchParams.ProductID)
+}
+
+// The best discount depends on the category and the seller of the product,
+// so it is dropped when they change
+func (cache *DiscountCache) InvalidateProductDiscount(ctx context.Context, productID uuid.UUID) error {
+	return cache.invalidate(ctx, model.ProductDiscount, productID)
 }
 
 func discountKey(productID string) string {
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Go-Marketplace/backend/pkg/logger"
+	"github.com/Go-Marketplace/backend/pkg/postgres"
+	"github.com/Go-Marketplace/backend/product/internal/api/grpc/dto"
+	"github.com/Go-Marketplace/backend/product/internal/model"
+	"github.com/jackc/pgx/v5"
+)
+
+// Stock and wishlist notifications are written by the database triggers,
+// the repo reads them and writes the ones that come from other services
+type NotificationRepo struct {
+	pg     *postgres.Postgres
+	logger *logger.Logger
+}
+
+func NewNotificationRepo(pg *postgres.Postgres, logger *logger.Logger) *NotificationRepo {
+	return &NotificationRepo{
+		pg:     pg,
+		logger: logger,
+	}
+}
+
+func scanNotification(rows pgx.Rows, notification *model.Notification) error {
+	return rows.Scan(
+		&notification.ID,
+		&notification.UserID,
+		&notification.ProductID,
+		&notification.Kind,
+		&notification.Quantity,
+		&notification.Message,
+		&notification.CreatedAt,
+	)
+}
+
+func (repo *NotificationRepo) GetNotifications(ctx context.Context, searchParams dto.SearchNotificationsDTO) ([]*model.Notification, error) {
+	query := getNotificationsQuery(searchParams)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Query getNotifications: %w", err)
+	}
+	defer rows.Close()
+
+	notifications := make([]*model.Notification, 0)
+	for rows.Next() {
+		notification := &model.Notification{}
+		if err = scanNotification(rows, notification); err != nil {
+			return nil, fmt.Errorf("failed to scan notification: %w", err)
+		}
+		notifications = append(notifications, notification)
+	}
+
+	return notifications, nil
+}
+
+func (repo *NotificationRepo) CreateNotification(ctx context.Context, notification model.Notification) error {
+	sqlQuery, args, err := createNotificationQuery(notification).ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = repo.pg.Pool.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec createNotification: %w", err)
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/Go-Marketplace/backend/pkg/logger"
 	"github.com/Go-Marketplace/backend/pkg/postgres"
@@ -29,13 +30,24 @@ func scanProduct(rows pgx.Rows, product *model.Product) error {
 		&product.ID,
 		&product.UserID,
 		&product.CategoryID,
+		&product.ExternalSKU,
 		&product.Name,
 		&product.Description,
 		&product.Price,
+		&product.Currency,
 		&product.Quantity,
-		&product.Moderated,
+		&product.ModerationStatus,
+		&product.RejectionReason,
+		&product.ModeratedBy,
+		&product.ModeratedAt,
+		&product.Rating,
+		&product.ReviewsCount,
+		&product.LowStockThreshold,
+		&product.HideWhenOutOfStock,
+		&product.MaxPerCustomer,
 		&product.CreatedAt,
 		&product.UpdatedAt,
+		&product.DeletedAt,
 	)
 }
 
@@ -112,7 +124,40 @@ func (repo *ProductRepo) GetProducts(ctx context.Context, searchParams dto.Searc
 	return products, nil
 }
 
+func (repo *ProductRepo) GetModerationQueue(ctx context.Context, queueParams dto.ModerationQueueDTO) ([]*model.Product, error) {
+	query := moderationQueueQuery(queueParams)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	return repo.getProductsByFilters(ctx, sqlQuery, args...)
+}
+
 func (repo *ProductRepo) CreateProduct(ctx context.Context, product model.Product) error {
+	conn, err := repo.pg.Pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to Acquire in CreateProduct: %w", err)
+	}
+	defer conn.Release()
+
+	tx, err := conn.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to begin CreateProduct transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				repo.logger.Error("failed to rollback transaction", err)
+			}
+		} else {
+			if err := tx.Commit(ctx); err != nil {
+				repo.logger.Error("failed to commit transaction", err)
+			}
+		}
+	}()
+
 	query := createProductQuery(product)
 
 	sqlQuery, args, err := query.ToSql()
@@ -120,14 +165,33 @@ func (repo *ProductRepo) CreateProduct(ctx context.Context, product model.Produc
 		return fmt.Errorf("failed to get sql query: %w", err)
 	}
 
-	if _, err = repo.pg.Pool.Exec(ctx, sqlQuery, args...); err != nil {
+	if _, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
 		return fmt.Errorf("failed to Exec sqlQuery %v: %w", sqlQuery, err)
 	}
 
+	if product.Quantity != 0 {
+		if err = setProductQuantityInTx(ctx, tx, product.ID, product.Quantity); err != nil {
+			return fmt.Errorf("failed to set product quantity in transaction: %w", err)
+		}
+	}
+
+	if err = createRevisionInTx(ctx, tx, model.RevisionCreated, product.ActorID, model.Product{}, product); err != nil {
+		return fmt.Errorf("failed to create revision in transaction: %w", err)
+	}
+
 	return nil
 }
 
-func (repo *ProductRepo) UpdateProduct(ctx context.Context, product model.Product) error {
+func updateProductInTx(ctx context.Context, tx pgx.Tx, product model.Product) error {
+	oldProduct, err := getProductForUpdateInTx(ctx, tx, product.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get product in transaction: %w", err)
+	}
+
+	if oldProduct == nil {
+		return nil
+	}
+
 	query := updateProductQuery(product)
 
 	sqlQuery, args, err := query.ToSql()
@@ -135,47 +199,231 @@ func (repo *ProductRepo) UpdateProduct(ctx context.Context, product model.Produc
 		return fmt.Errorf("failed to get sql query: %w", err)
 	}
 
-	if _, err = repo.pg.Pool.Exec(ctx, sqlQuery, args...); err != nil {
+	if _, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
 		return fmt.Errorf("failed to Exec updateProduct: %w", err)
 	}
 
+	if product.Quantity != 0 {
+		if err = setProductQuantityInTx(ctx, tx, product.ID, product.Quantity); err != nil {
+			return fmt.Errorf("failed to set product quantity in transaction: %w", err)
+		}
+	}
+
+	return updateRevisionInTx(ctx, tx, product, *oldProduct)
+}
+
+// Records the revision of an already applied product update
+func updateRevisionInTx(ctx context.Context, tx pgx.Tx, product, oldProduct model.Product) error {
+	newProduct, err := getProductForUpdateInTx(ctx, tx, product.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get product in transaction: %w", err)
+	}
+
+	action := model.RevisionUpdated
+	if product.ModeratedAt != nil {
+		action = model.RevisionModerated
+	}
+
+	if err = createRevisionInTx(ctx, tx, action, product.ActorID, oldProduct, *newProduct); err != nil {
+		return fmt.Errorf("failed to create revision in transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *ProductRepo) UpdateProduct(ctx context.Context, product model.Product) error {
+	conn, err := repo.pg.Pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to Acquire in UpdateProduct: %w", err)
+	}
+	defer conn.Release()
+
+	tx, err := conn.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to begin UpdateProduct transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				repo.logger.Error("failed to rollback transaction", err)
+			}
+		} else {
+			if err := tx.Commit(ctx); err != nil {
+				repo.logger.Error("failed to commit transaction", err)
+			}
+		}
+	}()
+
+	if err = updateProductInTx(ctx, tx, product); err != nil {
+		return fmt.Errorf("failed to update product in transaction: %w", err)
+	}
+
 	return nil
 }
 
 func (repo *ProductRepo) UpdateProducts(ctx context.Context, products []model.Product) error {
-	batch := &pgx.Batch{}
+	conn, err := repo.pg.Pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to Acquire in UpdateProducts: %w", err)
+	}
+	defer conn.Release()
+
+	tx, err := conn.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to begin UpdateProducts transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				repo.logger.Error("failed to rollback transaction", err)
+			}
+		} else {
+			if err := tx.Commit(ctx); err != nil {
+				repo.logger.Error("failed to commit transaction", err)
+			}
+		}
+	}()
+
 	for _, product := range products {
-		query := updateProductQuery(product)
+		if err = updateProductInTx(ctx, tx, product); err != nil {
+			return fmt.Errorf("failed to update product %s in transaction: %w", product.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// Marks the product as deleted, the row is kept until it is purged
+func (repo *ProductRepo) DeleteProduct(ctx context.Context, product model.Product) error {
+	conn, err := repo.pg.Pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to Acquire in DeleteProduct: %w", err)
+	}
+	defer conn.Release()
 
-		sqlQuery, args, err := query.ToSql()
+	tx, err := conn.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to begin DeleteProduct transaction: %w", err)
+	}
+	defer func() {
 		if err != nil {
-			return fmt.Errorf("failed to get sql query: %w", err)
+			if err := tx.Rollback(ctx); err != nil {
+				repo.logger.Error("failed to rollback transaction", err)
+			}
+		} else {
+			if err := tx.Commit(ctx); err != nil {
+				repo.logger.Error("failed to commit transaction", err)
+			}
 		}
+	}()
+
+	oldProduct, err := getProductForUpdateInTx(ctx, tx, product.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get product in transaction: %w", err)
+	}
+
+	if oldProduct == nil {
+		return nil
+	}
 
-		batch.Queue(sqlQuery, args...)
+	if err = deleteProductInTx(ctx, tx, *oldProduct, product.ActorID); err != nil {
+		return fmt.Errorf("failed to delete product in transaction: %w", err)
 	}
 
-	batchResults := repo.pg.Pool.SendBatch(ctx, batch)
-	return batchResults.Close()
+	return nil
 }
 
-func (repo *ProductRepo) DeleteProduct(ctx context.Context, productID uuid.UUID) error {
-	query := deleteProductQuery(productID)
+func deleteProductInTx(ctx context.Context, tx pgx.Tx, product model.Product, actorID *uuid.UUID) error {
+	deletedAt := time.Now()
+	query := deleteProductQuery(product.ID, deletedAt)
 
 	sqlQuery, args, err := query.ToSql()
 	if err != nil {
 		return fmt.Errorf("failed to get sql query: %w", err)
 	}
 
-	if _, err = repo.pg.Pool.Exec(ctx, sqlQuery, args...); err != nil {
+	if _, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
 		return fmt.Errorf("failed to Exec deleteProduct: %w", err)
 	}
 
+	deletedProduct := product
+	deletedProduct.DeletedAt = &deletedAt
+
+	if err = createRevisionInTx(ctx, tx, model.RevisionDeleted, actorID, product, deletedProduct); err != nil {
+		return fmt.Errorf("failed to create revision in transaction: %w", err)
+	}
+
 	return nil
 }
 
-func (repo *ProductRepo) DeleteUserProducts(ctx context.Context, userID uuid.UUID) error {
-	query := deleteUserProductsQuery(userID)
+func (repo *ProductRepo) RestoreProduct(ctx context.Context, product model.Product) error {
+	conn, err := repo.pg.Pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to Acquire in RestoreProduct: %w", err)
+	}
+	defer conn.Release()
+
+	tx, err := conn.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to begin RestoreProduct transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				repo.logger.Error("failed to rollback transaction", err)
+			}
+		} else {
+			if err := tx.Commit(ctx); err != nil {
+				repo.logger.Error("failed to commit transaction", err)
+			}
+		}
+	}()
+
+	oldProduct, err := getDeletedProductForUpdateInTx(ctx, tx, product.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get deleted product in transaction: %w", err)
+	}
+
+	if oldProduct == nil {
+		return nil
+	}
+
+	query := restoreProductQuery(product.ID)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec restoreProduct: %w", err)
+	}
+
+	restoredProduct := *oldProduct
+	restoredProduct.DeletedAt = nil
+
+	if err = createRevisionInTx(ctx, tx, model.RevisionRestored, product.ActorID, *oldProduct, restoredProduct); err != nil {
+		return fmt.Errorf("failed to create revision in transaction: %w", err)
+	}
+
+	return nil
+}
+
+// Returns the products that were deleted before the given time and can be purged
+func (repo *ProductRepo) GetDeletedProducts(ctx context.Context, deletedBefore time.Time) ([]*model.Product, error) {
+	query := getDeletedProductsQuery(deletedBefore)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	return repo.getProductsByFilters(ctx, sqlQuery, args...)
+}
+
+// Removes the deleted product row for good, live products are never purged
+func (repo *ProductRepo) PurgeProduct(ctx context.Context, productID uuid.UUID) error {
+	query := purgeProductQuery(productID)
 
 	sqlQuery, args, err := query.ToSql()
 	if err != nil {
@@ -183,7 +431,160 @@ func (repo *ProductRepo) DeleteUserProducts(ctx context.Context, userID uuid.UUI
 	}
 
 	if _, err = repo.pg.Pool.Exec(ctx, sqlQuery, args...); err != nil {
-		return fmt.Errorf("failed to Exec deleteUserProducts: %w", err)
+		return fmt.Errorf("failed to Exec purgeProduct: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *ProductRepo) SetStockAlert(ctx context.Context, product model.Product) error {
+	conn, err := repo.pg.Pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to Acquire in SetStockAlert: %w", err)
+	}
+	defer conn.Release()
+
+	tx, err := conn.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to begin SetStockAlert transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				repo.logger.Error("failed to rollback transaction", err)
+			}
+		} else {
+			if err := tx.Commit(ctx); err != nil {
+				repo.logger.Error("failed to commit transaction", err)
+			}
+		}
+	}()
+
+	oldProduct, err := getProductForUpdateInTx(ctx, tx, product.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get product in transaction: %w", err)
+	}
+
+	if oldProduct == nil {
+		return nil
+	}
+
+	query := setStockAlertQuery(product)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec setStockAlert: %w", err)
+	}
+
+	if err = updateRevisionInTx(ctx, tx, product, *oldProduct); err != nil {
+		return fmt.Errorf("failed to update revision in transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *ProductRepo) SetPurchaseLimit(ctx context.Context, product model.Product) error {
+	conn, err := repo.pg.Pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to Acquire in SetPurchaseLimit: %w", err)
+	}
+	defer conn.Release()
+
+	tx, err := conn.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to begin SetPurchaseLimit transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				repo.logger.Error("failed to rollback transaction", err)
+			}
+		} else {
+			if err := tx.Commit(ctx); err != nil {
+				repo.logger.Error("failed to commit transaction", err)
+			}
+		}
+	}()
+
+	oldProduct, err := getProductForUpdateInTx(ctx, tx, product.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get product in transaction: %w", err)
+	}
+
+	if oldProduct == nil {
+		return nil
+	}
+
+	sqlQuery, args, err := setPurchaseLimitQuery(product).ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec setPurchaseLimit: %w", err)
+	}
+
+	if err = updateRevisionInTx(ctx, tx, product, *oldProduct); err != nil {
+		return fmt.Errorf("failed to update revision in transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *ProductRepo) DeleteUserProducts(ctx context.Context, userID uuid.UUID) error {
+	conn, err := repo.pg.Pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to Acquire in DeleteUserProducts: %w", err)
+	}
+	defer conn.Release()
+
+	tx, err := conn.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to begin DeleteUserProducts transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				repo.logger.Error("failed to rollback transaction", err)
+			}
+		} else {
+			if err := tx.Commit(ctx); err != nil {
+				repo.logger.Error("failed to commit transaction", err)
+			}
+		}
+	}()
+
+	query := getUserProductsForUpdateQuery(userID)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return fmt.Errorf("failed to Query getUserProductsForUpdate: %w", err)
+	}
+
+	products := make([]model.Product, 0)
+	for rows.Next() {
+		product := model.Product{}
+		if err = scanProduct(rows, &product); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan product: %w", err)
+		}
+		products = append(products, product)
+	}
+	rows.Close()
+
+	for _, product := range products {
+		if err = deleteProductInTx(ctx, tx, product, &userID); err != nil {
+			return fmt.Errorf("failed to delete product %s in transaction: %w", product.ID, err)
+		}
 	}
 
 	return nil
@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Go-Marketplace/backend/pkg/logger"
+	"github.com/Go-Marketplace/backend/pkg/postgres"
+	"github.com/Go-Marketplace/backend/product/internal/api/grpc/dto"
+	"github.com/Go-Marketplace/backend/product/internal/model"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// SQLSTATE of the unique constraint violation
+const uniqueViolation = "23505"
+
+type ReviewRepo struct {
+	pg     *postgres.Postgres
+	logger *logger.Logger
+}
+
+func NewReviewRepo(pg *postgres.Postgres, logger *logger.Logger) *ReviewRepo {
+	return &ReviewRepo{
+		pg:     pg,
+		logger: logger,
+	}
+}
+
+func scanReview(rows pgx.Rows, review *model.Review) error {
+	return rows.Scan(
+		&review.ID,
+		&review.ProductID,
+		&review.UserID,
+		&review.Rating,
+		&review.Comment,
+		&review.Reply,
+		&review.RepliedAt,
+		&review.CreatedAt,
+		&review.UpdatedAt,
+	)
+}
+
+func (repo *ReviewRepo) GetReview(ctx context.Context, reviewID uuid.UUID) (*model.Review, error) {
+	query := getReviewQuery(reviewID)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Query getReview: %w", err)
+	}
+	defer rows.Close()
+
+	review := &model.Review{}
+	found := false
+	for rows.Next() {
+		if err = scanReview(rows, review); err != nil {
+			return nil, fmt.Errorf("failed to scan review: %w", err)
+		}
+		found = true
+	}
+
+	if !found {
+		return nil, nil
+	}
+
+	return review, nil
+}
+
+func (repo *ReviewRepo) GetReviews(ctx context.Context, searchParams dto.SearchReviewsDTO) ([]*model.Review, error) {
+	query := searchReviewsQuery(searchParams)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Query searchReviews: %w", err)
+	}
+	defer rows.Close()
+
+	reviews := make([]*model.Review, 0)
+	for rows.Next() {
+		review := &model.Review{}
+		if err = scanReview(rows, review); err != nil {
+			return nil, fmt.Errorf("failed to scan review: %w", err)
+		}
+		reviews = append(reviews, review)
+	}
+
+	return reviews, nil
+}
+
+// Creates the review and adds its rating to the aggregated rating of the product in one transaction
+func (repo *ReviewRepo) CreateReview(ctx context.Context, review model.Review) error {
+	conn, err := repo.pg.Pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to Acquire in CreateReview: %w", err)
+	}
+	defer conn.Release()
+
+	tx, err := conn.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to begin CreateReview transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				repo.logger.Error("failed to rollback transaction", err)
+			}
+		} else {
+			if err := tx.Commit(ctx); err != nil {
+				repo.logger.Error("failed to commit transaction", err)
+			}
+		}
+	}()
+
+	query := createReviewQuery(review)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+		// The user reviewed the product concurrently after the controller checked it
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return model.ErrReviewExists
+		}
+		return fmt.Errorf("failed to Exec createReview: %w", err)
+	}
+
+	ratingQuery := updateProductRatingQuery(review)
+
+	sqlQuery, args, err = ratingQuery.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec updateProductRating: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *ReviewRepo) UpdateReviewReply(ctx context.Context, review model.Review) error {
+	query := updateReviewReplyQuery(review)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = repo.pg.Pool.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec updateReviewReply: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Go-Marketplace/backend/pkg/logger"
+	"github.com/Go-Marketplace/backend/pkg/postgres"
+	"github.com/Go-Marketplace/backend/product/internal/api/grpc/dto"
+	"github.com/Go-Marketplace/backend/product/internal/model"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// Revisions are written by the ProductRepo in the same transaction as the product change,
+// the repo only reads them
+type RevisionRepo struct {
+	pg     *postgres.Postgres
+	logger *logger.Logger
+}
+
+func NewRevisionRepo(pg *postgres.Postgres, logger *logger.Logger) *RevisionRepo {
+	return &RevisionRepo{
+		pg:     pg,
+		logger: logger,
+	}
+}
+
+func scanRevision(rows pgx.Rows, revision *model.ProductRevision) error {
+	return rows.Scan(
+		&revision.ID,
+		&revision.ProductID,
+		&revision.ActorID,
+		&revision.Action,
+		&revision.Changes,
+		&revision.CreatedAt,
+	)
+}
+
+func scanPricePoint(rows pgx.Rows, point *model.PricePoint) error {
+	return rows.Scan(
+		&point.Price,
+		&point.ActorID,
+		&point.ChangedAt,
+	)
+}
+
+func (repo *RevisionRepo) GetRevisions(ctx context.Context, searchParams dto.SearchRevisionsDTO) ([]*model.ProductRevision, error) {
+	query := getRevisionsQuery(searchParams)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Query getRevisions: %w", err)
+	}
+	defer rows.Close()
+
+	revisions := make([]*model.ProductRevision, 0)
+	for rows.Next() {
+		revision := &model.ProductRevision{}
+		if err = scanRevision(rows, revision); err != nil {
+			return nil, fmt.Errorf("failed to scan revision: %w", err)
+		}
+		revisions = append(revisions, revision)
+	}
+
+	return revisions, nil
+}
+
+func (repo *RevisionRepo) GetPriceHistory(ctx context.Context, productID uuid.UUID) ([]*model.PricePoint, error) {
+	query := getPriceHistoryQuery(productID)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Query getPriceHistory: %w", err)
+	}
+	defer rows.Close()
+
+	points := make([]*model.PricePoint, 0)
+	for rows.Next() {
+		point := &model.PricePoint{}
+		if err = scanPricePoint(rows, point); err != nil {
+			return nil, fmt.Errorf("failed to scan price point: %w", err)
+		}
+		points = append(points, point)
+	}
+
+	return points, nil
+}
+
+// Returns the product locked until the end of the transaction, nil if it does not exist
+func getProductForUpdateInTx(ctx context.Context, tx pgx.Tx, productID uuid.UUID) (*model.Product, error) {
+	return getLockedProductInTx(ctx, tx, getProductForUpdateQuery(productID))
+}
+
+// Returns the deleted product locked until the end of the transaction, nil if there is no such product
+func getDeletedProductForUpdateInTx(ctx context.Context, tx pgx.Tx, productID uuid.UUID) (*model.Product, error) {
+	return getLockedProductInTx(ctx, tx, getDeletedProductForUpdateQuery(productID))
+}
+
+func getLockedProductInTx(ctx context.Context, tx pgx.Tx, query sq.SelectBuilder) (*model.Product, error) {
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Query getProductForUpdate: %w", err)
+	}
+	defer rows.Close()
+
+	product := &model.Product{}
+	found := false
+	for rows.Next() {
+		if err = scanProduct(rows, product); err != nil {
+			return nil, fmt.Errorf("failed to scan product: %w", err)
+		}
+		found = true
+	}
+
+	if !found {
+		return nil, nil
+	}
+
+	return product, nil
+}
+
+// Appends a revision with the fields that differ between the two product versions,
+// nothing is written when no field has changed
+func createRevisionInTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	action model.RevisionAction,
+	actorID *uuid.UUID,
+	old, new model.Product,
+) error {
+	changes := model.ProductChanges(old, new)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	query := createRevisionQuery(model.ProductRevision{
+		ID:        uuid.New(),
+		ProductID: new.ID,
+		ActorID:   actorID,
+		Action:    action,
+		Changes:   changes,
+		CreatedAt: time.Now(),
+	})
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec createRevision: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Go-Marketplace/backend/pkg/logger"
+	"github.com/Go-Marketplace/backend/pkg/postgres"
+	"github.com/Go-Marketplace/backend/product/internal/model"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type SellerRepo struct {
+	pg     *postgres.Postgres
+	logger *logger.Logger
+}
+
+func NewSellerRepo(pg *postgres.Postgres, logger *logger.Logger) *SellerRepo {
+	return &SellerRepo{
+		pg:     pg,
+		logger: logger,
+	}
+}
+
+func scanSellerProfile(rows pgx.Rows, profile *model.SellerProfile) error {
+	return rows.Scan(
+		&profile.UserID,
+		&profile.DisplayName,
+		&profile.Description,
+		&profile.LogoURL,
+		&profile.ReturnPolicy,
+		&profile.Rating,
+		&profile.ReviewsCount,
+		&profile.CreatedAt,
+		&profile.UpdatedAt,
+	)
+}
+
+func (repo *SellerRepo) GetSellerProfile(ctx context.Context, userID uuid.UUID) (*model.SellerProfile, error) {
+	query := getSellerProfileQuery(userID)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Query getSellerProfile: %w", err)
+	}
+	defer rows.Close()
+
+	profile := &model.SellerProfile{}
+	found := false
+	for rows.Next() {
+		if err = scanSellerProfile(rows, profile); err != nil {
+			return nil, fmt.Errorf("failed to scan seller profile: %w", err)
+		}
+		found = true
+	}
+
+	if !found {
+		return nil, nil
+	}
+
+	return profile, nil
+}
+
+// Creates the seller profile or updates it keeping the original creation time
+func (repo *SellerRepo) SetSellerProfile(ctx context.Context, profile model.SellerProfile) error {
+	query := setSellerProfileQuery(profile)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = repo.pg.Pool.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec setSellerProfile: %w", err)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/Go-Marketplace/backend/product/internal/api/grpc/dto"
 	"github.com/Go-Marketplace/backend/product/internal/model"
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
 	sq "github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
 )
@@ -17,28 +18,50 @@ func getAllProductsQuery() sq.SelectBuilder {
 			"product_id",
 			"user_id",
 			"category_id",
+			"external_sku",
 			"name",
 			"description",
 			"price",
+			"currency",
 			"quantity",
-			"moderated",
+			"moderation_status",
+			"rejection_reason",
+			"moderated_by",
+			"moderated_at",
+			"rating",
+			"reviews_count",
+			"low_stock_threshold",
+			"hide_when_out_of_stock",
+			"max_per_customer",
 			"created_at",
 			"updated_at",
+			"deleted_at",
 		).
 		From("products")
 }
 
-func getProductQuery(productID uuid.UUID) sq.SelectBuilder {
+func getLiveProductsQuery() sq.SelectBuilder {
 	return getAllProductsQuery().
+		Where(sq.Eq{
+			"deleted_at": nil,
+		})
+}
+
+func getProductQuery(productID uuid.UUID) sq.SelectBuilder {
+	return getLiveProductsQuery().
 		Where(sq.Eq{
 			"product_id": productID,
 		})
 }
 
 func searchProductsQuery(searchParams dto.SearchProductsDTO) sq.SelectBuilder {
-	query := getAllProductsQuery().Where(sq.Eq{
-		"moderated": searchParams.Moderated,
-	})
+	query := getLiveProductsQuery()
+
+	if searchParams.ModerationStatus != nil {
+		query = query.Where(sq.Eq{
+			"moderation_status": *searchParams.ModerationStatus,
+		})
+	}
 
 	if searchParams.UserID != uuid.Nil {
 		query = query.Where(sq.Eq{
@@ -52,6 +75,64 @@ func searchProductsQuery(searchParams dto.SearchProductsDTO) sq.SelectBuilder {
 		})
 	}
 
+	if searchParams.ExternalSKU != "" {
+		query = query.Where(sq.Eq{
+			"external_sku": searchParams.ExternalSKU,
+		})
+	}
+
+	if searchParams.MinRating > 0 {
+		query = query.Where(sq.GtOrEq{
+			"rating": searchParams.MinRating,
+		})
+	}
+
+	if !searchParams.IncludeHidden {
+		query = query.Where(sq.Or{
+			sq.Eq{
+				"hide_when_out_of_stock": false,
+			},
+			sq.Gt{
+				"quantity": 0,
+			},
+		})
+	}
+
+	switch searchParams.Order {
+	case pbProduct.ProductsOrder_RATING_DESC:
+		query = query.OrderBy("rating DESC", "reviews_count DESC")
+	case pbProduct.ProductsOrder_RATING_ASC:
+		query = query.OrderBy("rating ASC", "reviews_count ASC")
+	}
+
+	if searchParams.Limit != 0 {
+		// Pages must not overlap, so the newest products go first among equal ones
+		query = query.OrderBy("created_at DESC", "product_id").
+			Limit(searchParams.Limit)
+	}
+
+	if searchParams.Offset != 0 {
+		query = query.Offset(searchParams.Offset)
+	}
+
+	return query
+}
+
+func moderationQueueQuery(queueParams dto.ModerationQueueDTO) sq.SelectBuilder {
+	query := getLiveProductsQuery().
+		Where(sq.Eq{
+			"moderation_status": model.Pending,
+		}).
+		OrderBy("updated_at ASC")
+
+	if queueParams.Limit != 0 {
+		query = query.Limit(queueParams.Limit)
+	}
+
+	if queueParams.Offset != 0 {
+		query = query.Offset(queueParams.Offset)
+	}
+
 	return query
 }
 
@@ -62,11 +143,13 @@ func createProductQuery(product model.Product) sq.InsertBuilder {
 			"product_id",
 			"user_id",
 			"category_id",
+			"external_sku",
 			"name",
 			"description",
 			"price",
+			"currency",
 			"quantity",
-			"moderated",
+			"moderation_status",
 			"created_at",
 			"updated_at",
 		).
@@ -74,11 +157,13 @@ func createProductQuery(product model.Product) sq.InsertBuilder {
 			product.ID,
 			product.UserID,
 			product.CategoryID,
+			product.ExternalSKU,
 			product.Name,
 			product.Description,
 			product.Price,
+			product.Currency,
 			product.Quantity,
-			product.Moderated,
+			product.ModerationStatus,
 			product.CreatedAt,
 			product.UpdatedAt,
 		)
@@ -91,6 +176,10 @@ func updateProductQuery(product model.Product) sq.UpdateBuilder {
 		query = query.Set("category_id", product.CategoryID)
 	}
 
+	if product.ExternalSKU != "" {
+		query = query.Set("external_sku", product.ExternalSKU)
+	}
+
 	if product.Name != "" {
 		query = query.Set("name", product.Name)
 	}
@@ -103,15 +192,22 @@ func updateProductQuery(product model.Product) sq.UpdateBuilder {
 		query = query.Set("price", product.Price)
 	}
 
-	if product.Quantity != 0 {
-		query = query.Set("quantity", product.Quantity)
+	if product.Currency != "" {
+		query = query.Set("currency", product.Currency)
+	}
+
+	if product.ModerationStatus != model.Draft {
+		query = query.Set("moderation_status", product.ModerationStatus)
 	}
 
-	if product.Moderated {
-		query = query.Set("moderated", product.Moderated)
+	if product.ModeratedAt != nil {
+		query = query.
+			Set("rejection_reason", product.RejectionReason).
+			Set("moderated_by", product.ModeratedBy).
+			Set("moderated_at", product.ModeratedAt)
 	}
 
-	query.Set("updated_at", time.Now())
+	query = query.Set("updated_at", time.Now())
 
 	query = query.Where(sq.Eq{
 		"product_id": product.ID,
@@ -120,19 +216,42 @@ func updateProductQuery(product model.Product) sq.UpdateBuilder {
 	return query
 }
 
-func deleteProductQuery(productID uuid.UUID) sq.DeleteBuilder {
+func deleteProductQuery(productID uuid.UUID, deletedAt time.Time) sq.UpdateBuilder {
 	return psql.
-		Delete("products").
+		Update("products").
+		Set("deleted_at", deletedAt).
+		Set("updated_at", deletedAt).
 		Where(sq.Eq{
 			"product_id": productID,
 		})
 }
 
-func deleteUserProductsQuery(userID uuid.UUID) sq.DeleteBuilder {
+func restoreProductQuery(productID uuid.UUID) sq.UpdateBuilder {
+	return psql.
+		Update("products").
+		Set("deleted_at", nil).
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{
+			"product_id": productID,
+		})
+}
+
+func getDeletedProductsQuery(deletedBefore time.Time) sq.SelectBuilder {
+	return getAllProductsQuery().
+		Where(sq.Lt{
+			"deleted_at": deletedBefore,
+		}).
+		OrderBy("deleted_at ASC")
+}
+
+func purgeProductQuery(productID uuid.UUID) sq.DeleteBuilder {
 	return psql.
 		Delete("products").
 		Where(sq.Eq{
-			"user_id": userID,
+			"product_id": productID,
+		}).
+		Where(sq.NotEq{
+			"deleted_at": nil,
 		})
 }
 
@@ -152,3 +271,776 @@ func getCategory(categoryID int32) sq.SelectBuilder {
 			"category_id": categoryID,
 		})
 }
+
+func nullUUID(id uuid.UUID) interface{} {
+	if id == uuid.Nil {
+		return nil
+	}
+	return id
+}
+
+func nullInt32(value int32) interface{} {
+	if value == 0 {
+		return nil
+	}
+	return value
+}
+
+func getAllDiscountsQuery() sq.SelectBuilder {
+	return psql.
+		Select(
+			"discount_id",
+			"scope",
+			"product_id",
+			"COALESCE(category_id, 0)",
+			"seller_id",
+			"percent",
+			"priority",
+			"created_at",
+			"starts_at",
+			"ends_at",
+		).
+		From("discounts")
+}
+
+func getDiscountQuery(discountID uuid.UUID) sq.SelectBuilder {
+	return getAllDiscountsQuery().
+		Where(sq.Eq{
+			"discount_id": discountID,
+		})
+}
+
+func discountsTargetCondition(searchParams dto.SearchDiscountsDTO) sq.Eq {
+	condition := sq.Eq{
+		"scope": searchParams.Scope,
+	}
+
+	if searchParams.ProductID != uuid.Nil {
+		condition["product_id"] = searchParams.ProductID
+	}
+
+	if searchParams.CategoryID != 0 {
+		condition["category_id"] = searchParams.CategoryID
+	}
+
+	if searchParams.SellerID != uuid.Nil {
+		condition["seller_id"] = searchParams.SellerID
+	}
+
+	return condition
+}
+
+func searchDiscountsQuery(searchParams dto.SearchDiscountsDTO) sq.SelectBuilder {
+	query := getAllDiscountsQuery().
+		Where(discountsTargetCondition(searchParams)).
+		OrderBy("starts_at DESC")
+
+	if !searchParams.IncludeExpired {
+		query = query.Where(sq.Gt{
+			"ends_at": time.Now(),
+		})
+	}
+
+	return query
+}
+
+// Selects the discount with the highest priority among the ones active for the product,
+// ties are broken by the bigger percent and then by the narrower scope
+// Matches the discounts of the product itself, its category and its seller
+func productDiscountsCondition(product model.Product) sq.Or {
+	return sq.Or{
+		sq.Eq{"scope": model.ProductDiscount, "product_id": product.ID},
+		sq.Eq{"scope": model.CategoryDiscount, "category_id": product.CategoryID},
+		sq.Eq{"scope": model.SellerDiscount, "seller_id": product.UserID},
+	}
+}
+
+func getProductDiscountQuery(product model.Product) sq.SelectBuilder {
+	now := time.Now()
+
+	return getAllDiscountsQuery().
+		Where(sq.LtOrEq{
+			"starts_at": now,
+		}).
+		Where(sq.Gt{
+			"ends_at": now,
+		}).
+		Where(productDiscountsCondition(product)).
+		OrderBy("priority DESC", "percent DESC", "scope ASC").
+		Limit(1)
+}
+
+// Scheduled discounts that were ended before they started have ends_at equal to starts_at
+func getNextDiscountStartQuery(product model.Product) sq.SelectBuilder {
+	return psql.
+		Select("MIN(starts_at)").
+		From("discounts").
+		Where(sq.Gt{
+			"starts_at": time.Now(),
+		}).
+		Where("ends_at > starts_at").
+		Where(productDiscountsCondition(product))
+}
+
+func createDiscountQuery(discount model.Discount) sq.InsertBuilder {
+	return psql.
+		Insert("discounts").
+		Columns(
+			"discount_id",
+			"scope",
+			"product_id",
+			"category_id",
+			"seller_id",
+			"percent",
+			"priority",
+			"created_at",
+			"starts_at",
+			"ends_at",
+		).
+		Values(
+			discount.ID,
+			discount.Scope,
+			nullUUID(discount.ProductID),
+			nullInt32(discount.CategoryID),
+			nullUUID(discount.SellerID),
+			discount.Percent,
+			discount.Priority,
+			discount.CreatedAt,
+			discount.StartsAt,
+			discount.EndsAt,
+		)
+}
+
+// Discounts are never deleted to keep their history, ending a scheduled
+// discount collapses its period so that it never becomes active
+func endDiscountsQuery(searchParams dto.SearchDiscountsDTO) sq.UpdateBuilder {
+	now := time.Now()
+
+	return psql.
+		Update("discounts").
+		Set("ends_at", sq.Expr("GREATEST(starts_at, ?::timestamp)", now)).
+		Where(discountsTargetCondition(searchParams)).
+		Where(sq.Gt{
+			"ends_at": now,
+		})
+}
+
+func getAllReviewsQuery() sq.SelectBuilder {
+	return psql.
+		Select(
+			"review_id",
+			"product_id",
+			"user_id",
+			"rating",
+			"comment",
+			"reply",
+			"replied_at",
+			"created_at",
+			"updated_at",
+		).
+		From("reviews")
+}
+
+func getReviewQuery(reviewID uuid.UUID) sq.SelectBuilder {
+	return getAllReviewsQuery().
+		Where(sq.Eq{
+			"review_id": reviewID,
+		})
+}
+
+func searchReviewsQuery(searchParams dto.SearchReviewsDTO) sq.SelectBuilder {
+	query := getAllReviewsQuery().
+		Where(sq.Eq{
+			"product_id": searchParams.ProductID,
+		}).
+		OrderBy("created_at DESC")
+
+	if searchParams.UserID != uuid.Nil {
+		query = query.Where(sq.Eq{
+			"user_id": searchParams.UserID,
+		})
+	}
+
+	if searchParams.Limit != 0 {
+		query = query.Limit(searchParams.Limit)
+	}
+
+	if searchParams.Offset != 0 {
+		query = query.Offset(searchParams.Offset)
+	}
+
+	return query
+}
+
+func createReviewQuery(review model.Review) sq.InsertBuilder {
+	return psql.
+		Insert("reviews").
+		Columns(
+			"review_id",
+			"product_id",
+			"user_id",
+			"rating",
+			"comment",
+			"reply",
+			"replied_at",
+			"created_at",
+			"updated_at",
+		).
+		Values(
+			review.ID,
+			review.ProductID,
+			review.UserID,
+			review.Rating,
+			review.Comment,
+			review.Reply,
+			review.RepliedAt,
+			review.CreatedAt,
+			review.UpdatedAt,
+		)
+}
+
+func updateReviewReplyQuery(review model.Review) sq.UpdateBuilder {
+	return psql.
+		Update("reviews").
+		Set("reply", review.Reply).
+		Set("replied_at", review.RepliedAt).
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{
+			"review_id": review.ID,
+		})
+}
+
+// Adds the rating of a new review to the product aggregate in place, a concurrent
+// review of the same product waits for the row lock and adds to the updated values
+func updateProductRatingQuery(review model.Review) sq.UpdateBuilder {
+	return psql.
+		Update("products").
+		Set("rating", sq.Expr("(rating * reviews_count + ?) / (reviews_count + 1)", review.Rating)).
+		Set("reviews_count", sq.Expr("reviews_count + 1")).
+		Where(sq.Eq{
+			"product_id": review.ProductID,
+		})
+}
+
+func getWarehousesQuery() sq.SelectBuilder {
+	return psql.Select(
+		"warehouse_id",
+		"user_id",
+		"name",
+		"address",
+		"latitude",
+		"longitude",
+		"created_at",
+	).
+		From("warehouses")
+}
+
+func getWarehouseQuery(warehouseID uuid.UUID) sq.SelectBuilder {
+	return getWarehousesQuery().
+		Where(sq.Eq{
+			"warehouse_id": warehouseID,
+		})
+}
+
+func getUserWarehousesQuery(userID uuid.UUID) sq.SelectBuilder {
+	return getWarehousesQuery().
+		Where(sq.Eq{
+			"user_id": userID,
+		}).
+		OrderBy("created_at ASC")
+}
+
+func createWarehouseQuery(warehouse model.Warehouse) sq.InsertBuilder {
+	return psql.Insert("warehouses").
+		Columns(
+			"warehouse_id",
+			"user_id",
+			"name",
+			"address",
+			"latitude",
+			"longitude",
+			"created_at",
+		).
+		Values(
+			warehouse.ID,
+			warehouse.UserID,
+			warehouse.Name,
+			warehouse.Address,
+			warehouse.Location.Latitude,
+			warehouse.Location.Longitude,
+			warehouse.CreatedAt,
+		)
+}
+
+// The primary warehouse of a product is the oldest warehouse of its seller
+func getPrimaryWarehouseQuery(productID uuid.UUID) sq.SelectBuilder {
+	return psql.Select("warehouses.warehouse_id").
+		From("warehouses").
+		Join("products USING (user_id)").
+		Where(sq.Eq{
+			"products.product_id": productID,
+		}).
+		OrderBy("warehouses.created_at ASC").
+		Limit(1)
+}
+
+func createDefaultWarehouseQuery(warehouseID, productID uuid.UUID) sq.InsertBuilder {
+	return psql.Insert("warehouses").
+		Columns(
+			"warehouse_id",
+			"user_id",
+			"name",
+			"created_at",
+		).
+		Select(
+			psql.Select().
+				Column("?::uuid", warehouseID).
+				Column("user_id").
+				Column("'Default'").
+				Column("?::timestamp", time.Now()).
+				From("products").
+				Where(sq.Eq{
+					"product_id": productID,
+				}),
+		)
+}
+
+func getProductStocksQuery(productID uuid.UUID) sq.SelectBuilder {
+	return psql.Select(
+		"warehouse_stocks.warehouse_id",
+		"warehouse_stocks.product_id",
+		"warehouse_stocks.quantity",
+		"warehouses.latitude",
+		"warehouses.longitude",
+		"warehouse_stocks.updated_at",
+	).
+		From("warehouse_stocks").
+		Join("warehouses USING (warehouse_id)").
+		Where(sq.Eq{
+			"warehouse_stocks.product_id": productID,
+		}).
+		OrderBy("warehouses.created_at ASC")
+}
+
+func getWarehouseStockQuery(warehouseID, productID uuid.UUID) sq.SelectBuilder {
+	return getProductStocksQuery(productID).
+		Where(sq.Eq{
+			"warehouse_stocks.warehouse_id": warehouseID,
+		})
+}
+
+func setStockQuery(stock model.WarehouseStock) sq.InsertBuilder {
+	return psql.Insert("warehouse_stocks").
+		Columns(
+			"warehouse_id",
+			"product_id",
+			"quantity",
+			"updated_at",
+		).
+		Values(
+			stock.WarehouseID,
+			stock.ProductID,
+			stock.Quantity,
+			time.Now(),
+		).
+		Suffix("ON CONFLICT (warehouse_id, product_id) DO UPDATE SET quantity = EXCLUDED.quantity, updated_at = EXCLUDED.updated_at")
+}
+
+func addStockQuery(warehouseID, productID uuid.UUID, quantity int64) sq.InsertBuilder {
+	return psql.Insert("warehouse_stocks").
+		Columns(
+			"warehouse_id",
+			"product_id",
+			"quantity",
+			"updated_at",
+		).
+		Values(
+			warehouseID,
+			productID,
+			quantity,
+			time.Now(),
+		).
+		Suffix("ON CONFLICT (warehouse_id, product_id) DO UPDATE SET quantity = warehouse_stocks.quantity + EXCLUDED.quantity, updated_at = EXCLUDED.updated_at")
+}
+
+// Takes the quantity only if the warehouse has enough of the product and the product is not deleted
+func reserveStockQuery(reservation model.Reservation) sq.UpdateBuilder {
+	return psql.Update("warehouse_stocks").
+		Set("quantity", sq.Expr("quantity - ?", reservation.Quantity)).
+		Set("updated_at", time.Now()).
+		Where(sq.And{
+			sq.Eq{
+				"warehouse_id": reservation.WarehouseID,
+			},
+			sq.Eq{
+				"product_id": reservation.ProductID,
+			},
+			sq.GtOrEq{
+				"quantity": reservation.Quantity,
+			},
+			sq.Expr(
+				"EXISTS (SELECT 1 FROM products WHERE products.product_id = warehouse_stocks.product_id AND products.deleted_at IS NULL)",
+			),
+		})
+}
+
+// Changes the primary warehouse stock so that the product stocks sum up to the quantity
+func setProductQuantityQuery(warehouseID, productID uuid.UUID, quantity int64) sq.UpdateBuilder {
+	return psql.Update("warehouse_stocks").
+		Set("quantity", sq.Expr(
+			"quantity + (? - (SELECT COALESCE(SUM(quantity), 0) FROM warehouse_stocks WHERE product_id = ?))",
+			quantity,
+			productID,
+		)).
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{
+			"warehouse_id": warehouseID,
+			"product_id":   productID,
+		})
+}
+
+func setStockAlertQuery(product model.Product) sq.UpdateBuilder {
+	return psql.Update("products").
+		Set("low_stock_threshold", product.LowStockThreshold).
+		Set("hide_when_out_of_stock", product.HideWhenOutOfStock).
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{
+			"product_id": product.ID,
+		})
+}
+
+func setPurchaseLimitQuery(product model.Product) sq.UpdateBuilder {
+	return psql.Update("products").
+		Set("max_per_customer", product.MaxPerCustomer).
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{
+			"product_id": product.ID,
+		})
+}
+
+func getNotificationsQuery(searchParams dto.SearchNotificationsDTO) sq.SelectBuilder {
+	query := psql.Select(
+		"notification_id",
+		"user_id",
+		"product_id",
+		"kind",
+		"quantity",
+		"message",
+		"created_at",
+	).
+		From("notifications").
+		Where(sq.Eq{
+			"user_id": searchParams.UserID,
+		}).
+		OrderBy("created_at DESC")
+
+	if searchParams.Limit != 0 {
+		query = query.Limit(searchParams.Limit)
+	}
+
+	if searchParams.Offset != 0 {
+		query = query.Offset(searchParams.Offset)
+	}
+
+	return query
+}
+
+func createNotificationQuery(notification model.Notification) sq.InsertBuilder {
+	return psql.Insert("notifications").
+		Columns(
+			"notification_id",
+			"user_id",
+			"product_id",
+			"kind",
+			"quantity",
+			"message",
+			"created_at",
+		).
+		Values(
+			notification.ID,
+			notification.UserID,
+			notification.ProductID,
+			notification.Kind,
+			notification.Quantity,
+			notification.Message,
+			notification.CreatedAt,
+		)
+}
+
+func getProductForUpdateQuery(productID uuid.UUID) sq.SelectBuilder {
+	return getProductQuery(productID).
+		Suffix("FOR UPDATE")
+}
+
+func getDeletedProductForUpdateQuery(productID uuid.UUID) sq.SelectBuilder {
+	return getAllProductsQuery().
+		Where(sq.Eq{
+			"product_id": productID,
+		}).
+		Where(sq.NotEq{
+			"deleted_at": nil,
+		}).
+		Suffix("FOR UPDATE")
+}
+
+func getUserProductsForUpdateQuery(userID uuid.UUID) sq.SelectBuilder {
+	return getLiveProductsQuery().
+		Where(sq.Eq{
+			"user_id": userID,
+		}).
+		Suffix("FOR UPDATE")
+}
+
+func createRevisionQuery(revision model.ProductRevision) sq.InsertBuilder {
+	return psql.Insert("product_revisions").
+		Columns(
+			"revision_id",
+			"product_id",
+			"actor_id",
+			"action",
+			"changes",
+			"created_at",
+		).
+		Values(
+			revision.ID,
+			revision.ProductID,
+			revision.ActorID,
+			revision.Action,
+			revision.Changes,
+			revision.CreatedAt,
+		)
+}
+
+func getRevisionsQuery(searchParams dto.SearchRevisionsDTO) sq.SelectBuilder {
+	query := psql.Select(
+		"revision_id",
+		"product_id",
+		"actor_id",
+		"action",
+		"changes",
+		"created_at",
+	).
+		From("product_revisions").
+		Where(sq.Eq{
+			"product_id": searchParams.ProductID,
+		}).
+		OrderBy("created_at DESC")
+
+	if searchParams.Limit != 0 {
+		query = query.Limit(searchParams.Limit)
+	}
+
+	if searchParams.Offset != 0 {
+		query = query.Offset(searchParams.Offset)
+	}
+
+	return query
+}
+
+func getPriceHistoryQuery(productID uuid.UUID) sq.SelectBuilder {
+	return psql.Select(
+		"(changes->'price'->>'new')::BIGINT",
+		"actor_id",
+		"created_at",
+	).
+		From("product_revisions").
+		Where(sq.And{
+			sq.Eq{
+				"product_id": productID,
+			},
+			sq.Expr("changes->'price' IS NOT NULL"),
+		}).
+		OrderBy("created_at ASC")
+}
+
+// The seller rating is the average of all reviews of their live products
+func getSellerProfileQuery(userID uuid.UUID) sq.SelectBuilder {
+	return psql.Select(
+		"user_id",
+		"display_name",
+		"description",
+		"logo_url",
+		"return_policy",
+		`COALESCE((
+			SELECT SUM(products.rating * products.reviews_count) / NULLIF(SUM(products.reviews_count), 0)
+			FROM products
+			WHERE products.user_id = seller_profiles.user_id AND products.deleted_at IS NULL
+		), 0)::REAL`,
+		`(
+			SELECT COALESCE(SUM(products.reviews_count), 0)
+			FROM products
+			WHERE products.user_id = seller_profiles.user_id AND products.deleted_at IS NULL
+		)::BIGINT`,
+		"created_at",
+		"updated_at",
+	).
+		From("seller_profiles").
+		Where(sq.Eq{
+			"user_id": userID,
+		})
+}
+
+func setSellerProfileQuery(profile model.SellerProfile) sq.InsertBuilder {
+	return psql.Insert("seller_profiles").
+		Columns(
+			"user_id",
+			"display_name",
+			"description",
+			"logo_url",
+			"return_policy",
+			"created_at",
+			"updated_at",
+		).
+		Values(
+			profile.UserID,
+			profile.DisplayName,
+			profile.Description,
+			profile.LogoURL,
+			profile.ReturnPolicy,
+			profile.CreatedAt,
+			profile.UpdatedAt,
+		).
+		Suffix(`ON CONFLICT (user_id) DO UPDATE SET
+			display_name = EXCLUDED.display_name,
+			description = EXCLUDED.description,
+			logo_url = EXCLUDED.logo_url,
+			return_policy = EXCLUDED.return_policy,
+			updated_at = EXCLUDED.updated_at`)
+}
+
+func getWishlistsQuery() sq.SelectBuilder {
+	return psql.Select(
+		"wishlist_id",
+		"user_id",
+		"name",
+		"created_at",
+		"updated_at",
+	).
+		From("wishlists")
+}
+
+func getWishlistQuery(wishlistID uuid.UUID) sq.SelectBuilder {
+	return getWishlistsQuery().
+		Where(sq.Eq{
+			"wishlist_id": wishlistID,
+		})
+}
+
+func getWishlistByNameQuery(userID uuid.UUID, name string) sq.SelectBuilder {
+	return getWishlistsQuery().
+		Where(sq.Eq{
+			"user_id": userID,
+			"name":    name,
+		})
+}
+
+func getUserWishlistsQuery(userID uuid.UUID) sq.SelectBuilder {
+	return getWishlistsQuery().
+		Where(sq.Eq{
+			"user_id": userID,
+		}).
+		OrderBy("created_at ASC")
+}
+
+func getWishlistItemsQuery(wishlistIDs []uuid.UUID) sq.SelectBuilder {
+	return psql.Select(
+		"wishlist_id",
+		"product_id",
+		"added_at",
+	).
+		From("wishlist_items").
+		Where(sq.Eq{
+			"wishlist_id": wishlistIDs,
+		}).
+		OrderBy("added_at DESC")
+}
+
+func createWishlistQuery(wishlist model.Wishlist) sq.InsertBuilder {
+	return psql.Insert("wishlists").
+		Columns(
+			"wishlist_id",
+			"user_id",
+			"name",
+			"created_at",
+			"updated_at",
+		).
+		Values(
+			wishlist.ID,
+			wishlist.UserID,
+			wishlist.Name,
+			wishlist.CreatedAt,
+			wishlist.UpdatedAt,
+		)
+}
+
+func deleteWishlistQuery(wishlistID uuid.UUID) sq.DeleteBuilder {
+	return psql.Delete("wishlists").
+		Where(sq.Eq{
+			"wishlist_id": wishlistID,
+		})
+}
+
+func addWishlistItemQuery(item model.WishlistItem) sq.InsertBuilder {
+	return psql.Insert("wishlist_items").
+		Columns(
+			"wishlist_id",
+			"product_id",
+			"added_at",
+		).
+		Values(
+			item.WishlistID,
+			item.ProductID,
+			item.AddedAt,
+		).
+		Suffix("ON CONFLICT (wishlist_id, product_id) DO NOTHING")
+}
+
+func removeWishlistItemQuery(wishlistID, productID uuid.UUID) sq.DeleteBuilder {
+	return psql.Delete("wishlist_items").
+		Where(sq.Eq{
+			"wishlist_id": wishlistID,
+			"product_id":  productID,
+		})
+}
+
+func touchWishlistQuery(wishlistID uuid.UUID) sq.UpdateBuilder {
+	return psql.Update("wishlists").
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{
+			"wishlist_id": wishlistID,
+		})
+}
+
+func getExchangeRatesQuery() sq.SelectBuilder {
+	return psql.Select(
+		"currency",
+		"rate",
+		"updated_at",
+	).
+		From("exchange_rates").
+		OrderBy("currency")
+}
+
+func getExchangeRateQuery(currency string) sq.SelectBuilder {
+	return getExchangeRatesQuery().
+		Where(sq.Eq{
+			"currency": currency,
+		})
+}
+
+func setExchangeRateQuery(rate *model.ExchangeRate) sq.InsertBuilder {
+	return psql.Insert("exchange_rates").
+		Columns(
+			"currency",
+			"rate",
+			"updated_at",
+		).
+		Values(
+			rate.Currency,
+			rate.Rate,
+			rate.UpdatedAt,
+		).
+		Suffix(`ON CONFLICT (currency) DO UPDATE SET
+			rate = EXCLUDED.rate,
+			updated_at = EXCLUDED.updated_at`)
+}
@@ -0,0 +1,421 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/Go-Marketplace/backend/pkg/logger"
+	"github.com/Go-Marketplace/backend/pkg/postgres"
+	"github.com/Go-Marketplace/backend/product/internal/model"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type WarehouseRepo struct {
+	pg     *postgres.Postgres
+	logger *logger.Logger
+}
+
+func NewWarehouseRepo(pg *postgres.Postgres, logger *logger.Logger) *WarehouseRepo {
+	return &WarehouseRepo{
+		pg:     pg,
+		logger: logger,
+	}
+}
+
+func scanWarehouse(rows pgx.Rows, warehouse *model.Warehouse) error {
+	return rows.Scan(
+		&warehouse.ID,
+		&warehouse.UserID,
+		&warehouse.Name,
+		&warehouse.Address,
+		&warehouse.Location.Latitude,
+		&warehouse.Location.Longitude,
+		&warehouse.CreatedAt,
+	)
+}
+
+func scanWarehouseStock(rows pgx.Rows, stock *model.WarehouseStock) error {
+	return rows.Scan(
+		&stock.WarehouseID,
+		&stock.ProductID,
+		&stock.Quantity,
+		&stock.Location.Latitude,
+		&stock.Location.Longitude,
+		&stock.UpdatedAt,
+	)
+}
+
+func (repo *WarehouseRepo) GetWarehouse(ctx context.Context, warehouseID uuid.UUID) (*model.Warehouse, error) {
+	query := getWarehouseQuery(warehouseID)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Query getWarehouse: %w", err)
+	}
+	defer rows.Close()
+
+	warehouse := &model.Warehouse{}
+	found := false
+	for rows.Next() {
+		if err = scanWarehouse(rows, warehouse); err != nil {
+			return nil, fmt.Errorf("failed to scan warehouse: %w", err)
+		}
+		found = true
+	}
+
+	if !found {
+		return nil, nil
+	}
+
+	return warehouse, nil
+}
+
+func (repo *WarehouseRepo) GetWarehouses(ctx context.Context, userID uuid.UUID) ([]*model.Warehouse, error) {
+	query := getUserWarehousesQuery(userID)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Query getUserWarehouses: %w", err)
+	}
+	defer rows.Close()
+
+	warehouses := make([]*model.Warehouse, 0)
+	for rows.Next() {
+		warehouse := &model.Warehouse{}
+		if err = scanWarehouse(rows, warehouse); err != nil {
+			return nil, fmt.Errorf("failed to scan warehouse: %w", err)
+		}
+		warehouses = append(warehouses, warehouse)
+	}
+
+	return warehouses, nil
+}
+
+func (repo *WarehouseRepo) CreateWarehouse(ctx context.Context, warehouse model.Warehouse) error {
+	query := createWarehouseQuery(warehouse)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = repo.pg.Pool.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec createWarehouse: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *WarehouseRepo) getStocks(ctx context.Context, query string, args ...interface{}) ([]*model.WarehouseStock, error) {
+	rows, err := repo.pg.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Query %s: %w", query, err)
+	}
+	defer rows.Close()
+
+	stocks := make([]*model.WarehouseStock, 0)
+	for rows.Next() {
+		stock := &model.WarehouseStock{}
+		if err = scanWarehouseStock(rows, stock); err != nil {
+			return nil, fmt.Errorf("failed to scan warehouse stock: %w", err)
+		}
+		stocks = append(stocks, stock)
+	}
+
+	return stocks, nil
+}
+
+func (repo *WarehouseRepo) GetProductStocks(ctx context.Context, productID uuid.UUID) ([]*model.WarehouseStock, error) {
+	query := getProductStocksQuery(productID)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	return repo.getStocks(ctx, sqlQuery, args...)
+}
+
+func (repo *WarehouseRepo) GetWarehouseStock(ctx context.Context, warehouseID, productID uuid.UUID) (*model.WarehouseStock, error) {
+	query := getWarehouseStockQuery(warehouseID, productID)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	stocks, err := repo.getStocks(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(stocks) == 0 {
+		return nil, nil
+	}
+
+	return stocks[0], nil
+}
+
+func (repo *WarehouseRepo) SetStock(ctx context.Context, stock model.WarehouseStock) error {
+	query := setStockQuery(stock)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = repo.pg.Pool.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec setStock: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *WarehouseRepo) ReserveStock(ctx context.Context, reservation model.Reservation) error {
+	query := reserveStockQuery(reservation)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	tag, err := repo.pg.Pool.Exec(ctx, sqlQuery, args...)
+	if err != nil {
+		return fmt.Errorf("failed to Exec reserveStock: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return model.ErrInsufficientStock
+	}
+
+	return nil
+}
+
+func (repo *WarehouseRepo) ReleaseStock(ctx context.Context, reservation model.Reservation) (uuid.UUID, error) {
+	conn, err := repo.pg.Pool.Acquire(ctx)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to Acquire in ReleaseStock: %w", err)
+	}
+	defer conn.Release()
+
+	tx, err := conn.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to begin ReleaseStock transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				repo.logger.Error("failed to rollback transaction", err)
+			}
+		} else {
+			if err := tx.Commit(ctx); err != nil {
+				repo.logger.Error("failed to commit transaction", err)
+			}
+		}
+	}()
+
+	warehouseID := reservation.WarehouseID
+	if warehouseID == uuid.Nil {
+		warehouseID, err = getPrimaryWarehouseInTx(ctx, tx, reservation.ProductID)
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("failed to get primary warehouse in transaction: %w", err)
+		}
+	}
+
+	query := addStockQuery(warehouseID, reservation.ProductID, reservation.Quantity)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to Exec addStock: %w", err)
+	}
+
+	return warehouseID, nil
+}
+
+// Applies all the changes in one transaction, a reservation the warehouse does not have enough
+// stock for rolls back the whole transaction. Releases without a warehouse are returned to the
+// seller primary warehouse, which is written back to the change
+func (repo *WarehouseRepo) ChangeStocks(ctx context.Context, changes []*model.ReservationChange) error {
+	conn, err := repo.pg.Pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to Acquire in ChangeStocks: %w", err)
+	}
+	defer conn.Release()
+
+	tx, err := conn.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to begin ChangeStocks transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				repo.logger.Error("failed to rollback transaction", err)
+			}
+		} else {
+			if err := tx.Commit(ctx); err != nil {
+				repo.logger.Error("failed to commit transaction", err)
+			}
+		}
+	}()
+
+	// Stocks are locked in the same order by every transaction to avoid deadlocks
+	ordered := make([]*model.ReservationChange, len(changes))
+	copy(ordered, changes)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if ordered[i].ProductID != ordered[j].ProductID {
+			return ordered[i].ProductID.String() < ordered[j].ProductID.String()
+		}
+		return ordered[i].WarehouseID.String() < ordered[j].WarehouseID.String()
+	})
+
+	for _, change := range ordered {
+		if err = changeStockInTx(ctx, tx, change); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func changeStockInTx(ctx context.Context, tx pgx.Tx, change *model.ReservationChange) error {
+	if change.Quantity > 0 {
+		query := reserveStockQuery(model.Reservation{
+			ProductID:   change.ProductID,
+			WarehouseID: change.WarehouseID,
+			Quantity:    change.Quantity,
+		})
+
+		sqlQuery, args, err := query.ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to get sql query: %w", err)
+		}
+
+		tag, err := tx.Exec(ctx, sqlQuery, args...)
+		if err != nil {
+			return fmt.Errorf("failed to Exec reserveStock: %w", err)
+		}
+
+		if tag.RowsAffected() == 0 {
+			return model.ErrInsufficientStock
+		}
+
+		return nil
+	}
+
+	if change.WarehouseID == uuid.Nil {
+		warehouseID, err := getPrimaryWarehouseInTx(ctx, tx, change.ProductID)
+		if err != nil {
+			return fmt.Errorf("failed to get primary warehouse in transaction: %w", err)
+		}
+		change.WarehouseID = warehouseID
+	}
+
+	query := addStockQuery(change.WarehouseID, change.ProductID, -change.Quantity)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec addStock: %w", err)
+	}
+
+	return nil
+}
+
+// Returns the primary warehouse of the product seller, a default warehouse
+// is created for sellers who have none yet
+func getPrimaryWarehouseInTx(ctx context.Context, tx pgx.Tx, productID uuid.UUID) (uuid.UUID, error) {
+	query := getPrimaryWarehouseQuery(productID)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to Query getPrimaryWarehouse: %w", err)
+	}
+
+	warehouseID := uuid.Nil
+	for rows.Next() {
+		if err = rows.Scan(&warehouseID); err != nil {
+			rows.Close()
+			return uuid.Nil, fmt.Errorf("failed to scan warehouse id: %w", err)
+		}
+	}
+	rows.Close()
+
+	if warehouseID != uuid.Nil {
+		return warehouseID, nil
+	}
+
+	warehouseID = uuid.New()
+	createQuery := createDefaultWarehouseQuery(warehouseID, productID)
+
+	sqlQuery, args, err = createQuery.ToSql()
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	tag, err := tx.Exec(ctx, sqlQuery, args...)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to Exec createDefaultWarehouse: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return uuid.Nil, fmt.Errorf("product %s not found", productID)
+	}
+
+	return warehouseID, nil
+}
+
+// Makes the product stocks sum up to the quantity by changing the primary warehouse stock
+func setProductQuantityInTx(ctx context.Context, tx pgx.Tx, productID uuid.UUID, quantity int64) error {
+	warehouseID, err := getPrimaryWarehouseInTx(ctx, tx, productID)
+	if err != nil {
+		return fmt.Errorf("failed to get primary warehouse in transaction: %w", err)
+	}
+
+	insertQuery := addStockQuery(warehouseID, productID, 0)
+
+	sqlQuery, args, err := insertQuery.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec addStock: %w", err)
+	}
+
+	query := setProductQuantityQuery(warehouseID, productID, quantity)
+
+	sqlQuery, args, err = query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec setProductQuantity: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,255 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Go-Marketplace/backend/pkg/logger"
+	"github.com/Go-Marketplace/backend/pkg/postgres"
+	"github.com/Go-Marketplace/backend/product/internal/model"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type WishlistRepo struct {
+	pg     *postgres.Postgres
+	logger *logger.Logger
+}
+
+func NewWishlistRepo(pg *postgres.Postgres, logger *logger.Logger) *WishlistRepo {
+	return &WishlistRepo{
+		pg:     pg,
+		logger: logger,
+	}
+}
+
+func scanWishlist(rows pgx.Rows, wishlist *model.Wishlist) error {
+	return rows.Scan(
+		&wishlist.ID,
+		&wishlist.UserID,
+		&wishlist.Name,
+		&wishlist.CreatedAt,
+		&wishlist.UpdatedAt,
+	)
+}
+
+func scanWishlistItem(rows pgx.Rows, item *model.WishlistItem) error {
+	return rows.Scan(
+		&item.WishlistID,
+		&item.ProductID,
+		&item.AddedAt,
+	)
+}
+
+// Returns the wishlists found by the query together with their items
+func (repo *WishlistRepo) getWishlists(ctx context.Context, query sq.SelectBuilder) ([]*model.Wishlist, error) {
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Query getWishlists: %w", err)
+	}
+
+	wishlists := make([]*model.Wishlist, 0)
+	wishlistsByID := make(map[uuid.UUID]*model.Wishlist)
+	for rows.Next() {
+		wishlist := &model.Wishlist{
+			Items: make([]*model.WishlistItem, 0),
+		}
+		if err = scanWishlist(rows, wishlist); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan wishlist: %w", err)
+		}
+		wishlists = append(wishlists, wishlist)
+		wishlistsByID[wishlist.ID] = wishlist
+	}
+	rows.Close()
+
+	if len(wishlists) == 0 {
+		return wishlists, nil
+	}
+
+	wishlistIDs := make([]uuid.UUID, 0, len(wishlists))
+	for _, wishlist := range wishlists {
+		wishlistIDs = append(wishlistIDs, wishlist.ID)
+	}
+
+	sqlQuery, args, err = getWishlistItemsQuery(wishlistIDs).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err = repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Query getWishlistItems: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		item := &model.WishlistItem{}
+		if err = scanWishlistItem(rows, item); err != nil {
+			return nil, fmt.Errorf("failed to scan wishlist item: %w", err)
+		}
+
+		wishlist := wishlistsByID[item.WishlistID]
+		wishlist.Items = append(wishlist.Items, item)
+	}
+
+	return wishlists, nil
+}
+
+func (repo *WishlistRepo) getWishlist(ctx context.Context, query sq.SelectBuilder) (*model.Wishlist, error) {
+	wishlists, err := repo.getWishlists(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(wishlists) == 0 {
+		return nil, nil
+	}
+
+	return wishlists[0], nil
+}
+
+func (repo *WishlistRepo) GetWishlist(ctx context.Context, wishlistID uuid.UUID) (*model.Wishlist, error) {
+	return repo.getWishlist(ctx, getWishlistQuery(wishlistID))
+}
+
+func (repo *WishlistRepo) GetWishlistByName(ctx context.Context, userID uuid.UUID, name string) (*model.Wishlist, error) {
+	return repo.getWishlist(ctx, getWishlistByNameQuery(userID, name))
+}
+
+func (repo *WishlistRepo) GetWishlists(ctx context.Context, userID uuid.UUID) ([]*model.Wishlist, error) {
+	return repo.getWishlists(ctx, getUserWishlistsQuery(userID))
+}
+
+func (repo *WishlistRepo) CreateWishlist(ctx context.Context, wishlist model.Wishlist) error {
+	query := createWishlistQuery(wishlist)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = repo.pg.Pool.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec createWishlist: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *WishlistRepo) DeleteWishlist(ctx context.Context, wishlistID uuid.UUID) error {
+	query := deleteWishlistQuery(wishlistID)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = repo.pg.Pool.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec deleteWishlist: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *WishlistRepo) AddWishlistItem(ctx context.Context, item model.WishlistItem) error {
+	conn, err := repo.pg.Pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to Acquire in AddWishlistItem: %w", err)
+	}
+	defer conn.Release()
+
+	tx, err := conn.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to begin AddWishlistItem transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				repo.logger.Error("failed to rollback transaction", err)
+			}
+		} else {
+			if err := tx.Commit(ctx); err != nil {
+				repo.logger.Error("failed to commit transaction", err)
+			}
+		}
+	}()
+
+	query := addWishlistItemQuery(item)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec addWishlistItem: %w", err)
+	}
+
+	if err = touchWishlistInTx(ctx, tx, item.WishlistID); err != nil {
+		return fmt.Errorf("failed to touch wishlist in transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *WishlistRepo) RemoveWishlistItem(ctx context.Context, wishlistID, productID uuid.UUID) error {
+	conn, err := repo.pg.Pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to Acquire in RemoveWishlistItem: %w", err)
+	}
+	defer conn.Release()
+
+	tx, err := conn.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to begin RemoveWishlistItem transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				repo.logger.Error("failed to rollback transaction", err)
+			}
+		} else {
+			if err := tx.Commit(ctx); err != nil {
+				repo.logger.Error("failed to commit transaction", err)
+			}
+		}
+	}()
+
+	query := removeWishlistItemQuery(wishlistID, productID)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec removeWishlistItem: %w", err)
+	}
+
+	if err = touchWishlistInTx(ctx, tx, wishlistID); err != nil {
+		return fmt.Errorf("failed to touch wishlist in transaction: %w", err)
+	}
+
+	return nil
+}
+
+func touchWishlistInTx(ctx context.Context, tx pgx.Tx, wishlistID uuid.UUID) error {
+	query := touchWishlistQuery(wishlistID)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec touchWishlist: %w", err)
+	}
+
+	return nil
+}
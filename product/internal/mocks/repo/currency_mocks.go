@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: product/internal/infrastructure/interfaces/currency.go
+
+// Package mock_interfaces is a generated GoMock package.
+package mock_interfaces
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/Go-Marketplace/backend/product/internal/model"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCurrencyRepo is a mock of CurrencyRepo interface.
+type MockCurrencyRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockCurrencyRepoMockRecorder
+}
+
+// MockCurrencyRepoMockRecorder is the mock recorder for MockCurrencyRepo.
+type MockCurrencyRepoMockRecorder struct {
+	mock *MockCurrencyRepo
+}
+
+// NewMockCurrencyRepo creates a new mock instance.
+func NewMockCurrencyRepo(ctrl *gomock.Controller) *MockCurrencyRepo {
+	mock := &MockCurrencyRepo{ctrl: ctrl}
+	mock.recorder = &MockCurrencyRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCurrencyRepo) EXPECT() *MockCurrencyRepoMockRecorder {
+	return m.recorder
+}
+
+// GetExchangeRate mocks base method.
+func (m *MockCurrencyRepo) GetExchangeRate(ctx context.Context, currency string) (*model.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExchangeRate", ctx, currency)
+	ret0, _ := ret[0].(*model.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExchangeRate indicates an expected call of GetExchangeRate.
+func (mr *MockCurrencyRepoMockRecorder) GetExchangeRate(ctx, currency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExchangeRate", reflect.TypeOf((*MockCurrencyRepo)(nil).GetExchangeRate), ctx, currency)
+}
+
+// GetExchangeRates mocks base method.
+func (m *MockCurrencyRepo) GetExchangeRates(ctx context.Context) ([]*model.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExchangeRates", ctx)
+	ret0, _ := ret[0].([]*model.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExchangeRates indicates an expected call of GetExchangeRates.
+func (mr *MockCurrencyRepoMockRecorder) GetExchangeRates(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExchangeRates", reflect.TypeOf((*MockCurrencyRepo)(nil).GetExchangeRates), ctx)
+}
+
+// SetExchangeRates mocks base method.
+func (m *MockCurrencyRepo) SetExchangeRates(ctx context.Context, rates []*model.ExchangeRate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetExchangeRates", ctx, rates)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetExchangeRates indicates an expected call of SetExchangeRates.
+func (mr *MockCurrencyRepoMockRecorder) SetExchangeRates(ctx, rates interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetExchangeRates", reflect.TypeOf((*MockCurrencyRepo)(nil).SetExchangeRates), ctx, rates)
+}
@@ -7,7 +7,9 @@ package mock_interfaces
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
+	dto "github.com/Go-Marketplace/backend/product/internal/api/grpc/dto"
 	model "github.com/Go-Marketplace/backend/product/internal/model"
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -50,31 +52,90 @@ func (mr *MockDiscountRepoMockRecorder) CreateDiscount(ctx, discount interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDiscount", reflect.TypeOf((*MockDiscountRepo)(nil).CreateDiscount), ctx, discount)
 }
 
-// DeleteDiscount mocks base method.
-func (m *MockDiscountRepo) DeleteDiscount(ctx context.Context, productID uuid.UUID) error {
+// EndDiscounts mocks base method.
+func (m *MockDiscountRepo) EndDiscounts(ctx context.Context, searchParams dto.SearchDiscountsDTO) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteDiscount", ctx, productID)
+	ret := m.ctrl.Call(m, "EndDiscounts", ctx, searchParams)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteDiscount indicates an expected call of DeleteDiscount.
-func (mr *MockDiscountRepoMockRecorder) DeleteDiscount(ctx, productID interface{}) *gomock.Call {
+// EndDiscounts indicates an expected call of EndDiscounts.
+func (mr *MockDiscountRepoMockRecorder) EndDiscounts(ctx, searchParams interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDiscount", reflect.TypeOf((*MockDiscountRepo)(nil).DeleteDiscount), ctx, productID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndDiscounts", reflect.TypeOf((*MockDiscountRepo)(nil).EndDiscounts), ctx, searchParams)
 }
 
 // GetDiscount mocks base method.
-func (m *MockDiscountRepo) GetDiscount(ctx context.Context, productID uuid.UUID) (*model.Discount, error) {
+func (m *MockDiscountRepo) GetDiscount(ctx context.Context, discountID uuid.UUID) (*model.Discount, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDiscount", ctx, productID)
+	ret := m.ctrl.Call(m, "GetDiscount", ctx, discountID)
 	ret0, _ := ret[0].(*model.Discount)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetDiscount indicates an expected call of GetDiscount.
-func (mr *MockDiscountRepoMockRecorder) GetDiscount(ctx, productID interface{}) *gomock.Call {
+func (mr *MockDiscountRepoMockRecorder) GetDiscount(ctx, discountID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiscount", reflect.TypeOf((*MockDiscountRepo)(nil).GetDiscount), ctx, productID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiscount", reflect.TypeOf((*MockDiscountRepo)(nil).GetDiscount), ctx, discountID)
+}
+
+// GetDiscounts mocks base method.
+func (m *MockDiscountRepo) GetDiscounts(ctx context.Context, searchParams dto.SearchDiscountsDTO) ([]*model.Discount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDiscounts", ctx, searchParams)
+	ret0, _ := ret[0].([]*model.Discount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDiscounts indicates an expected call of GetDiscounts.
+func (mr *MockDiscountRepoMockRecorder) GetDiscounts(ctx, searchParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiscounts", reflect.TypeOf((*MockDiscountRepo)(nil).GetDiscounts), ctx, searchParams)
+}
+
+// GetNextDiscountStart mocks base method.
+func (m *MockDiscountRepo) GetNextDiscountStart(ctx context.Context, product model.Product) (*time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextDiscountStart", ctx, product)
+	ret0, _ := ret[0].(*time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNextDiscountStart indicates an expected call of GetNextDiscountStart.
+func (mr *MockDiscountRepoMockRecorder) GetNextDiscountStart(ctx, product interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextDiscountStart", reflect.TypeOf((*MockDiscountRepo)(nil).GetNextDiscountStart), ctx, product)
+}
+
+// GetProductDiscount mocks base method.
+func (m *MockDiscountRepo) GetProductDiscount(ctx context.Context, product model.Product) (*model.Discount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductDiscount", ctx, product)
+	ret0, _ := ret[0].(*model.Discount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductDiscount indicates an expected call of GetProductDiscount.
+func (mr *MockDiscountRepoMockRecorder) GetProductDiscount(ctx, product interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductDiscount", reflect.TypeOf((*MockDiscountRepo)(nil).GetProductDiscount), ctx, product)
+}
+
+// InvalidateProductDiscount mocks base method.
+func (m *MockDiscountRepo) InvalidateProductDiscount(ctx context.Context, productID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvalidateProductDiscount", ctx, productID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InvalidateProductDiscount indicates an expected call of InvalidateProductDiscount.
+func (mr *MockDiscountRepoMockRecorder) InvalidateProductDiscount(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateProductDiscount", reflect.TypeOf((*MockDiscountRepo)(nil).InvalidateProductDiscount), ctx, productID)
 }
@@ -7,6 +7,7 @@ package mock_interfaces
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	dto "github.com/Go-Marketplace/backend/product/internal/api/grpc/dto"
 	model "github.com/Go-Marketplace/backend/product/internal/model"
@@ -52,17 +53,17 @@ func (mr *MockProductRepoMockRecorder) CreateProduct(ctx, product interface{}) *
 }
 
 // DeleteProduct mocks base method.
-func (m *MockProductRepo) DeleteProduct(ctx context.Context, productID uuid.UUID) error {
+func (m *MockProductRepo) DeleteProduct(ctx context.Context, product model.Product) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteProduct", ctx, productID)
+	ret := m.ctrl.Call(m, "DeleteProduct", ctx, product)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteProduct indicates an expected call of DeleteProduct.
-func (mr *MockProductRepoMockRecorder) DeleteProduct(ctx, productID interface{}) *gomock.Call {
+func (mr *MockProductRepoMockRecorder) DeleteProduct(ctx, product interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProduct", reflect.TypeOf((*MockProductRepo)(nil).DeleteProduct), ctx, productID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProduct", reflect.TypeOf((*MockProductRepo)(nil).DeleteProduct), ctx, product)
 }
 
 // DeleteUserProducts mocks base method.
@@ -109,6 +110,36 @@ func (mr *MockProductRepoMockRecorder) GetCategory(ctx, categoryID interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategory", reflect.TypeOf((*MockProductRepo)(nil).GetCategory), ctx, categoryID)
 }
 
+// GetDeletedProducts mocks base method.
+func (m *MockProductRepo) GetDeletedProducts(ctx context.Context, deletedBefore time.Time) ([]*model.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedProducts", ctx, deletedBefore)
+	ret0, _ := ret[0].([]*model.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedProducts indicates an expected call of GetDeletedProducts.
+func (mr *MockProductRepoMockRecorder) GetDeletedProducts(ctx, deletedBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedProducts", reflect.TypeOf((*MockProductRepo)(nil).GetDeletedProducts), ctx, deletedBefore)
+}
+
+// GetModerationQueue mocks base method.
+func (m *MockProductRepo) GetModerationQueue(ctx context.Context, queueParams dto.ModerationQueueDTO) ([]*model.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModerationQueue", ctx, queueParams)
+	ret0, _ := ret[0].([]*model.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModerationQueue indicates an expected call of GetModerationQueue.
+func (mr *MockProductRepoMockRecorder) GetModerationQueue(ctx, queueParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModerationQueue", reflect.TypeOf((*MockProductRepo)(nil).GetModerationQueue), ctx, queueParams)
+}
+
 // GetProduct mocks base method.
 func (m *MockProductRepo) GetProduct(ctx context.Context, productID uuid.UUID) (*model.Product, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +170,62 @@ func (mr *MockProductRepoMockRecorder) GetProducts(ctx, searchParams interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProducts", reflect.TypeOf((*MockProductRepo)(nil).GetProducts), ctx, searchParams)
 }
 
+// PurgeProduct mocks base method.
+func (m *MockProductRepo) PurgeProduct(ctx context.Context, productID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeProduct", ctx, productID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeProduct indicates an expected call of PurgeProduct.
+func (mr *MockProductRepoMockRecorder) PurgeProduct(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeProduct", reflect.TypeOf((*MockProductRepo)(nil).PurgeProduct), ctx, productID)
+}
+
+// RestoreProduct mocks base method.
+func (m *MockProductRepo) RestoreProduct(ctx context.Context, product model.Product) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreProduct", ctx, product)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreProduct indicates an expected call of RestoreProduct.
+func (mr *MockProductRepoMockRecorder) RestoreProduct(ctx, product interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreProduct", reflect.TypeOf((*MockProductRepo)(nil).RestoreProduct), ctx, product)
+}
+
+// SetPurchaseLimit mocks base method.
+func (m *MockProductRepo) SetPurchaseLimit(ctx context.Context, product model.Product) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPurchaseLimit", ctx, product)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPurchaseLimit indicates an expected call of SetPurchaseLimit.
+func (mr *MockProductRepoMockRecorder) SetPurchaseLimit(ctx, product interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPurchaseLimit", reflect.TypeOf((*MockProductRepo)(nil).SetPurchaseLimit), ctx, product)
+}
+
+// SetStockAlert mocks base method.
+func (m *MockProductRepo) SetStockAlert(ctx context.Context, product model.Product) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStockAlert", ctx, product)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetStockAlert indicates an expected call of SetStockAlert.
+func (mr *MockProductRepoMockRecorder) SetStockAlert(ctx, product interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStockAlert", reflect.TypeOf((*MockProductRepo)(nil).SetStockAlert), ctx, product)
+}
+
 // UpdateProduct mocks base method.
 func (m *MockProductRepo) UpdateProduct(ctx context.Context, product model.Product) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: product/internal/infrastructure/interfaces/review.go
+
+// Package mock_interfaces is a generated GoMock package.
+package mock_interfaces
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/Go-Marketplace/backend/product/internal/api/grpc/dto"
+	model "github.com/Go-Marketplace/backend/product/internal/model"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockReviewRepo is a mock of ReviewRepo interface.
+type MockReviewRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewRepoMockRecorder
+}
+
+// MockReviewRepoMockRecorder is the mock recorder for MockReviewRepo.
+type MockReviewRepoMockRecorder struct {
+	mock *MockReviewRepo
+}
+
+// NewMockReviewRepo creates a new mock instance.
+func NewMockReviewRepo(ctrl *gomock.Controller) *MockReviewRepo {
+	mock := &MockReviewRepo{ctrl: ctrl}
+	mock.recorder = &MockReviewRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewRepo) EXPECT() *MockReviewRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateReview mocks base method.
+func (m *MockReviewRepo) CreateReview(ctx context.Context, review model.Review) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReview", ctx, review)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateReview indicates an expected call of CreateReview.
+func (mr *MockReviewRepoMockRecorder) CreateReview(ctx, review interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReview", reflect.TypeOf((*MockReviewRepo)(nil).CreateReview), ctx, review)
+}
+
+// GetReview mocks base method.
+func (m *MockReviewRepo) GetReview(ctx context.Context, reviewID uuid.UUID) (*model.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReview", ctx, reviewID)
+	ret0, _ := ret[0].(*model.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReview indicates an expected call of GetReview.
+func (mr *MockReviewRepoMockRecorder) GetReview(ctx, reviewID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReview", reflect.TypeOf((*MockReviewRepo)(nil).GetReview), ctx, reviewID)
+}
+
+// GetReviews mocks base method.
+func (m *MockReviewRepo) GetReviews(ctx context.Context, searchParams dto.SearchReviewsDTO) ([]*model.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviews", ctx, searchParams)
+	ret0, _ := ret[0].([]*model.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviews indicates an expected call of GetReviews.
+func (mr *MockReviewRepoMockRecorder) GetReviews(ctx, searchParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviews", reflect.TypeOf((*MockReviewRepo)(nil).GetReviews), ctx, searchParams)
+}
+
+// UpdateReviewReply mocks base method.
+func (m *MockReviewRepo) UpdateReviewReply(ctx context.Context, review model.Review) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReviewReply", ctx, review)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReviewReply indicates an expected call of UpdateReviewReply.
+func (mr *MockReviewRepoMockRecorder) UpdateReviewReply(ctx, review interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReviewReply", reflect.TypeOf((*MockReviewRepo)(nil).UpdateReviewReply), ctx, review)
+}
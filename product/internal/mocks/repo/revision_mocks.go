@@ -0,0 +1,68 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: product/internal/infrastructure/interfaces/revision.go
+
+// Package mock_interfaces is a generated GoMock package.
+package mock_interfaces
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/Go-Marketplace/backend/product/internal/api/grpc/dto"
+	model "github.com/Go-Marketplace/backend/product/internal/model"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockRevisionRepo is a mock of RevisionRepo interface.
+type MockRevisionRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevisionRepoMockRecorder
+}
+
+// MockRevisionRepoMockRecorder is the mock recorder for MockRevisionRepo.
+type MockRevisionRepoMockRecorder struct {
+	mock *MockRevisionRepo
+}
+
+// NewMockRevisionRepo creates a new mock instance.
+func NewMockRevisionRepo(ctrl *gomock.Controller) *MockRevisionRepo {
+	mock := &MockRevisionRepo{ctrl: ctrl}
+	mock.recorder = &MockRevisionRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevisionRepo) EXPECT() *MockRevisionRepoMockRecorder {
+	return m.recorder
+}
+
+// GetPriceHistory mocks base method.
+func (m *MockRevisionRepo) GetPriceHistory(ctx context.Context, productID uuid.UUID) ([]*model.PricePoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceHistory", ctx, productID)
+	ret0, _ := ret[0].([]*model.PricePoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceHistory indicates an expected call of GetPriceHistory.
+func (mr *MockRevisionRepoMockRecorder) GetPriceHistory(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceHistory", reflect.TypeOf((*MockRevisionRepo)(nil).GetPriceHistory), ctx, productID)
+}
+
+// GetRevisions mocks base method.
+func (m *MockRevisionRepo) GetRevisions(ctx context.Context, searchParams dto.SearchRevisionsDTO) ([]*model.ProductRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisions", ctx, searchParams)
+	ret0, _ := ret[0].([]*model.ProductRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisions indicates an expected call of GetRevisions.
+func (mr *MockRevisionRepoMockRecorder) GetRevisions(ctx, searchParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisions", reflect.TypeOf((*MockRevisionRepo)(nil).GetRevisions), ctx, searchParams)
+}
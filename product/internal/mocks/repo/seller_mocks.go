@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: product/internal/infrastructure/interfaces/seller.go
+
+// Package mock_interfaces is a generated GoMock package.
+package mock_interfaces
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/Go-Marketplace/backend/product/internal/model"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockSellerRepo is a mock of SellerRepo interface.
+type MockSellerRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockSellerRepoMockRecorder
+}
+
+// MockSellerRepoMockRecorder is the mock recorder for MockSellerRepo.
+type MockSellerRepoMockRecorder struct {
+	mock *MockSellerRepo
+}
+
+// NewMockSellerRepo creates a new mock instance.
+func NewMockSellerRepo(ctrl *gomock.Controller) *MockSellerRepo {
+	mock := &MockSellerRepo{ctrl: ctrl}
+	mock.recorder = &MockSellerRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSellerRepo) EXPECT() *MockSellerRepoMockRecorder {
+	return m.recorder
+}
+
+// GetSellerProfile mocks base method.
+func (m *MockSellerRepo) GetSellerProfile(ctx context.Context, userID uuid.UUID) (*model.SellerProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSellerProfile", ctx, userID)
+	ret0, _ := ret[0].(*model.SellerProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSellerProfile indicates an expected call of GetSellerProfile.
+func (mr *MockSellerRepoMockRecorder) GetSellerProfile(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSellerProfile", reflect.TypeOf((*MockSellerRepo)(nil).GetSellerProfile), ctx, userID)
+}
+
+// SetSellerProfile mocks base method.
+func (m *MockSellerRepo) SetSellerProfile(ctx context.Context, profile model.SellerProfile) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSellerProfile", ctx, profile)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSellerProfile indicates an expected call of SetSellerProfile.
+func (mr *MockSellerRepoMockRecorder) SetSellerProfile(ctx, profile interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSellerProfile", reflect.TypeOf((*MockSellerRepo)(nil).SetSellerProfile), ctx, profile)
+}
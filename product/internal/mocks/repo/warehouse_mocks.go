@@ -0,0 +1,168 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: product/internal/infrastructure/interfaces/warehouse.go
+
+// Package mock_interfaces is a generated GoMock package.
+package mock_interfaces
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/Go-Marketplace/backend/product/internal/model"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockWarehouseRepo is a mock of WarehouseRepo interface.
+type MockWarehouseRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockWarehouseRepoMockRecorder
+}
+
+// MockWarehouseRepoMockRecorder is the mock recorder for MockWarehouseRepo.
+type MockWarehouseRepoMockRecorder struct {
+	mock *MockWarehouseRepo
+}
+
+// NewMockWarehouseRepo creates a new mock instance.
+func NewMockWarehouseRepo(ctrl *gomock.Controller) *MockWarehouseRepo {
+	mock := &MockWarehouseRepo{ctrl: ctrl}
+	mock.recorder = &MockWarehouseRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWarehouseRepo) EXPECT() *MockWarehouseRepoMockRecorder {
+	return m.recorder
+}
+
+// ChangeStocks mocks base method.
+func (m *MockWarehouseRepo) ChangeStocks(ctx context.Context, changes []*model.ReservationChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeStocks", ctx, changes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeStocks indicates an expected call of ChangeStocks.
+func (mr *MockWarehouseRepoMockRecorder) ChangeStocks(ctx, changes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeStocks", reflect.TypeOf((*MockWarehouseRepo)(nil).ChangeStocks), ctx, changes)
+}
+
+// CreateWarehouse mocks base method.
+func (m *MockWarehouseRepo) CreateWarehouse(ctx context.Context, warehouse model.Warehouse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWarehouse", ctx, warehouse)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWarehouse indicates an expected call of CreateWarehouse.
+func (mr *MockWarehouseRepoMockRecorder) CreateWarehouse(ctx, warehouse interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWarehouse", reflect.TypeOf((*MockWarehouseRepo)(nil).CreateWarehouse), ctx, warehouse)
+}
+
+// GetProductStocks mocks base method.
+func (m *MockWarehouseRepo) GetProductStocks(ctx context.Context, productID uuid.UUID) ([]*model.WarehouseStock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductStocks", ctx, productID)
+	ret0, _ := ret[0].([]*model.WarehouseStock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductStocks indicates an expected call of GetProductStocks.
+func (mr *MockWarehouseRepoMockRecorder) GetProductStocks(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductStocks", reflect.TypeOf((*MockWarehouseRepo)(nil).GetProductStocks), ctx, productID)
+}
+
+// GetWarehouse mocks base method.
+func (m *MockWarehouseRepo) GetWarehouse(ctx context.Context, warehouseID uuid.UUID) (*model.Warehouse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWarehouse", ctx, warehouseID)
+	ret0, _ := ret[0].(*model.Warehouse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWarehouse indicates an expected call of GetWarehouse.
+func (mr *MockWarehouseRepoMockRecorder) GetWarehouse(ctx, warehouseID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWarehouse", reflect.TypeOf((*MockWarehouseRepo)(nil).GetWarehouse), ctx, warehouseID)
+}
+
+// GetWarehouseStock mocks base method.
+func (m *MockWarehouseRepo) GetWarehouseStock(ctx context.Context, warehouseID, productID uuid.UUID) (*model.WarehouseStock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWarehouseStock", ctx, warehouseID, productID)
+	ret0, _ := ret[0].(*model.WarehouseStock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWarehouseStock indicates an expected call of GetWarehouseStock.
+func (mr *MockWarehouseRepoMockRecorder) GetWarehouseStock(ctx, warehouseID, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWarehouseStock", reflect.TypeOf((*MockWarehouseRepo)(nil).GetWarehouseStock), ctx, warehouseID, productID)
+}
+
+// GetWarehouses mocks base method.
+func (m *MockWarehouseRepo) GetWarehouses(ctx context.Context, userID uuid.UUID) ([]*model.Warehouse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWarehouses", ctx, userID)
+	ret0, _ := ret[0].([]*model.Warehouse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWarehouses indicates an expected call of GetWarehouses.
+func (mr *MockWarehouseRepoMockRecorder) GetWarehouses(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWarehouses", reflect.TypeOf((*MockWarehouseRepo)(nil).GetWarehouses), ctx, userID)
+}
+
+// ReleaseStock mocks base method.
+func (m *MockWarehouseRepo) ReleaseStock(ctx context.Context, reservation model.Reservation) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseStock", ctx, reservation)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseStock indicates an expected call of ReleaseStock.
+func (mr *MockWarehouseRepoMockRecorder) ReleaseStock(ctx, reservation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseStock", reflect.TypeOf((*MockWarehouseRepo)(nil).ReleaseStock), ctx, reservation)
+}
+
+// ReserveStock mocks base method.
+func (m *MockWarehouseRepo) ReserveStock(ctx context.Context, reservation model.Reservation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveStock", ctx, reservation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReserveStock indicates an expected call of ReserveStock.
+func (mr *MockWarehouseRepoMockRecorder) ReserveStock(ctx, reservation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveStock", reflect.TypeOf((*MockWarehouseRepo)(nil).ReserveStock), ctx, reservation)
+}
+
+// SetStock mocks base method.
+func (m *MockWarehouseRepo) SetStock(ctx context.Context, stock model.WarehouseStock) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStock", ctx, stock)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetStock indicates an expected call of SetStock.
+func (mr *MockWarehouseRepoMockRecorder) SetStock(ctx, stock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStock", reflect.TypeOf((*MockWarehouseRepo)(nil).SetStock), ctx, stock)
+}
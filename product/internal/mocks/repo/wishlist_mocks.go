@@ -0,0 +1,138 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: product/internal/infrastructure/interfaces/wishlist.go
+
+// Package mock_interfaces is a generated GoMock package.
+package mock_interfaces
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/Go-Marketplace/backend/product/internal/model"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockWishlistRepo is a mock of WishlistRepo interface.
+type MockWishlistRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockWishlistRepoMockRecorder
+}
+
+// MockWishlistRepoMockRecorder is the mock recorder for MockWishlistRepo.
+type MockWishlistRepoMockRecorder struct {
+	mock *MockWishlistRepo
+}
+
+// NewMockWishlistRepo creates a new mock instance.
+func NewMockWishlistRepo(ctrl *gomock.Controller) *MockWishlistRepo {
+	mock := &MockWishlistRepo{ctrl: ctrl}
+	mock.recorder = &MockWishlistRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWishlistRepo) EXPECT() *MockWishlistRepoMockRecorder {
+	return m.recorder
+}
+
+// AddWishlistItem mocks base method.
+func (m *MockWishlistRepo) AddWishlistItem(ctx context.Context, item model.WishlistItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWishlistItem", ctx, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddWishlistItem indicates an expected call of AddWishlistItem.
+func (mr *MockWishlistRepoMockRecorder) AddWishlistItem(ctx, item interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWishlistItem", reflect.TypeOf((*MockWishlistRepo)(nil).AddWishlistItem), ctx, item)
+}
+
+// CreateWishlist mocks base method.
+func (m *MockWishlistRepo) CreateWishlist(ctx context.Context, wishlist model.Wishlist) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWishlist", ctx, wishlist)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWishlist indicates an expected call of CreateWishlist.
+func (mr *MockWishlistRepoMockRecorder) CreateWishlist(ctx, wishlist interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWishlist", reflect.TypeOf((*MockWishlistRepo)(nil).CreateWishlist), ctx, wishlist)
+}
+
+// DeleteWishlist mocks base method.
+func (m *MockWishlistRepo) DeleteWishlist(ctx context.Context, wishlistID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWishlist", ctx, wishlistID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWishlist indicates an expected call of DeleteWishlist.
+func (mr *MockWishlistRepoMockRecorder) DeleteWishlist(ctx, wishlistID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWishlist", reflect.TypeOf((*MockWishlistRepo)(nil).DeleteWishlist), ctx, wishlistID)
+}
+
+// GetWishlist mocks base method.
+func (m *MockWishlistRepo) GetWishlist(ctx context.Context, wishlistID uuid.UUID) (*model.Wishlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWishlist", ctx, wishlistID)
+	ret0, _ := ret[0].(*model.Wishlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWishlist indicates an expected call of GetWishlist.
+func (mr *MockWishlistRepoMockRecorder) GetWishlist(ctx, wishlistID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWishlist", reflect.TypeOf((*MockWishlistRepo)(nil).GetWishlist), ctx, wishlistID)
+}
+
+// GetWishlistByName mocks base method.
+func (m *MockWishlistRepo) GetWishlistByName(ctx context.Context, userID uuid.UUID, name string) (*model.Wishlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWishlistByName", ctx, userID, name)
+	ret0, _ := ret[0].(*model.Wishlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWishlistByName indicates an expected call of GetWishlistByName.
+func (mr *MockWishlistRepoMockRecorder) GetWishlistByName(ctx, userID, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWishlistByName", reflect.TypeOf((*MockWishlistRepo)(nil).GetWishlistByName), ctx, userID, name)
+}
+
+// GetWishlists mocks base method.
+func (m *MockWishlistRepo) GetWishlists(ctx context.Context, userID uuid.UUID) ([]*model.Wishlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWishlists", ctx, userID)
+	ret0, _ := ret[0].([]*model.Wishlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWishlists indicates an expected call of GetWishlists.
+func (mr *MockWishlistRepoMockRecorder) GetWishlists(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWishlists", reflect.TypeOf((*MockWishlistRepo)(nil).GetWishlists), ctx, userID)
+}
+
+// RemoveWishlistItem mocks base method.
+func (m *MockWishlistRepo) RemoveWishlistItem(ctx context.Context, wishlistID, productID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveWishlistItem", ctx, wishlistID, productID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveWishlistItem indicates an expected call of RemoveWishlistItem.
+func (mr *MockWishlistRepoMockRecorder) RemoveWishlistItem(ctx, wishlistID, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveWishlistItem", reflect.TypeOf((*MockWishlistRepo)(nil).RemoveWishlistItem), ctx, wishlistID, productID)
+}
@@ -7,9 +7,11 @@ package mock_usecase
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	dto "github.com/Go-Marketplace/backend/product/internal/api/grpc/dto"
 	model "github.com/Go-Marketplace/backend/product/internal/model"
+	product "github.com/Go-Marketplace/backend/proto/gen/product"
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
 )
@@ -37,6 +39,36 @@ func (m *MockIProductUsecase) EXPECT() *MockIProductUsecaseMockRecorder {
 	return m.recorder
 }
 
+// AddWishlistItem mocks base method.
+func (m *MockIProductUsecase) AddWishlistItem(ctx context.Context, item model.WishlistItem) (*model.Wishlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWishlistItem", ctx, item)
+	ret0, _ := ret[0].(*model.Wishlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWishlistItem indicates an expected call of AddWishlistItem.
+func (mr *MockIProductUsecaseMockRecorder) AddWishlistItem(ctx, item interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWishlistItem", reflect.TypeOf((*MockIProductUsecase)(nil).AddWishlistItem), ctx, item)
+}
+
+// ChangeReservations mocks base method.
+func (m *MockIProductUsecase) ChangeReservations(ctx context.Context, changes []*model.ReservationChange) ([]*model.ReservationChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeReservations", ctx, changes)
+	ret0, _ := ret[0].([]*model.ReservationChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeReservations indicates an expected call of ChangeReservations.
+func (mr *MockIProductUsecaseMockRecorder) ChangeReservations(ctx, changes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeReservations", reflect.TypeOf((*MockIProductUsecase)(nil).ChangeReservations), ctx, changes)
+}
+
 // CreateDiscount mocks base method.
 func (m *MockIProductUsecase) CreateDiscount(ctx context.Context, discount model.Discount) (*model.Product, error) {
 	m.ctrl.T.Helper()
@@ -52,6 +84,20 @@ func (mr *MockIProductUsecaseMockRecorder) CreateDiscount(ctx, discount interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDiscount", reflect.TypeOf((*MockIProductUsecase)(nil).CreateDiscount), ctx, discount)
 }
 
+// CreateNotification mocks base method.
+func (m *MockIProductUsecase) CreateNotification(ctx context.Context, notification model.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotification", ctx, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNotification indicates an expected call of CreateNotification.
+func (mr *MockIProductUsecaseMockRecorder) CreateNotification(ctx, notification interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotification", reflect.TypeOf((*MockIProductUsecase)(nil).CreateNotification), ctx, notification)
+}
+
 // CreateProduct mocks base method.
 func (m *MockIProductUsecase) CreateProduct(ctx context.Context, product model.Product) error {
 	m.ctrl.T.Helper()
@@ -66,6 +112,66 @@ func (mr *MockIProductUsecaseMockRecorder) CreateProduct(ctx, product interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProduct", reflect.TypeOf((*MockIProductUsecase)(nil).CreateProduct), ctx, product)
 }
 
+// CreateReview mocks base method.
+func (m *MockIProductUsecase) CreateReview(ctx context.Context, review model.Review) (*model.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReview", ctx, review)
+	ret0, _ := ret[0].(*model.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReview indicates an expected call of CreateReview.
+func (mr *MockIProductUsecaseMockRecorder) CreateReview(ctx, review interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReview", reflect.TypeOf((*MockIProductUsecase)(nil).CreateReview), ctx, review)
+}
+
+// CreateScopeDiscount mocks base method.
+func (m *MockIProductUsecase) CreateScopeDiscount(ctx context.Context, discount model.Discount) (*model.Discount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateScopeDiscount", ctx, discount)
+	ret0, _ := ret[0].(*model.Discount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateScopeDiscount indicates an expected call of CreateScopeDiscount.
+func (mr *MockIProductUsecaseMockRecorder) CreateScopeDiscount(ctx, discount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScopeDiscount", reflect.TypeOf((*MockIProductUsecase)(nil).CreateScopeDiscount), ctx, discount)
+}
+
+// CreateWarehouse mocks base method.
+func (m *MockIProductUsecase) CreateWarehouse(ctx context.Context, warehouse model.Warehouse) (*model.Warehouse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWarehouse", ctx, warehouse)
+	ret0, _ := ret[0].(*model.Warehouse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWarehouse indicates an expected call of CreateWarehouse.
+func (mr *MockIProductUsecaseMockRecorder) CreateWarehouse(ctx, warehouse interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWarehouse", reflect.TypeOf((*MockIProductUsecase)(nil).CreateWarehouse), ctx, warehouse)
+}
+
+// CreateWishlist mocks base method.
+func (m *MockIProductUsecase) CreateWishlist(ctx context.Context, wishlist model.Wishlist) (*model.Wishlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWishlist", ctx, wishlist)
+	ret0, _ := ret[0].(*model.Wishlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWishlist indicates an expected call of CreateWishlist.
+func (mr *MockIProductUsecaseMockRecorder) CreateWishlist(ctx, wishlist interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWishlist", reflect.TypeOf((*MockIProductUsecase)(nil).CreateWishlist), ctx, wishlist)
+}
+
 // DeleteDiscount mocks base method.
 func (m *MockIProductUsecase) DeleteDiscount(ctx context.Context, productID uuid.UUID) (*model.Product, error) {
 	m.ctrl.T.Helper()
@@ -82,17 +188,17 @@ func (mr *MockIProductUsecaseMockRecorder) DeleteDiscount(ctx, productID interfa
 }
 
 // DeleteProduct mocks base method.
-func (m *MockIProductUsecase) DeleteProduct(ctx context.Context, productID uuid.UUID) error {
+func (m *MockIProductUsecase) DeleteProduct(ctx context.Context, product model.Product) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteProduct", ctx, productID)
+	ret := m.ctrl.Call(m, "DeleteProduct", ctx, product)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteProduct indicates an expected call of DeleteProduct.
-func (mr *MockIProductUsecaseMockRecorder) DeleteProduct(ctx, productID interface{}) *gomock.Call {
+func (mr *MockIProductUsecaseMockRecorder) DeleteProduct(ctx, product interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProduct", reflect.TypeOf((*MockIProductUsecase)(nil).DeleteProduct), ctx, productID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProduct", reflect.TypeOf((*MockIProductUsecase)(nil).DeleteProduct), ctx, product)
 }
 
 // DeleteUserProducts mocks base method.
@@ -109,6 +215,34 @@ func (mr *MockIProductUsecaseMockRecorder) DeleteUserProducts(ctx, userID interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserProducts", reflect.TypeOf((*MockIProductUsecase)(nil).DeleteUserProducts), ctx, userID)
 }
 
+// DeleteWishlist mocks base method.
+func (m *MockIProductUsecase) DeleteWishlist(ctx context.Context, wishlistID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWishlist", ctx, wishlistID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWishlist indicates an expected call of DeleteWishlist.
+func (mr *MockIProductUsecaseMockRecorder) DeleteWishlist(ctx, wishlistID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWishlist", reflect.TypeOf((*MockIProductUsecase)(nil).DeleteWishlist), ctx, wishlistID)
+}
+
+// EndDiscounts mocks base method.
+func (m *MockIProductUsecase) EndDiscounts(ctx context.Context, searchParams dto.SearchDiscountsDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndDiscounts", ctx, searchParams)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EndDiscounts indicates an expected call of EndDiscounts.
+func (mr *MockIProductUsecaseMockRecorder) EndDiscounts(ctx, searchParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndDiscounts", reflect.TypeOf((*MockIProductUsecase)(nil).EndDiscounts), ctx, searchParams)
+}
+
 // GetAllCategories mocks base method.
 func (m *MockIProductUsecase) GetAllCategories(ctx context.Context) ([]*model.Category, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +273,111 @@ func (mr *MockIProductUsecaseMockRecorder) GetCategory(ctx, id interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategory", reflect.TypeOf((*MockIProductUsecase)(nil).GetCategory), ctx, id)
 }
 
+// GetDeletedProducts mocks base method.
+func (m *MockIProductUsecase) GetDeletedProducts(ctx context.Context, deletedBefore time.Time) ([]*model.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedProducts", ctx, deletedBefore)
+	ret0, _ := ret[0].([]*model.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedProducts indicates an expected call of GetDeletedProducts.
+func (mr *MockIProductUsecaseMockRecorder) GetDeletedProducts(ctx, deletedBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedProducts", reflect.TypeOf((*MockIProductUsecase)(nil).GetDeletedProducts), ctx, deletedBefore)
+}
+
+// GetDiscounts mocks base method.
+func (m *MockIProductUsecase) GetDiscounts(ctx context.Context, searchParams dto.SearchDiscountsDTO) ([]*model.Discount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDiscounts", ctx, searchParams)
+	ret0, _ := ret[0].([]*model.Discount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDiscounts indicates an expected call of GetDiscounts.
+func (mr *MockIProductUsecaseMockRecorder) GetDiscounts(ctx, searchParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiscounts", reflect.TypeOf((*MockIProductUsecase)(nil).GetDiscounts), ctx, searchParams)
+}
+
+// GetExchangeRate mocks base method.
+func (m *MockIProductUsecase) GetExchangeRate(ctx context.Context, currency string) (*model.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExchangeRate", ctx, currency)
+	ret0, _ := ret[0].(*model.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExchangeRate indicates an expected call of GetExchangeRate.
+func (mr *MockIProductUsecaseMockRecorder) GetExchangeRate(ctx, currency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExchangeRate", reflect.TypeOf((*MockIProductUsecase)(nil).GetExchangeRate), ctx, currency)
+}
+
+// GetExchangeRates mocks base method.
+func (m *MockIProductUsecase) GetExchangeRates(ctx context.Context) ([]*model.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExchangeRates", ctx)
+	ret0, _ := ret[0].([]*model.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExchangeRates indicates an expected call of GetExchangeRates.
+func (mr *MockIProductUsecaseMockRecorder) GetExchangeRates(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExchangeRates", reflect.TypeOf((*MockIProductUsecase)(nil).GetExchangeRates), ctx)
+}
+
+// GetModerationQueue mocks base method.
+func (m *MockIProductUsecase) GetModerationQueue(ctx context.Context, queueParams dto.ModerationQueueDTO) ([]*model.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModerationQueue", ctx, queueParams)
+	ret0, _ := ret[0].([]*model.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModerationQueue indicates an expected call of GetModerationQueue.
+func (mr *MockIProductUsecaseMockRecorder) GetModerationQueue(ctx, queueParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModerationQueue", reflect.TypeOf((*MockIProductUsecase)(nil).GetModerationQueue), ctx, queueParams)
+}
+
+// GetNotifications mocks base method.
+func (m *MockIProductUsecase) GetNotifications(ctx context.Context, searchParams dto.SearchNotificationsDTO) ([]*model.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotifications", ctx, searchParams)
+	ret0, _ := ret[0].([]*model.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotifications indicates an expected call of GetNotifications.
+func (mr *MockIProductUsecaseMockRecorder) GetNotifications(ctx, searchParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotifications", reflect.TypeOf((*MockIProductUsecase)(nil).GetNotifications), ctx, searchParams)
+}
+
+// GetPriceHistory mocks base method.
+func (m *MockIProductUsecase) GetPriceHistory(ctx context.Context, productID uuid.UUID) ([]*model.PricePoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceHistory", ctx, productID)
+	ret0, _ := ret[0].([]*model.PricePoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceHistory indicates an expected call of GetPriceHistory.
+func (mr *MockIProductUsecaseMockRecorder) GetPriceHistory(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceHistory", reflect.TypeOf((*MockIProductUsecase)(nil).GetPriceHistory), ctx, productID)
+}
+
 // GetProduct mocks base method.
 func (m *MockIProductUsecase) GetProduct(ctx context.Context, productID uuid.UUID) (*model.Product, error) {
 	m.ctrl.T.Helper()
@@ -154,6 +393,51 @@ func (mr *MockIProductUsecaseMockRecorder) GetProduct(ctx, productID interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProduct", reflect.TypeOf((*MockIProductUsecase)(nil).GetProduct), ctx, productID)
 }
 
+// GetProductHistory mocks base method.
+func (m *MockIProductUsecase) GetProductHistory(ctx context.Context, searchParams dto.SearchRevisionsDTO) ([]*model.ProductRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductHistory", ctx, searchParams)
+	ret0, _ := ret[0].([]*model.ProductRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductHistory indicates an expected call of GetProductHistory.
+func (mr *MockIProductUsecaseMockRecorder) GetProductHistory(ctx, searchParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductHistory", reflect.TypeOf((*MockIProductUsecase)(nil).GetProductHistory), ctx, searchParams)
+}
+
+// GetProductReviews mocks base method.
+func (m *MockIProductUsecase) GetProductReviews(ctx context.Context, searchParams dto.SearchReviewsDTO) ([]*model.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductReviews", ctx, searchParams)
+	ret0, _ := ret[0].([]*model.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductReviews indicates an expected call of GetProductReviews.
+func (mr *MockIProductUsecaseMockRecorder) GetProductReviews(ctx, searchParams interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductReviews", reflect.TypeOf((*MockIProductUsecase)(nil).GetProductReviews), ctx, searchParams)
+}
+
+// GetProductStocks mocks base method.
+func (m *MockIProductUsecase) GetProductStocks(ctx context.Context, productID uuid.UUID) ([]*model.WarehouseStock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductStocks", ctx, productID)
+	ret0, _ := ret[0].([]*model.WarehouseStock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductStocks indicates an expected call of GetProductStocks.
+func (mr *MockIProductUsecaseMockRecorder) GetProductStocks(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductStocks", reflect.TypeOf((*MockIProductUsecase)(nil).GetProductStocks), ctx, productID)
+}
+
 // GetProducts mocks base method.
 func (m *MockIProductUsecase) GetProducts(ctx context.Context, searchParams dto.SearchProductsDTO) ([]*model.Product, error) {
 	m.ctrl.T.Helper()
@@ -169,6 +453,274 @@ func (mr *MockIProductUsecaseMockRecorder) GetProducts(ctx, searchParams interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProducts", reflect.TypeOf((*MockIProductUsecase)(nil).GetProducts), ctx, searchParams)
 }
 
+// GetReview mocks base method.
+func (m *MockIProductUsecase) GetReview(ctx context.Context, reviewID uuid.UUID) (*model.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReview", ctx, reviewID)
+	ret0, _ := ret[0].(*model.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReview indicates an expected call of GetReview.
+func (mr *MockIProductUsecaseMockRecorder) GetReview(ctx, reviewID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReview", reflect.TypeOf((*MockIProductUsecase)(nil).GetReview), ctx, reviewID)
+}
+
+// GetSellerProfile mocks base method.
+func (m *MockIProductUsecase) GetSellerProfile(ctx context.Context, userID uuid.UUID) (*model.SellerProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSellerProfile", ctx, userID)
+	ret0, _ := ret[0].(*model.SellerProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSellerProfile indicates an expected call of GetSellerProfile.
+func (mr *MockIProductUsecaseMockRecorder) GetSellerProfile(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSellerProfile", reflect.TypeOf((*MockIProductUsecase)(nil).GetSellerProfile), ctx, userID)
+}
+
+// GetWarehouse mocks base method.
+func (m *MockIProductUsecase) GetWarehouse(ctx context.Context, warehouseID uuid.UUID) (*model.Warehouse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWarehouse", ctx, warehouseID)
+	ret0, _ := ret[0].(*model.Warehouse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWarehouse indicates an expected call of GetWarehouse.
+func (mr *MockIProductUsecaseMockRecorder) GetWarehouse(ctx, warehouseID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWarehouse", reflect.TypeOf((*MockIProductUsecase)(nil).GetWarehouse), ctx, warehouseID)
+}
+
+// GetWarehouses mocks base method.
+func (m *MockIProductUsecase) GetWarehouses(ctx context.Context, userID uuid.UUID) ([]*model.Warehouse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWarehouses", ctx, userID)
+	ret0, _ := ret[0].([]*model.Warehouse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWarehouses indicates an expected call of GetWarehouses.
+func (mr *MockIProductUsecaseMockRecorder) GetWarehouses(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWarehouses", reflect.TypeOf((*MockIProductUsecase)(nil).GetWarehouses), ctx, userID)
+}
+
+// GetWishlist mocks base method.
+func (m *MockIProductUsecase) GetWishlist(ctx context.Context, wishlistID uuid.UUID) (*model.Wishlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWishlist", ctx, wishlistID)
+	ret0, _ := ret[0].(*model.Wishlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWishlist indicates an expected call of GetWishlist.
+func (mr *MockIProductUsecaseMockRecorder) GetWishlist(ctx, wishlistID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWishlist", reflect.TypeOf((*MockIProductUsecase)(nil).GetWishlist), ctx, wishlistID)
+}
+
+// GetWishlistByName mocks base method.
+func (m *MockIProductUsecase) GetWishlistByName(ctx context.Context, userID uuid.UUID, name string) (*model.Wishlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWishlistByName", ctx, userID, name)
+	ret0, _ := ret[0].(*model.Wishlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWishlistByName indicates an expected call of GetWishlistByName.
+func (mr *MockIProductUsecaseMockRecorder) GetWishlistByName(ctx, userID, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWishlistByName", reflect.TypeOf((*MockIProductUsecase)(nil).GetWishlistByName), ctx, userID, name)
+}
+
+// GetWishlists mocks base method.
+func (m *MockIProductUsecase) GetWishlists(ctx context.Context, userID uuid.UUID) ([]*model.Wishlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWishlists", ctx, userID)
+	ret0, _ := ret[0].([]*model.Wishlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWishlists indicates an expected call of GetWishlists.
+func (mr *MockIProductUsecaseMockRecorder) GetWishlists(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWishlists", reflect.TypeOf((*MockIProductUsecase)(nil).GetWishlists), ctx, userID)
+}
+
+// PurgeProduct mocks base method.
+func (m *MockIProductUsecase) PurgeProduct(ctx context.Context, productID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeProduct", ctx, productID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeProduct indicates an expected call of PurgeProduct.
+func (mr *MockIProductUsecaseMockRecorder) PurgeProduct(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeProduct", reflect.TypeOf((*MockIProductUsecase)(nil).PurgeProduct), ctx, productID)
+}
+
+// ReleaseProduct mocks base method.
+func (m *MockIProductUsecase) ReleaseProduct(ctx context.Context, reservation model.Reservation) (*model.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseProduct", ctx, reservation)
+	ret0, _ := ret[0].(*model.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseProduct indicates an expected call of ReleaseProduct.
+func (mr *MockIProductUsecaseMockRecorder) ReleaseProduct(ctx, reservation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseProduct", reflect.TypeOf((*MockIProductUsecase)(nil).ReleaseProduct), ctx, reservation)
+}
+
+// RemoveWishlistItem mocks base method.
+func (m *MockIProductUsecase) RemoveWishlistItem(ctx context.Context, wishlistID, productID uuid.UUID) (*model.Wishlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveWishlistItem", ctx, wishlistID, productID)
+	ret0, _ := ret[0].(*model.Wishlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveWishlistItem indicates an expected call of RemoveWishlistItem.
+func (mr *MockIProductUsecaseMockRecorder) RemoveWishlistItem(ctx, wishlistID, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveWishlistItem", reflect.TypeOf((*MockIProductUsecase)(nil).RemoveWishlistItem), ctx, wishlistID, productID)
+}
+
+// ReplyReview mocks base method.
+func (m *MockIProductUsecase) ReplyReview(ctx context.Context, review model.Review) (*model.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplyReview", ctx, review)
+	ret0, _ := ret[0].(*model.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplyReview indicates an expected call of ReplyReview.
+func (mr *MockIProductUsecaseMockRecorder) ReplyReview(ctx, review interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplyReview", reflect.TypeOf((*MockIProductUsecase)(nil).ReplyReview), ctx, review)
+}
+
+// ReserveProduct mocks base method.
+func (m *MockIProductUsecase) ReserveProduct(ctx context.Context, reservation model.Reservation, strategy product.AllocationStrategy, destination *model.Location) (*model.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveProduct", ctx, reservation, strategy, destination)
+	ret0, _ := ret[0].(*model.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReserveProduct indicates an expected call of ReserveProduct.
+func (mr *MockIProductUsecaseMockRecorder) ReserveProduct(ctx, reservation, strategy, destination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveProduct", reflect.TypeOf((*MockIProductUsecase)(nil).ReserveProduct), ctx, reservation, strategy, destination)
+}
+
+// RestoreProduct mocks base method.
+func (m *MockIProductUsecase) RestoreProduct(ctx context.Context, product model.Product) (*model.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreProduct", ctx, product)
+	ret0, _ := ret[0].(*model.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreProduct indicates an expected call of RestoreProduct.
+func (mr *MockIProductUsecaseMockRecorder) RestoreProduct(ctx, product interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreProduct", reflect.TypeOf((*MockIProductUsecase)(nil).RestoreProduct), ctx, product)
+}
+
+// SetExchangeRates mocks base method.
+func (m *MockIProductUsecase) SetExchangeRates(ctx context.Context, rates []*model.ExchangeRate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetExchangeRates", ctx, rates)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetExchangeRates indicates an expected call of SetExchangeRates.
+func (mr *MockIProductUsecaseMockRecorder) SetExchangeRates(ctx, rates interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetExchangeRates", reflect.TypeOf((*MockIProductUsecase)(nil).SetExchangeRates), ctx, rates)
+}
+
+// SetPurchaseLimit mocks base method.
+func (m *MockIProductUsecase) SetPurchaseLimit(ctx context.Context, product model.Product) (*model.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPurchaseLimit", ctx, product)
+	ret0, _ := ret[0].(*model.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPurchaseLimit indicates an expected call of SetPurchaseLimit.
+func (mr *MockIProductUsecaseMockRecorder) SetPurchaseLimit(ctx, product interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPurchaseLimit", reflect.TypeOf((*MockIProductUsecase)(nil).SetPurchaseLimit), ctx, product)
+}
+
+// SetSellerProfile mocks base method.
+func (m *MockIProductUsecase) SetSellerProfile(ctx context.Context, profile model.SellerProfile) (*model.SellerProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSellerProfile", ctx, profile)
+	ret0, _ := ret[0].(*model.SellerProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetSellerProfile indicates an expected call of SetSellerProfile.
+func (mr *MockIProductUsecaseMockRecorder) SetSellerProfile(ctx, profile interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSellerProfile", reflect.TypeOf((*MockIProductUsecase)(nil).SetSellerProfile), ctx, profile)
+}
+
+// SetStockAlert mocks base method.
+func (m *MockIProductUsecase) SetStockAlert(ctx context.Context, product model.Product) (*model.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStockAlert", ctx, product)
+	ret0, _ := ret[0].(*model.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetStockAlert indicates an expected call of SetStockAlert.
+func (mr *MockIProductUsecaseMockRecorder) SetStockAlert(ctx, product interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStockAlert", reflect.TypeOf((*MockIProductUsecase)(nil).SetStockAlert), ctx, product)
+}
+
+// SetWarehouseStock mocks base method.
+func (m *MockIProductUsecase) SetWarehouseStock(ctx context.Context, stock model.WarehouseStock) (*model.WarehouseStock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWarehouseStock", ctx, stock)
+	ret0, _ := ret[0].(*model.WarehouseStock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetWarehouseStock indicates an expected call of SetWarehouseStock.
+func (mr *MockIProductUsecaseMockRecorder) SetWarehouseStock(ctx, stock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWarehouseStock", reflect.TypeOf((*MockIProductUsecase)(nil).SetWarehouseStock), ctx, stock)
+}
+
 // UpdateProduct mocks base method.
 func (m *MockIProductUsecase) UpdateProduct(ctx context.Context, product model.Product) (*model.Product, error) {
 	m.ctrl.T.Helper()
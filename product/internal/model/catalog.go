@@ -0,0 +1,38 @@
+package model
+
+import (
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+	"github.com/google/uuid"
+)
+
+type ImportRowStatus int32
+
+const (
+	ImportFailed ImportRowStatus = iota
+	ImportCreated
+	ImportUpdated
+)
+
+// Represents the outcome of importing one row of a catalog file
+type ImportRowResult struct {
+	Row         int64           `json:"row"`
+	ExternalSKU string          `json:"external_sku"`
+	ProductID   uuid.UUID       `json:"product_id"`
+	Status      ImportRowStatus `json:"status"`
+	Error       string          `json:"error"`
+}
+
+func (result *ImportRowResult) ToProto() *pbProduct.ImportRowResult {
+	var productID string
+	if result.ProductID != uuid.Nil {
+		productID = result.ProductID.String()
+	}
+
+	return &pbProduct.ImportRowResult{
+		Row:         result.Row,
+		ExternalSku: result.ExternalSKU,
+		ProductId:   productID,
+		Status:      pbProduct.ImportRowStatus(result.Status),
+		Error:       result.Error,
+	}
+}
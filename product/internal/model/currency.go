@@ -0,0 +1,118 @@
+package model
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Go-Marketplace/backend/pkg/money"
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+	"github.com/go-playground/validator/v10"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Currency of the marketplace the exchange rates are quoted in,
+// products created without a currency are priced in it
+const BaseCurrency = "RUB"
+
+var ErrBaseCurrencyRate = errors.New("the rate of the base currency is always 1")
+
+// Represents how the exchange rate is stored in the database,
+// the rate is the price of one unit of the currency in the base currency
+type ExchangeRate struct {
+	Currency  string    `json:"currency" validate:"iso4217"`
+	Rate      float64   `json:"rate" validate:"gt=0"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (rate *ExchangeRate) Validate() error {
+	if rate.Currency == BaseCurrency {
+		return ErrBaseCurrencyRate
+	}
+
+	validate := validator.New()
+	return validate.Struct(rate)
+}
+
+func (rate *ExchangeRate) ToProto() *pbProduct.ExchangeRateResponse {
+	return &pbProduct.ExchangeRateResponse{
+		Currency:  rate.Currency,
+		Rate:      rate.Rate,
+		UpdatedAt: timestamppb.New(rate.UpdatedAt),
+	}
+}
+
+// Reads the exchange rates from a CSV file with the currency and rate columns
+func ReadExchangeRates(r io.Reader, updatedAt time.Time) ([]*ExchangeRate, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read csv header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		columns[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+
+	for _, column := range []string{"currency", "rate"} {
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("csv header has no %q column", column)
+		}
+	}
+
+	rates := make([]*ExchangeRate, 0)
+	seen := make(map[string]bool)
+	for row := 2; ; row++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", row, err)
+		}
+
+		value, err := strconv.ParseFloat(strings.TrimSpace(record[columns["rate"]]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: invalid rate: %w", row, err)
+		}
+
+		rate := &ExchangeRate{
+			Currency:  money.NormalizeCurrency(record[columns["currency"]]),
+			Rate:      value,
+			UpdatedAt: updatedAt,
+		}
+
+		if err = rate.Validate(); err != nil {
+			return nil, fmt.Errorf("row %d: %w", row, err)
+		}
+
+		if seen[rate.Currency] {
+			return nil, fmt.Errorf("row %d: duplicate currency %s", row, rate.Currency)
+		}
+		seen[rate.Currency] = true
+
+		rates = append(rates, rate)
+	}
+
+	return rates, nil
+}
+
+// Returns the exchange rates with the base currency, which always has the rate 1
+func NewExchangeRates(rates []*ExchangeRate) money.ExchangeRates {
+	exchangeRates := money.ExchangeRates{
+		BaseCurrency: 1,
+	}
+
+	for _, rate := range rates {
+		exchangeRates[rate.Currency] = rate.Rate
+	}
+
+	return exchangeRates
+}
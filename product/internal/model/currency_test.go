@@ -0,0 +1,151 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Go-Marketplace/backend/product/internal/model"
+)
+
+func TestReadExchangeRates(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	testcases := []struct {
+		name          string
+		file          string
+		expectedRates []*model.ExchangeRate
+		wasError      bool
+	}{
+		{
+			name: "Valid file",
+			file: "currency,rate\nusd,92.5\nEUR, 100.25\n",
+			expectedRates: []*model.ExchangeRate{
+				{Currency: "USD", Rate: 92.5, UpdatedAt: now},
+				{Currency: "EUR", Rate: 100.25, UpdatedAt: now},
+			},
+			wasError: false,
+		},
+		{
+			name:          "Columns in any order",
+			file:          "rate,currency\n0.6,JPY\n",
+			expectedRates: []*model.ExchangeRate{{Currency: "JPY", Rate: 0.6, UpdatedAt: now}},
+			wasError:      false,
+		},
+		{
+			name:     "No rate column",
+			file:     "currency\nUSD\n",
+			wasError: true,
+		},
+		{
+			name:     "Invalid rate",
+			file:     "currency,rate\nUSD,abc\n",
+			wasError: true,
+		},
+		{
+			name:     "Negative rate",
+			file:     "currency,rate\nUSD,-1\n",
+			wasError: true,
+		},
+		{
+			name:     "Unknown currency code",
+			file:     "currency,rate\nXYZ,1\n",
+			wasError: true,
+		},
+		{
+			name:     "Base currency rate",
+			file:     "currency,rate\nRUB,2\n",
+			wasError: true,
+		},
+		{
+			name:     "Duplicate currency",
+			file:     "currency,rate\nUSD,92\nusd,93\n",
+			wasError: true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualRates, actualErr := model.ReadExchangeRates(strings.NewReader(testcase.file), now)
+
+			assert.Equal(t, testcase.wasError, actualErr != nil)
+			if !testcase.wasError {
+				assert.Equal(t, testcase.expectedRates, actualRates)
+			}
+		})
+	}
+}
+
+func TestSetDisplayCurrency(t *testing.T) {
+	t.Parallel()
+
+	rates := model.NewExchangeRates([]*model.ExchangeRate{
+		{Currency: "USD", Rate: 90},
+		{Currency: "EUR", Rate: 100},
+	})
+
+	testcases := []struct {
+		name          string
+		product       *model.Product
+		currency      string
+		expectedPrice int64
+		expectedRate  float64
+		wasError      bool
+	}{
+		{
+			name:          "Base currency to another currency",
+			product:       &model.Product{Price: 9000, Currency: "RUB"},
+			currency:      "USD",
+			expectedPrice: 100,
+			expectedRate:  1.0 / 90,
+			wasError:      false,
+		},
+		{
+			name:          "Between two other currencies",
+			product:       &model.Product{Price: 1000, Currency: "EUR"},
+			currency:      "USD",
+			expectedPrice: 1111,
+			expectedRate:  100.0 / 90,
+			wasError:      false,
+		},
+		{
+			name:          "Same currency",
+			product:       &model.Product{Price: 1000, Currency: "USD"},
+			currency:      "USD",
+			expectedPrice: 1000,
+			expectedRate:  1,
+			wasError:      false,
+		},
+		{
+			name:     "Unknown currency",
+			product:  &model.Product{Price: 1000, Currency: "USD"},
+			currency: "GBP",
+			wasError: true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualErr := testcase.product.SetDisplayCurrency(rates, testcase.currency)
+
+			assert.Equal(t, testcase.wasError, actualErr != nil)
+			if !testcase.wasError {
+				assert.Equal(t, testcase.currency, testcase.product.DisplayCurrency)
+				assert.Equal(t, testcase.expectedPrice, testcase.product.DisplayPrice)
+				assert.InDelta(t, testcase.expectedRate, testcase.product.ExchangeRate, 1e-9)
+			}
+		})
+	}
+}
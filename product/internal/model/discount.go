@@ -11,28 +11,79 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+type DiscountScope int32
+
+const (
+	ProductDiscount DiscountScope = iota
+	CategoryDiscount
+	SellerDiscount
+)
+
+// Represents how the discount structure is stored in the database.
+// Depending on the scope the discount applies to one product, to every product
+// of a category or to every product of a seller
 type Discount struct {
-	ProductID uuid.UUID `json:"product_id"`
-	Percent   float32   `json:"percent" validate:"required,min=0,max=100"`
-	CreatedAt time.Time `json:"created_at"`
-	EndedAt   time.Time `json:"ended_at"`
+	ID         uuid.UUID     `json:"discount_id"`
+	Scope      DiscountScope `json:"scope" validate:"min=0,max=2"`
+	ProductID  uuid.UUID     `json:"product_id"`
+	CategoryID int32         `json:"category_id"`
+	SellerID   uuid.UUID     `json:"seller_id"`
+	Percent    float32       `json:"percent" validate:"required,min=0,max=100"`
+	Priority   int32         `json:"priority"`
+	CreatedAt  time.Time     `json:"created_at"`
+	StartsAt   time.Time     `json:"starts_at"`
+	EndsAt     time.Time     `json:"ends_at"`
 }
 
 func (discount *Discount) Validate() error {
-	if discount.EndedAt.Before(discount.CreatedAt) {
-		return fmt.Errorf("ended_at cannot be less than created_at")
+	if discount.EndsAt.Before(discount.StartsAt) {
+		return fmt.Errorf("ended_at cannot be less than starts_at")
+	}
+
+	switch discount.Scope {
+	case ProductDiscount:
+		if discount.ProductID == uuid.Nil {
+			return fmt.Errorf("product discount must have product_id")
+		}
+	case CategoryDiscount:
+		if discount.CategoryID == 0 {
+			return fmt.Errorf("category discount must have category_id")
+		}
+	case SellerDiscount:
+		if discount.SellerID == uuid.Nil {
+			return fmt.Errorf("seller discount must have seller_id")
+		}
 	}
 
 	validate := validator.New()
 	return validate.Struct(discount)
 }
 
+// Reports whether the discount is in effect at the given time
+func (discount *Discount) ActiveAt(moment time.Time) bool {
+	return !moment.Before(discount.StartsAt) && moment.Before(discount.EndsAt)
+}
+
 func (discount *Discount) ToProto() *pbProduct.DiscountResponse {
+	var productID, sellerID string
+	if discount.ProductID != uuid.Nil {
+		productID = discount.ProductID.String()
+	}
+	if discount.SellerID != uuid.Nil {
+		sellerID = discount.SellerID.String()
+	}
+
 	return &pbProduct.DiscountResponse{
-		ProductId: discount.ProductID.String(),
-		Percent:   discount.Percent,
-		CreatedAt: timestamppb.New(discount.CreatedAt),
-		EndedAt:   timestamppb.New(discount.EndedAt),
+		DiscountId: discount.ID.String(),
+		Scope:      pbProduct.DiscountScope(discount.Scope),
+		ProductId:  productID,
+		CategoryId: discount.CategoryID,
+		SellerId:   sellerID,
+		Percent:    discount.Percent,
+		Priority:   discount.Priority,
+		CreatedAt:  timestamppb.New(discount.CreatedAt),
+		StartsAt:   timestamppb.New(discount.StartsAt),
+		EndedAt:    timestamppb.New(discount.EndsAt),
 	}
 }
 
@@ -41,7 +92,7 @@ func (discount *Discount) MarshalBinary() ([]byte, error) {
 }
 
 func (discount *Discount) UnmarshalBinary(data []byte) error {
-	if err := json.Unmarshal(data, &discount); err != nil {
+	if err := json.Unmarshal(data, discount); err != nil {
 		return fmt.Errorf("cannot unmarshal binary to discount: %w", err)
 	}
 
@@ -2,14 +2,18 @@ package model_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/Go-Marketplace/backend/product/internal/model"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestValidateDiscount(t *testing.T) {
 	t.Parallel()
 
+	now := time.Now()
+
 	testcases := []struct {
 		name     string
 		discount *model.Discount
@@ -18,17 +22,46 @@ func TestValidateDiscount(t *testing.T) {
 		{
 			name: "Discount is valid",
 			discount: &model.Discount{
-				Percent: 20,
+				ProductID: uuid.New(),
+				Percent:   20,
 			},
 			wasError: false,
 		},
 		{
 			name: "Too big percent",
 			discount: &model.Discount{
-				Percent: 120,
+				ProductID: uuid.New(),
+				Percent:   120,
+			},
+			wasError: true,
+		},
+		{
+			name: "Ends before start",
+			discount: &model.Discount{
+				ProductID: uuid.New(),
+				Percent:   20,
+				StartsAt:  now,
+				EndsAt:    now.Add(-time.Hour),
+			},
+			wasError: true,
+		},
+		{
+			name: "Category discount without category",
+			discount: &model.Discount{
+				Scope:   model.CategoryDiscount,
+				Percent: 20,
 			},
 			wasError: true,
 		},
+		{
+			name: "Seller discount is valid",
+			discount: &model.Discount{
+				Scope:    model.SellerDiscount,
+				SellerID: uuid.New(),
+				Percent:  20,
+			},
+			wasError: false,
+		},
 	}
 
 	for _, testcase := range testcases {
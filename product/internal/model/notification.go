@@ -0,0 +1,58 @@
+package model
+
+import (
+	"time"
+
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type NotificationKind int16
+
+const (
+	LowStock NotificationKind = iota
+	OutOfStock
+	// Wishlisted product is back in stock
+	BackInStock
+	// Wishlisted product is covered by a new discount
+	OnDiscount
+	// Cart is about to expire and its products to be returned
+	CartExpiring
+	// Cart expired and can be restored from the link in the message
+	CartAbandoned
+)
+
+// Represents how the user notification is stored in the database
+type Notification struct {
+	ID        uuid.UUID        `json:"notification_id"`
+	UserID    uuid.UUID        `json:"user_id"`
+	ProductID *uuid.UUID       `json:"product_id"`
+	Kind      NotificationKind `json:"kind" validate:"min=0,max=5"`
+	Quantity  int64            `json:"quantity"`
+	Message   string           `json:"message" validate:"max=1000"`
+	CreatedAt time.Time        `json:"created_at"`
+}
+
+func (notification *Notification) Validate() error {
+	validate := validator.New()
+	return validate.Struct(notification)
+}
+
+func (notification *Notification) ToProto() *pbProduct.NotificationResponse {
+	var productID string
+	if notification.ProductID != nil {
+		productID = notification.ProductID.String()
+	}
+
+	return &pbProduct.NotificationResponse{
+		NotificationId: notification.ID.String(),
+		UserId:         notification.UserID.String(),
+		ProductId:      productID,
+		Kind:           pbProduct.NotificationKind(notification.Kind),
+		Quantity:       notification.Quantity,
+		Message:        notification.Message,
+		CreatedAt:      timestamppb.New(notification.CreatedAt),
+	}
+}
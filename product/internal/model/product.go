@@ -3,26 +3,57 @@ package model
 import (
 	"time"
 
+	"github.com/Go-Marketplace/backend/pkg/money"
 	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+type ModerationStatus int32
+
+const (
+	Draft ModerationStatus = iota
+	Pending
+	Approved
+	Rejected
+)
+
 // Represents how the product structure is stored in the database
 type Product struct {
-	ID          uuid.UUID `json:"product_id"`
-	UserID      uuid.UUID `json:"user_id"`
-	CategoryID  int32     `json:"category_id"`
-	Name        string    `json:"name" validate:"max=128"`
-	Description string    `json:"description" validate:"max=1024"`
-	Price       int64     `json:"price" validate:"min=0,max=1000000000"`
-	Quantity    int64     `json:"quantity" validate:"min=0,max=10000000"`
-	Moderated   bool      `json:"moderated"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID               uuid.UUID        `json:"product_id"`
+	UserID           uuid.UUID        `json:"user_id"`
+	CategoryID       int32            `json:"category_id"`
+	ExternalSKU      string           `json:"external_sku" validate:"max=64"`
+	Name             string           `json:"name" validate:"max=128"`
+	Description      string           `json:"description" validate:"max=1024"`
+	Price            int64            `json:"price" validate:"min=0,max=1000000000"`
+	Currency         string           `json:"currency" validate:"omitempty,iso4217"`
+	Quantity         int64            `json:"quantity" validate:"min=0,max=10000000"`
+	ModerationStatus ModerationStatus `json:"moderation_status" validate:"min=0,max=3"`
+	RejectionReason  string           `json:"rejection_reason" validate:"max=1024"`
+	ModeratedBy      *uuid.UUID       `json:"moderated_by"`
+	ModeratedAt      *time.Time       `json:"moderated_at"`
+	Rating           float32          `json:"rating"`
+	ReviewsCount     int64            `json:"reviews_count"`
+	// Seller is alerted when the quantity drops to the threshold, zero disables the alert
+	LowStockThreshold  int64 `json:"low_stock_threshold" validate:"min=0,max=10000000"`
+	HideWhenOutOfStock bool  `json:"hide_when_out_of_stock"`
+	// Most units of the product one customer can buy within the lookback window, zero means no limit
+	MaxPerCustomer int64     `json:"max_per_customer" validate:"min=0,max=10000000"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+	// Deleted products are hidden everywhere until they are restored or purged
+	DeletedAt *time.Time `json:"deleted_at"`
+	// User who makes the change, it is only recorded in the product revisions
+	ActorID *uuid.UUID `json:"-"`
 
 	Discount *Discount
+
+	// Price converted to the currency the products are displayed in, it is not stored
+	DisplayCurrency string  `json:"-"`
+	DisplayPrice    int64   `json:"-"`
+	ExchangeRate    float64 `json:"-"`
 }
 
 func (product *Product) Validate() error {
@@ -30,24 +61,76 @@ func (product *Product) Validate() error {
 	return validate.Struct(product)
 }
 
+// Reports whether applying the update changes the name, description, price or currency of the product,
+// which requires the product to be reviewed again
+func (product *Product) NeedsReview(update Product) bool {
+	return (update.Name != "" && update.Name != product.Name) ||
+		(update.Description != "" && update.Description != product.Description) ||
+		(update.Price != 0 && update.Price != product.Price) ||
+		(update.Currency != "" && update.Currency != product.Currency)
+}
+
+// Converts the price to the display currency with the exchange rates
+func (product *Product) SetDisplayCurrency(rates money.ExchangeRates, currency string) error {
+	rate, err := rates.Rate(product.Currency, currency)
+	if err != nil {
+		return err
+	}
+
+	product.DisplayCurrency = currency
+	product.DisplayPrice = money.ConvertAmount(product.Price, rate)
+	product.ExchangeRate = rate
+
+	return nil
+}
+
 func (product *Product) ToProto() *pbProduct.ProductResponse {
 	var discount *pbProduct.DiscountResponse
 	if product.Discount != nil {
 		discount = product.Discount.ToProto()
 	}
 
+	var moderatedBy string
+	if product.ModeratedBy != nil {
+		moderatedBy = product.ModeratedBy.String()
+	}
+
+	var moderatedAt *timestamppb.Timestamp
+	if product.ModeratedAt != nil {
+		moderatedAt = timestamppb.New(*product.ModeratedAt)
+	}
+
+	var deletedAt *timestamppb.Timestamp
+	if product.DeletedAt != nil {
+		deletedAt = timestamppb.New(*product.DeletedAt)
+	}
+
 	return &pbProduct.ProductResponse{
-		ProductId:   product.ID.String(),
-		UserId:      product.UserID.String(),
-		CategoryId:  product.CategoryID,
-		Name:        product.Name,
-		Description: product.Description,
-		Price:       product.Price,
-		Quantity:    product.Quantity,
-		Moderated:   product.Moderated,
-		Discount:    discount,
-		CreatedAt:   timestamppb.New(product.CreatedAt),
-		UpdatedAt:   timestamppb.New(product.UpdatedAt),
+		ProductId:          product.ID.String(),
+		UserId:             product.UserID.String(),
+		CategoryId:         product.CategoryID,
+		ExternalSku:        product.ExternalSKU,
+		Name:               product.Name,
+		Description:        product.Description,
+		Price:              product.Price,
+		Currency:           product.Currency,
+		DisplayCurrency:    product.DisplayCurrency,
+		DisplayPrice:       product.DisplayPrice,
+		ExchangeRate:       product.ExchangeRate,
+		Quantity:           product.Quantity,
+		ModerationStatus:   pbProduct.ModerationStatus(product.ModerationStatus),
+		RejectionReason:    product.RejectionReason,
+		ModeratedBy:        moderatedBy,
+		ModeratedAt:        moderatedAt,
+		Rating:             product.Rating,
+		ReviewsCount:       product.ReviewsCount,
+		Discount:           discount,
+		LowStockThreshold:  product.LowStockThreshold,
+		HideWhenOutOfStock: product.HideWhenOutOfStock,
+		MaxPerCustomer:     product.MaxPerCustomer,
+		CreatedAt:          timestamppb.New(product.CreatedAt),
+		UpdatedAt:          timestamppb.New(product.UpdatedAt),
+		DeletedAt:          deletedAt,
 	}
 }
 
@@ -66,6 +66,17 @@ func TestValidateProduct(t *testing.T) {
 			},
 			wasError: true,
 		},
+		{
+			name: "Negative low stock threshold",
+			product: &model.Product{
+				Name:              "test",
+				Description:       "test",
+				Price:             100,
+				Quantity:          100,
+				LowStockThreshold: -1,
+			},
+			wasError: true,
+		},
 	}
 
 	for _, testcase := range testcases {
@@ -80,3 +91,67 @@ func TestValidateProduct(t *testing.T) {
 		})
 	}
 }
+
+func TestProductNeedsReview(t *testing.T) {
+	t.Parallel()
+
+	product := &model.Product{
+		Name:        "test",
+		Description: "test",
+		Price:       100,
+		Quantity:    100,
+	}
+
+	testcases := []struct {
+		name     string
+		update   model.Product
+		expected bool
+	}{
+		{
+			name: "Name changed",
+			update: model.Product{
+				Name: "new name",
+			},
+			expected: true,
+		},
+		{
+			name: "Description changed",
+			update: model.Product{
+				Description: "new description",
+			},
+			expected: true,
+		},
+		{
+			name: "Price changed",
+			update: model.Product{
+				Price: 200,
+			},
+			expected: true,
+		},
+		{
+			name: "Only quantity changed",
+			update: model.Product{
+				Quantity: 50,
+			},
+			expected: false,
+		},
+		{
+			name: "Same values",
+			update: model.Product{
+				Name:  "test",
+				Price: 100,
+			},
+			expected: false,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testcase.expected, product.NeedsReview(testcase.update))
+		})
+	}
+}
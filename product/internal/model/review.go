@@ -0,0 +1,50 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var ErrReviewExists = errors.New("user has already reviewed the product")
+
+// Represents how the product review is stored in the database
+type Review struct {
+	ID        uuid.UUID  `json:"review_id"`
+	ProductID uuid.UUID  `json:"product_id"`
+	UserID    uuid.UUID  `json:"user_id"`
+	Rating    int32      `json:"rating" validate:"min=1,max=5"`
+	Comment   string     `json:"comment" validate:"max=1024"`
+	Reply     string     `json:"reply" validate:"max=1024"`
+	RepliedAt *time.Time `json:"replied_at"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+func (review *Review) Validate() error {
+	validate := validator.New()
+	return validate.Struct(review)
+}
+
+func (review *Review) ToProto() *pbProduct.ReviewResponse {
+	var repliedAt *timestamppb.Timestamp
+	if review.RepliedAt != nil {
+		repliedAt = timestamppb.New(*review.RepliedAt)
+	}
+
+	return &pbProduct.ReviewResponse{
+		ReviewId:  review.ID.String(),
+		ProductId: review.ProductID.String(),
+		UserId:    review.UserID.String(),
+		Rating:    review.Rating,
+		Comment:   review.Comment,
+		Reply:     review.Reply,
+		RepliedAt: repliedAt,
+		CreatedAt: timestamppb.New(review.CreatedAt),
+		UpdatedAt: timestamppb.New(review.UpdatedAt),
+	}
+}
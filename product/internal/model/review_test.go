@@ -0,0 +1,72 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Go-Marketplace/backend/product/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateReview(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		review   *model.Review
+		wasError bool
+	}{
+		{
+			name: "Review is valid",
+			review: &model.Review{
+				Rating:  5,
+				Comment: "test",
+			},
+			wasError: false,
+		},
+		{
+			name: "Too small rating",
+			review: &model.Review{
+				Rating:  0,
+				Comment: "test",
+			},
+			wasError: true,
+		},
+		{
+			name: "Too big rating",
+			review: &model.Review{
+				Rating:  6,
+				Comment: "test",
+			},
+			wasError: true,
+		},
+		{
+			name: "Too long comment",
+			review: &model.Review{
+				Rating:  4,
+				Comment: strings.Repeat("t", 1025),
+			},
+			wasError: true,
+		},
+		{
+			name: "Too long reply",
+			review: &model.Review{
+				Rating: 4,
+				Reply:  strings.Repeat("t", 1025),
+			},
+			wasError: true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualErr := testcase.review.Validate()
+
+			assert.Equal(t, testcase.wasError, actualErr != nil)
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type RevisionAction int16
+
+const (
+	RevisionCreated RevisionAction = iota
+	RevisionUpdated
+	RevisionModerated
+	RevisionDeleted
+	RevisionRestored
+)
+
+type FieldChange struct {
+	Old string `json:"old"`
+	New string `json:"new"`
+}
+
+// Represents how one product change is stored in the append-only revisions table.
+// Changes are keyed by the products column name
+type ProductRevision struct {
+	ID        uuid.UUID              `json:"revision_id"`
+	ProductID uuid.UUID              `json:"product_id"`
+	ActorID   *uuid.UUID             `json:"actor_id"`
+	Action    RevisionAction         `json:"action"`
+	Changes   map[string]FieldChange `json:"changes"`
+	CreatedAt time.Time              `json:"created_at"`
+}
+
+// Returns the product fields that differ between the two versions of the product,
+// comparing against an empty product gives every field set on creation
+func ProductChanges(old, new Product) map[string]FieldChange {
+	changes := make(map[string]FieldChange)
+
+	compare := func(field string, oldValue, newValue interface{}) {
+		oldString, newString := fmt.Sprint(oldValue), fmt.Sprint(newValue)
+		if oldString != newString {
+			changes[field] = FieldChange{
+				Old: oldString,
+				New: newString,
+			}
+		}
+	}
+
+	compare("category_id", old.CategoryID, new.CategoryID)
+	compare("external_sku", old.ExternalSKU, new.ExternalSKU)
+	compare("name", old.Name, new.Name)
+	compare("description", old.Description, new.Description)
+	compare("price", old.Price, new.Price)
+	compare("currency", old.Currency, new.Currency)
+	compare("quantity", old.Quantity, new.Quantity)
+	compare("moderation_status", pbProduct.ModerationStatus(old.ModerationStatus), pbProduct.ModerationStatus(new.ModerationStatus))
+	compare("rejection_reason", old.RejectionReason, new.RejectionReason)
+	compare("low_stock_threshold", old.LowStockThreshold, new.LowStockThreshold)
+	compare("hide_when_out_of_stock", old.HideWhenOutOfStock, new.HideWhenOutOfStock)
+	compare("max_per_customer", old.MaxPerCustomer, new.MaxPerCustomer)
+	compare("deleted_at", formatTime(old.DeletedAt), formatTime(new.DeletedAt))
+
+	return changes
+}
+
+func formatTime(value *time.Time) string {
+	if value == nil {
+		return ""
+	}
+
+	return value.UTC().Format(time.RFC3339)
+}
+
+func (revision *ProductRevision) ToProto() *pbProduct.ProductRevisionResponse {
+	var actorID string
+	if revision.ActorID != nil {
+		actorID = revision.ActorID.String()
+	}
+
+	fields := make([]string, 0, len(revision.Changes))
+	for field := range revision.Changes {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	changes := make([]*pbProduct.FieldChange, 0, len(fields))
+	for _, field := range fields {
+		changes = append(changes, &pbProduct.FieldChange{
+			Field:    field,
+			OldValue: revision.Changes[field].Old,
+			NewValue: revision.Changes[field].New,
+		})
+	}
+
+	return &pbProduct.ProductRevisionResponse{
+		RevisionId: revision.ID.String(),
+		ProductId:  revision.ProductID.String(),
+		ActorId:    actorID,
+		Action:     pbProduct.RevisionAction(revision.Action),
+		Changes:    changes,
+		CreatedAt:  timestamppb.New(revision.CreatedAt),
+	}
+}
+
+// Represents the product price set by one revision
+type PricePoint struct {
+	Price     int64      `json:"price"`
+	ActorID   *uuid.UUID `json:"actor_id"`
+	ChangedAt time.Time  `json:"changed_at"`
+}
+
+func (point *PricePoint) ToProto() *pbProduct.PricePoint {
+	var actorID string
+	if point.ActorID != nil {
+		actorID = point.ActorID.String()
+	}
+
+	return &pbProduct.PricePoint{
+		Price:     point.Price,
+		ActorId:   actorID,
+		ChangedAt: timestamppb.New(point.ChangedAt),
+	}
+}
+
+type PriceHistory struct {
+	ProductID    uuid.UUID
+	Prices       []*PricePoint
+	CurrentPrice int64
+	LowestPrice  int64
+}
+
+func (history *PriceHistory) ToProto() *pbProduct.PriceHistoryResponse {
+	prices := make([]*pbProduct.PricePoint, 0, len(history.Prices))
+	for _, point := range history.Prices {
+		prices = append(prices, point.ToProto())
+	}
+
+	return &pbProduct.PriceHistoryResponse{
+		ProductId:    history.ProductID.String(),
+		Prices:       prices,
+		CurrentPrice: history.CurrentPrice,
+		LowestPrice:  history.LowestPrice,
+	}
+}
+
+// Returns the lowest price the product had since the given time, including the price
+// that was already in effect at that moment. Points must be ordered by ChangedAt
+func LowestPrice(points []*PricePoint, since time.Time) int64 {
+	var lowest int64
+	found := false
+
+	for i, point := range points {
+		inEffectAtSince := !point.ChangedAt.After(since) && (i == len(points)-1 || points[i+1].ChangedAt.After(since))
+		if point.ChangedAt.After(since) || inEffectAtSince {
+			if !found || point.Price < lowest {
+				lowest = point.Price
+				found = true
+			}
+		}
+	}
+
+	return lowest
+}
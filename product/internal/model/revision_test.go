@@ -0,0 +1,138 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Go-Marketplace/backend/product/internal/model"
+)
+
+func TestProductChanges(t *testing.T) {
+	t.Parallel()
+
+	product := model.Product{
+		Name:     "name",
+		Price:    100,
+		Quantity: 10,
+	}
+
+	deletedAt := time.Date(2023, 12, 17, 10, 0, 0, 0, time.UTC)
+
+	testcases := []struct {
+		name            string
+		old             model.Product
+		new             model.Product
+		expectedChanges map[string]model.FieldChange
+	}{
+		{
+			name:            "Nothing changed",
+			old:             product,
+			new:             product,
+			expectedChanges: map[string]model.FieldChange{},
+		},
+		{
+			name: "Price and quantity changed",
+			old:  product,
+			new: model.Product{
+				Name:     "name",
+				Price:    90,
+				Quantity: 5,
+			},
+			expectedChanges: map[string]model.FieldChange{
+				"price":    {Old: "100", New: "90"},
+				"quantity": {Old: "10", New: "5"},
+			},
+		},
+		{
+			name: "Moderation status is recorded by name",
+			old:  product,
+			new: model.Product{
+				Name:             "name",
+				Price:            100,
+				Quantity:         10,
+				ModerationStatus: model.Approved,
+			},
+			expectedChanges: map[string]model.FieldChange{
+				"moderation_status": {Old: "DRAFT", New: "APPROVED"},
+			},
+		},
+		{
+			name: "Deletion time is recorded",
+			old:  product,
+			new: model.Product{
+				Name:      "name",
+				Price:     100,
+				Quantity:  10,
+				DeletedAt: &deletedAt,
+			},
+			expectedChanges: map[string]model.FieldChange{
+				"deleted_at": {Old: "", New: "2023-12-17T10:00:00Z"},
+			},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testcase.expectedChanges, model.ProductChanges(testcase.old, testcase.new))
+		})
+	}
+}
+
+func TestLowestPrice(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	points := []*model.PricePoint{
+		{Price: 50, ChangedAt: now.Add(-60 * 24 * time.Hour)},
+		{Price: 120, ChangedAt: now.Add(-40 * 24 * time.Hour)},
+		{Price: 100, ChangedAt: now.Add(-10 * 24 * time.Hour)},
+	}
+
+	testcases := []struct {
+		name          string
+		points        []*model.PricePoint
+		since         time.Time
+		expectedPrice int64
+	}{
+		{
+			name:          "Price in effect at the start of the period is included",
+			points:        points,
+			since:         now.Add(-30 * 24 * time.Hour),
+			expectedPrice: 100,
+		},
+		{
+			name:          "Older prices are included for a longer period",
+			points:        points,
+			since:         now.Add(-90 * 24 * time.Hour),
+			expectedPrice: 50,
+		},
+		{
+			name:          "Only the last price is in effect",
+			points:        points,
+			since:         now,
+			expectedPrice: 100,
+		},
+		{
+			name:          "No prices",
+			points:        nil,
+			since:         now,
+			expectedPrice: 0,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testcase.expectedPrice, model.LowestPrice(testcase.points, testcase.since))
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package model
+
+import (
+	"time"
+
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Represents how the seller profile is stored in the database.
+// Rating and ReviewsCount are aggregated from the seller products and are not stored
+type SellerProfile struct {
+	UserID       uuid.UUID `json:"user_id"`
+	DisplayName  string    `json:"display_name" validate:"required,max=128"`
+	Description  string    `json:"description" validate:"max=2048"`
+	LogoURL      string    `json:"logo_url" validate:"omitempty,url,max=512"`
+	ReturnPolicy string    `json:"return_policy" validate:"max=4096"`
+	Rating       float32   `json:"rating"`
+	ReviewsCount int64     `json:"reviews_count"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+func (profile *SellerProfile) Validate() error {
+	validate := validator.New()
+	return validate.Struct(profile)
+}
+
+func (profile *SellerProfile) ToProto() *pbProduct.SellerProfileResponse {
+	return &pbProduct.SellerProfileResponse{
+		UserId:       profile.UserID.String(),
+		DisplayName:  profile.DisplayName,
+		Description:  profile.Description,
+		LogoUrl:      profile.LogoURL,
+		ReturnPolicy: profile.ReturnPolicy,
+		Rating:       profile.Rating,
+		ReviewsCount: profile.ReviewsCount,
+		CreatedAt:    timestamppb.New(profile.CreatedAt),
+		UpdatedAt:    timestamppb.New(profile.UpdatedAt),
+	}
+}
+
+type Storefront struct {
+	Profile  *SellerProfile
+	Products []*Product
+}
+
+func (storefront *Storefront) ToProto() *pbProduct.StorefrontResponse {
+	products := make([]*pbProduct.ProductResponse, 0, len(storefront.Products))
+	for _, product := range storefront.Products {
+		products = append(products, product.ToProto())
+	}
+
+	return &pbProduct.StorefrontResponse{
+		Profile:  storefront.Profile.ToProto(),
+		Products: products,
+	}
+}
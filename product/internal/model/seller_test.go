@@ -0,0 +1,70 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Go-Marketplace/backend/product/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSellerProfile(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		profile  *model.SellerProfile
+		wasError bool
+	}{
+		{
+			name: "Seller profile is valid",
+			profile: &model.SellerProfile{
+				DisplayName:  "test",
+				LogoURL:      "https://example.com/logo.png",
+				ReturnPolicy: "30 days",
+			},
+			wasError: false,
+		},
+		{
+			name: "Logo is optional",
+			profile: &model.SellerProfile{
+				DisplayName: "test",
+			},
+			wasError: false,
+		},
+		{
+			name: "Empty display name",
+			profile: &model.SellerProfile{
+				DisplayName: "",
+			},
+			wasError: true,
+		},
+		{
+			name: "Too long display name",
+			profile: &model.SellerProfile{
+				DisplayName: strings.Repeat("t", 129),
+			},
+			wasError: true,
+		},
+		{
+			name: "Invalid logo url",
+			profile: &model.SellerProfile{
+				DisplayName: "test",
+				LogoURL:     "logo",
+			},
+			wasError: true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualErr := testcase.profile.Validate()
+
+			assert.Equal(t, testcase.wasError, actualErr != nil)
+		})
+	}
+}
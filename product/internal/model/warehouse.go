@@ -0,0 +1,133 @@
+package model
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var ErrInsufficientStock = errors.New("warehouse does not have enough stock")
+
+const earthRadiusKm = 6371.0
+
+type Location struct {
+	Latitude  float64 `json:"latitude" validate:"min=-90,max=90"`
+	Longitude float64 `json:"longitude" validate:"min=-180,max=180"`
+}
+
+// Returns the great-circle distance between two locations in kilometers
+func (location Location) DistanceTo(other Location) float64 {
+	lat1 := location.Latitude * math.Pi / 180
+	lat2 := other.Latitude * math.Pi / 180
+	dLat := lat2 - lat1
+	dLon := (other.Longitude - location.Longitude) * math.Pi / 180
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(a))
+}
+
+func (location Location) ToProto() *pbProduct.Location {
+	return &pbProduct.Location{
+		Latitude:  location.Latitude,
+		Longitude: location.Longitude,
+	}
+}
+
+// Represents how the seller warehouse is stored in the database
+type Warehouse struct {
+	ID        uuid.UUID `json:"warehouse_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	Name      string    `json:"name" validate:"required,max=128"`
+	Address   string    `json:"address" validate:"max=512"`
+	Location  Location  `json:"location"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (warehouse *Warehouse) Validate() error {
+	validate := validator.New()
+	return validate.Struct(warehouse)
+}
+
+func (warehouse *Warehouse) ToProto() *pbProduct.WarehouseResponse {
+	return &pbProduct.WarehouseResponse{
+		WarehouseId: warehouse.ID.String(),
+		UserId:      warehouse.UserID.String(),
+		Name:        warehouse.Name,
+		Address:     warehouse.Address,
+		Location:    warehouse.Location.ToProto(),
+		CreatedAt:   timestamppb.New(warehouse.CreatedAt),
+	}
+}
+
+// Represents the product stock level in one warehouse in the database.
+// Location is the location of the warehouse and is not stored with the stock
+type WarehouseStock struct {
+	WarehouseID uuid.UUID `json:"warehouse_id"`
+	ProductID   uuid.UUID `json:"product_id"`
+	Quantity    int64     `json:"quantity" validate:"min=0,max=10000000"`
+	Location    Location  `json:"-"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+func (stock *WarehouseStock) Validate() error {
+	validate := validator.New()
+	return validate.Struct(stock)
+}
+
+func (stock *WarehouseStock) ToProto() *pbProduct.WarehouseStockResponse {
+	return &pbProduct.WarehouseStockResponse{
+		WarehouseId: stock.WarehouseID.String(),
+		ProductId:   stock.ProductID.String(),
+		Quantity:    stock.Quantity,
+		UpdatedAt:   timestamppb.New(stock.UpdatedAt),
+	}
+}
+
+// Represents the product quantity taken from or returned to a warehouse
+type Reservation struct {
+	ProductID   uuid.UUID `json:"product_id"`
+	WarehouseID uuid.UUID `json:"warehouse_id"`
+	Quantity    int64     `json:"quantity" validate:"min=1,max=10000000"`
+}
+
+func (reservation *Reservation) Validate() error {
+	validate := validator.New()
+	return validate.Struct(reservation)
+}
+
+func (reservation *Reservation) ToProto() *pbProduct.ReservationResponse {
+	return &pbProduct.ReservationResponse{
+		ProductId:   reservation.ProductID.String(),
+		WarehouseId: reservation.WarehouseID.String(),
+		Quantity:    reservation.Quantity,
+	}
+}
+
+// Represents one of the reservations that are changed together in one transaction.
+// A negative quantity returns the product to the warehouse instead of taking it
+type ReservationChange struct {
+	ProductID   uuid.UUID                    `json:"product_id"`
+	WarehouseID uuid.UUID                    `json:"warehouse_id"`
+	Quantity    int64                        `json:"quantity" validate:"ne=0,min=-10000000,max=10000000"`
+	Strategy    pbProduct.AllocationStrategy `json:"-"`
+	Destination *Location                    `json:"-"`
+}
+
+func (change *ReservationChange) Validate() error {
+	validate := validator.New()
+	return validate.Struct(change)
+}
+
+func (change *ReservationChange) ToProto() *pbProduct.ReservationResponse {
+	return &pbProduct.ReservationResponse{
+		ProductId:   change.ProductID.String(),
+		WarehouseId: change.WarehouseID.String(),
+		Quantity:    change.Quantity,
+	}
+}
@@ -0,0 +1,81 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Go-Marketplace/backend/product/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateWarehouse(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name      string
+		warehouse *model.Warehouse
+		wasError  bool
+	}{
+		{
+			name: "Warehouse is valid",
+			warehouse: &model.Warehouse{
+				Name:     "test",
+				Location: model.Location{Latitude: 55.75, Longitude: 37.62},
+			},
+			wasError: false,
+		},
+		{
+			name: "Empty name",
+			warehouse: &model.Warehouse{
+				Name: "",
+			},
+			wasError: true,
+		},
+		{
+			name: "Too long name",
+			warehouse: &model.Warehouse{
+				Name: strings.Repeat("t", 129),
+			},
+			wasError: true,
+		},
+		{
+			name: "Invalid latitude",
+			warehouse: &model.Warehouse{
+				Name:     "test",
+				Location: model.Location{Latitude: 91},
+			},
+			wasError: true,
+		},
+		{
+			name: "Invalid longitude",
+			warehouse: &model.Warehouse{
+				Name:     "test",
+				Location: model.Location{Longitude: -181},
+			},
+			wasError: true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualErr := testcase.warehouse.Validate()
+
+			assert.Equal(t, testcase.wasError, actualErr != nil)
+		})
+	}
+}
+
+func TestDistanceTo(t *testing.T) {
+	t.Parallel()
+
+	moscow := model.Location{Latitude: 55.7558, Longitude: 37.6173}
+	petersburg := model.Location{Latitude: 59.9343, Longitude: 30.3351}
+
+	assert.InDelta(t, 0, moscow.DistanceTo(moscow), 0.001)
+	assert.InDelta(t, 634, moscow.DistanceTo(petersburg), 5)
+	assert.InDelta(t, moscow.DistanceTo(petersburg), petersburg.DistanceTo(moscow), 0.001)
+}
@@ -0,0 +1,77 @@
+package model
+
+import (
+	"time"
+
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Name of the wishlist products are moved to from the cart when no wishlist is given
+const SavedForLater = "Saved for later"
+
+// Represents how the user wishlist is stored in the database.
+// Wishlists do not reserve stock of their products
+type Wishlist struct {
+	ID        uuid.UUID       `json:"wishlist_id"`
+	UserID    uuid.UUID       `json:"user_id"`
+	Name      string          `json:"name" validate:"required,max=128"`
+	Items     []*WishlistItem `json:"items"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+func (wishlist *Wishlist) Validate() error {
+	validate := validator.New()
+	return validate.Struct(wishlist)
+}
+
+// Reports whether the product is in the wishlist
+func (wishlist *Wishlist) Contains(productID uuid.UUID) bool {
+	for _, item := range wishlist.Items {
+		if item != nil && item.ProductID == productID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (wishlist *Wishlist) ToProto() *pbProduct.WishlistResponse {
+	items := make([]*pbProduct.WishlistItemResponse, 0, len(wishlist.Items))
+	for _, item := range wishlist.Items {
+		if item.Product == nil {
+			continue
+		}
+
+		items = append(items, item.ToProto())
+	}
+
+	return &pbProduct.WishlistResponse{
+		WishlistId: wishlist.ID.String(),
+		UserId:     wishlist.UserID.String(),
+		Name:       wishlist.Name,
+		Items:      items,
+		CreatedAt:  timestamppb.New(wishlist.CreatedAt),
+		UpdatedAt:  timestamppb.New(wishlist.UpdatedAt),
+	}
+}
+
+// Represents how the wishlist product is stored in the database.
+// Product is loaded separately and is nil for deleted products
+type WishlistItem struct {
+	WishlistID uuid.UUID `json:"wishlist_id"`
+	ProductID  uuid.UUID `json:"product_id"`
+	AddedAt    time.Time `json:"added_at"`
+
+	Product *Product
+}
+
+func (item *WishlistItem) ToProto() *pbProduct.WishlistItemResponse {
+	return &pbProduct.WishlistItemResponse{
+		Product: item.Product.ToProto(),
+		AddedAt: timestamppb.New(item.AddedAt),
+	}
+}
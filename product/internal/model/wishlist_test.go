@@ -0,0 +1,93 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Go-Marketplace/backend/product/internal/model"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateWishlist(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		wishlist *model.Wishlist
+		wasError bool
+	}{
+		{
+			name: "Wishlist is valid",
+			wishlist: &model.Wishlist{
+				Name: model.SavedForLater,
+			},
+			wasError: false,
+		},
+		{
+			name: "Empty name",
+			wishlist: &model.Wishlist{
+				Name: "",
+			},
+			wasError: true,
+		},
+		{
+			name: "Too long name",
+			wishlist: &model.Wishlist{
+				Name: strings.Repeat("t", 129),
+			},
+			wasError: true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualErr := testcase.wishlist.Validate()
+
+			assert.Equal(t, testcase.wasError, actualErr != nil)
+		})
+	}
+}
+
+func TestWishlistContains(t *testing.T) {
+	t.Parallel()
+
+	productID := uuid.New()
+	wishlist := &model.Wishlist{
+		Items: []*model.WishlistItem{
+			{ProductID: uuid.New()},
+			{ProductID: productID},
+		},
+	}
+
+	testcases := []struct {
+		name      string
+		productID uuid.UUID
+		expected  bool
+	}{
+		{
+			name:      "Product is in the wishlist",
+			productID: productID,
+			expected:  true,
+		},
+		{
+			name:      "Product is not in the wishlist",
+			productID: uuid.New(),
+			expected:  false,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testcase.expected, wishlist.Contains(testcase.productID))
+		})
+	}
+}
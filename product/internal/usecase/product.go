@@ -2,11 +2,14 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/Go-Marketplace/backend/pkg/logger"
+	"github.com/Go-Marketplace/backend/product/internal/allocation"
 	"github.com/Go-Marketplace/backend/product/internal/api/grpc/dto"
 	"github.com/Go-Marketplace/backend/product/internal/infrastructure/interfaces"
 	"github.com/Go-Marketplace/backend/product/internal/model"
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
 	"github.com/google/uuid"
 )
 
@@ -14,11 +17,17 @@ type IProductUsecase interface {
 	// Product
 	GetProducts(ctx context.Context, searchParams dto.SearchProductsDTO) ([]*model.Product, error)
 	GetProduct(ctx context.Context, productID uuid.UUID) (*model.Product, error)
+	GetModerationQueue(ctx context.Context, queueParams dto.ModerationQueueDTO) ([]*model.Product, error)
 	CreateProduct(ctx context.Context, product model.Product) error
 	UpdateProduct(ctx context.Context, product model.Product) (*model.Product, error)
 	UpdateProducts(ctx context.Context, products []model.Product) error
-	DeleteProduct(ctx context.Context, productID uuid.UUID) error
+	SetStockAlert(ctx context.Context, product model.Product) (*model.Product, error)
+	SetPurchaseLimit(ctx context.Context, product model.Product) (*model.Product, error)
+	DeleteProduct(ctx context.Context, product model.Product) error
 	DeleteUserProducts(ctx context.Context, userID uuid.UUID) error
+	RestoreProduct(ctx context.Context, product model.Product) (*model.Product, error)
+	GetDeletedProducts(ctx context.Context, deletedBefore time.Time) ([]*model.Product, error)
+	PurgeProduct(ctx context.Context, productID uuid.UUID) error
 
 	// Category
 	GetCategory(ctx context.Context, id int32) (*model.Category, error)
@@ -27,26 +36,100 @@ type IProductUsecase interface {
 	// Discount
 	CreateDiscount(ctx context.Context, discount model.Discount) (*model.Product, error)
 	DeleteDiscount(ctx context.Context, productID uuid.UUID) (*model.Product, error)
+	CreateScopeDiscount(ctx context.Context, discount model.Discount) (*model.Discount, error)
+	GetDiscounts(ctx context.Context, searchParams dto.SearchDiscountsDTO) ([]*model.Discount, error)
+	EndDiscounts(ctx context.Context, searchParams dto.SearchDiscountsDTO) error
+
+	// Review
+	GetReview(ctx context.Context, reviewID uuid.UUID) (*model.Review, error)
+	GetProductReviews(ctx context.Context, searchParams dto.SearchReviewsDTO) ([]*model.Review, error)
+	CreateReview(ctx context.Context, review model.Review) (*model.Review, error)
+	ReplyReview(ctx context.Context, review model.Review) (*model.Review, error)
+
+	// Warehouse
+	GetWarehouse(ctx context.Context, warehouseID uuid.UUID) (*model.Warehouse, error)
+	GetWarehouses(ctx context.Context, userID uuid.UUID) ([]*model.Warehouse, error)
+	CreateWarehouse(ctx context.Context, warehouse model.Warehouse) (*model.Warehouse, error)
+	GetProductStocks(ctx context.Context, productID uuid.UUID) ([]*model.WarehouseStock, error)
+	SetWarehouseStock(ctx context.Context, stock model.WarehouseStock) (*model.WarehouseStock, error)
+	ReserveProduct(ctx context.Context, reservation model.Reservation, strategy pbProduct.AllocationStrategy, destination *model.Location) (*model.Reservation, error)
+	ReleaseProduct(ctx context.Context, reservation model.Reservation) (*model.Reservation, error)
+	ChangeReservations(ctx context.Context, changes []*model.ReservationChange) ([]*model.ReservationChange, error)
+
+	// Notification
+	GetNotifications(ctx context.Context, searchParams dto.SearchNotificationsDTO) ([]*model.Notification, error)
+	CreateNotification(ctx context.Context, notification model.Notification) error
+
+	// Revision
+	GetProductHistory(ctx context.Context, searchParams dto.SearchRevisionsDTO) ([]*model.ProductRevision, error)
+	GetPriceHistory(ctx context.Context, productID uuid.UUID) ([]*model.PricePoint, error)
+
+	// Seller
+	GetSellerProfile(ctx context.Context, userID uuid.UUID) (*model.SellerProfile, error)
+	SetSellerProfile(ctx context.Context, profile model.SellerProfile) (*model.SellerProfile, error)
+
+	// Wishlist
+	GetWishlist(ctx context.Context, wishlistID uuid.UUID) (*model.Wishlist, error)
+	GetWishlistByName(ctx context.Context, userID uuid.UUID, name string) (*model.Wishlist, error)
+	GetWishlists(ctx context.Context, userID uuid.UUID) ([]*model.Wishlist, error)
+	CreateWishlist(ctx context.Context, wishlist model.Wishlist) (*model.Wishlist, error)
+	DeleteWishlist(ctx context.Context, wishlistID uuid.UUID) error
+	AddWishlistItem(ctx context.Context, item model.WishlistItem) (*model.Wishlist, error)
+	RemoveWishlistItem(ctx context.Context, wishlistID, productID uuid.UUID) (*model.Wishlist, error)
+
+	// Currency
+	GetExchangeRates(ctx context.Context) ([]*model.ExchangeRate, error)
+	GetExchangeRate(ctx context.Context, currency string) (*model.ExchangeRate, error)
+	SetExchangeRates(ctx context.Context, rates []*model.ExchangeRate) error
 }
 
 type ProductUsecase struct {
-	productRepo  interfaces.ProductRepo
-	discountRepo interfaces.DiscountRepo
-	logger       *logger.Logger
+	productRepo      interfaces.ProductRepo
+	discountRepo     interfaces.DiscountRepo
+	reviewRepo       interfaces.ReviewRepo
+	warehouseRepo    interfaces.WarehouseRepo
+	notificationRepo interfaces.NotificationRepo
+	revisionRepo     interfaces.RevisionRepo
+	sellerRepo       interfaces.SellerRepo
+	wishlistRepo     interfaces.WishlistRepo
+	currencyRepo     interfaces.CurrencyRepo
+	logger           *logger.Logger
 }
 
-func NewProductUsecase(productRepo interfaces.ProductRepo, discountRepo interfaces.DiscountRepo, logger *logger.Logger) *ProductUsecase {
+func NewProductUsecase(
+	productRepo interfaces.ProductRepo,
+	discountRepo interfaces.DiscountRepo,
+	reviewRepo interfaces.ReviewRepo,
+	warehouseRepo interfaces.WarehouseRepo,
+	notificationRepo interfaces.NotificationRepo,
+	revisionRepo interfaces.RevisionRepo,
+	sellerRepo interfaces.SellerRepo,
+	wishlistRepo interfaces.WishlistRepo,
+	currencyRepo interfaces.CurrencyRepo,
+	logger *logger.Logger,
+) *ProductUsecase {
 	return &ProductUsecase{
-		productRepo:  productRepo,
-		discountRepo: discountRepo,
-		logger:       logger,
+		productRepo:      productRepo,
+		discountRepo:     discountRepo,
+		reviewRepo:       reviewRepo,
+		warehouseRepo:    warehouseRepo,
+		notificationRepo: notificationRepo,
+		revisionRepo:     revisionRepo,
+		sellerRepo:       sellerRepo,
+		wishlistRepo:     wishlistRepo,
+		currencyRepo:     currencyRepo,
+		logger:           logger,
 	}
 }
 
 func (usecase *ProductUsecase) setProductsDiscount(ctx context.Context, products ...*model.Product) error {
 	var err error
 	for _, product := range products {
-		product.Discount, err = usecase.discountRepo.GetDiscount(ctx, product.ID)
+		if product == nil {
+			continue
+		}
+
+		product.Discount, err = usecase.discountRepo.GetProductDiscount(ctx, *product)
 		if err != nil {
 			return err
 		}
@@ -83,12 +166,34 @@ func (usecase *ProductUsecase) GetProducts(ctx context.Context, searchParams dto
 	return products, nil
 }
 
+func (usecase *ProductUsecase) GetModerationQueue(ctx context.Context, queueParams dto.ModerationQueueDTO) ([]*model.Product, error) {
+	products, err := usecase.productRepo.GetModerationQueue(ctx, queueParams)
+	if err != nil {
+		return nil, err
+	}
+
+	err = usecase.setProductsDiscount(ctx, products...)
+	if err != nil {
+		return nil, err
+	}
+
+	return products, nil
+}
+
 func (usecase *ProductUsecase) CreateProduct(ctx context.Context, product model.Product) error {
 	return usecase.productRepo.CreateProduct(ctx, product)
 }
 
 func (usecase *ProductUsecase) UpdateProducts(ctx context.Context, products []model.Product) error {
-	return usecase.productRepo.UpdateProducts(ctx, products)
+	if err := usecase.productRepo.UpdateProducts(ctx, products); err != nil {
+		return err
+	}
+
+	for _, product := range products {
+		usecase.invalidateDiscountAfterUpdate(ctx, product)
+	}
+
+	return nil
 }
 
 func (usecase *ProductUsecase) UpdateProduct(ctx context.Context, product model.Product) (*model.Product, error) {
@@ -96,17 +201,63 @@ func (usecase *ProductUsecase) UpdateProduct(ctx context.Context, product model.
 		return nil, err
 	}
 
+	usecase.invalidateDiscountAfterUpdate(ctx, product)
+
 	return usecase.GetProduct(ctx, product.ID)
 }
 
-func (usecase *ProductUsecase) DeleteProduct(ctx context.Context, productID uuid.UUID) error {
-	return usecase.productRepo.DeleteProduct(ctx, productID)
+// A product moved to another category may get another category discount. The product
+// is already updated, so a failed invalidation only keeps the old discount until it expires
+func (usecase *ProductUsecase) invalidateDiscountAfterUpdate(ctx context.Context, product model.Product) {
+	if product.CategoryID == 0 {
+		return
+	}
+
+	if err := usecase.discountRepo.InvalidateProductDiscount(ctx, product.ID); err != nil {
+		usecase.logger.Error("failed to invalidate discount of product %s: %s", product.ID, err)
+	}
+}
+
+func (usecase *ProductUsecase) SetStockAlert(ctx context.Context, product model.Product) (*model.Product, error) {
+	if err := usecase.productRepo.SetStockAlert(ctx, product); err != nil {
+		return nil, err
+	}
+
+	return usecase.GetProduct(ctx, product.ID)
+}
+
+func (usecase *ProductUsecase) SetPurchaseLimit(ctx context.Context, product model.Product) (*model.Product, error) {
+	if err := usecase.productRepo.SetPurchaseLimit(ctx, product); err != nil {
+		return nil, err
+	}
+
+	return usecase.GetProduct(ctx, product.ID)
+}
+
+func (usecase *ProductUsecase) DeleteProduct(ctx context.Context, product model.Product) error {
+	return usecase.productRepo.DeleteProduct(ctx, product)
 }
 
 func (usecase *ProductUsecase) DeleteUserProducts(ctx context.Context, userID uuid.UUID) error {
 	return usecase.productRepo.DeleteUserProducts(ctx, userID)
 }
 
+func (usecase *ProductUsecase) RestoreProduct(ctx context.Context, product model.Product) (*model.Product, error) {
+	if err := usecase.productRepo.RestoreProduct(ctx, product); err != nil {
+		return nil, err
+	}
+
+	return usecase.GetProduct(ctx, product.ID)
+}
+
+func (usecase *ProductUsecase) GetDeletedProducts(ctx context.Context, deletedBefore time.Time) ([]*model.Product, error) {
+	return usecase.productRepo.GetDeletedProducts(ctx, deletedBefore)
+}
+
+func (usecase *ProductUsecase) PurgeProduct(ctx context.Context, productID uuid.UUID) error {
+	return usecase.productRepo.PurgeProduct(ctx, productID)
+}
+
 func (usecase *ProductUsecase) GetCategory(ctx context.Context, categoryID int32) (*model.Category, error) {
 	return usecase.productRepo.GetCategory(ctx, categoryID)
 }
@@ -124,9 +275,275 @@ func (usecase *ProductUsecase) CreateDiscount(ctx context.Context, discount mode
 }
 
 func (usecase *ProductUsecase) DeleteDiscount(ctx context.Context, productID uuid.UUID) (*model.Product, error) {
-	if err := usecase.discountRepo.DeleteDiscount(ctx, productID); err != nil {
+	if err := usecase.discountRepo.EndDiscounts(ctx, dto.SearchDiscountsDTO{
+		Scope:     pbProduct.DiscountScope_PRODUCT_DISCOUNT,
+		ProductID: productID,
+	}); err != nil {
 		return nil, err
 	}
 
 	return usecase.GetProduct(ctx, productID)
 }
+
+func (usecase *ProductUsecase) CreateScopeDiscount(ctx context.Context, discount model.Discount) (*model.Discount, error) {
+	if err := usecase.discountRepo.CreateDiscount(ctx, discount); err != nil {
+		return nil, err
+	}
+
+	return usecase.discountRepo.GetDiscount(ctx, discount.ID)
+}
+
+func (usecase *ProductUsecase) GetDiscounts(ctx context.Context, searchParams dto.SearchDiscountsDTO) ([]*model.Discount, error) {
+	return usecase.discountRepo.GetDiscounts(ctx, searchParams)
+}
+
+func (usecase *ProductUsecase) EndDiscounts(ctx context.Context, searchParams dto.SearchDiscountsDTO) error {
+	return usecase.discountRepo.EndDiscounts(ctx, searchParams)
+}
+
+func (usecase *ProductUsecase) GetReview(ctx context.Context, reviewID uuid.UUID) (*model.Review, error) {
+	return usecase.reviewRepo.GetReview(ctx, reviewID)
+}
+
+func (usecase *ProductUsecase) GetProductReviews(ctx context.Context, searchParams dto.SearchReviewsDTO) ([]*model.Review, error) {
+	return usecase.reviewRepo.GetReviews(ctx, searchParams)
+}
+
+func (usecase *ProductUsecase) CreateReview(ctx context.Context, review model.Review) (*model.Review, error) {
+	if err := usecase.reviewRepo.CreateReview(ctx, review); err != nil {
+		return nil, err
+	}
+
+	return usecase.GetReview(ctx, review.ID)
+}
+
+func (usecase *ProductUsecase) ReplyReview(ctx context.Context, review model.Review) (*model.Review, error) {
+	if err := usecase.reviewRepo.UpdateReviewReply(ctx, review); err != nil {
+		return nil, err
+	}
+
+	return usecase.GetReview(ctx, review.ID)
+}
+
+func (usecase *ProductUsecase) GetWarehouse(ctx context.Context, warehouseID uuid.UUID) (*model.Warehouse, error) {
+	return usecase.warehouseRepo.GetWarehouse(ctx, warehouseID)
+}
+
+func (usecase *ProductUsecase) GetWarehouses(ctx context.Context, userID uuid.UUID) ([]*model.Warehouse, error) {
+	return usecase.warehouseRepo.GetWarehouses(ctx, userID)
+}
+
+func (usecase *ProductUsecase) CreateWarehouse(ctx context.Context, warehouse model.Warehouse) (*model.Warehouse, error) {
+	if err := usecase.warehouseRepo.CreateWarehouse(ctx, warehouse); err != nil {
+		return nil, err
+	}
+
+	return usecase.GetWarehouse(ctx, warehouse.ID)
+}
+
+func (usecase *ProductUsecase) GetProductStocks(ctx context.Context, productID uuid.UUID) ([]*model.WarehouseStock, error) {
+	return usecase.warehouseRepo.GetProductStocks(ctx, productID)
+}
+
+func (usecase *ProductUsecase) SetWarehouseStock(ctx context.Context, stock model.WarehouseStock) (*model.WarehouseStock, error) {
+	if err := usecase.warehouseRepo.SetStock(ctx, stock); err != nil {
+		return nil, err
+	}
+
+	return usecase.warehouseRepo.GetWarehouseStock(ctx, stock.WarehouseID, stock.ProductID)
+}
+
+// Takes the quantity from the reservation warehouse, when no warehouse is given
+// it is chosen among the product stocks by the allocation strategy
+func (usecase *ProductUsecase) ReserveProduct(
+	ctx context.Context,
+	reservation model.Reservation,
+	strategy pbProduct.AllocationStrategy,
+	destination *model.Location,
+) (*model.Reservation, error) {
+	if reservation.WarehouseID == uuid.Nil {
+		stocks, err := usecase.warehouseRepo.GetProductStocks(ctx, reservation.ProductID)
+		if err != nil {
+			return nil, err
+		}
+
+		stock, err := allocation.Get(strategy).Choose(stocks, reservation.Quantity, destination)
+		if err != nil {
+			return nil, err
+		}
+
+		reservation.WarehouseID = stock.WarehouseID
+	}
+
+	if err := usecase.warehouseRepo.ReserveStock(ctx, reservation); err != nil {
+		return nil, err
+	}
+
+	return &reservation, nil
+}
+
+// Returns the quantity to the reservation warehouse, reservations without
+// a warehouse are returned to the seller primary warehouse
+func (usecase *ProductUsecase) ReleaseProduct(ctx context.Context, reservation model.Reservation) (*model.Reservation, error) {
+	warehouseID, err := usecase.warehouseRepo.ReleaseStock(ctx, reservation)
+	if err != nil {
+		return nil, err
+	}
+
+	reservation.WarehouseID = warehouseID
+
+	return &reservation, nil
+}
+
+// Reservations without a warehouse are allocated first, then all changes are applied together
+func (usecase *ProductUsecase) ChangeReservations(ctx context.Context, changes []*model.ReservationChange) ([]*model.ReservationChange, error) {
+	for _, change := range changes {
+		if change.Quantity < 0 || change.WarehouseID != uuid.Nil {
+			continue
+		}
+
+		stocks, err := usecase.warehouseRepo.GetProductStocks(ctx, change.ProductID)
+		if err != nil {
+			return nil, err
+		}
+
+		stock, err := allocation.Get(change.Strategy).Choose(stocks, change.Quantity, change.Destination)
+		if err != nil {
+			return nil, err
+		}
+
+		change.WarehouseID = stock.WarehouseID
+	}
+
+	if err := usecase.warehouseRepo.ChangeStocks(ctx, changes); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+func (usecase *ProductUsecase) GetNotifications(ctx context.Context, searchParams dto.SearchNotificationsDTO) ([]*model.Notification, error) {
+	return usecase.notificationRepo.GetNotifications(ctx, searchParams)
+}
+
+func (usecase *ProductUsecase) CreateNotification(ctx context.Context, notification model.Notification) error {
+	return usecase.notificationRepo.CreateNotification(ctx, notification)
+}
+
+func (usecase *ProductUsecase) GetProductHistory(ctx context.Context, searchParams dto.SearchRevisionsDTO) ([]*model.ProductRevision, error) {
+	return usecase.revisionRepo.GetRevisions(ctx, searchParams)
+}
+
+func (usecase *ProductUsecase) GetPriceHistory(ctx context.Context, productID uuid.UUID) ([]*model.PricePoint, error) {
+	return usecase.revisionRepo.GetPriceHistory(ctx, productID)
+}
+
+func (usecase *ProductUsecase) GetSellerProfile(ctx context.Context, userID uuid.UUID) (*model.SellerProfile, error) {
+	return usecase.sellerRepo.GetSellerProfile(ctx, userID)
+}
+
+func (usecase *ProductUsecase) SetSellerProfile(ctx context.Context, profile model.SellerProfile) (*model.SellerProfile, error) {
+	if err := usecase.sellerRepo.SetSellerProfile(ctx, profile); err != nil {
+		return nil, err
+	}
+
+	return usecase.GetSellerProfile(ctx, profile.UserID)
+}
+
+// Loads the current state of the wishlisted products, deleted products are left nil
+func (usecase *ProductUsecase) setWishlistsProducts(ctx context.Context, wishlists ...*model.Wishlist) error {
+	var err error
+	for _, wishlist := range wishlists {
+		if wishlist == nil {
+			continue
+		}
+
+		for _, item := range wishlist.Items {
+			item.Product, err = usecase.GetProduct(ctx, item.ProductID)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (usecase *ProductUsecase) GetWishlist(ctx context.Context, wishlistID uuid.UUID) (*model.Wishlist, error) {
+	wishlist, err := usecase.wishlistRepo.GetWishlist(ctx, wishlistID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = usecase.setWishlistsProducts(ctx, wishlist); err != nil {
+		return nil, err
+	}
+
+	return wishlist, nil
+}
+
+func (usecase *ProductUsecase) GetWishlistByName(ctx context.Context, userID uuid.UUID, name string) (*model.Wishlist, error) {
+	wishlist, err := usecase.wishlistRepo.GetWishlistByName(ctx, userID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = usecase.setWishlistsProducts(ctx, wishlist); err != nil {
+		return nil, err
+	}
+
+	return wishlist, nil
+}
+
+func (usecase *ProductUsecase) GetWishlists(ctx context.Context, userID uuid.UUID) ([]*model.Wishlist, error) {
+	wishlists, err := usecase.wishlistRepo.GetWishlists(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = usecase.setWishlistsProducts(ctx, wishlists...); err != nil {
+		return nil, err
+	}
+
+	return wishlists, nil
+}
+
+func (usecase *ProductUsecase) CreateWishlist(ctx context.Context, wishlist model.Wishlist) (*model.Wishlist, error) {
+	if err := usecase.wishlistRepo.CreateWishlist(ctx, wishlist); err != nil {
+		return nil, err
+	}
+
+	return usecase.GetWishlist(ctx, wishlist.ID)
+}
+
+func (usecase *ProductUsecase) DeleteWishlist(ctx context.Context, wishlistID uuid.UUID) error {
+	return usecase.wishlistRepo.DeleteWishlist(ctx, wishlistID)
+}
+
+func (usecase *ProductUsecase) AddWishlistItem(ctx context.Context, item model.WishlistItem) (*model.Wishlist, error) {
+	if err := usecase.wishlistRepo.AddWishlistItem(ctx, item); err != nil {
+		return nil, err
+	}
+
+	return usecase.GetWishlist(ctx, item.WishlistID)
+}
+
+func (usecase *ProductUsecase) RemoveWishlistItem(ctx context.Context, wishlistID, productID uuid.UUID) (*model.Wishlist, error) {
+	if err := usecase.wishlistRepo.RemoveWishlistItem(ctx, wishlistID, productID); err != nil {
+		return nil, err
+	}
+
+	return usecase.GetWishlist(ctx, wishlistID)
+}
+
+func (usecase *ProductUsecase) GetExchangeRates(ctx context.Context) ([]*model.ExchangeRate, error) {
+	return usecase.currencyRepo.GetExchangeRates(ctx)
+}
+
+func (usecase *ProductUsecase) GetExchangeRate(ctx context.Context, currency string) (*model.ExchangeRate, error) {
+	return usecase.currencyRepo.GetExchangeRate(ctx, currency)
+}
+
+func (usecase *ProductUsecase) SetExchangeRates(ctx context.Context, rates []*model.ExchangeRate) error {
+	return usecase.currencyRepo.SetExchangeRates(ctx, rates)
+}
@@ -10,12 +10,25 @@ import (
 	mocks "github.com/Go-Marketplace/backend/product/internal/mocks/repo"
 	"github.com/Go-Marketplace/backend/product/internal/model"
 	"github.com/Go-Marketplace/backend/product/internal/usecase"
+	pbProduct "github.com/Go-Marketplace/backend/proto/gen/product"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
-func productHelper(t *testing.T) (*usecase.ProductUsecase, *mocks.MockProductRepo, *mocks.MockDiscountRepo) {
+type productMocks struct {
+	productRepo      *mocks.MockProductRepo
+	discountRepo     *mocks.MockDiscountRepo
+	reviewRepo       *mocks.MockReviewRepo
+	warehouseRepo    *mocks.MockWarehouseRepo
+	notificationRepo *mocks.MockNotificationRepo
+	revisionRepo     *mocks.MockRevisionRepo
+	sellerRepo       *mocks.MockSellerRepo
+	wishlistRepo     *mocks.MockWishlistRepo
+	currencyRepo     *mocks.MockCurrencyRepo
+}
+
+func productHelper(t *testing.T) (*usecase.ProductUsecase, *productMocks) {
 	t.Helper()
 
 	mockCtrl := gomock.NewController(t)
@@ -23,11 +36,23 @@ func productHelper(t *testing.T) (*usecase.ProductUsecase, *mocks.MockProductRep
 
 	logger := logger.New("debug")
 
-	productRepo := mocks.NewMockProductRepo(mockCtrl)
-	discountRepo := mocks.NewMockDiscountRepo(mockCtrl)
-	productUsecase := usecase.NewProductUsecase(productRepo, discountRepo, logger)
+	repos := &productMocks{
+		productRepo:      mocks.NewMockProductRepo(mockCtrl),
+		discountRepo:     mocks.NewMockDiscountRepo(mockCtrl),
+		reviewRepo:       mocks.NewMockReviewRepo(mockCtrl),
+		warehouseRepo:    mocks.NewMockWarehouseRepo(mockCtrl),
+		notificationRepo: mocks.NewMockNotificationRepo(mockCtrl),
+		revisionRepo:     mocks.NewMockRevisionRepo(mockCtrl),
+		sellerRepo:       mocks.NewMockSellerRepo(mockCtrl),
+		wishlistRepo:     mocks.NewMockWishlistRepo(mockCtrl),
+		currencyRepo:     mocks.NewMockCurrencyRepo(mockCtrl),
+	}
+	productUsecase := usecase.NewProductUsecase(
+		repos.productRepo, repos.discountRepo, repos.reviewRepo, repos.warehouseRepo, repos.notificationRepo,
+		repos.revisionRepo, repos.sellerRepo, repos.wishlistRepo, repos.currencyRepo, logger,
+	)
 
-	return productUsecase, productRepo, discountRepo
+	return productUsecase, repos
 }
 
 func TestGetProduct(t *testing.T) {
@@ -66,7 +91,7 @@ func TestGetProduct(t *testing.T) {
 			},
 			mock: func(productRepo *mocks.MockProductRepo, discountRepo *mocks.MockDiscountRepo) {
 				productRepo.EXPECT().GetProduct(ctx, productID).Return(expectedProductFromRepo, nil).Times(1)
-				discountRepo.EXPECT().GetDiscount(ctx, productID).Return(expectedDiscountFromRepo, nil).Times(1)
+				discountRepo.EXPECT().GetProductDiscount(ctx, *expectedProductFromRepo).Return(expectedDiscountFromRepo, nil).Times(1)
 			},
 			expectedProduct: expectedProductFromRepo,
 			expectedErr:     nil,
@@ -91,7 +116,7 @@ func TestGetProduct(t *testing.T) {
 			},
 			mock: func(productRepo *mocks.MockProductRepo, discountRepo *mocks.MockDiscountRepo) {
 				productRepo.EXPECT().GetProduct(ctx, productID).Return(expectedProductFromRepo, nil).Times(1)
-				discountRepo.EXPECT().GetDiscount(ctx, productID).Return(nil, expectedErrFromRepo).Times(1)
+				discountRepo.EXPECT().GetProductDiscount(ctx, *expectedProductFromRepo).Return(nil, expectedErrFromRepo).Times(1)
 			},
 			expectedProduct: nil,
 			expectedErr:     expectedErrFromRepo,
@@ -102,8 +127,8 @@ func TestGetProduct(t *testing.T) {
 		testcase := testcase
 
 		t.Run(testcase.name, func(t *testing.T) {
-			productUsecase, productRepo, discountRepo := productHelper(t)
-			testcase.mock(productRepo, discountRepo)
+			productUsecase, repos := productHelper(t)
+			testcase.mock(repos.productRepo, repos.discountRepo)
 
 			actualProduct, actualErr := productUsecase.GetProduct(
 				testcase.args.ctx,
@@ -153,7 +178,7 @@ func TestGetProducts(t *testing.T) {
 			},
 			mock: func(productRepo *mocks.MockProductRepo, discountRepo *mocks.MockDiscountRepo) {
 				productRepo.EXPECT().GetProducts(ctx, dto.SearchProductsDTO{}).Return(expectedProductsFromRepo, nil).Times(1)
-				discountRepo.EXPECT().GetDiscount(ctx, productID).Return(expectedDiscountFromRepo, nil).Times(1)
+				discountRepo.EXPECT().GetProductDiscount(ctx, *expectedProductsFromRepo[0]).Return(expectedDiscountFromRepo, nil).Times(1)
 			},
 			expectedProducts: expectedProductsFromRepo,
 			expectedErr:      nil,
@@ -178,7 +203,7 @@ func TestGetProducts(t *testing.T) {
 			},
 			mock: func(productRepo *mocks.MockProductRepo, discountRepo *mocks.MockDiscountRepo) {
 				productRepo.EXPECT().GetProducts(ctx, dto.SearchProductsDTO{}).Return(expectedProductsFromRepo, nil).Times(1)
-				discountRepo.EXPECT().GetDiscount(ctx, productID).Return(nil, expectedErrFromRepo).Times(1)
+				discountRepo.EXPECT().GetProductDiscount(ctx, *expectedProductsFromRepo[0]).Return(nil, expectedErrFromRepo).Times(1)
 			},
 			expectedProducts: nil,
 			expectedErr:      expectedErrFromRepo,
@@ -189,8 +214,8 @@ func TestGetProducts(t *testing.T) {
 		testcase := testcase
 
 		t.Run(testcase.name, func(t *testing.T) {
-			productUsecase, productRepo, discountRepo := productHelper(t)
-			testcase.mock(productRepo, discountRepo)
+			productUsecase, repos := productHelper(t)
+			testcase.mock(repos.productRepo, repos.discountRepo)
 
 			actualProducts, actualErr := productUsecase.GetProducts(
 				testcase.args.ctx,
@@ -203,6 +228,85 @@ func TestGetProducts(t *testing.T) {
 	}
 }
 
+func TestGetModerationQueue(t *testing.T) {
+	type args struct {
+		ctx         context.Context
+		queueParams dto.ModerationQueueDTO
+	}
+
+	ctx := context.Background()
+	productID := uuid.New()
+
+	queueParams := dto.ModerationQueueDTO{
+		Limit:  10,
+		Offset: 20,
+	}
+
+	expectedProductsFromRepo := []*model.Product{
+		{
+			ID:               productID,
+			Name:             "test",
+			ModerationStatus: model.Pending,
+		},
+	}
+	expectedDiscountFromRepo := &model.Discount{
+		ProductID: productID,
+		Percent:   20,
+	}
+	expectedErrFromRepo := errors.New("test error")
+
+	testcases := []struct {
+		name             string
+		args             args
+		mock             func(productRepo *mocks.MockProductRepo, discountRepo *mocks.MockDiscountRepo)
+		expectedProducts []*model.Product
+		expectedErr      error
+	}{
+		{
+			name: "Successfully get moderation queue",
+			args: args{
+				ctx:         ctx,
+				queueParams: queueParams,
+			},
+			mock: func(productRepo *mocks.MockProductRepo, discountRepo *mocks.MockDiscountRepo) {
+				productRepo.EXPECT().GetModerationQueue(ctx, queueParams).Return(expectedProductsFromRepo, nil).Times(1)
+				discountRepo.EXPECT().GetProductDiscount(ctx, *expectedProductsFromRepo[0]).Return(expectedDiscountFromRepo, nil).Times(1)
+			},
+			expectedProducts: expectedProductsFromRepo,
+			expectedErr:      nil,
+		},
+		{
+			name: "Got error when get moderation queue",
+			args: args{
+				ctx:         ctx,
+				queueParams: queueParams,
+			},
+			mock: func(productRepo *mocks.MockProductRepo, discountRepo *mocks.MockDiscountRepo) {
+				productRepo.EXPECT().GetModerationQueue(ctx, queueParams).Return(nil, expectedErrFromRepo).Times(1)
+			},
+			expectedProducts: nil,
+			expectedErr:      expectedErrFromRepo,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			productUsecase, repos := productHelper(t)
+			testcase.mock(repos.productRepo, repos.discountRepo)
+
+			actualProducts, actualErr := productUsecase.GetModerationQueue(
+				testcase.args.ctx,
+				testcase.args.queueParams,
+			)
+
+			assert.Equal(t, testcase.expectedProducts, actualProducts)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
 func TestCreateProduct(t *testing.T) {
 	t.Parallel()
 
@@ -258,8 +362,8 @@ func TestCreateProduct(t *testing.T) {
 		t.Run(testcase.name, func(t *testing.T) {
 			t.Parallel()
 
-			productUsecase, productRepo, _ := productHelper(t)
-			testcase.mock(productRepo)
+			productUsecase, repos := productHelper(t)
+			testcase.mock(repos.productRepo)
 
 			actualErr := productUsecase.CreateProduct(
 				testcase.args.ctx,
@@ -287,6 +391,10 @@ func TestUpdateProduct(t *testing.T) {
 		Name:        "test",
 		Description: "test",
 	}
+	movedProduct := model.Product{
+		ID:         productID,
+		CategoryID: 2,
+	}
 
 	expectedProductFromRepo := &model.Product{
 		ID:          productID,
@@ -315,7 +423,22 @@ func TestUpdateProduct(t *testing.T) {
 			mock: func(productRepo *mocks.MockProductRepo, discountRepo *mocks.MockDiscountRepo) {
 				productRepo.EXPECT().UpdateProduct(ctx, testProduct).Return(nil).Times(1)
 				productRepo.EXPECT().GetProduct(ctx, productID).Return(expectedProductFromRepo, nil).Times(1)
-				discountRepo.EXPECT().GetDiscount(ctx, productID).Return(expectedDiscountFromRepo, nil).Times(1)
+				discountRepo.EXPECT().GetProductDiscount(ctx, *expectedProductFromRepo).Return(expectedDiscountFromRepo, nil).Times(1)
+			},
+			expectedProduct: expectedProductFromRepo,
+			expectedErr:     nil,
+		},
+		{
+			name: "Category change drops the cached discount",
+			args: args{
+				ctx:     ctx,
+				product: movedProduct,
+			},
+			mock: func(productRepo *mocks.MockProductRepo, discountRepo *mocks.MockDiscountRepo) {
+				productRepo.EXPECT().UpdateProduct(ctx, movedProduct).Return(nil).Times(1)
+				discountRepo.EXPECT().InvalidateProductDiscount(ctx, productID).Return(nil).Times(1)
+				productRepo.EXPECT().GetProduct(ctx, productID).Return(expectedProductFromRepo, nil).Times(1)
+				discountRepo.EXPECT().GetProductDiscount(ctx, *expectedProductFromRepo).Return(expectedDiscountFromRepo, nil).Times(1)
 			},
 			expectedProduct: expectedProductFromRepo,
 			expectedErr:     nil,
@@ -353,8 +476,8 @@ func TestUpdateProduct(t *testing.T) {
 		t.Run(testcase.name, func(t *testing.T) {
 			t.Parallel()
 
-			productUsecase, productRepo, discountRepo := productHelper(t)
-			testcase.mock(productRepo, discountRepo)
+			productUsecase, repos := productHelper(t)
+			testcase.mock(repos.productRepo, repos.discountRepo)
 
 			actualProduct, actualErr := productUsecase.UpdateProduct(
 				testcase.args.ctx,
@@ -367,47 +490,63 @@ func TestUpdateProduct(t *testing.T) {
 	}
 }
 
-func TestDeleteProduct(t *testing.T) {
+func TestSetStockAlert(t *testing.T) {
 	t.Parallel()
 
 	type args struct {
-		ctx       context.Context
-		productID uuid.UUID
+		ctx     context.Context
+		product model.Product
 	}
 
 	ctx := context.Background()
 
 	productID := uuid.New()
+	testProduct := model.Product{
+		ID:                 productID,
+		LowStockThreshold:  5,
+		HideWhenOutOfStock: true,
+	}
 
+	expectedProductFromRepo := &model.Product{
+		ID:                 productID,
+		Name:               "test",
+		LowStockThreshold:  5,
+		HideWhenOutOfStock: true,
+	}
 	expectedErrFromRepo := errors.New("test error")
 
 	testcases := []struct {
-		name        string
-		args        args
-		mock        func(productRepo *mocks.MockProductRepo)
-		expectedErr error
+		name            string
+		args            args
+		mock            func(productRepo *mocks.MockProductRepo, discountRepo *mocks.MockDiscountRepo)
+		expectedProduct *model.Product
+		expectedErr     error
 	}{
 		{
-			name: "Successfully delete product",
+			name: "Successfully set stock alert",
 			args: args{
-				ctx:       ctx,
-				productID: productID,
+				ctx:     ctx,
+				product: testProduct,
 			},
-			mock: func(productRepo *mocks.MockProductRepo) {
-				productRepo.EXPECT().DeleteProduct(ctx, productID).Return(nil).Times(1)
+			mock: func(productRepo *mocks.MockProductRepo, discountRepo *mocks.MockDiscountRepo) {
+				productRepo.EXPECT().SetStockAlert(ctx, testProduct).Return(nil).Times(1)
+				productRepo.EXPECT().GetProduct(ctx, productID).Return(expectedProductFromRepo, nil).Times(1)
+				discountRepo.EXPECT().GetProductDiscount(ctx, *expectedProductFromRepo).Return(nil, nil).Times(1)
 			},
-			expectedErr: nil,
+			expectedProduct: expectedProductFromRepo,
+			expectedErr:     nil,
 		},
 		{
-			name: "Got error when delete product",
+			name: "Got error when set stock alert",
 			args: args{
-				ctx:       ctx,
-				productID: productID,
+				ctx:     ctx,
+				product: testProduct,
 			},
-			mock: func(productRepo *mocks.MockProductRepo) {
-				productRepo.EXPECT().DeleteProduct(ctx, productID).Return(expectedErrFromRepo).Times(1)
+			mock: func(productRepo *mocks.MockProductRepo, discountRepo *mocks.MockDiscountRepo) {
+				productRepo.EXPECT().SetStockAlert(ctx, testProduct).Return(expectedErrFromRepo).Times(1)
 			},
-			expectedErr: expectedErrFromRepo,
+			expectedProduct: nil,
+			expectedErr:     expectedErrFromRepo,
 		},
 	}
 
@@ -417,67 +556,75 @@ func TestDeleteProduct(t *testing.T) {
 		t.Run(testcase.name, func(t *testing.T) {
 			t.Parallel()
 
-			productUsecase, productRepo, _ := productHelper(t)
-			testcase.mock(productRepo)
+			productUsecase, repos := productHelper(t)
+			testcase.mock(repos.productRepo, repos.discountRepo)
 
-			actualErr := productUsecase.DeleteProduct(
+			actualProduct, actualErr := productUsecase.SetStockAlert(
 				testcase.args.ctx,
-				testcase.args.productID,
+				testcase.args.product,
 			)
 
+			assert.Equal(t, testcase.expectedProduct, actualProduct)
 			assert.Equal(t, testcase.expectedErr, actualErr)
 		})
 	}
 }
 
-func TestGetCategory(t *testing.T) {
+func TestSetPurchaseLimit(t *testing.T) {
 	t.Parallel()
 
 	type args struct {
-		ctx context.Context
-		id  int32
+		ctx     context.Context
+		product model.Product
 	}
 
 	ctx := context.Background()
 
-	var categoryID int32 = 1
+	productID := uuid.New()
+	testProduct := model.Product{
+		ID:             productID,
+		MaxPerCustomer: 2,
+	}
 
-	expectedCategoryFromRepo := &model.Category{
-		ID:   int32(categoryID),
-		Name: "test",
+	expectedProductFromRepo := &model.Product{
+		ID:             productID,
+		Name:           "test",
+		MaxPerCustomer: 2,
 	}
 	expectedErrFromRepo := errors.New("test error")
 
 	testcases := []struct {
-		name             string
-		args             args
-		mock             func(productRepo *mocks.MockProductRepo)
-		expectedCategory *model.Category
-		expectedErr      error
+		name            string
+		args            args
+		mock            func(productRepo *mocks.MockProductRepo, discountRepo *mocks.MockDiscountRepo)
+		expectedProduct *model.Product
+		expectedErr     error
 	}{
 		{
-			name: "Successfully get category",
+			name: "Successfully set purchase limit",
 			args: args{
-				ctx: ctx,
-				id:  categoryID,
+				ctx:     ctx,
+				product: testProduct,
 			},
-			mock: func(productRepo *mocks.MockProductRepo) {
-				productRepo.EXPECT().GetCategory(ctx, categoryID).Return(expectedCategoryFromRepo, nil).Times(1)
+			mock: func(productRepo *mocks.MockProductRepo, discountRepo *mocks.MockDiscountRepo) {
+				productRepo.EXPECT().SetPurchaseLimit(ctx, testProduct).Return(nil).Times(1)
+				productRepo.EXPECT().GetProduct(ctx, productID).Return(expectedProductFromRepo, nil).Times(1)
+				discountRepo.EXPECT().GetProductDiscount(ctx, *expectedProductFromRepo).Return(nil, nil).Times(1)
 			},
-			expectedCategory: expectedCategoryFromRepo,
-			expectedErr:      nil,
+			expectedProduct: expectedProductFromRepo,
+			expectedErr:     nil,
 		},
 		{
-			name: "Got error when get category",
+			name: "Got error when set purchase limit",
 			args: args{
-				ctx: ctx,
-				id:  categoryID,
+				ctx:     ctx,
+				product: testProduct,
 			},
-			mock: func(productRepo *mocks.MockProductRepo) {
-				productRepo.EXPECT().GetCategory(ctx, categoryID).Return(nil, expectedErrFromRepo).Times(1)
+			mock: func(productRepo *mocks.MockProductRepo, discountRepo *mocks.MockDiscountRepo) {
+				productRepo.EXPECT().SetPurchaseLimit(ctx, testProduct).Return(expectedErrFromRepo).Times(1)
 			},
-			expectedCategory: nil,
-			expectedErr:      expectedErrFromRepo,
+			expectedProduct: nil,
+			expectedErr:     expectedErrFromRepo,
 		},
 	}
 
@@ -487,67 +634,65 @@ func TestGetCategory(t *testing.T) {
 		t.Run(testcase.name, func(t *testing.T) {
 			t.Parallel()
 
-			productUsecase, productRepo, _ := productHelper(t)
-			testcase.mock(productRepo)
+			productUsecase, repos := productHelper(t)
+			testcase.mock(repos.productRepo, repos.discountRepo)
 
-			actualCategory, actualErr := productUsecase.GetCategory(
+			actualProduct, actualErr := productUsecase.SetPurchaseLimit(
 				testcase.args.ctx,
-				testcase.args.id,
+				testcase.args.product,
 			)
 
-			assert.Equal(t, testcase.expectedCategory, actualCategory)
+			assert.Equal(t, testcase.expectedProduct, actualProduct)
 			assert.Equal(t, testcase.expectedErr, actualErr)
 		})
 	}
 }
 
-func TestGetAllCategories(t *testing.T) {
+func TestDeleteProduct(t *testing.T) {
 	t.Parallel()
 
 	type args struct {
-		ctx context.Context
+		ctx     context.Context
+		product model.Product
 	}
 
 	ctx := context.Background()
 
-	var categoryID int32 = 1
-
-	expectedCategoryFromRepo := []*model.Category{
-		{
-			ID:   categoryID,
-			Name: "test",
-		},
+	actorID := uuid.New()
+	testProduct := model.Product{
+		ID:      uuid.New(),
+		ActorID: &actorID,
 	}
+
 	expectedErrFromRepo := errors.New("test error")
 
 	testcases := []struct {
-		name               string
-		args               args
-		mock               func(productRepo *mocks.MockProductRepo)
-		expectedCategories []*model.Category
-		expectedErr        error
+		name        string
+		args        args
+		mock        func(productRepo *mocks.MockProductRepo)
+		expectedErr error
 	}{
 		{
-			name: "Successfully get all categories",
+			name: "Successfully delete product",
 			args: args{
-				ctx: ctx,
+				ctx:     ctx,
+				product: testProduct,
 			},
 			mock: func(productRepo *mocks.MockProductRepo) {
-				productRepo.EXPECT().GetAllCategories(ctx).Return(expectedCategoryFromRepo, nil).Times(1)
+				productRepo.EXPECT().DeleteProduct(ctx, testProduct).Return(nil).Times(1)
 			},
-			expectedCategories: expectedCategoryFromRepo,
-			expectedErr:        nil,
+			expectedErr: nil,
 		},
 		{
-			name: "Got error when get all categories",
+			name: "Got error when delete product",
 			args: args{
-				ctx: ctx,
+				ctx:     ctx,
+				product: testProduct,
 			},
 			mock: func(productRepo *mocks.MockProductRepo) {
-				productRepo.EXPECT().GetAllCategories(ctx).Return(nil, expectedErrFromRepo).Times(1)
+				productRepo.EXPECT().DeleteProduct(ctx, testProduct).Return(expectedErrFromRepo).Times(1)
 			},
-			expectedCategories: nil,
-			expectedErr:        expectedErrFromRepo,
+			expectedErr: expectedErrFromRepo,
 		},
 	}
 
@@ -557,44 +702,41 @@ func TestGetAllCategories(t *testing.T) {
 		t.Run(testcase.name, func(t *testing.T) {
 			t.Parallel()
 
-			productUsecase, productRepo, _ := productHelper(t)
-			testcase.mock(productRepo)
+			productUsecase, repos := productHelper(t)
+			testcase.mock(repos.productRepo)
 
-			actualCategory, actualErr := productUsecase.GetAllCategories(
+			actualErr := productUsecase.DeleteProduct(
 				testcase.args.ctx,
+				testcase.args.product,
 			)
 
-			assert.Equal(t, testcase.expectedCategories, actualCategory)
 			assert.Equal(t, testcase.expectedErr, actualErr)
 		})
 	}
 }
 
-func TestCreateDiscount(t *testing.T) {
+func TestRestoreProduct(t *testing.T) {
 	t.Parallel()
 
 	type args struct {
-		ctx      context.Context
-		discount model.Discount
+		ctx     context.Context
+		product model.Product
 	}
 
 	ctx := context.Background()
 
 	productID := uuid.New()
-	testDiscount := model.Discount{
-		ProductID: productID,
-		Percent:   20,
+	adminID := uuid.New()
+	testProduct := model.Product{
+		ID:      productID,
+		ActorID: &adminID,
 	}
 
 	expectedProductFromRepo := &model.Product{
-		ID:          productID,
-		Name:        "test",
-		Description: "test",
-	}
-	expectedDiscountFromRepo := &model.Discount{
-		ProductID: productID,
-		Percent:   20,
+		ID:   productID,
+		Name: "restored",
 	}
+
 	expectedErrFromRepo := errors.New("test error")
 
 	testcases := []struct {
@@ -605,40 +747,40 @@ func TestCreateDiscount(t *testing.T) {
 		expectedErr     error
 	}{
 		{
-			name: "Successfully create discount",
+			name: "Successfully restore product",
 			args: args{
-				ctx:      ctx,
-				discount: testDiscount,
+				ctx:     ctx,
+				product: testProduct,
 			},
 			mock: func(productRepo *mocks.MockProductRepo, discountRepo *mocks.MockDiscountRepo) {
-				discountRepo.EXPECT().CreateDiscount(ctx, testDiscount).Return(nil).Times(1)
+				productRepo.EXPECT().RestoreProduct(ctx, testProduct).Return(nil).Times(1)
 				productRepo.EXPECT().GetProduct(ctx, productID).Return(expectedProductFromRepo, nil).Times(1)
-				discountRepo.EXPECT().GetDiscount(ctx, productID).Return(expectedDiscountFromRepo, nil).Times(1)
+				discountRepo.EXPECT().GetProductDiscount(ctx, *expectedProductFromRepo).Return(nil, nil).Times(1)
 			},
 			expectedProduct: expectedProductFromRepo,
 			expectedErr:     nil,
 		},
 		{
-			name: "Got error when create discount",
+			name: "Product is not deleted",
 			args: args{
-				ctx:      ctx,
-				discount: testDiscount,
+				ctx:     ctx,
+				product: testProduct,
 			},
 			mock: func(productRepo *mocks.MockProductRepo, discountRepo *mocks.MockDiscountRepo) {
-				discountRepo.EXPECT().CreateDiscount(ctx, testDiscount).Return(expectedErrFromRepo).Times(1)
+				productRepo.EXPECT().RestoreProduct(ctx, testProduct).Return(nil).Times(1)
+				productRepo.EXPECT().GetProduct(ctx, productID).Return(nil, nil).Times(1)
 			},
 			expectedProduct: nil,
-			expectedErr:     expectedErrFromRepo,
+			expectedErr:     nil,
 		},
 		{
-			name: "Got error when get product",
+			name: "Got error when restore product",
 			args: args{
-				ctx:      ctx,
-				discount: testDiscount,
+				ctx:     ctx,
+				product: testProduct,
 			},
 			mock: func(productRepo *mocks.MockProductRepo, discountRepo *mocks.MockDiscountRepo) {
-				discountRepo.EXPECT().CreateDiscount(ctx, testDiscount).Return(nil).Times(1)
-				productRepo.EXPECT().GetProduct(ctx, productID).Return(nil, expectedErrFromRepo).Times(1)
+				productRepo.EXPECT().RestoreProduct(ctx, testProduct).Return(expectedErrFromRepo).Times(1)
 			},
 			expectedProduct: nil,
 			expectedErr:     expectedErrFromRepo,
@@ -651,12 +793,12 @@ func TestCreateDiscount(t *testing.T) {
 		t.Run(testcase.name, func(t *testing.T) {
 			t.Parallel()
 
-			productUsecase, productRepo, discountRepo := productHelper(t)
-			testcase.mock(productRepo, discountRepo)
+			productUsecase, repos := productHelper(t)
+			testcase.mock(repos.productRepo, repos.discountRepo)
 
-			actualProduct, actualErr := productUsecase.CreateDiscount(
+			actualProduct, actualErr := productUsecase.RestoreProduct(
 				testcase.args.ctx,
-				testcase.args.discount,
+				testcase.args.product,
 			)
 
 			assert.Equal(t, testcase.expectedProduct, actualProduct)
@@ -665,11 +807,246 @@ func TestCreateDiscount(t *testing.T) {
 	}
 }
 
-func TestDeleteDiscount(t *testing.T) {
+func TestGetCategory(t *testing.T) {
 	t.Parallel()
 
 	type args struct {
-		ctx        context.Context
+		ctx context.Context
+		id  int32
+	}
+
+	ctx := context.Background()
+
+	var categoryID int32 = 1
+
+	expectedCategoryFromRepo := &model.Category{
+		ID:   int32(categoryID),
+		Name: "test",
+	}
+	expectedErrFromRepo := errors.New("test error")
+
+	testcases := []struct {
+		name             string
+		args             args
+		mock             func(productRepo *mocks.MockProductRepo)
+		expectedCategory *model.Category
+		expectedErr      error
+	}{
+		{
+			name: "Successfully get category",
+			args: args{
+				ctx: ctx,
+				id:  categoryID,
+			},
+			mock: func(productRepo *mocks.MockProductRepo) {
+				productRepo.EXPECT().GetCategory(ctx, categoryID).Return(expectedCategoryFromRepo, nil).Times(1)
+			},
+			expectedCategory: expectedCategoryFromRepo,
+			expectedErr:      nil,
+		},
+		{
+			name: "Got error when get category",
+			args: args{
+				ctx: ctx,
+				id:  categoryID,
+			},
+			mock: func(productRepo *mocks.MockProductRepo) {
+				productRepo.EXPECT().GetCategory(ctx, categoryID).Return(nil, expectedErrFromRepo).Times(1)
+			},
+			expectedCategory: nil,
+			expectedErr:      expectedErrFromRepo,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			productUsecase, repos := productHelper(t)
+			testcase.mock(repos.productRepo)
+
+			actualCategory, actualErr := productUsecase.GetCategory(
+				testcase.args.ctx,
+				testcase.args.id,
+			)
+
+			assert.Equal(t, testcase.expectedCategory, actualCategory)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestGetAllCategories(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ctx context.Context
+	}
+
+	ctx := context.Background()
+
+	var categoryID int32 = 1
+
+	expectedCategoryFromRepo := []*model.Category{
+		{
+			ID:   categoryID,
+			Name: "test",
+		},
+	}
+	expectedErrFromRepo := errors.New("test error")
+
+	testcases := []struct {
+		name               string
+		args               args
+		mock               func(productRepo *mocks.MockProductRepo)
+		expectedCategories []*model.Category
+		expectedErr        error
+	}{
+		{
+			name: "Successfully get all categories",
+			args: args{
+				ctx: ctx,
+			},
+			mock: func(productRepo *mocks.MockProductRepo) {
+				productRepo.EXPECT().GetAllCategories(ctx).Return(expectedCategoryFromRepo, nil).Times(1)
+			},
+			expectedCategories: expectedCategoryFromRepo,
+			expectedErr:        nil,
+		},
+		{
+			name: "Got error when get all categories",
+			args: args{
+				ctx: ctx,
+			},
+			mock: func(productRepo *mocks.MockProductRepo) {
+				productRepo.EXPECT().GetAllCategories(ctx).Return(nil, expectedErrFromRepo).Times(1)
+			},
+			expectedCategories: nil,
+			expectedErr:        expectedErrFromRepo,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			productUsecase, repos := productHelper(t)
+			testcase.mock(repos.productRepo)
+
+			actualCategory, actualErr := productUsecase.GetAllCategories(
+				testcase.args.ctx,
+			)
+
+			assert.Equal(t, testcase.expectedCategories, actualCategory)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestCreateDiscount(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ctx      context.Context
+		discount model.Discount
+	}
+
+	ctx := context.Background()
+
+	productID := uuid.New()
+	testDiscount := model.Discount{
+		ProductID: productID,
+		Percent:   20,
+	}
+
+	expectedProductFromRepo := &model.Product{
+		ID:          productID,
+		Name:        "test",
+		Description: "test",
+	}
+	expectedDiscountFromRepo := &model.Discount{
+		ProductID: productID,
+		Percent:   20,
+	}
+	expectedErrFromRepo := errors.New("test error")
+
+	testcases := []struct {
+		name            string
+		args            args
+		mock            func(productRepo *mocks.MockProductRepo, discountRepo *mocks.MockDiscountRepo)
+		expectedProduct *model.Product
+		expectedErr     error
+	}{
+		{
+			name: "Successfully create discount",
+			args: args{
+				ctx:      ctx,
+				discount: testDiscount,
+			},
+			mock: func(productRepo *mocks.MockProductRepo, discountRepo *mocks.MockDiscountRepo) {
+				discountRepo.EXPECT().CreateDiscount(ctx, testDiscount).Return(nil).Times(1)
+				productRepo.EXPECT().GetProduct(ctx, productID).Return(expectedProductFromRepo, nil).Times(1)
+				discountRepo.EXPECT().GetProductDiscount(ctx, *expectedProductFromRepo).Return(expectedDiscountFromRepo, nil).Times(1)
+			},
+			expectedProduct: expectedProductFromRepo,
+			expectedErr:     nil,
+		},
+		{
+			name: "Got error when create discount",
+			args: args{
+				ctx:      ctx,
+				discount: testDiscount,
+			},
+			mock: func(productRepo *mocks.MockProductRepo, discountRepo *mocks.MockDiscountRepo) {
+				discountRepo.EXPECT().CreateDiscount(ctx, testDiscount).Return(expectedErrFromRepo).Times(1)
+			},
+			expectedProduct: nil,
+			expectedErr:     expectedErrFromRepo,
+		},
+		{
+			name: "Got error when get product",
+			args: args{
+				ctx:      ctx,
+				discount: testDiscount,
+			},
+			mock: func(productRepo *mocks.MockProductRepo, discountRepo *mocks.MockDiscountRepo) {
+				discountRepo.EXPECT().CreateDiscount(ctx, testDiscount).Return(nil).Times(1)
+				productRepo.EXPECT().GetProduct(ctx, productID).Return(nil, expectedErrFromRepo).Times(1)
+			},
+			expectedProduct: nil,
+			expectedErr:     expectedErrFromRepo,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			productUsecase, repos := productHelper(t)
+			testcase.mock(repos.productRepo, repos.discountRepo)
+
+			actualProduct, actualErr := productUsecase.CreateDiscount(
+				testcase.args.ctx,
+				testcase.args.discount,
+			)
+
+			assert.Equal(t, testcase.expectedProduct, actualProduct)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestDeleteDiscount(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ctx        context.Context
 		discountID uuid.UUID
 	}
 
@@ -677,6 +1054,11 @@ func TestDeleteDiscount(t *testing.T) {
 
 	productID := uuid.New()
 
+	productDiscounts := dto.SearchDiscountsDTO{
+		Scope:     pbProduct.DiscountScope_PRODUCT_DISCOUNT,
+		ProductID: productID,
+	}
+
 	expectedProductFromRepo := &model.Product{
 		ID:          productID,
 		Name:        "test",
@@ -702,9 +1084,9 @@ func TestDeleteDiscount(t *testing.T) {
 				discountID: productID,
 			},
 			mock: func(productRepo *mocks.MockProductRepo, discountRepo *mocks.MockDiscountRepo) {
-				discountRepo.EXPECT().DeleteDiscount(ctx, productID).Return(nil).Times(1)
+				discountRepo.EXPECT().EndDiscounts(ctx, productDiscounts).Return(nil).Times(1)
 				productRepo.EXPECT().GetProduct(ctx, productID).Return(expectedProductFromRepo, nil).Times(1)
-				discountRepo.EXPECT().GetDiscount(ctx, productID).Return(expectedDiscountFromRepo, nil).Times(1)
+				discountRepo.EXPECT().GetProductDiscount(ctx, *expectedProductFromRepo).Return(expectedDiscountFromRepo, nil).Times(1)
 			},
 			expectedProduct: expectedProductFromRepo,
 			expectedErr:     nil,
@@ -716,7 +1098,7 @@ func TestDeleteDiscount(t *testing.T) {
 				discountID: productID,
 			},
 			mock: func(productRepo *mocks.MockProductRepo, discountRepo *mocks.MockDiscountRepo) {
-				discountRepo.EXPECT().DeleteDiscount(ctx, productID).Return(expectedErrFromRepo).Times(1)
+				discountRepo.EXPECT().EndDiscounts(ctx, productDiscounts).Return(expectedErrFromRepo).Times(1)
 			},
 			expectedProduct: nil,
 			expectedErr:     expectedErrFromRepo,
@@ -728,7 +1110,7 @@ func TestDeleteDiscount(t *testing.T) {
 				discountID: productID,
 			},
 			mock: func(productRepo *mocks.MockProductRepo, discountRepo *mocks.MockDiscountRepo) {
-				discountRepo.EXPECT().DeleteDiscount(ctx, productID).Return(nil).Times(1)
+				discountRepo.EXPECT().EndDiscounts(ctx, productDiscounts).Return(nil).Times(1)
 				productRepo.EXPECT().GetProduct(ctx, productID).Return(nil, expectedErrFromRepo).Times(1)
 			},
 			expectedProduct: nil,
@@ -742,8 +1124,8 @@ func TestDeleteDiscount(t *testing.T) {
 		t.Run(testcase.name, func(t *testing.T) {
 			t.Parallel()
 
-			productUsecase, productRepo, discountRepo := productHelper(t)
-			testcase.mock(productRepo, discountRepo)
+			productUsecase, repos := productHelper(t)
+			testcase.mock(repos.productRepo, repos.discountRepo)
 
 			actualProduct, actualErr := productUsecase.DeleteDiscount(
 				testcase.args.ctx,
@@ -755,3 +1137,1381 @@ func TestDeleteDiscount(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateScopeDiscount(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ctx      context.Context
+		discount model.Discount
+	}
+
+	ctx := context.Background()
+
+	testDiscount := model.Discount{
+		ID:         uuid.New(),
+		Scope:      model.CategoryDiscount,
+		CategoryID: 1,
+		Percent:    10,
+	}
+
+	expectedDiscountFromRepo := &testDiscount
+	expectedErrFromRepo := errors.New("test error")
+
+	testcases := []struct {
+		name             string
+		args             args
+		mock             func(discountRepo *mocks.MockDiscountRepo)
+		expectedDiscount *model.Discount
+		expectedErr      error
+	}{
+		{
+			name: "Successfully create scope discount",
+			args: args{
+				ctx:      ctx,
+				discount: testDiscount,
+			},
+			mock: func(discountRepo *mocks.MockDiscountRepo) {
+				discountRepo.EXPECT().CreateDiscount(ctx, testDiscount).Return(nil).Times(1)
+				discountRepo.EXPECT().GetDiscount(ctx, testDiscount.ID).Return(expectedDiscountFromRepo, nil).Times(1)
+			},
+			expectedDiscount: expectedDiscountFromRepo,
+			expectedErr:      nil,
+		},
+		{
+			name: "Got error when create scope discount",
+			args: args{
+				ctx:      ctx,
+				discount: testDiscount,
+			},
+			mock: func(discountRepo *mocks.MockDiscountRepo) {
+				discountRepo.EXPECT().CreateDiscount(ctx, testDiscount).Return(expectedErrFromRepo).Times(1)
+			},
+			expectedDiscount: nil,
+			expectedErr:      expectedErrFromRepo,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			productUsecase, repos := productHelper(t)
+			testcase.mock(repos.discountRepo)
+
+			actualDiscount, actualErr := productUsecase.CreateScopeDiscount(
+				testcase.args.ctx,
+				testcase.args.discount,
+			)
+
+			assert.Equal(t, testcase.expectedDiscount, actualDiscount)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestGetDiscounts(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ctx          context.Context
+		searchParams dto.SearchDiscountsDTO
+	}
+
+	ctx := context.Background()
+
+	searchParams := dto.SearchDiscountsDTO{
+		Scope:          pbProduct.DiscountScope_SELLER_DISCOUNT,
+		SellerID:       uuid.New(),
+		IncludeExpired: true,
+	}
+
+	expectedDiscountsFromRepo := []*model.Discount{
+		{
+			ID:       uuid.New(),
+			Scope:    model.SellerDiscount,
+			SellerID: searchParams.SellerID,
+			Percent:  15,
+		},
+	}
+	expectedErrFromRepo := errors.New("test error")
+
+	testcases := []struct {
+		name              string
+		args              args
+		mock              func(discountRepo *mocks.MockDiscountRepo)
+		expectedDiscounts []*model.Discount
+		expectedErr       error
+	}{
+		{
+			name: "Successfully get discounts",
+			args: args{
+				ctx:          ctx,
+				searchParams: searchParams,
+			},
+			mock: func(discountRepo *mocks.MockDiscountRepo) {
+				discountRepo.EXPECT().GetDiscounts(ctx, searchParams).Return(expectedDiscountsFromRepo, nil).Times(1)
+			},
+			expectedDiscounts: expectedDiscountsFromRepo,
+			expectedErr:       nil,
+		},
+		{
+			name: "Got error when get discounts",
+			args: args{
+				ctx:          ctx,
+				searchParams: searchParams,
+			},
+			mock: func(discountRepo *mocks.MockDiscountRepo) {
+				discountRepo.EXPECT().GetDiscounts(ctx, searchParams).Return(nil, expectedErrFromRepo).Times(1)
+			},
+			expectedDiscounts: nil,
+			expectedErr:       expectedErrFromRepo,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			productUsecase, repos := productHelper(t)
+			testcase.mock(repos.discountRepo)
+
+			actualDiscounts, actualErr := productUsecase.GetDiscounts(
+				testcase.args.ctx,
+				testcase.args.searchParams,
+			)
+
+			assert.Equal(t, testcase.expectedDiscounts, actualDiscounts)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestEndDiscounts(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ctx          context.Context
+		searchParams dto.SearchDiscountsDTO
+	}
+
+	ctx := context.Background()
+
+	searchParams := dto.SearchDiscountsDTO{
+		Scope:      pbProduct.DiscountScope_CATEGORY_DISCOUNT,
+		CategoryID: 1,
+	}
+
+	expectedErrFromRepo := errors.New("test error")
+
+	testcases := []struct {
+		name        string
+		args        args
+		mock        func(discountRepo *mocks.MockDiscountRepo)
+		expectedErr error
+	}{
+		{
+			name: "Successfully end discounts",
+			args: args{
+				ctx:          ctx,
+				searchParams: searchParams,
+			},
+			mock: func(discountRepo *mocks.MockDiscountRepo) {
+				discountRepo.EXPECT().EndDiscounts(ctx, searchParams).Return(nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Got error when end discounts",
+			args: args{
+				ctx:          ctx,
+				searchParams: searchParams,
+			},
+			mock: func(discountRepo *mocks.MockDiscountRepo) {
+				discountRepo.EXPECT().EndDiscounts(ctx, searchParams).Return(expectedErrFromRepo).Times(1)
+			},
+			expectedErr: expectedErrFromRepo,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			productUsecase, repos := productHelper(t)
+			testcase.mock(repos.discountRepo)
+
+			actualErr := productUsecase.EndDiscounts(
+				testcase.args.ctx,
+				testcase.args.searchParams,
+			)
+
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestGetProductReviews(t *testing.T) {
+	type args struct {
+		ctx          context.Context
+		searchParams dto.SearchReviewsDTO
+	}
+
+	ctx := context.Background()
+
+	searchParams := dto.SearchReviewsDTO{
+		ProductID: uuid.New(),
+		Limit:     10,
+	}
+
+	expectedReviewsFromRepo := []*model.Review{
+		{
+			ID:        uuid.New(),
+			ProductID: searchParams.ProductID,
+			Rating:    5,
+		},
+	}
+	expectedErrFromRepo := errors.New("test error")
+
+	testcases := []struct {
+		name            string
+		args            args
+		mock            func(reviewRepo *mocks.MockReviewRepo)
+		expectedReviews []*model.Review
+		expectedErr     error
+	}{
+		{
+			name: "Successfully get product reviews",
+			args: args{
+				ctx:          ctx,
+				searchParams: searchParams,
+			},
+			mock: func(reviewRepo *mocks.MockReviewRepo) {
+				reviewRepo.EXPECT().GetReviews(ctx, searchParams).Return(expectedReviewsFromRepo, nil).Times(1)
+			},
+			expectedReviews: expectedReviewsFromRepo,
+			expectedErr:     nil,
+		},
+		{
+			name: "Got error when get product reviews",
+			args: args{
+				ctx:          ctx,
+				searchParams: searchParams,
+			},
+			mock: func(reviewRepo *mocks.MockReviewRepo) {
+				reviewRepo.EXPECT().GetReviews(ctx, searchParams).Return(nil, expectedErrFromRepo).Times(1)
+			},
+			expectedReviews: nil,
+			expectedErr:     expectedErrFromRepo,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			productUsecase, repos := productHelper(t)
+			testcase.mock(repos.reviewRepo)
+
+			actualReviews, actualErr := productUsecase.GetProductReviews(
+				testcase.args.ctx,
+				testcase.args.searchParams,
+			)
+
+			assert.Equal(t, testcase.expectedReviews, actualReviews)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestCreateReview(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		review model.Review
+	}
+
+	ctx := context.Background()
+
+	review := model.Review{
+		ID:        uuid.New(),
+		ProductID: uuid.New(),
+		UserID:    uuid.New(),
+		Rating:    4,
+		Comment:   "test",
+	}
+
+	expectedReviewFromRepo := &review
+	expectedErrFromRepo := errors.New("test error")
+
+	testcases := []struct {
+		name           string
+		args           args
+		mock           func(reviewRepo *mocks.MockReviewRepo)
+		expectedReview *model.Review
+		expectedErr    error
+	}{
+		{
+			name: "Successfully create review",
+			args: args{
+				ctx:    ctx,
+				review: review,
+			},
+			mock: func(reviewRepo *mocks.MockReviewRepo) {
+				reviewRepo.EXPECT().CreateReview(ctx, review).Return(nil).Times(1)
+				reviewRepo.EXPECT().GetReview(ctx, review.ID).Return(expectedReviewFromRepo, nil).Times(1)
+			},
+			expectedReview: expectedReviewFromRepo,
+			expectedErr:    nil,
+		},
+		{
+			name: "Got error when create review",
+			args: args{
+				ctx:    ctx,
+				review: review,
+			},
+			mock: func(reviewRepo *mocks.MockReviewRepo) {
+				reviewRepo.EXPECT().CreateReview(ctx, review).Return(expectedErrFromRepo).Times(1)
+			},
+			expectedReview: nil,
+			expectedErr:    expectedErrFromRepo,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			productUsecase, repos := productHelper(t)
+			testcase.mock(repos.reviewRepo)
+
+			actualReview, actualErr := productUsecase.CreateReview(
+				testcase.args.ctx,
+				testcase.args.review,
+			)
+
+			assert.Equal(t, testcase.expectedReview, actualReview)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestReplyReview(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		review model.Review
+	}
+
+	ctx := context.Background()
+
+	review := model.Review{
+		ID:        uuid.New(),
+		ProductID: uuid.New(),
+		UserID:    uuid.New(),
+		Rating:    4,
+		Reply:     "thanks",
+	}
+
+	expectedReviewFromRepo := &review
+	expectedErrFromRepo := errors.New("test error")
+
+	testcases := []struct {
+		name           string
+		args           args
+		mock           func(reviewRepo *mocks.MockReviewRepo)
+		expectedReview *model.Review
+		expectedErr    error
+	}{
+		{
+			name: "Successfully reply review",
+			args: args{
+				ctx:    ctx,
+				review: review,
+			},
+			mock: func(reviewRepo *mocks.MockReviewRepo) {
+				reviewRepo.EXPECT().UpdateReviewReply(ctx, review).Return(nil).Times(1)
+				reviewRepo.EXPECT().GetReview(ctx, review.ID).Return(expectedReviewFromRepo, nil).Times(1)
+			},
+			expectedReview: expectedReviewFromRepo,
+			expectedErr:    nil,
+		},
+		{
+			name: "Got error when reply review",
+			args: args{
+				ctx:    ctx,
+				review: review,
+			},
+			mock: func(reviewRepo *mocks.MockReviewRepo) {
+				reviewRepo.EXPECT().UpdateReviewReply(ctx, review).Return(expectedErrFromRepo).Times(1)
+			},
+			expectedReview: nil,
+			expectedErr:    expectedErrFromRepo,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			productUsecase, repos := productHelper(t)
+			testcase.mock(repos.reviewRepo)
+
+			actualReview, actualErr := productUsecase.ReplyReview(
+				testcase.args.ctx,
+				testcase.args.review,
+			)
+
+			assert.Equal(t, testcase.expectedReview, actualReview)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestCreateWarehouse(t *testing.T) {
+	type args struct {
+		ctx       context.Context
+		warehouse model.Warehouse
+	}
+
+	ctx := context.Background()
+
+	warehouse := model.Warehouse{
+		ID:     uuid.New(),
+		UserID: uuid.New(),
+		Name:   "test",
+	}
+
+	expectedWarehouseFromRepo := &warehouse
+	expectedErrFromRepo := errors.New("test error")
+
+	testcases := []struct {
+		name              string
+		args              args
+		mock              func(warehouseRepo *mocks.MockWarehouseRepo)
+		expectedWarehouse *model.Warehouse
+		expectedErr       error
+	}{
+		{
+			name: "Successfully create warehouse",
+			args: args{
+				ctx:       ctx,
+				warehouse: warehouse,
+			},
+			mock: func(warehouseRepo *mocks.MockWarehouseRepo) {
+				warehouseRepo.EXPECT().CreateWarehouse(ctx, warehouse).Return(nil).Times(1)
+				warehouseRepo.EXPECT().GetWarehouse(ctx, warehouse.ID).Return(expectedWarehouseFromRepo, nil).Times(1)
+			},
+			expectedWarehouse: expectedWarehouseFromRepo,
+			expectedErr:       nil,
+		},
+		{
+			name: "Got error when create warehouse",
+			args: args{
+				ctx:       ctx,
+				warehouse: warehouse,
+			},
+			mock: func(warehouseRepo *mocks.MockWarehouseRepo) {
+				warehouseRepo.EXPECT().CreateWarehouse(ctx, warehouse).Return(expectedErrFromRepo).Times(1)
+			},
+			expectedWarehouse: nil,
+			expectedErr:       expectedErrFromRepo,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			productUsecase, repos := productHelper(t)
+			testcase.mock(repos.warehouseRepo)
+
+			actualWarehouse, actualErr := productUsecase.CreateWarehouse(
+				testcase.args.ctx,
+				testcase.args.warehouse,
+			)
+
+			assert.Equal(t, testcase.expectedWarehouse, actualWarehouse)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestSetWarehouseStock(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		stock model.WarehouseStock
+	}
+
+	ctx := context.Background()
+
+	stock := model.WarehouseStock{
+		WarehouseID: uuid.New(),
+		ProductID:   uuid.New(),
+		Quantity:    10,
+	}
+
+	expectedStockFromRepo := &stock
+	expectedErrFromRepo := errors.New("test error")
+
+	testcases := []struct {
+		name          string
+		args          args
+		mock          func(warehouseRepo *mocks.MockWarehouseRepo)
+		expectedStock *model.WarehouseStock
+		expectedErr   error
+	}{
+		{
+			name: "Successfully set warehouse stock",
+			args: args{
+				ctx:   ctx,
+				stock: stock,
+			},
+			mock: func(warehouseRepo *mocks.MockWarehouseRepo) {
+				warehouseRepo.EXPECT().SetStock(ctx, stock).Return(nil).Times(1)
+				warehouseRepo.EXPECT().GetWarehouseStock(ctx, stock.WarehouseID, stock.ProductID).Return(expectedStockFromRepo, nil).Times(1)
+			},
+			expectedStock: expectedStockFromRepo,
+			expectedErr:   nil,
+		},
+		{
+			name: "Got error when set warehouse stock",
+			args: args{
+				ctx:   ctx,
+				stock: stock,
+			},
+			mock: func(warehouseRepo *mocks.MockWarehouseRepo) {
+				warehouseRepo.EXPECT().SetStock(ctx, stock).Return(expectedErrFromRepo).Times(1)
+			},
+			expectedStock: nil,
+			expectedErr:   expectedErrFromRepo,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			productUsecase, repos := productHelper(t)
+			testcase.mock(repos.warehouseRepo)
+
+			actualStock, actualErr := productUsecase.SetWarehouseStock(
+				testcase.args.ctx,
+				testcase.args.stock,
+			)
+
+			assert.Equal(t, testcase.expectedStock, actualStock)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestReserveProduct(t *testing.T) {
+	type args struct {
+		ctx         context.Context
+		reservation model.Reservation
+		strategy    pbProduct.AllocationStrategy
+		destination *model.Location
+	}
+
+	ctx := context.Background()
+
+	productID := uuid.New()
+	warehouseID := uuid.New()
+
+	reservation := model.Reservation{
+		ProductID: productID,
+		Quantity:  2,
+	}
+	reservationWithWarehouse := model.Reservation{
+		ProductID:   productID,
+		WarehouseID: warehouseID,
+		Quantity:    2,
+	}
+
+	stocks := []*model.WarehouseStock{
+		{
+			WarehouseID: uuid.New(),
+			ProductID:   productID,
+			Quantity:    5,
+		},
+		{
+			WarehouseID: warehouseID,
+			ProductID:   productID,
+			Quantity:    10,
+		},
+	}
+
+	expectedErrFromRepo := errors.New("test error")
+
+	testcases := []struct {
+		name                string
+		args                args
+		mock                func(warehouseRepo *mocks.MockWarehouseRepo)
+		expectedReservation *model.Reservation
+		expectedErr         error
+	}{
+		{
+			name: "Successfully reserve product from allocated warehouse",
+			args: args{
+				ctx:         ctx,
+				reservation: reservation,
+				strategy:    pbProduct.AllocationStrategy_MOST_STOCK,
+			},
+			mock: func(warehouseRepo *mocks.MockWarehouseRepo) {
+				warehouseRepo.EXPECT().GetProductStocks(ctx, productID).Return(stocks, nil).Times(1)
+				warehouseRepo.EXPECT().ReserveStock(ctx, reservationWithWarehouse).Return(nil).Times(1)
+			},
+			expectedReservation: &reservationWithWarehouse,
+			expectedErr:         nil,
+		},
+		{
+			name: "Successfully reserve product from given warehouse",
+			args: args{
+				ctx:         ctx,
+				reservation: reservationWithWarehouse,
+				strategy:    pbProduct.AllocationStrategy_NEAREST,
+			},
+			mock: func(warehouseRepo *mocks.MockWarehouseRepo) {
+				warehouseRepo.EXPECT().ReserveStock(ctx, reservationWithWarehouse).Return(nil).Times(1)
+			},
+			expectedReservation: &reservationWithWarehouse,
+			expectedErr:         nil,
+		},
+		{
+			name: "No warehouse has enough stock",
+			args: args{
+				ctx: ctx,
+				reservation: model.Reservation{
+					ProductID: productID,
+					Quantity:  100,
+				},
+				strategy: pbProduct.AllocationStrategy_MOST_STOCK,
+			},
+			mock: func(warehouseRepo *mocks.MockWarehouseRepo) {
+				warehouseRepo.EXPECT().GetProductStocks(ctx, productID).Return(stocks, nil).Times(1)
+			},
+			expectedReservation: nil,
+			expectedErr:         model.ErrInsufficientStock,
+		},
+		{
+			name: "Got error when reserve stock",
+			args: args{
+				ctx:         ctx,
+				reservation: reservationWithWarehouse,
+				strategy:    pbProduct.AllocationStrategy_MOST_STOCK,
+			},
+			mock: func(warehouseRepo *mocks.MockWarehouseRepo) {
+				warehouseRepo.EXPECT().ReserveStock(ctx, reservationWithWarehouse).Return(expectedErrFromRepo).Times(1)
+			},
+			expectedReservation: nil,
+			expectedErr:         expectedErrFromRepo,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			productUsecase, repos := productHelper(t)
+			testcase.mock(repos.warehouseRepo)
+
+			actualReservation, actualErr := productUsecase.ReserveProduct(
+				testcase.args.ctx,
+				testcase.args.reservation,
+				testcase.args.strategy,
+				testcase.args.destination,
+			)
+
+			assert.Equal(t, testcase.expectedReservation, actualReservation)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestReleaseProduct(t *testing.T) {
+	type args struct {
+		ctx         context.Context
+		reservation model.Reservation
+	}
+
+	ctx := context.Background()
+
+	warehouseID := uuid.New()
+	reservation := model.Reservation{
+		ProductID: uuid.New(),
+		Quantity:  2,
+	}
+
+	expectedErrFromRepo := errors.New("test error")
+
+	testcases := []struct {
+		name                string
+		args                args
+		mock                func(warehouseRepo *mocks.MockWarehouseRepo)
+		expectedReservation *model.Reservation
+		expectedErr         error
+	}{
+		{
+			name: "Successfully release product",
+			args: args{
+				ctx:         ctx,
+				reservation: reservation,
+			},
+			mock: func(warehouseRepo *mocks.MockWarehouseRepo) {
+				warehouseRepo.EXPECT().ReleaseStock(ctx, reservation).Return(warehouseID, nil).Times(1)
+			},
+			expectedReservation: &model.Reservation{
+				ProductID:   reservation.ProductID,
+				WarehouseID: warehouseID,
+				Quantity:    reservation.Quantity,
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Got error when release product",
+			args: args{
+				ctx:         ctx,
+				reservation: reservation,
+			},
+			mock: func(warehouseRepo *mocks.MockWarehouseRepo) {
+				warehouseRepo.EXPECT().ReleaseStock(ctx, reservation).Return(uuid.Nil, expectedErrFromRepo).Times(1)
+			},
+			expectedReservation: nil,
+			expectedErr:         expectedErrFromRepo,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			productUsecase, repos := productHelper(t)
+			testcase.mock(repos.warehouseRepo)
+
+			actualReservation, actualErr := productUsecase.ReleaseProduct(
+				testcase.args.ctx,
+				testcase.args.reservation,
+			)
+
+			assert.Equal(t, testcase.expectedReservation, actualReservation)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestGetNotifications(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ctx          context.Context
+		searchParams dto.SearchNotificationsDTO
+	}
+
+	ctx := context.Background()
+
+	searchParams := dto.SearchNotificationsDTO{
+		UserID: uuid.New(),
+		Limit:  10,
+	}
+
+	productID := uuid.New()
+	expectedNotificationsFromRepo := []*model.Notification{
+		{
+			ID:        uuid.New(),
+			UserID:    searchParams.UserID,
+			ProductID: &productID,
+			Kind:      model.OutOfStock,
+		},
+	}
+	expectedErrFromRepo := errors.New("test error")
+
+	testcases := []struct {
+		name                  string
+		args                  args
+		mock                  func(notificationRepo *mocks.MockNotificationRepo)
+		expectedNotifications []*model.Notification
+		expectedErr           error
+	}{
+		{
+			name: "Successfully get notifications",
+			args: args{
+				ctx:          ctx,
+				searchParams: searchParams,
+			},
+			mock: func(notificationRepo *mocks.MockNotificationRepo) {
+				notificationRepo.EXPECT().GetNotifications(ctx, searchParams).Return(expectedNotificationsFromRepo, nil).Times(1)
+			},
+			expectedNotifications: expectedNotificationsFromRepo,
+			expectedErr:           nil,
+		},
+		{
+			name: "Got error when get notifications",
+			args: args{
+				ctx:          ctx,
+				searchParams: searchParams,
+			},
+			mock: func(notificationRepo *mocks.MockNotificationRepo) {
+				notificationRepo.EXPECT().GetNotifications(ctx, searchParams).Return(nil, expectedErrFromRepo).Times(1)
+			},
+			expectedNotifications: nil,
+			expectedErr:           expectedErrFromRepo,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			productUsecase, repos := productHelper(t)
+			testcase.mock(repos.notificationRepo)
+
+			actualNotifications, actualErr := productUsecase.GetNotifications(
+				testcase.args.ctx,
+				testcase.args.searchParams,
+			)
+
+			assert.Equal(t, testcase.expectedNotifications, actualNotifications)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestCreateNotification(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ctx          context.Context
+		notification model.Notification
+	}
+
+	ctx := context.Background()
+
+	notification := model.Notification{
+		ID:      uuid.New(),
+		UserID:  uuid.New(),
+		Kind:    model.CartExpiring,
+		Message: "Your cart expires soon",
+	}
+	expectedErrFromRepo := errors.New("test error")
+
+	testcases := []struct {
+		name        string
+		args        args
+		mock        func(notificationRepo *mocks.MockNotificationRepo)
+		expectedErr error
+	}{
+		{
+			name: "Successfully create notification",
+			args: args{
+				ctx:          ctx,
+				notification: notification,
+			},
+			mock: func(notificationRepo *mocks.MockNotificationRepo) {
+				notificationRepo.EXPECT().CreateNotification(ctx, notification).Return(nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Got error when create notification",
+			args: args{
+				ctx:          ctx,
+				notification: notification,
+			},
+			mock: func(notificationRepo *mocks.MockNotificationRepo) {
+				notificationRepo.EXPECT().CreateNotification(ctx, notification).Return(expectedErrFromRepo).Times(1)
+			},
+			expectedErr: expectedErrFromRepo,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			productUsecase, repos := productHelper(t)
+			testcase.mock(repos.notificationRepo)
+
+			actualErr := productUsecase.CreateNotification(
+				testcase.args.ctx,
+				testcase.args.notification,
+			)
+
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestGetProductHistory(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ctx          context.Context
+		searchParams dto.SearchRevisionsDTO
+	}
+
+	ctx := context.Background()
+
+	searchParams := dto.SearchRevisionsDTO{
+		ProductID: uuid.New(),
+		Limit:     10,
+	}
+
+	expectedRevisionsFromRepo := []*model.ProductRevision{
+		{
+			ID:        uuid.New(),
+			ProductID: searchParams.ProductID,
+			Action:    model.RevisionUpdated,
+			Changes: map[string]model.FieldChange{
+				"price": {Old: "100", New: "90"},
+			},
+		},
+	}
+	expectedErrFromRepo := errors.New("test error")
+
+	testcases := []struct {
+		name              string
+		args              args
+		mock              func(revisionRepo *mocks.MockRevisionRepo)
+		expectedRevisions []*model.ProductRevision
+		expectedErr       error
+	}{
+		{
+			name: "Successfully get product history",
+			args: args{
+				ctx:          ctx,
+				searchParams: searchParams,
+			},
+			mock: func(revisionRepo *mocks.MockRevisionRepo) {
+				revisionRepo.EXPECT().GetRevisions(ctx, searchParams).Return(expectedRevisionsFromRepo, nil).Times(1)
+			},
+			expectedRevisions: expectedRevisionsFromRepo,
+			expectedErr:       nil,
+		},
+		{
+			name: "Got error when get product history",
+			args: args{
+				ctx:          ctx,
+				searchParams: searchParams,
+			},
+			mock: func(revisionRepo *mocks.MockRevisionRepo) {
+				revisionRepo.EXPECT().GetRevisions(ctx, searchParams).Return(nil, expectedErrFromRepo).Times(1)
+			},
+			expectedRevisions: nil,
+			expectedErr:       expectedErrFromRepo,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			productUsecase, repos := productHelper(t)
+			testcase.mock(repos.revisionRepo)
+
+			actualRevisions, actualErr := productUsecase.GetProductHistory(
+				testcase.args.ctx,
+				testcase.args.searchParams,
+			)
+
+			assert.Equal(t, testcase.expectedRevisions, actualRevisions)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestGetPriceHistory(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ctx       context.Context
+		productID uuid.UUID
+	}
+
+	ctx := context.Background()
+	productID := uuid.New()
+
+	expectedPointsFromRepo := []*model.PricePoint{
+		{Price: 100},
+		{Price: 90},
+	}
+	expectedErrFromRepo := errors.New("test error")
+
+	testcases := []struct {
+		name           string
+		args           args
+		mock           func(revisionRepo *mocks.MockRevisionRepo)
+		expectedPoints []*model.PricePoint
+		expectedErr    error
+	}{
+		{
+			name: "Successfully get price history",
+			args: args{
+				ctx:       ctx,
+				productID: productID,
+			},
+			mock: func(revisionRepo *mocks.MockRevisionRepo) {
+				revisionRepo.EXPECT().GetPriceHistory(ctx, productID).Return(expectedPointsFromRepo, nil).Times(1)
+			},
+			expectedPoints: expectedPointsFromRepo,
+			expectedErr:    nil,
+		},
+		{
+			name: "Got error when get price history",
+			args: args{
+				ctx:       ctx,
+				productID: productID,
+			},
+			mock: func(revisionRepo *mocks.MockRevisionRepo) {
+				revisionRepo.EXPECT().GetPriceHistory(ctx, productID).Return(nil, expectedErrFromRepo).Times(1)
+			},
+			expectedPoints: nil,
+			expectedErr:    expectedErrFromRepo,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			productUsecase, repos := productHelper(t)
+			testcase.mock(repos.revisionRepo)
+
+			actualPoints, actualErr := productUsecase.GetPriceHistory(
+				testcase.args.ctx,
+				testcase.args.productID,
+			)
+
+			assert.Equal(t, testcase.expectedPoints, actualPoints)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestSetSellerProfile(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ctx     context.Context
+		profile model.SellerProfile
+	}
+
+	ctx := context.Background()
+
+	userID := uuid.New()
+	testProfile := model.SellerProfile{
+		UserID:      userID,
+		DisplayName: "test",
+	}
+
+	expectedProfileFromRepo := &model.SellerProfile{
+		UserID:       userID,
+		DisplayName:  "test",
+		Rating:       4.5,
+		ReviewsCount: 2,
+	}
+	expectedErrFromRepo := errors.New("test error")
+
+	testcases := []struct {
+		name            string
+		args            args
+		mock            func(sellerRepo *mocks.MockSellerRepo)
+		expectedProfile *model.SellerProfile
+		expectedErr     error
+	}{
+		{
+			name: "Successfully set seller profile",
+			args: args{
+				ctx:     ctx,
+				profile: testProfile,
+			},
+			mock: func(sellerRepo *mocks.MockSellerRepo) {
+				sellerRepo.EXPECT().SetSellerProfile(ctx, testProfile).Return(nil).Times(1)
+				sellerRepo.EXPECT().GetSellerProfile(ctx, userID).Return(expectedProfileFromRepo, nil).Times(1)
+			},
+			expectedProfile: expectedProfileFromRepo,
+			expectedErr:     nil,
+		},
+		{
+			name: "Got error when set seller profile",
+			args: args{
+				ctx:     ctx,
+				profile: testProfile,
+			},
+			mock: func(sellerRepo *mocks.MockSellerRepo) {
+				sellerRepo.EXPECT().SetSellerProfile(ctx, testProfile).Return(expectedErrFromRepo).Times(1)
+			},
+			expectedProfile: nil,
+			expectedErr:     expectedErrFromRepo,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			productUsecase, repos := productHelper(t)
+			testcase.mock(repos.sellerRepo)
+
+			actualProfile, actualErr := productUsecase.SetSellerProfile(
+				testcase.args.ctx,
+				testcase.args.profile,
+			)
+
+			assert.Equal(t, testcase.expectedProfile, actualProfile)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestCreateWishlist(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ctx      context.Context
+		wishlist model.Wishlist
+	}
+
+	ctx := context.Background()
+
+	testWishlist := model.Wishlist{
+		ID:     uuid.New(),
+		UserID: uuid.New(),
+		Name:   model.SavedForLater,
+	}
+
+	expectedWishlistFromRepo := &model.Wishlist{
+		ID:     testWishlist.ID,
+		UserID: testWishlist.UserID,
+		Name:   model.SavedForLater,
+		Items:  []*model.WishlistItem{},
+	}
+	expectedErrFromRepo := errors.New("test error")
+
+	testcases := []struct {
+		name             string
+		args             args
+		mock             func(wishlistRepo *mocks.MockWishlistRepo)
+		expectedWishlist *model.Wishlist
+		expectedErr      error
+	}{
+		{
+			name: "Successfully create wishlist",
+			args: args{
+				ctx:      ctx,
+				wishlist: testWishlist,
+			},
+			mock: func(wishlistRepo *mocks.MockWishlistRepo) {
+				wishlistRepo.EXPECT().CreateWishlist(ctx, testWishlist).Return(nil).Times(1)
+				wishlistRepo.EXPECT().GetWishlist(ctx, testWishlist.ID).Return(expectedWishlistFromRepo, nil).Times(1)
+			},
+			expectedWishlist: expectedWishlistFromRepo,
+			expectedErr:      nil,
+		},
+		{
+			name: "Got error when create wishlist",
+			args: args{
+				ctx:      ctx,
+				wishlist: testWishlist,
+			},
+			mock: func(wishlistRepo *mocks.MockWishlistRepo) {
+				wishlistRepo.EXPECT().CreateWishlist(ctx, testWishlist).Return(expectedErrFromRepo).Times(1)
+			},
+			expectedWishlist: nil,
+			expectedErr:      expectedErrFromRepo,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			productUsecase, repos := productHelper(t)
+			testcase.mock(repos.wishlistRepo)
+
+			actualWishlist, actualErr := productUsecase.CreateWishlist(
+				testcase.args.ctx,
+				testcase.args.wishlist,
+			)
+
+			assert.Equal(t, testcase.expectedWishlist, actualWishlist)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestChangeReservations(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	productID := uuid.New()
+	otherProductID := uuid.New()
+	warehouseID := uuid.New()
+	otherWarehouseID := uuid.New()
+
+	stocks := []*model.WarehouseStock{
+		{
+			WarehouseID: otherWarehouseID,
+			ProductID:   productID,
+			Quantity:    5,
+		},
+		{
+			WarehouseID: warehouseID,
+			ProductID:   productID,
+			Quantity:    10,
+		},
+	}
+
+	expectedErrFromRepo := errors.New("test error")
+
+	testcases := []struct {
+		name            string
+		changes         func() []*model.ReservationChange
+		mock            func(warehouseRepo *mocks.MockWarehouseRepo)
+		expectedChanges []*model.ReservationChange
+		expectedErr     error
+	}{
+		{
+			name: "Successfully allocate reservation and release product",
+			changes: func() []*model.ReservationChange {
+				return []*model.ReservationChange{
+					{ProductID: productID, Quantity: 2, Strategy: pbProduct.AllocationStrategy_MOST_STOCK},
+					{ProductID: otherProductID, WarehouseID: otherWarehouseID, Quantity: -3},
+				}
+			},
+			mock: func(warehouseRepo *mocks.MockWarehouseRepo) {
+				warehouseRepo.EXPECT().GetProductStocks(ctx, productID).Return(stocks, nil).Times(1)
+				warehouseRepo.EXPECT().ChangeStocks(ctx, []*model.ReservationChange{
+					{ProductID: productID, WarehouseID: warehouseID, Quantity: 2, Strategy: pbProduct.AllocationStrategy_MOST_STOCK},
+					{ProductID: otherProductID, WarehouseID: otherWarehouseID, Quantity: -3},
+				}).Return(nil).Times(1)
+			},
+			expectedChanges: []*model.ReservationChange{
+				{ProductID: productID, WarehouseID: warehouseID, Quantity: 2, Strategy: pbProduct.AllocationStrategy_MOST_STOCK},
+				{ProductID: otherProductID, WarehouseID: otherWarehouseID, Quantity: -3},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "No warehouse has enough stock",
+			changes: func() []*model.ReservationChange {
+				return []*model.ReservationChange{
+					{ProductID: productID, Quantity: 100},
+				}
+			},
+			mock: func(warehouseRepo *mocks.MockWarehouseRepo) {
+				warehouseRepo.EXPECT().GetProductStocks(ctx, productID).Return(stocks, nil).Times(1)
+			},
+			expectedChanges: nil,
+			expectedErr:     model.ErrInsufficientStock,
+		},
+		{
+			name: "Got error when change stocks",
+			changes: func() []*model.ReservationChange {
+				return []*model.ReservationChange{
+					{ProductID: productID, WarehouseID: warehouseID, Quantity: 2},
+				}
+			},
+			mock: func(warehouseRepo *mocks.MockWarehouseRepo) {
+				warehouseRepo.EXPECT().ChangeStocks(ctx, gomock.Any()).Return(expectedErrFromRepo).Times(1)
+			},
+			expectedChanges: nil,
+			expectedErr:     expectedErrFromRepo,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			productUsecase, repos := productHelper(t)
+			testcase.mock(repos.warehouseRepo)
+
+			actualChanges, actualErr := productUsecase.ChangeReservations(ctx, testcase.changes())
+
+			assert.Equal(t, testcase.expectedChanges, actualChanges)
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
+
+func TestSetExchangeRates(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ctx   context.Context
+		rates []*model.ExchangeRate
+	}
+
+	ctx := context.Background()
+
+	testRates := []*model.ExchangeRate{
+		{Currency: "USD", Rate: 90},
+		{Currency: "EUR", Rate: 100},
+	}
+	expectedErrFromRepo := errors.New("test error")
+
+	testcases := []struct {
+		name        string
+		args        args
+		mock        func(currencyRepo *mocks.MockCurrencyRepo)
+		expectedErr error
+	}{
+		{
+			name: "Successfully set exchange rates",
+			args: args{
+				ctx:   ctx,
+				rates: testRates,
+			},
+			mock: func(currencyRepo *mocks.MockCurrencyRepo) {
+				currencyRepo.EXPECT().SetExchangeRates(ctx, testRates).Return(nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Got error when set exchange rates",
+			args: args{
+				ctx:   ctx,
+				rates: testRates,
+			},
+			mock: func(currencyRepo *mocks.MockCurrencyRepo) {
+				currencyRepo.EXPECT().SetExchangeRates(ctx, testRates).Return(expectedErrFromRepo).Times(1)
+			},
+			expectedErr: expectedErrFromRepo,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			productUsecase, repos := productHelper(t)
+			testcase.mock(repos.currencyRepo)
+
+			actualErr := productUsecase.SetExchangeRates(testcase.args.ctx, testcase.args.rates)
+
+			assert.Equal(t, testcase.expectedErr, actualErr)
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/Go-Marketplace/backend/pkg/logger"
+	"github.com/Go-Marketplace/backend/product/internal/usecase"
+	pbCart "github.com/Go-Marketplace/backend/proto/gen/cart"
+	"gopkg.in/tomb.v2"
+)
+
+type PurgeWorkerConfig struct {
+	// How long a deleted product can still be restored
+	Retention           time.Duration
+	PurgeWorkerInterval time.Duration
+}
+
+type purgeWorker struct {
+	tomb           tomb.Tomb
+	productUsecase *usecase.ProductUsecase
+	cartClient     pbCart.CartClient
+	config         PurgeWorkerConfig
+	logger         *logger.Logger
+}
+
+func NewPurgeWorker(
+	productUsecase *usecase.ProductUsecase,
+	cartClient pbCart.CartClient,
+	config PurgeWorkerConfig,
+	logger *logger.Logger,
+) *purgeWorker {
+	return &purgeWorker{
+		tomb:           tomb.Tomb{},
+		productUsecase: productUsecase,
+		cartClient:     cartClient,
+		config:         config,
+		logger:         logger,
+	}
+}
+
+func (worker *purgeWorker) Run(ctx context.Context) {
+	worker.logger.Info("Start purge worker with %v interval, retention: %v", worker.config.PurgeWorkerInterval, worker.config.Retention)
+
+	worker.tomb.Go(func() error {
+		ticker := time.NewTicker(worker.config.PurgeWorkerInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-worker.tomb.Dying():
+				worker.logger.Info("Stop running purge worker")
+				return nil
+
+			case <-ticker.C:
+				products, err := worker.productUsecase.GetDeletedProducts(ctx, time.Now().Add(-worker.config.Retention))
+				if err != nil {
+					worker.logger.Error("Cannot get deleted products: %s", err.Error())
+					continue
+				}
+
+				if len(products) != 0 {
+					worker.logger.Info("Purging %d deleted products", len(products))
+				}
+
+				for _, product := range products {
+					// The cartlines and their reservations are removed when the product is deleted and
+					// a deleted product can't be reserved, this only clears the lines left by a failure
+					if _, err = worker.cartClient.DeleteProductCartlines(ctx, &pbCart.DeleteProductCartlinesRequest{
+						ProductId: product.ID.String(),
+					}); err != nil {
+						worker.logger.Error("failed to delete product %v cartlines: %s", product.ID, err.Error())
+						continue
+					}
+
+					if err = worker.productUsecase.PurgeProduct(ctx, product.ID); err != nil {
+						worker.logger.Error("failed to purge product %v: %s", product.ID, err.Error())
+						continue
+					}
+				}
+			}
+		}
+	})
+}
+
+func (worker *purgeWorker) Stop() error {
+	worker.logger.Info("Stop purge worker")
+	worker.tomb.Kill(nil)
+	return worker.tomb.Wait()
+}
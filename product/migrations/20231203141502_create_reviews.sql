@@ -0,0 +1,37 @@
+-- +goose Up
+ALTER TABLE products
+    ADD COLUMN IF NOT EXISTS rating REAL NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS reviews_count BIGINT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS reviews (
+    review_id UUID NOT NULL PRIMARY KEY,
+    product_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    rating INTEGER NOT NULL,
+    comment TEXT NOT NULL,
+    reply TEXT NOT NULL DEFAULT '',
+    replied_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+
+    UNIQUE (product_id, user_id),
+    CHECK (rating BETWEEN 1 AND 5),
+    FOREIGN KEY (product_id) REFERENCES products(product_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS reviews_product_id_idx ON reviews (product_id, created_at);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE IF EXISTS reviews;
+
+ALTER TABLE products
+    DROP COLUMN IF EXISTS rating,
+    DROP COLUMN IF EXISTS reviews_count;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- +goose Up
+ALTER TABLE products
+    ADD COLUMN IF NOT EXISTS moderation_status INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS rejection_reason TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS moderated_by UUID,
+    ADD COLUMN IF NOT EXISTS moderated_at TIMESTAMP;
+
+UPDATE products SET moderation_status = CASE WHEN moderated THEN 2 ELSE 1 END;
+
+ALTER TABLE products DROP COLUMN IF EXISTS moderated;
+
+CREATE INDEX IF NOT EXISTS products_moderation_status_idx ON products (moderation_status, updated_at);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP INDEX IF EXISTS products_moderation_status_idx;
+
+ALTER TABLE products ADD COLUMN IF NOT EXISTS moderated BOOL NOT NULL DEFAULT false;
+
+UPDATE products SET moderated = moderation_status = 2;
+
+ALTER TABLE products
+    DROP COLUMN IF EXISTS moderation_status,
+    DROP COLUMN IF EXISTS rejection_reason,
+    DROP COLUMN IF EXISTS moderated_by,
+    DROP COLUMN IF EXISTS moderated_at;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+ALTER TABLE products ADD COLUMN IF NOT EXISTS external_sku TEXT NOT NULL DEFAULT '';
+
+CREATE UNIQUE INDEX IF NOT EXISTS products_user_id_external_sku_idx ON products (user_id, external_sku) WHERE external_sku <> '';
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP INDEX IF EXISTS products_user_id_external_sku_idx;
+
+ALTER TABLE products DROP COLUMN IF EXISTS external_sku;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS discounts (
+    discount_id UUID NOT NULL PRIMARY KEY,
+    scope INTEGER NOT NULL,
+    product_id UUID,
+    category_id INTEGER,
+    seller_id UUID,
+    percent REAL NOT NULL,
+    priority INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL,
+    starts_at TIMESTAMP NOT NULL,
+    ends_at TIMESTAMP NOT NULL,
+
+    CHECK (percent > 0 AND percent <= 100),
+    CHECK (ends_at >= starts_at),
+    FOREIGN KEY (product_id) REFERENCES products(product_id) ON DELETE CASCADE,
+    FOREIGN KEY (category_id) REFERENCES categories(category_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS discounts_product_id_idx ON discounts (product_id, ends_at);
+CREATE INDEX IF NOT EXISTS discounts_category_id_idx ON discounts (category_id, ends_at);
+CREATE INDEX IF NOT EXISTS discounts_seller_id_idx ON discounts (seller_id, ends_at);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE IF EXISTS discounts;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
@@ -0,0 +1,82 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS warehouses (
+    warehouse_id UUID NOT NULL PRIMARY KEY,
+    user_id UUID NOT NULL,
+    name TEXT NOT NULL,
+    address TEXT NOT NULL DEFAULT '',
+    latitude DOUBLE PRECISION NOT NULL DEFAULT 0,
+    longitude DOUBLE PRECISION NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS warehouses_user_id_idx ON warehouses (user_id, created_at);
+
+CREATE TABLE IF NOT EXISTS warehouse_stocks (
+    warehouse_id UUID NOT NULL,
+    product_id UUID NOT NULL,
+    quantity BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (warehouse_id, product_id),
+    CHECK (quantity >= 0),
+    FOREIGN KEY (warehouse_id) REFERENCES warehouses(warehouse_id) ON DELETE CASCADE,
+    FOREIGN KEY (product_id) REFERENCES products(product_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS warehouse_stocks_product_id_idx ON warehouse_stocks (product_id);
+
+-- Every seller gets a default warehouse holding the stock they had before
+INSERT INTO warehouses (warehouse_id, user_id, name, created_at)
+SELECT gen_random_uuid(), user_id, 'Default', now()
+FROM (SELECT DISTINCT user_id FROM products) AS sellers;
+
+INSERT INTO warehouse_stocks (warehouse_id, product_id, quantity, updated_at)
+SELECT warehouses.warehouse_id, products.product_id, products.quantity, now()
+FROM products
+JOIN warehouses USING (user_id);
+
+-- products.quantity is kept equal to the sum of the product stocks
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION sync_product_quantity() RETURNS TRIGGER AS $$
+DECLARE
+    stock_product_id UUID;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        stock_product_id := OLD.product_id;
+    ELSE
+        stock_product_id := NEW.product_id;
+    END IF;
+
+    UPDATE products
+    SET quantity = (
+        SELECT COALESCE(SUM(quantity), 0)
+        FROM warehouse_stocks
+        WHERE product_id = stock_product_id
+    )
+    WHERE product_id = stock_product_id;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER warehouse_stocks_sync_product_quantity
+AFTER INSERT OR UPDATE OR DELETE ON warehouse_stocks
+FOR EACH ROW EXECUTE FUNCTION sync_product_quantity();
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TRIGGER IF EXISTS warehouse_stocks_sync_product_quantity ON warehouse_stocks;
+
+DROP FUNCTION IF EXISTS sync_product_quantity();
+
+DROP TABLE IF EXISTS warehouse_stocks;
+
+DROP TABLE IF EXISTS warehouses;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
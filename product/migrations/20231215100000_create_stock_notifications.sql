@@ -0,0 +1,63 @@
+-- +goose Up
+ALTER TABLE products ADD COLUMN IF NOT EXISTS low_stock_threshold BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE products ADD COLUMN IF NOT EXISTS hide_when_out_of_stock BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS notifications (
+    notification_id UUID NOT NULL PRIMARY KEY,
+    user_id UUID NOT NULL,
+    product_id UUID,
+    kind SMALLINT NOT NULL,
+    quantity BIGINT NOT NULL DEFAULT 0,
+    message TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+
+    FOREIGN KEY (product_id) REFERENCES products(product_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS notifications_user_id_idx ON notifications (user_id, created_at DESC);
+
+-- Every change of products.quantity, whatever path it comes from, is checked
+-- against the low-stock threshold. Kinds: 0 - low stock, 1 - out of stock
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION notify_stock_level() RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.quantity = 0 AND OLD.quantity > 0 THEN
+        INSERT INTO notifications (notification_id, user_id, product_id, kind, quantity, message, created_at)
+        VALUES (gen_random_uuid(), NEW.user_id, NEW.product_id, 1, NEW.quantity,
+            format('Product "%s" is out of stock', NEW.name), now());
+    ELSIF NEW.low_stock_threshold > 0
+        AND NEW.quantity <= NEW.low_stock_threshold
+        AND OLD.quantity > NEW.low_stock_threshold THEN
+        INSERT INTO notifications (notification_id, user_id, product_id, kind, quantity, message, created_at)
+        VALUES (gen_random_uuid(), NEW.user_id, NEW.product_id, 0, NEW.quantity,
+            format('Product "%s" is low on stock: %s left', NEW.name, NEW.quantity), now());
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER products_notify_stock_level
+AFTER UPDATE OF quantity ON products
+FOR EACH ROW
+WHEN (OLD.quantity IS DISTINCT FROM NEW.quantity)
+EXECUTE FUNCTION notify_stock_level();
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TRIGGER IF EXISTS products_notify_stock_level ON products;
+
+DROP FUNCTION IF EXISTS notify_stock_level();
+
+DROP TABLE IF EXISTS notifications;
+
+ALTER TABLE products DROP COLUMN IF EXISTS hide_when_out_of_stock;
+ALTER TABLE products DROP COLUMN IF EXISTS low_stock_threshold;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
@@ -0,0 +1,49 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS product_revisions (
+    revision_id UUID NOT NULL PRIMARY KEY,
+    product_id UUID NOT NULL,
+    actor_id UUID,
+    action SMALLINT NOT NULL,
+    changes JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS product_revisions_product_id_idx ON product_revisions (product_id, created_at);
+
+-- Revisions are append-only and outlive the product they describe
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION forbid_revision_change() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'product_revisions is append-only';
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER product_revisions_append_only
+BEFORE UPDATE OR DELETE ON product_revisions
+FOR EACH ROW EXECUTE FUNCTION forbid_revision_change();
+
+-- Existing products start their history with a creation revision
+INSERT INTO product_revisions (revision_id, product_id, actor_id, action, changes, created_at)
+SELECT gen_random_uuid(), product_id, user_id, 0,
+    jsonb_build_object(
+        'name', jsonb_build_object('old', '', 'new', name),
+        'price', jsonb_build_object('old', '0', 'new', price::TEXT)
+    ),
+    created_at
+FROM products;
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TRIGGER IF EXISTS product_revisions_append_only ON product_revisions;
+
+DROP FUNCTION IF EXISTS forbid_revision_change();
+
+DROP TABLE IF EXISTS product_revisions;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
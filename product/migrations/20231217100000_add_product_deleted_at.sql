@@ -0,0 +1,28 @@
+-- +goose Up
+ALTER TABLE products ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS products_deleted_at_idx ON products (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- A deleted product keeps its sku until it is purged, so only live products have to be unique
+DROP INDEX IF EXISTS products_user_id_external_sku_idx;
+
+CREATE UNIQUE INDEX IF NOT EXISTS products_user_id_external_sku_idx ON products (user_id, external_sku) WHERE external_sku <> '' AND deleted_at IS NULL;
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DELETE FROM products WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS products_user_id_external_sku_idx;
+
+CREATE UNIQUE INDEX IF NOT EXISTS products_user_id_external_sku_idx ON products (user_id, external_sku) WHERE external_sku <> '';
+
+DROP INDEX IF EXISTS products_deleted_at_idx;
+
+ALTER TABLE products DROP COLUMN IF EXISTS deleted_at;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
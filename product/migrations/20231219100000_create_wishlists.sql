@@ -0,0 +1,103 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS wishlists (
+    wishlist_id UUID NOT NULL PRIMARY KEY,
+    user_id UUID NOT NULL,
+    name TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+
+    UNIQUE (user_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS wishlist_items (
+    wishlist_id UUID NOT NULL,
+    product_id UUID NOT NULL,
+    added_at TIMESTAMP NOT NULL,
+
+    PRIMARY KEY (wishlist_id, product_id),
+    FOREIGN KEY (wishlist_id) REFERENCES wishlists(wishlist_id) ON DELETE CASCADE,
+    FOREIGN KEY (product_id) REFERENCES products(product_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS wishlist_items_product_id_idx ON wishlist_items (product_id);
+
+-- Users who wishlisted a product are told when it is back in stock. Kind: 2 - back in stock
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION notify_wishlist_back_in_stock() RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO notifications (notification_id, user_id, product_id, kind, quantity, message, created_at)
+    SELECT gen_random_uuid(), owners.user_id, NEW.product_id, 2, NEW.quantity,
+        format('Product "%s" from your wishlist is back in stock', NEW.name), now()
+    FROM (
+        SELECT DISTINCT wishlists.user_id
+        FROM wishlist_items
+        JOIN wishlists ON wishlists.wishlist_id = wishlist_items.wishlist_id
+        WHERE wishlist_items.product_id = NEW.product_id
+    ) AS owners;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER products_notify_wishlist_back_in_stock
+AFTER UPDATE OF quantity ON products
+FOR EACH ROW
+WHEN (OLD.quantity = 0 AND NEW.quantity > 0 AND NEW.deleted_at IS NULL)
+EXECUTE FUNCTION notify_wishlist_back_in_stock();
+
+-- Users who wishlisted a product are told about every new discount that covers it,
+-- whatever its scope is. Kind: 3 - discount
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION notify_wishlist_discount() RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO notifications (notification_id, user_id, product_id, kind, quantity, message, created_at)
+    SELECT gen_random_uuid(), targets.user_id, targets.product_id, 3, targets.quantity,
+        format('Product "%s" from your wishlist is %s%% off from %s to %s',
+            targets.name, NEW.percent,
+            to_char(NEW.starts_at, 'YYYY-MM-DD HH24:MI'), to_char(NEW.ends_at, 'YYYY-MM-DD HH24:MI')),
+        now()
+    FROM (
+        SELECT DISTINCT wishlists.user_id, products.product_id, products.name, products.quantity
+        FROM wishlist_items
+        JOIN wishlists ON wishlists.wishlist_id = wishlist_items.wishlist_id
+        JOIN products ON products.product_id = wishlist_items.product_id
+        WHERE products.deleted_at IS NULL
+            AND CASE NEW.scope
+                WHEN 0 THEN products.product_id = NEW.product_id
+                WHEN 1 THEN products.category_id = NEW.category_id
+                ELSE products.user_id = NEW.seller_id
+            END
+    ) AS targets;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER discounts_notify_wishlist
+AFTER INSERT ON discounts
+FOR EACH ROW EXECUTE FUNCTION notify_wishlist_discount();
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TRIGGER IF EXISTS discounts_notify_wishlist ON discounts;
+
+DROP FUNCTION IF EXISTS notify_wishlist_discount();
+
+DROP TRIGGER IF EXISTS products_notify_wishlist_back_in_stock ON products;
+
+DROP FUNCTION IF EXISTS notify_wishlist_back_in_stock();
+
+DELETE FROM notifications WHERE kind IN (2, 3);
+
+DROP TABLE IF EXISTS wishlist_items;
+
+DROP TABLE IF EXISTS wishlists;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
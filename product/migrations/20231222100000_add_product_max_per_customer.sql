@@ -0,0 +1,14 @@
+-- +goose Up
+-- Zero means the product has no purchase limit
+ALTER TABLE products ADD COLUMN IF NOT EXISTS max_per_customer BIGINT NOT NULL DEFAULT 0;
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+ALTER TABLE products DROP COLUMN IF EXISTS max_per_customer;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
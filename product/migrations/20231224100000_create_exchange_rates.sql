@@ -0,0 +1,23 @@
+-- +goose Up
+-- Prices of the existing products are in the base currency
+ALTER TABLE products ADD COLUMN IF NOT EXISTS currency TEXT NOT NULL DEFAULT 'RUB';
+
+-- Price of one unit of the currency in the base currency
+CREATE TABLE IF NOT EXISTS exchange_rates (
+    currency TEXT NOT NULL PRIMARY KEY,
+    rate DOUBLE PRECISION NOT NULL CHECK (rate > 0),
+    updated_at TIMESTAMP NOT NULL
+);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE IF EXISTS exchange_rates;
+
+ALTER TABLE products DROP COLUMN IF EXISTS currency;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
@@ -7,6 +7,7 @@
 package cart
 
 import (
+	product "github.com/Go-Marketplace/backend/proto/gen/product"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -21,6 +22,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type CartlineIssue int32
+
+const (
+	CartlineIssue_CARTLINE_ISSUE_UNKNOWN CartlineIssue = 0
+	CartlineIssue_PRODUCT_REMOVED        CartlineIssue = 1
+	CartlineIssue_PRODUCT_UNMODERATED    CartlineIssue = 2
+	CartlineIssue_STOCK_SHORT            CartlineIssue = 3
+)
+
+// Enum value maps for CartlineIssue.
+var (
+	CartlineIssue_name = map[int32]string{
+		0: "CARTLINE_ISSUE_UNKNOWN",
+		1: "PRODUCT_REMOVED",
+		2: "PRODUCT_UNMODERATED",
+		3: "STOCK_SHORT",
+	}
+	CartlineIssue_value = map[string]int32{
+		"CARTLINE_ISSUE_UNKNOWN": 0,
+		"PRODUCT_REMOVED":        1,
+		"PRODUCT_UNMODERATED":    2,
+		"STOCK_SHORT":            3,
+	}
+)
+
+func (x CartlineIssue) Enum() *CartlineIssue {
+	p := new(CartlineIssue)
+	*p = x
+	return p
+}
+
+func (x CartlineIssue) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CartlineIssue) Descriptor() protoreflect.EnumDescriptor {
+	return file_cart_proto_enumTypes[0].Descriptor()
+}
+
+func (CartlineIssue) Type() protoreflect.EnumType {
+	return &file_cart_proto_enumTypes[0]
+}
+
+func (x CartlineIssue) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CartlineIssue.Descriptor instead.
+func (CartlineIssue) EnumDescriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{0}
+}
+
 type GetUserCartRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -74,6 +127,7 @@ type CreateCartRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Guest  bool   `protobuf:"varint,2,opt,name=guest,proto3" json:"guest,omitempty"`
 }
 
 func (x *CreateCartRequest) Reset() {
@@ -115,13 +169,24 @@ func (x *CreateCartRequest) GetUserId() string {
 	return ""
 }
 
+func (x *CreateCartRequest) GetGuest() bool {
+	if x != nil {
+		return x.Guest
+	}
+	return false
+}
+
 type CreateCartlineRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ProductId string `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	UserId           string                     `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProductId        string                     `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Strategy         product.AllocationStrategy `protobuf:"varint,3,opt,name=strategy,proto3,enum=product.AllocationStrategy" json:"strategy,omitempty"`
+	ShippingLocation *product.Location          `protobuf:"bytes,4,opt,name=shipping_location,json=shippingLocation,proto3" json:"shipping_location,omitempty"`
+	// Added to the product line when the cart already has it, zero adds one
+	Quantity int64 `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
 }
 
 func (x *CreateCartlineRequest) Reset() {
@@ -170,6 +235,27 @@ func (x *CreateCartlineRequest) GetProductId() string {
 	return ""
 }
 
+func (x *CreateCartlineRequest) GetStrategy() product.AllocationStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return product.AllocationStrategy(0)
+}
+
+func (x *CreateCartlineRequest) GetShippingLocation() *product.Location {
+	if x != nil {
+		return x.ShippingLocation
+	}
+	return nil
+}
+
+func (x *CreateCartlineRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
 type UpdateCartlineRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -233,6 +319,134 @@ func (x *UpdateCartlineRequest) GetQuantity() int64 {
 	return 0
 }
 
+// Sets the product line quantity, zero removes the line
+type CartlineChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductId string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity  int64  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+}
+
+func (x *CartlineChange) Reset() {
+	*x = CartlineChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cart_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CartlineChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartlineChange) ProtoMessage() {}
+
+func (x *CartlineChange) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartlineChange.ProtoReflect.Descriptor instead.
+func (*CartlineChange) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CartlineChange) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CartlineChange) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// New lines are reserved by the strategy
+type BatchUpdateCartRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId           string                     `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Changes          []*CartlineChange          `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+	Strategy         product.AllocationStrategy `protobuf:"varint,3,opt,name=strategy,proto3,enum=product.AllocationStrategy" json:"strategy,omitempty"`
+	ShippingLocation *product.Location          `protobuf:"bytes,4,opt,name=shipping_location,json=shippingLocation,proto3" json:"shipping_location,omitempty"`
+}
+
+func (x *BatchUpdateCartRequest) Reset() {
+	*x = BatchUpdateCartRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cart_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchUpdateCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateCartRequest) ProtoMessage() {}
+
+func (x *BatchUpdateCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateCartRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateCartRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BatchUpdateCartRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BatchUpdateCartRequest) GetChanges() []*CartlineChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *BatchUpdateCartRequest) GetStrategy() product.AllocationStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return product.AllocationStrategy(0)
+}
+
+func (x *BatchUpdateCartRequest) GetShippingLocation() *product.Location {
+	if x != nil {
+		return x.ShippingLocation
+	}
+	return nil
+}
+
 type DeleteCartlineRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -245,7 +459,7 @@ type DeleteCartlineRequest struct {
 func (x *DeleteCartlineRequest) Reset() {
 	*x = DeleteCartlineRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cart_proto_msgTypes[4]
+		mi := &file_cart_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -258,7 +472,7 @@ func (x *DeleteCartlineRequest) String() string {
 func (*DeleteCartlineRequest) ProtoMessage() {}
 
 func (x *DeleteCartlineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_proto_msgTypes[4]
+	mi := &file_cart_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -271,7 +485,7 @@ func (x *DeleteCartlineRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCartlineRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCartlineRequest) Descriptor() ([]byte, []int) {
-	return file_cart_proto_rawDescGZIP(), []int{4}
+	return file_cart_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DeleteCartlineRequest) GetUserId() string {
@@ -299,7 +513,7 @@ type DeleteCartRequest struct {
 func (x *DeleteCartRequest) Reset() {
 	*x = DeleteCartRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cart_proto_msgTypes[5]
+		mi := &file_cart_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -312,7 +526,7 @@ func (x *DeleteCartRequest) String() string {
 func (*DeleteCartRequest) ProtoMessage() {}
 
 func (x *DeleteCartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_proto_msgTypes[5]
+	mi := &file_cart_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -325,7 +539,7 @@ func (x *DeleteCartRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCartRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCartRequest) Descriptor() ([]byte, []int) {
-	return file_cart_proto_rawDescGZIP(), []int{5}
+	return file_cart_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *DeleteCartRequest) GetUserId() string {
@@ -346,7 +560,7 @@ type DeleteCartCartlinesRequest struct {
 func (x *DeleteCartCartlinesRequest) Reset() {
 	*x = DeleteCartCartlinesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cart_proto_msgTypes[6]
+		mi := &file_cart_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -359,7 +573,7 @@ func (x *DeleteCartCartlinesRequest) String() string {
 func (*DeleteCartCartlinesRequest) ProtoMessage() {}
 
 func (x *DeleteCartCartlinesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_proto_msgTypes[6]
+	mi := &file_cart_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -372,7 +586,7 @@ func (x *DeleteCartCartlinesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCartCartlinesRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCartCartlinesRequest) Descriptor() ([]byte, []int) {
-	return file_cart_proto_rawDescGZIP(), []int{6}
+	return file_cart_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DeleteCartCartlinesRequest) GetUserId() string {
@@ -393,7 +607,7 @@ type DeleteProductCartlinesRequest struct {
 func (x *DeleteProductCartlinesRequest) Reset() {
 	*x = DeleteProductCartlinesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cart_proto_msgTypes[7]
+		mi := &file_cart_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -406,7 +620,7 @@ func (x *DeleteProductCartlinesRequest) String() string {
 func (*DeleteProductCartlinesRequest) ProtoMessage() {}
 
 func (x *DeleteProductCartlinesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_proto_msgTypes[7]
+	mi := &file_cart_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -419,7 +633,7 @@ func (x *DeleteProductCartlinesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProductCartlinesRequest.ProtoReflect.Descriptor instead.
 func (*DeleteProductCartlinesRequest) Descriptor() ([]byte, []int) {
-	return file_cart_proto_rawDescGZIP(), []int{7}
+	return file_cart_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DeleteProductCartlinesRequest) GetProductId() string {
@@ -440,7 +654,7 @@ type PrepareOrderRequest struct {
 func (x *PrepareOrderRequest) Reset() {
 	*x = PrepareOrderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cart_proto_msgTypes[8]
+		mi := &file_cart_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -453,7 +667,7 @@ func (x *PrepareOrderRequest) String() string {
 func (*PrepareOrderRequest) ProtoMessage() {}
 
 func (x *PrepareOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_proto_msgTypes[8]
+	mi := &file_cart_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -466,7 +680,7 @@ func (x *PrepareOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrepareOrderRequest.ProtoReflect.Descriptor instead.
 func (*PrepareOrderRequest) Descriptor() ([]byte, []int) {
-	return file_cart_proto_rawDescGZIP(), []int{8}
+	return file_cart_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *PrepareOrderRequest) GetUserId() string {
@@ -476,34 +690,35 @@ func (x *PrepareOrderRequest) GetUserId() string {
 	return ""
 }
 
-type CartResponse struct {
+type GetCartSummaryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId    string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Cartlines []*CartlineResponse    `protobuf:"bytes,2,rep,name=cartlines,proto3" json:"cartlines,omitempty"`
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Region code the taxes are calculated for, like RU or DE
+	ShippingRegion string `protobuf:"bytes,2,opt,name=shipping_region,json=shippingRegion,proto3" json:"shipping_region,omitempty"`
+	// Currency the cart is priced in, the base currency of the marketplace when empty
+	Currency string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
 }
 
-func (x *CartResponse) Reset() {
-	*x = CartResponse{}
+func (x *GetCartSummaryRequest) Reset() {
+	*x = GetCartSummaryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cart_proto_msgTypes[9]
+		mi := &file_cart_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CartResponse) String() string {
+func (x *GetCartSummaryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CartResponse) ProtoMessage() {}
+func (*GetCartSummaryRequest) ProtoMessage() {}
 
-func (x *CartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_proto_msgTypes[9]
+func (x *GetCartSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -514,68 +729,59 @@ func (x *CartResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CartResponse.ProtoReflect.Descriptor instead.
-func (*CartResponse) Descriptor() ([]byte, []int) {
-	return file_cart_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetCartSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetCartSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *CartResponse) GetUserId() string {
+func (x *GetCartSummaryRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *CartResponse) GetCartlines() []*CartlineResponse {
-	if x != nil {
-		return x.Cartlines
-	}
-	return nil
-}
-
-func (x *CartResponse) GetCreatedAt() *timestamppb.Timestamp {
+func (x *GetCartSummaryRequest) GetShippingRegion() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.ShippingRegion
 	}
-	return nil
+	return ""
 }
 
-func (x *CartResponse) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *GetCartSummaryRequest) GetCurrency() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Currency
 	}
-	return nil
+	return ""
 }
 
-type CartlineResponse struct {
+// An empty code removes the promo code from the cart
+type ApplyPromoCodeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId    string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ProductId string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Quantity  int64                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Code   string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 }
 
-func (x *CartlineResponse) Reset() {
-	*x = CartlineResponse{}
+func (x *ApplyPromoCodeRequest) Reset() {
+	*x = ApplyPromoCodeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cart_proto_msgTypes[10]
+		mi := &file_cart_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CartlineResponse) String() string {
+func (x *ApplyPromoCodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CartlineResponse) ProtoMessage() {}
+func (*ApplyPromoCodeRequest) ProtoMessage() {}
 
-func (x *CartlineResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_proto_msgTypes[10]
+func (x *ApplyPromoCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -586,69 +792,51 @@ func (x *CartlineResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CartlineResponse.ProtoReflect.Descriptor instead.
-func (*CartlineResponse) Descriptor() ([]byte, []int) {
-	return file_cart_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ApplyPromoCodeRequest.ProtoReflect.Descriptor instead.
+func (*ApplyPromoCodeRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *CartlineResponse) GetUserId() string {
+func (x *ApplyPromoCodeRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *CartlineResponse) GetProductId() string {
+func (x *ApplyPromoCodeRequest) GetCode() string {
 	if x != nil {
-		return x.ProductId
+		return x.Code
 	}
 	return ""
 }
 
-func (x *CartlineResponse) GetQuantity() int64 {
-	if x != nil {
-		return x.Quantity
-	}
-	return 0
-}
-
-func (x *CartlineResponse) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *CartlineResponse) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return nil
-}
-
-type DeleteCartResponse struct {
+type MergeCartsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	UserId  string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GuestId string `protobuf:"bytes,2,opt,name=guest_id,json=guestId,proto3" json:"guest_id,omitempty"`
 }
 
-func (x *DeleteCartResponse) Reset() {
-	*x = DeleteCartResponse{}
+func (x *MergeCartsRequest) Reset() {
+	*x = MergeCartsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cart_proto_msgTypes[11]
+		mi := &file_cart_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteCartResponse) String() string {
+func (x *MergeCartsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCartResponse) ProtoMessage() {}
+func (*MergeCartsRequest) ProtoMessage() {}
 
-func (x *DeleteCartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_proto_msgTypes[11]
+func (x *MergeCartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -659,9 +847,768 @@ func (x *DeleteCartResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCartResponse.ProtoReflect.Descriptor instead.
-func (*DeleteCartResponse) Descriptor() ([]byte, []int) {
-	return file_cart_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use MergeCartsRequest.ProtoReflect.Descriptor instead.
+func (*MergeCartsRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MergeCartsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MergeCartsRequest) GetGuestId() string {
+	if x != nil {
+		return x.GuestId
+	}
+	return ""
+}
+
+type CartResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Cartlines []*CartlineResponse    `protobuf:"bytes,2,rep,name=cartlines,proto3" json:"cartlines,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	PromoCode string                 `protobuf:"bytes,5,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	Guest     bool                   `protobuf:"varint,6,opt,name=guest,proto3" json:"guest,omitempty"`
+	// Unset when the cart has no cleanup scheduled, every cartline change moves it out
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *CartResponse) Reset() {
+	*x = CartResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cart_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartResponse) ProtoMessage() {}
+
+func (x *CartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartResponse.ProtoReflect.Descriptor instead.
+func (*CartResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CartResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CartResponse) GetCartlines() []*CartlineResponse {
+	if x != nil {
+		return x.Cartlines
+	}
+	return nil
+}
+
+func (x *CartResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CartResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *CartResponse) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *CartResponse) GetGuest() bool {
+	if x != nil {
+		return x.Guest
+	}
+	return false
+}
+
+func (x *CartResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type CartlineResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProductId   string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity    int64                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	WarehouseId string                 `protobuf:"bytes,6,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+}
+
+func (x *CartlineResponse) Reset() {
+	*x = CartlineResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cart_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CartlineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartlineResponse) ProtoMessage() {}
+
+func (x *CartlineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartlineResponse.ProtoReflect.Descriptor instead.
+func (*CartlineResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CartlineResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CartlineResponse) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CartlineResponse) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CartlineResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CartlineResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *CartlineResponse) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+type CartSummaryLineResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductId      string                    `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	WarehouseId    string                    `protobuf:"bytes,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Name           string                    `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Quantity       int64                     `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice      int64                     `protobuf:"varint,5,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	Discount       *product.DiscountResponse `protobuf:"bytes,6,opt,name=discount,proto3" json:"discount,omitempty"`
+	Subtotal       int64                     `protobuf:"varint,7,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	DiscountAmount int64                     `protobuf:"varint,8,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	Total          int64                     `protobuf:"varint,9,opt,name=total,proto3" json:"total,omitempty"`
+	Issues         []CartlineIssue           `protobuf:"varint,10,rep,packed,name=issues,proto3,enum=cart.CartlineIssue" json:"issues,omitempty"`
+	TaxRate        float32                   `protobuf:"fixed32,11,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"`
+	Tax            int64                     `protobuf:"varint,12,opt,name=tax,proto3" json:"tax,omitempty"`
+}
+
+func (x *CartSummaryLineResponse) Reset() {
+	*x = CartSummaryLineResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cart_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CartSummaryLineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartSummaryLineResponse) ProtoMessage() {}
+
+func (x *CartSummaryLineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartSummaryLineResponse.ProtoReflect.Descriptor instead.
+func (*CartSummaryLineResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CartSummaryLineResponse) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CartSummaryLineResponse) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *CartSummaryLineResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CartSummaryLineResponse) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CartSummaryLineResponse) GetUnitPrice() int64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *CartSummaryLineResponse) GetDiscount() *product.DiscountResponse {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *CartSummaryLineResponse) GetSubtotal() int64 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
+}
+
+func (x *CartSummaryLineResponse) GetDiscountAmount() int64 {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return 0
+}
+
+func (x *CartSummaryLineResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CartSummaryLineResponse) GetIssues() []CartlineIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *CartSummaryLineResponse) GetTaxRate() float32 {
+	if x != nil {
+		return x.TaxRate
+	}
+	return 0
+}
+
+func (x *CartSummaryLineResponse) GetTax() int64 {
+	if x != nil {
+		return x.Tax
+	}
+	return 0
+}
+
+type CartSummaryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId           string                     `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Lines            []*CartSummaryLineResponse `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	Subtotal         int64                      `protobuf:"varint,3,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	Discount         int64                      `protobuf:"varint,4,opt,name=discount,proto3" json:"discount,omitempty"`
+	PromoCode        string                     `protobuf:"bytes,5,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	PromoDiscount    int64                      `protobuf:"varint,6,opt,name=promo_discount,json=promoDiscount,proto3" json:"promo_discount,omitempty"`
+	PromoError       string                     `protobuf:"bytes,7,opt,name=promo_error,json=promoError,proto3" json:"promo_error,omitempty"`
+	Total            int64                      `protobuf:"varint,8,opt,name=total,proto3" json:"total,omitempty"`
+	ReadyForCheckout bool                       `protobuf:"varint,9,opt,name=ready_for_checkout,json=readyForCheckout,proto3" json:"ready_for_checkout,omitempty"`
+	ShippingRegion   string                     `protobuf:"bytes,10,opt,name=shipping_region,json=shippingRegion,proto3" json:"shipping_region,omitempty"`
+	Tax              int64                      `protobuf:"varint,11,opt,name=tax,proto3" json:"tax,omitempty"`
+	Currency         string                     `protobuf:"bytes,12,opt,name=currency,proto3" json:"currency,omitempty"`
+}
+
+func (x *CartSummaryResponse) Reset() {
+	*x = CartSummaryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cart_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CartSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartSummaryResponse) ProtoMessage() {}
+
+func (x *CartSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartSummaryResponse.ProtoReflect.Descriptor instead.
+func (*CartSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CartSummaryResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CartSummaryResponse) GetLines() []*CartSummaryLineResponse {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *CartSummaryResponse) GetSubtotal() int64 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
+}
+
+func (x *CartSummaryResponse) GetDiscount() int64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *CartSummaryResponse) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *CartSummaryResponse) GetPromoDiscount() int64 {
+	if x != nil {
+		return x.PromoDiscount
+	}
+	return 0
+}
+
+func (x *CartSummaryResponse) GetPromoError() string {
+	if x != nil {
+		return x.PromoError
+	}
+	return ""
+}
+
+func (x *CartSummaryResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CartSummaryResponse) GetReadyForCheckout() bool {
+	if x != nil {
+		return x.ReadyForCheckout
+	}
+	return false
+}
+
+func (x *CartSummaryResponse) GetShippingRegion() string {
+	if x != nil {
+		return x.ShippingRegion
+	}
+	return ""
+}
+
+func (x *CartSummaryResponse) GetTax() int64 {
+	if x != nil {
+		return x.Tax
+	}
+	return 0
+}
+
+func (x *CartSummaryResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// Puts the products of the expired cart back into the user cart, all of them or none
+type RestoreAbandonedCartRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId          string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AbandonedCartId string `protobuf:"bytes,2,opt,name=abandoned_cart_id,json=abandonedCartId,proto3" json:"abandoned_cart_id,omitempty"`
+}
+
+func (x *RestoreAbandonedCartRequest) Reset() {
+	*x = RestoreAbandonedCartRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cart_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreAbandonedCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreAbandonedCartRequest) ProtoMessage() {}
+
+func (x *RestoreAbandonedCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreAbandonedCartRequest.ProtoReflect.Descriptor instead.
+func (*RestoreAbandonedCartRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RestoreAbandonedCartRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RestoreAbandonedCartRequest) GetAbandonedCartId() string {
+	if x != nil {
+		return x.AbandonedCartId
+	}
+	return ""
+}
+
+// The period defaults to the last 30 days, zero category_id means all categories
+type GetAbandonedCartMetricsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From       *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	CategoryId int32                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+}
+
+func (x *GetAbandonedCartMetricsRequest) Reset() {
+	*x = GetAbandonedCartMetricsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cart_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAbandonedCartMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAbandonedCartMetricsRequest) ProtoMessage() {}
+
+func (x *GetAbandonedCartMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAbandonedCartMetricsRequest.ProtoReflect.Descriptor instead.
+func (*GetAbandonedCartMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetAbandonedCartMetricsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetAbandonedCartMetricsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetAbandonedCartMetricsRequest) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+type AbandonedCartMetricResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Day           *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	CategoryId    int32                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Carts         int64                  `protobuf:"varint,3,opt,name=carts,proto3" json:"carts,omitempty"`
+	Quantity      int64                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Value         int64                  `protobuf:"varint,5,opt,name=value,proto3" json:"value,omitempty"`
+	RestoredCarts int64                  `protobuf:"varint,6,opt,name=restored_carts,json=restoredCarts,proto3" json:"restored_carts,omitempty"`
+}
+
+func (x *AbandonedCartMetricResponse) Reset() {
+	*x = AbandonedCartMetricResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cart_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AbandonedCartMetricResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbandonedCartMetricResponse) ProtoMessage() {}
+
+func (x *AbandonedCartMetricResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbandonedCartMetricResponse.ProtoReflect.Descriptor instead.
+func (*AbandonedCartMetricResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AbandonedCartMetricResponse) GetDay() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Day
+	}
+	return nil
+}
+
+func (x *AbandonedCartMetricResponse) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *AbandonedCartMetricResponse) GetCarts() int64 {
+	if x != nil {
+		return x.Carts
+	}
+	return 0
+}
+
+func (x *AbandonedCartMetricResponse) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *AbandonedCartMetricResponse) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *AbandonedCartMetricResponse) GetRestoredCarts() int64 {
+	if x != nil {
+		return x.RestoredCarts
+	}
+	return 0
+}
+
+type AbandonedCartMetricsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metrics []*AbandonedCartMetricResponse `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics,omitempty"`
+}
+
+func (x *AbandonedCartMetricsResponse) Reset() {
+	*x = AbandonedCartMetricsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cart_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AbandonedCartMetricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbandonedCartMetricsResponse) ProtoMessage() {}
+
+func (x *AbandonedCartMetricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbandonedCartMetricsResponse.ProtoReflect.Descriptor instead.
+func (*AbandonedCartMetricsResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AbandonedCartMetricsResponse) GetMetrics() []*AbandonedCartMetricResponse {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+type DeleteCartResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteCartResponse) Reset() {
+	*x = DeleteCartResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cart_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCartResponse) ProtoMessage() {}
+
+func (x *DeleteCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCartResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCartResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{22}
 }
 
 type DeleteCartlineResponse struct {
@@ -673,7 +1620,7 @@ type DeleteCartlineResponse struct {
 func (x *DeleteCartlineResponse) Reset() {
 	*x = DeleteCartlineResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cart_proto_msgTypes[12]
+		mi := &file_cart_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -686,7 +1633,7 @@ func (x *DeleteCartlineResponse) String() string {
 func (*DeleteCartlineResponse) ProtoMessage() {}
 
 func (x *DeleteCartlineResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_proto_msgTypes[12]
+	mi := &file_cart_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -699,7 +1646,7 @@ func (x *DeleteCartlineResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCartlineResponse.ProtoReflect.Descriptor instead.
 func (*DeleteCartlineResponse) Descriptor() ([]byte, []int) {
-	return file_cart_proto_rawDescGZIP(), []int{12}
+	return file_cart_proto_rawDescGZIP(), []int{23}
 }
 
 type DeleteCartCartlinesResponse struct {
@@ -711,7 +1658,7 @@ type DeleteCartCartlinesResponse struct {
 func (x *DeleteCartCartlinesResponse) Reset() {
 	*x = DeleteCartCartlinesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cart_proto_msgTypes[13]
+		mi := &file_cart_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -724,7 +1671,7 @@ func (x *DeleteCartCartlinesResponse) String() string {
 func (*DeleteCartCartlinesResponse) ProtoMessage() {}
 
 func (x *DeleteCartCartlinesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_proto_msgTypes[13]
+	mi := &file_cart_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -737,7 +1684,7 @@ func (x *DeleteCartCartlinesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCartCartlinesResponse.ProtoReflect.Descriptor instead.
 func (*DeleteCartCartlinesResponse) Descriptor() ([]byte, []int) {
-	return file_cart_proto_rawDescGZIP(), []int{13}
+	return file_cart_proto_rawDescGZIP(), []int{24}
 }
 
 type DeleteProductCartlinesResponse struct {
@@ -749,7 +1696,7 @@ type DeleteProductCartlinesResponse struct {
 func (x *DeleteProductCartlinesResponse) Reset() {
 	*x = DeleteProductCartlinesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cart_proto_msgTypes[14]
+		mi := &file_cart_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -762,7 +1709,7 @@ func (x *DeleteProductCartlinesResponse) String() string {
 func (*DeleteProductCartlinesResponse) ProtoMessage() {}
 
 func (x *DeleteProductCartlinesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_proto_msgTypes[14]
+	mi := &file_cart_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -775,7 +1722,7 @@ func (x *DeleteProductCartlinesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProductCartlinesResponse.ProtoReflect.Descriptor instead.
 func (*DeleteProductCartlinesResponse) Descriptor() ([]byte, []int) {
-	return file_cart_proto_rawDescGZIP(), []int{14}
+	return file_cart_proto_rawDescGZIP(), []int{25}
 }
 
 type PrepareOrderResponse struct {
@@ -787,7 +1734,7 @@ type PrepareOrderResponse struct {
 func (x *PrepareOrderResponse) Reset() {
 	*x = PrepareOrderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cart_proto_msgTypes[15]
+		mi := &file_cart_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -800,7 +1747,7 @@ func (x *PrepareOrderResponse) String() string {
 func (*PrepareOrderResponse) ProtoMessage() {}
 
 func (x *PrepareOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_proto_msgTypes[15]
+	mi := &file_cart_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -813,7 +1760,63 @@ func (x *PrepareOrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrepareOrderResponse.ProtoReflect.Descriptor instead.
 func (*PrepareOrderResponse) Descriptor() ([]byte, []int) {
-	return file_cart_proto_rawDescGZIP(), []int{15}
+	return file_cart_proto_rawDescGZIP(), []int{26}
+}
+
+type ApplyPromoCodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cart *CartResponse `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
+	// Discount in the base currency of the marketplace, the promo code values are in it
+	Discount int64 `protobuf:"varint,2,opt,name=discount,proto3" json:"discount,omitempty"`
+}
+
+func (x *ApplyPromoCodeResponse) Reset() {
+	*x = ApplyPromoCodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cart_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyPromoCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyPromoCodeResponse) ProtoMessage() {}
+
+func (x *ApplyPromoCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyPromoCodeResponse.ProtoReflect.Descriptor instead.
+func (*ApplyPromoCodeResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ApplyPromoCodeResponse) GetCart() *CartResponse {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+func (x *ApplyPromoCodeResponse) GetDiscount() int64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
 }
 
 var File_cart_proto protoreflect.FileDescriptor
@@ -822,79 +1825,235 @@ var file_cart_proto_rawDesc = []byte{
 	0x0a, 0x0a, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x63, 0x61,
 	0x72, 0x74, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x2d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x61,
+	0x6f, 0x74, 0x6f, 0x1a, 0x0d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x2d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x61, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x42, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x67, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x67, 0x75, 0x65, 0x73, 0x74, 0x22, 0xe4, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x61, 0x72, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x12, 0x3e, 0x0a, 0x11, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10,
+	0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x6b, 0x0a, 0x15,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x4b, 0x0a, 0x0e, 0x43, 0x61, 0x72,
+	0x74, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0xda, 0x01, 0x0a, 0x16, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x61,
+	0x72, 0x74, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x08, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x12, 0x3e, 0x0a, 0x11, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5f,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x10, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x4f, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x72,
+	0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x49, 0x64, 0x22, 0x2c, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61,
 	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
 	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x22, 0x2c, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x22, 0x4f, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x74, 0x6c, 0x69,
-	0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x49, 0x64, 0x22, 0x35, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x72, 0x74,
+	0x43, 0x61, 0x72, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x3e, 0x0a, 0x1d, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x43, 0x61, 0x72, 0x74, 0x6c, 0x69,
+	0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x22, 0x2e, 0x0a, 0x13, 0x50, 0x72, 0x65,
+	0x70, 0x61, 0x72, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x75, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x43, 0x61, 0x72, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x73,
+	0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x22, 0x44, 0x0a, 0x15, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
 	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49,
-	0x64, 0x22, 0x6b, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x74, 0x6c,
-	0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x47, 0x0a, 0x11, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43,
+	0x61, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22,
+	0xc3, 0x02, 0x0a, 0x0c, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x09, 0x63, 0x61, 0x72,
+	0x74, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63,
+	0x61, 0x72, 0x74, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x63, 0x61, 0x72, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x6f,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x75, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x67, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0xff, 0x01, 0x0a, 0x10, 0x43, 0x61, 0x72, 0x74, 0x6c, 0x69,
+	0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
 	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
 	0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69,
 	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
 	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x4f,
-	0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x72, 0x74, 0x6c, 0x69, 0x6e, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x22,
-	0x2c, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x35, 0x0a,
-	0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x72, 0x74, 0x43, 0x61, 0x72, 0x74, 0x6c,
-	0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x22, 0x3e, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x43, 0x61, 0x72, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x49, 0x64, 0x22, 0x2e, 0x0a, 0x13, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x22, 0xd3, 0x01, 0x0a, 0x0c, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x34,
-	0x0a, 0x09, 0x63, 0x61, 0x72, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x6c, 0x69, 0x6e,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x63, 0x61, 0x72, 0x74, 0x6c,
-	0x69, 0x6e, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xdc, 0x01, 0x0a, 0x10, 0x43,
-	0x61, 0x72, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39,
-	0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x18, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x72, 0x74, 0x6c, 0x69, 0x6e,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x43, 0x61, 0x72, 0x74, 0x43, 0x61, 0x72, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x43, 0x61, 0x72, 0x74, 0x6c, 0x69, 0x6e,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x50, 0x72,
-	0x65, 0x70, 0x61, 0x72, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x32, 0xa2, 0x05, 0x0a, 0x04, 0x43, 0x61, 0x72, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x47,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x61, 0x72, 0x65,
+	0x68, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x64, 0x22, 0x96, 0x03, 0x0a, 0x17, 0x43, 0x61, 0x72, 0x74,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x61, 0x72, 0x65, 0x68, 0x6f,
+	0x75, 0x73, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x6e, 0x69, 0x74, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x75, 0x62, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73,
+	0x75, 0x62, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x43, 0x61,
+	0x72, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x06, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x74, 0x61, 0x78, 0x52, 0x61, 0x74, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x74, 0x61, 0x78, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x74, 0x61, 0x78,
+	0x22, 0x9d, 0x03, 0x0a, 0x13, 0x43, 0x61, 0x72, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x33, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x75, 0x62, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x44, 0x69, 0x73, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x6f,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2c, 0x0a, 0x12, 0x72,
+	0x65, 0x61, 0x64, 0x79, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x72, 0x65, 0x61, 0x64, 0x79, 0x46, 0x6f,
+	0x72, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x68, 0x69,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x78, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x03, 0x74, 0x61, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x22, 0x62, 0x0a, 0x1b, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x62, 0x61, 0x6e, 0x64,
+	0x6f, 0x6e, 0x65, 0x64, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x62, 0x61, 0x6e,
+	0x64, 0x6f, 0x6e, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x62, 0x61, 0x6e, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x43, 0x61,
+	0x72, 0x74, 0x49, 0x64, 0x22, 0x9d, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x41, 0x62, 0x61, 0x6e,
+	0x64, 0x6f, 0x6e, 0x65, 0x64, 0x43, 0x61, 0x72, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x02, 0x74, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x49, 0x64, 0x22, 0xdb, 0x01, 0x0a, 0x1b, 0x41, 0x62, 0x61, 0x6e, 0x64, 0x6f, 0x6e,
+	0x65, 0x64, 0x43, 0x61, 0x72, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x64,
+	0x61, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x61, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x63, 0x61, 0x72, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x72, 0x74, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x43, 0x61, 0x72,
+	0x74, 0x73, 0x22, 0x5b, 0x0a, 0x1c, 0x41, 0x62, 0x61, 0x6e, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x43,
+	0x61, 0x72, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x41, 0x62, 0x61, 0x6e, 0x64,
+	0x6f, 0x6e, 0x65, 0x64, 0x43, 0x61, 0x72, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x22,
+	0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
+	0x61, 0x72, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1d, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x72, 0x74, 0x43, 0x61, 0x72,
+	0x74, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20,
+	0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x43,
+	0x61, 0x72, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x16, 0x0a, 0x14, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5c, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x6c,
+	0x79, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x63, 0x61, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2a, 0x6a, 0x0a, 0x0d, 0x43, 0x61, 0x72, 0x74, 0x6c, 0x69,
+	0x6e, 0x65, 0x49, 0x73, 0x73, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x41, 0x52, 0x54, 0x4c,
+	0x49, 0x4e, 0x45, 0x5f, 0x49, 0x53, 0x53, 0x55, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x52,
+	0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x52, 0x4f, 0x44,
+	0x55, 0x43, 0x54, 0x5f, 0x55, 0x4e, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54,
+	0x10, 0x03, 0x32, 0xed, 0x08, 0x0a, 0x04, 0x43, 0x61, 0x72, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x47,
 	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x61, 0x72, 0x74, 0x12, 0x18, 0x2e, 0x63, 0x61, 0x72,
 	0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x43, 0x61, 0x72, 0x74,
@@ -916,31 +2075,60 @@ var file_cart_proto_rawDesc = []byte{
 	0x12, 0x19, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x4f,
 	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x61,
 	0x72, 0x74, 0x2e, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x43, 0x61, 0x72, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1b, 0x2e, 0x63, 0x61, 0x72, 0x74,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x43, 0x61,
-	0x72, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45,
-	0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x74, 0x6c, 0x69, 0x6e, 0x65,
-	0x12, 0x1b, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61,
-	0x72, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x63, 0x61, 0x72, 0x74, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
-	0x61, 0x72, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1b, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x72, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x43, 0x61, 0x72, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x63, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x43, 0x61, 0x72, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x63,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x6c, 0x79,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x2e, 0x63, 0x61, 0x72, 0x74,
+	0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x41, 0x70,
+	0x70, 0x6c, 0x79, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x61, 0x72,
+	0x74, 0x73, 0x12, 0x17, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43,
+	0x61, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x63, 0x61,
+	0x72, 0x74, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x48, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x12, 0x1b, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x74,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x14, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x41, 0x62, 0x61, 0x6e, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x43, 0x61, 0x72,
+	0x74, 0x12, 0x21, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x41, 0x62, 0x61, 0x6e, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x43, 0x61, 0x72, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41,
+	0x62, 0x61, 0x6e, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x43, 0x61, 0x72, 0x74, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x12, 0x24, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x62,
+	0x61, 0x6e, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x43, 0x61, 0x72, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x61, 0x72, 0x74,
+	0x2e, 0x41, 0x62, 0x61, 0x6e, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x43, 0x61, 0x72, 0x74, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a,
+	0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x12,
+	0x1b, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72,
+	0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63,
+	0x61, 0x72, 0x74, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61,
+	0x72, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1b, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x6c,
+	0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0f, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x74, 0x12, 0x1c,
+	0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x63,
+	0x61, 0x72, 0x74, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4b, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x72, 0x74, 0x6c, 0x69,
+	0x6e, 0x65, 0x12, 0x1b, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x43, 0x61, 0x72, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x72,
+	0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a,
+	0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x43, 0x61,
+	0x72, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x43, 0x61, 0x72, 0x74,
+	0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63,
 	0x61, 0x72, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x43, 0x61, 0x72, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x24, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x43, 0x61, 0x72, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x47, 0x6f, 0x2d, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70,
-	0x6c, 0x61, 0x63, 0x65, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x63, 0x61, 0x72, 0x74, 0x3b, 0x63, 0x61, 0x72, 0x74,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x43, 0x61, 0x72, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x47, 0x6f, 0x2d, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f,
+	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65,
+	0x6e, 0x2f, 0x63, 0x61, 0x72, 0x74, 0x3b, 0x63, 0x61, 0x72, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -955,55 +2143,98 @@ func file_cart_proto_rawDescGZIP() []byte {
 	return file_cart_proto_rawDescData
 }
 
-var file_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_cart_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_cart_proto_goTypes = []interface{}{
-	(*GetUserCartRequest)(nil),             // 0: cart.GetUserCartRequest
-	(*CreateCartRequest)(nil),              // 1: cart.CreateCartRequest
-	(*CreateCartlineRequest)(nil),          // 2: cart.CreateCartlineRequest
-	(*UpdateCartlineRequest)(nil),          // 3: cart.UpdateCartlineRequest
-	(*DeleteCartlineRequest)(nil),          // 4: cart.DeleteCartlineRequest
-	(*DeleteCartRequest)(nil),              // 5: cart.DeleteCartRequest
-	(*DeleteCartCartlinesRequest)(nil),     // 6: cart.DeleteCartCartlinesRequest
-	(*DeleteProductCartlinesRequest)(nil),  // 7: cart.DeleteProductCartlinesRequest
-	(*PrepareOrderRequest)(nil),            // 8: cart.PrepareOrderRequest
-	(*CartResponse)(nil),                   // 9: cart.CartResponse
-	(*CartlineResponse)(nil),               // 10: cart.CartlineResponse
-	(*DeleteCartResponse)(nil),             // 11: cart.DeleteCartResponse
-	(*DeleteCartlineResponse)(nil),         // 12: cart.DeleteCartlineResponse
-	(*DeleteCartCartlinesResponse)(nil),    // 13: cart.DeleteCartCartlinesResponse
-	(*DeleteProductCartlinesResponse)(nil), // 14: cart.DeleteProductCartlinesResponse
-	(*PrepareOrderResponse)(nil),           // 15: cart.PrepareOrderResponse
-	(*timestamppb.Timestamp)(nil),          // 16: google.protobuf.Timestamp
+	(CartlineIssue)(0),                     // 0: cart.CartlineIssue
+	(*GetUserCartRequest)(nil),             // 1: cart.GetUserCartRequest
+	(*CreateCartRequest)(nil),              // 2: cart.CreateCartRequest
+	(*CreateCartlineRequest)(nil),          // 3: cart.CreateCartlineRequest
+	(*UpdateCartlineRequest)(nil),          // 4: cart.UpdateCartlineRequest
+	(*CartlineChange)(nil),                 // 5: cart.CartlineChange
+	(*BatchUpdateCartRequest)(nil),         // 6: cart.BatchUpdateCartRequest
+	(*DeleteCartlineRequest)(nil),          // 7: cart.DeleteCartlineRequest
+	(*DeleteCartRequest)(nil),              // 8: cart.DeleteCartRequest
+	(*DeleteCartCartlinesRequest)(nil),     // 9: cart.DeleteCartCartlinesRequest
+	(*DeleteProductCartlinesRequest)(nil),  // 10: cart.DeleteProductCartlinesRequest
+	(*PrepareOrderRequest)(nil),            // 11: cart.PrepareOrderRequest
+	(*GetCartSummaryRequest)(nil),          // 12: cart.GetCartSummaryRequest
+	(*ApplyPromoCodeRequest)(nil),          // 13: cart.ApplyPromoCodeRequest
+	(*MergeCartsRequest)(nil),              // 14: cart.MergeCartsRequest
+	(*CartResponse)(nil),                   // 15: cart.CartResponse
+	(*CartlineResponse)(nil),               // 16: cart.CartlineResponse
+	(*CartSummaryLineResponse)(nil),        // 17: cart.CartSummaryLineResponse
+	(*CartSummaryResponse)(nil),            // 18: cart.CartSummaryResponse
+	(*RestoreAbandonedCartRequest)(nil),    // 19: cart.RestoreAbandonedCartRequest
+	(*GetAbandonedCartMetricsRequest)(nil), // 20: cart.GetAbandonedCartMetricsRequest
+	(*AbandonedCartMetricResponse)(nil),    // 21: cart.AbandonedCartMetricResponse
+	(*AbandonedCartMetricsResponse)(nil),   // 22: cart.AbandonedCartMetricsResponse
+	(*DeleteCartResponse)(nil),             // 23: cart.DeleteCartResponse
+	(*DeleteCartlineResponse)(nil),         // 24: cart.DeleteCartlineResponse
+	(*DeleteCartCartlinesResponse)(nil),    // 25: cart.DeleteCartCartlinesResponse
+	(*DeleteProductCartlinesResponse)(nil), // 26: cart.DeleteProductCartlinesResponse
+	(*PrepareOrderResponse)(nil),           // 27: cart.PrepareOrderResponse
+	(*ApplyPromoCodeResponse)(nil),         // 28: cart.ApplyPromoCodeResponse
+	(product.AllocationStrategy)(0),        // 29: product.AllocationStrategy
+	(*product.Location)(nil),               // 30: product.Location
+	(*timestamppb.Timestamp)(nil),          // 31: google.protobuf.Timestamp
+	(*product.DiscountResponse)(nil),       // 32: product.DiscountResponse
 }
 var file_cart_proto_depIdxs = []int32{
-	10, // 0: cart.CartResponse.cartlines:type_name -> cart.CartlineResponse
-	16, // 1: cart.CartResponse.created_at:type_name -> google.protobuf.Timestamp
-	16, // 2: cart.CartResponse.updated_at:type_name -> google.protobuf.Timestamp
-	16, // 3: cart.CartlineResponse.created_at:type_name -> google.protobuf.Timestamp
-	16, // 4: cart.CartlineResponse.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 5: cart.Cart.GetUserCart:input_type -> cart.GetUserCartRequest
-	1,  // 6: cart.Cart.CreateCart:input_type -> cart.CreateCartRequest
-	5,  // 7: cart.Cart.DeleteCart:input_type -> cart.DeleteCartRequest
-	6,  // 8: cart.Cart.DeleteCartCartlines:input_type -> cart.DeleteCartCartlinesRequest
-	8,  // 9: cart.Cart.PrepareOrder:input_type -> cart.PrepareOrderRequest
-	2,  // 10: cart.Cart.CreateCartline:input_type -> cart.CreateCartlineRequest
-	3,  // 11: cart.Cart.UpdateCartline:input_type -> cart.UpdateCartlineRequest
-	4,  // 12: cart.Cart.DeleteCartline:input_type -> cart.DeleteCartlineRequest
-	7,  // 13: cart.Cart.DeleteProductCartlines:input_type -> cart.DeleteProductCartlinesRequest
-	9,  // 14: cart.Cart.GetUserCart:output_type -> cart.CartResponse
-	9,  // 15: cart.Cart.CreateCart:output_type -> cart.CartResponse
-	11, // 16: cart.Cart.DeleteCart:output_type -> cart.DeleteCartResponse
-	13, // 17: cart.Cart.DeleteCartCartlines:output_type -> cart.DeleteCartCartlinesResponse
-	15, // 18: cart.Cart.PrepareOrder:output_type -> cart.PrepareOrderResponse
-	10, // 19: cart.Cart.CreateCartline:output_type -> cart.CartlineResponse
-	10, // 20: cart.Cart.UpdateCartline:output_type -> cart.CartlineResponse
-	12, // 21: cart.Cart.DeleteCartline:output_type -> cart.DeleteCartlineResponse
-	14, // 22: cart.Cart.DeleteProductCartlines:output_type -> cart.DeleteProductCartlinesResponse
-	14, // [14:23] is the sub-list for method output_type
-	5,  // [5:14] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	29, // 0: cart.CreateCartlineRequest.strategy:type_name -> product.AllocationStrategy
+	30, // 1: cart.CreateCartlineRequest.shipping_location:type_name -> product.Location
+	5,  // 2: cart.BatchUpdateCartRequest.changes:type_name -> cart.CartlineChange
+	29, // 3: cart.BatchUpdateCartRequest.strategy:type_name -> product.AllocationStrategy
+	30, // 4: cart.BatchUpdateCartRequest.shipping_location:type_name -> product.Location
+	16, // 5: cart.CartResponse.cartlines:type_name -> cart.CartlineResponse
+	31, // 6: cart.CartResponse.created_at:type_name -> google.protobuf.Timestamp
+	31, // 7: cart.CartResponse.updated_at:type_name -> google.protobuf.Timestamp
+	31, // 8: cart.CartResponse.expires_at:type_name -> google.protobuf.Timestamp
+	31, // 9: cart.CartlineResponse.created_at:type_name -> google.protobuf.Timestamp
+	31, // 10: cart.CartlineResponse.updated_at:type_name -> google.protobuf.Timestamp
+	32, // 11: cart.CartSummaryLineResponse.discount:type_name -> product.DiscountResponse
+	0,  // 12: cart.CartSummaryLineResponse.issues:type_name -> cart.CartlineIssue
+	17, // 13: cart.CartSummaryResponse.lines:type_name -> cart.CartSummaryLineResponse
+	31, // 14: cart.GetAbandonedCartMetricsRequest.from:type_name -> google.protobuf.Timestamp
+	31, // 15: cart.GetAbandonedCartMetricsRequest.to:type_name -> google.protobuf.Timestamp
+	31, // 16: cart.AbandonedCartMetricResponse.day:type_name -> google.protobuf.Timestamp
+	21, // 17: cart.AbandonedCartMetricsResponse.metrics:type_name -> cart.AbandonedCartMetricResponse
+	15, // 18: cart.ApplyPromoCodeResponse.cart:type_name -> cart.CartResponse
+	1,  // 19: cart.Cart.GetUserCart:input_type -> cart.GetUserCartRequest
+	2,  // 20: cart.Cart.CreateCart:input_type -> cart.CreateCartRequest
+	8,  // 21: cart.Cart.DeleteCart:input_type -> cart.DeleteCartRequest
+	9,  // 22: cart.Cart.DeleteCartCartlines:input_type -> cart.DeleteCartCartlinesRequest
+	11, // 23: cart.Cart.PrepareOrder:input_type -> cart.PrepareOrderRequest
+	13, // 24: cart.Cart.ApplyPromoCode:input_type -> cart.ApplyPromoCodeRequest
+	14, // 25: cart.Cart.MergeCarts:input_type -> cart.MergeCartsRequest
+	12, // 26: cart.Cart.GetCartSummary:input_type -> cart.GetCartSummaryRequest
+	19, // 27: cart.Cart.RestoreAbandonedCart:input_type -> cart.RestoreAbandonedCartRequest
+	20, // 28: cart.Cart.GetAbandonedCartMetrics:input_type -> cart.GetAbandonedCartMetricsRequest
+	3,  // 29: cart.Cart.CreateCartline:input_type -> cart.CreateCartlineRequest
+	4,  // 30: cart.Cart.UpdateCartline:input_type -> cart.UpdateCartlineRequest
+	6,  // 31: cart.Cart.BatchUpdateCart:input_type -> cart.BatchUpdateCartRequest
+	7,  // 32: cart.Cart.DeleteCartline:input_type -> cart.DeleteCartlineRequest
+	10, // 33: cart.Cart.DeleteProductCartlines:input_type -> cart.DeleteProductCartlinesRequest
+	15, // 34: cart.Cart.GetUserCart:output_type -> cart.CartResponse
+	15, // 35: cart.Cart.CreateCart:output_type -> cart.CartResponse
+	23, // 36: cart.Cart.DeleteCart:output_type -> cart.DeleteCartResponse
+	25, // 37: cart.Cart.DeleteCartCartlines:output_type -> cart.DeleteCartCartlinesResponse
+	27, // 38: cart.Cart.PrepareOrder:output_type -> cart.PrepareOrderResponse
+	28, // 39: cart.Cart.ApplyPromoCode:output_type -> cart.ApplyPromoCodeResponse
+	15, // 40: cart.Cart.MergeCarts:output_type -> cart.CartResponse
+	18, // 41: cart.Cart.GetCartSummary:output_type -> cart.CartSummaryResponse
+	15, // 42: cart.Cart.RestoreAbandonedCart:output_type -> cart.CartResponse
+	22, // 43: cart.Cart.GetAbandonedCartMetrics:output_type -> cart.AbandonedCartMetricsResponse
+	16, // 44: cart.Cart.CreateCartline:output_type -> cart.CartlineResponse
+	16, // 45: cart.Cart.UpdateCartline:output_type -> cart.CartlineResponse
+	15, // 46: cart.Cart.BatchUpdateCart:output_type -> cart.CartResponse
+	24, // 47: cart.Cart.DeleteCartline:output_type -> cart.DeleteCartlineResponse
+	26, // 48: cart.Cart.DeleteProductCartlines:output_type -> cart.DeleteProductCartlinesResponse
+	34, // [34:49] is the sub-list for method output_type
+	19, // [19:34] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_cart_proto_init() }
@@ -1061,7 +2292,7 @@ func file_cart_proto_init() {
 			}
 		}
 		file_cart_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteCartlineRequest); i {
+			switch v := v.(*CartlineChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1073,7 +2304,7 @@ func file_cart_proto_init() {
 			}
 		}
 		file_cart_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteCartRequest); i {
+			switch v := v.(*BatchUpdateCartRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1085,7 +2316,7 @@ func file_cart_proto_init() {
 			}
 		}
 		file_cart_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteCartCartlinesRequest); i {
+			switch v := v.(*DeleteCartlineRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1097,7 +2328,7 @@ func file_cart_proto_init() {
 			}
 		}
 		file_cart_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteProductCartlinesRequest); i {
+			switch v := v.(*DeleteCartRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1109,7 +2340,7 @@ func file_cart_proto_init() {
 			}
 		}
 		file_cart_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PrepareOrderRequest); i {
+			switch v := v.(*DeleteCartCartlinesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1121,7 +2352,7 @@ func file_cart_proto_init() {
 			}
 		}
 		file_cart_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CartResponse); i {
+			switch v := v.(*DeleteProductCartlinesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1133,7 +2364,7 @@ func file_cart_proto_init() {
 			}
 		}
 		file_cart_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CartlineResponse); i {
+			switch v := v.(*PrepareOrderRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1145,7 +2376,7 @@ func file_cart_proto_init() {
 			}
 		}
 		file_cart_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteCartResponse); i {
+			switch v := v.(*GetCartSummaryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1157,7 +2388,7 @@ func file_cart_proto_init() {
 			}
 		}
 		file_cart_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteCartlineResponse); i {
+			switch v := v.(*ApplyPromoCodeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1169,7 +2400,7 @@ func file_cart_proto_init() {
 			}
 		}
 		file_cart_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteCartCartlinesResponse); i {
+			switch v := v.(*MergeCartsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1181,7 +2412,7 @@ func file_cart_proto_init() {
 			}
 		}
 		file_cart_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteProductCartlinesResponse); i {
+			switch v := v.(*CartResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1193,6 +2424,138 @@ func file_cart_proto_init() {
 			}
 		}
 		file_cart_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CartlineResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cart_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CartSummaryLineResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cart_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CartSummaryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cart_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreAbandonedCartRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cart_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAbandonedCartMetricsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cart_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AbandonedCartMetricResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cart_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AbandonedCartMetricsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cart_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCartResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cart_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCartlineResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cart_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCartCartlinesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cart_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteProductCartlinesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cart_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PrepareOrderResponse); i {
 			case 0:
 				return &v.state
@@ -1204,19 +2567,32 @@ func file_cart_proto_init() {
 				return nil
 			}
 		}
+		file_cart_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyPromoCodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cart_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   16,
+			NumEnums:      1,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_cart_proto_goTypes,
 		DependencyIndexes: file_cart_proto_depIdxs,
+		EnumInfos:         file_cart_proto_enumTypes,
 		MessageInfos:      file_cart_proto_msgTypes,
 	}.Build()
 	File_cart_proto = out.File
@@ -19,15 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Cart_GetUserCart_FullMethodName            = "/cart.Cart/GetUserCart"
-	Cart_CreateCart_FullMethodName             = "/cart.Cart/CreateCart"
-	Cart_DeleteCart_FullMethodName             = "/cart.Cart/DeleteCart"
-	Cart_DeleteCartCartlines_FullMethodName    = "/cart.Cart/DeleteCartCartlines"
-	Cart_PrepareOrder_FullMethodName           = "/cart.Cart/PrepareOrder"
-	Cart_CreateCartline_FullMethodName         = "/cart.Cart/CreateCartline"
-	Cart_UpdateCartline_FullMethodName         = "/cart.Cart/UpdateCartline"
-	Cart_DeleteCartline_FullMethodName         = "/cart.Cart/DeleteCartline"
-	Cart_DeleteProductCartlines_FullMethodName = "/cart.Cart/DeleteProductCartlines"
+	Cart_GetUserCart_FullMethodName             = "/cart.Cart/GetUserCart"
+	Cart_CreateCart_FullMethodName              = "/cart.Cart/CreateCart"
+	Cart_DeleteCart_FullMethodName              = "/cart.Cart/DeleteCart"
+	Cart_DeleteCartCartlines_FullMethodName     = "/cart.Cart/DeleteCartCartlines"
+	Cart_PrepareOrder_FullMethodName            = "/cart.Cart/PrepareOrder"
+	Cart_ApplyPromoCode_FullMethodName          = "/cart.Cart/ApplyPromoCode"
+	Cart_MergeCarts_FullMethodName              = "/cart.Cart/MergeCarts"
+	Cart_GetCartSummary_FullMethodName          = "/cart.Cart/GetCartSummary"
+	Cart_RestoreAbandonedCart_FullMethodName    = "/cart.Cart/RestoreAbandonedCart"
+	Cart_GetAbandonedCartMetrics_FullMethodName = "/cart.Cart/GetAbandonedCartMetrics"
+	Cart_CreateCartline_FullMethodName          = "/cart.Cart/CreateCartline"
+	Cart_UpdateCartline_FullMethodName          = "/cart.Cart/UpdateCartline"
+	Cart_BatchUpdateCart_FullMethodName         = "/cart.Cart/BatchUpdateCart"
+	Cart_DeleteCartline_FullMethodName          = "/cart.Cart/DeleteCartline"
+	Cart_DeleteProductCartlines_FullMethodName  = "/cart.Cart/DeleteProductCartlines"
 )
 
 // CartClient is the client API for Cart service.
@@ -39,8 +45,14 @@ type CartClient interface {
 	DeleteCart(ctx context.Context, in *DeleteCartRequest, opts ...grpc.CallOption) (*DeleteCartResponse, error)
 	DeleteCartCartlines(ctx context.Context, in *DeleteCartCartlinesRequest, opts ...grpc.CallOption) (*DeleteCartCartlinesResponse, error)
 	PrepareOrder(ctx context.Context, in *PrepareOrderRequest, opts ...grpc.CallOption) (*PrepareOrderResponse, error)
+	ApplyPromoCode(ctx context.Context, in *ApplyPromoCodeRequest, opts ...grpc.CallOption) (*ApplyPromoCodeResponse, error)
+	MergeCarts(ctx context.Context, in *MergeCartsRequest, opts ...grpc.CallOption) (*CartResponse, error)
+	GetCartSummary(ctx context.Context, in *GetCartSummaryRequest, opts ...grpc.CallOption) (*CartSummaryResponse, error)
+	RestoreAbandonedCart(ctx context.Context, in *RestoreAbandonedCartRequest, opts ...grpc.CallOption) (*CartResponse, error)
+	GetAbandonedCartMetrics(ctx context.Context, in *GetAbandonedCartMetricsRequest, opts ...grpc.CallOption) (*AbandonedCartMetricsResponse, error)
 	CreateCartline(ctx context.Context, in *CreateCartlineRequest, opts ...grpc.CallOption) (*CartlineResponse, error)
 	UpdateCartline(ctx context.Context, in *UpdateCartlineRequest, opts ...grpc.CallOption) (*CartlineResponse, error)
+	BatchUpdateCart(ctx context.Context, in *BatchUpdateCartRequest, opts ...grpc.CallOption) (*CartResponse, error)
 	DeleteCartline(ctx context.Context, in *DeleteCartlineRequest, opts ...grpc.CallOption) (*DeleteCartlineResponse, error)
 	DeleteProductCartlines(ctx context.Context, in *DeleteProductCartlinesRequest, opts ...grpc.CallOption) (*DeleteProductCartlinesResponse, error)
 }
@@ -98,6 +110,51 @@ func (c *cartClient) PrepareOrder(ctx context.Context, in *PrepareOrderRequest,
 	return out, nil
 }
 
+func (c *cartClient) ApplyPromoCode(ctx context.Context, in *ApplyPromoCodeRequest, opts ...grpc.CallOption) (*ApplyPromoCodeResponse, error) {
+	out := new(ApplyPromoCodeResponse)
+	err := c.cc.Invoke(ctx, Cart_ApplyPromoCode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) MergeCarts(ctx context.Context, in *MergeCartsRequest, opts ...grpc.CallOption) (*CartResponse, error) {
+	out := new(CartResponse)
+	err := c.cc.Invoke(ctx, Cart_MergeCarts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) GetCartSummary(ctx context.Context, in *GetCartSummaryRequest, opts ...grpc.CallOption) (*CartSummaryResponse, error) {
+	out := new(CartSummaryResponse)
+	err := c.cc.Invoke(ctx, Cart_GetCartSummary_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) RestoreAbandonedCart(ctx context.Context, in *RestoreAbandonedCartRequest, opts ...grpc.CallOption) (*CartResponse, error) {
+	out := new(CartResponse)
+	err := c.cc.Invoke(ctx, Cart_RestoreAbandonedCart_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) GetAbandonedCartMetrics(ctx context.Context, in *GetAbandonedCartMetricsRequest, opts ...grpc.CallOption) (*AbandonedCartMetricsResponse, error) {
+	out := new(AbandonedCartMetricsResponse)
+	err := c.cc.Invoke(ctx, Cart_GetAbandonedCartMetrics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cartClient) CreateCartline(ctx context.Context, in *CreateCartlineRequest, opts ...grpc.CallOption) (*CartlineResponse, error) {
 	out := new(CartlineResponse)
 	err := c.cc.Invoke(ctx, Cart_CreateCartline_FullMethodName, in, out, opts...)
@@ -116,6 +173,15 @@ func (c *cartClient) UpdateCartline(ctx context.Context, in *UpdateCartlineReque
 	return out, nil
 }
 
+func (c *cartClient) BatchUpdateCart(ctx context.Context, in *BatchUpdateCartRequest, opts ...grpc.CallOption) (*CartResponse, error) {
+	out := new(CartResponse)
+	err := c.cc.Invoke(ctx, Cart_BatchUpdateCart_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cartClient) DeleteCartline(ctx context.Context, in *DeleteCartlineRequest, opts ...grpc.CallOption) (*DeleteCartlineResponse, error) {
 	out := new(DeleteCartlineResponse)
 	err := c.cc.Invoke(ctx, Cart_DeleteCartline_FullMethodName, in, out, opts...)
@@ -143,8 +209,14 @@ type CartServer interface {
 	DeleteCart(context.Context, *DeleteCartRequest) (*DeleteCartResponse, error)
 	DeleteCartCartlines(context.Context, *DeleteCartCartlinesRequest) (*DeleteCartCartlinesResponse, error)
 	PrepareOrder(context.Context, *PrepareOrderRequest) (*PrepareOrderResponse, error)
+	ApplyPromoCode(context.Context, *ApplyPromoCodeRequest) (*ApplyPromoCodeResponse, error)
+	MergeCarts(context.Context, *MergeCartsRequest) (*CartResponse, error)
+	GetCartSummary(context.Context, *GetCartSummaryRequest) (*CartSummaryResponse, error)
+	RestoreAbandonedCart(context.Context, *RestoreAbandonedCartRequest) (*CartResponse, error)
+	GetAbandonedCartMetrics(context.Context, *GetAbandonedCartMetricsRequest) (*AbandonedCartMetricsResponse, error)
 	CreateCartline(context.Context, *CreateCartlineRequest) (*CartlineResponse, error)
 	UpdateCartline(context.Context, *UpdateCartlineRequest) (*CartlineResponse, error)
+	BatchUpdateCart(context.Context, *BatchUpdateCartRequest) (*CartResponse, error)
 	DeleteCartline(context.Context, *DeleteCartlineRequest) (*DeleteCartlineResponse, error)
 	DeleteProductCartlines(context.Context, *DeleteProductCartlinesRequest) (*DeleteProductCartlinesResponse, error)
 	mustEmbedUnimplementedCartServer()
@@ -169,12 +241,30 @@ func (UnimplementedCartServer) DeleteCartCartlines(context.Context, *DeleteCartC
 func (UnimplementedCartServer) PrepareOrder(context.Context, *PrepareOrderRequest) (*PrepareOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PrepareOrder not implemented")
 }
+func (UnimplementedCartServer) ApplyPromoCode(context.Context, *ApplyPromoCodeRequest) (*ApplyPromoCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyPromoCode not implemented")
+}
+func (UnimplementedCartServer) MergeCarts(context.Context, *MergeCartsRequest) (*CartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeCarts not implemented")
+}
+func (UnimplementedCartServer) GetCartSummary(context.Context, *GetCartSummaryRequest) (*CartSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCartSummary not implemented")
+}
+func (UnimplementedCartServer) RestoreAbandonedCart(context.Context, *RestoreAbandonedCartRequest) (*CartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreAbandonedCart not implemented")
+}
+func (UnimplementedCartServer) GetAbandonedCartMetrics(context.Context, *GetAbandonedCartMetricsRequest) (*AbandonedCartMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAbandonedCartMetrics not implemented")
+}
 func (UnimplementedCartServer) CreateCartline(context.Context, *CreateCartlineRequest) (*CartlineResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateCartline not implemented")
 }
 func (UnimplementedCartServer) UpdateCartline(context.Context, *UpdateCartlineRequest) (*CartlineResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCartline not implemented")
 }
+func (UnimplementedCartServer) BatchUpdateCart(context.Context, *BatchUpdateCartRequest) (*CartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateCart not implemented")
+}
 func (UnimplementedCartServer) DeleteCartline(context.Context, *DeleteCartlineRequest) (*DeleteCartlineResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCartline not implemented")
 }
@@ -284,6 +374,96 @@ func _Cart_PrepareOrder_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cart_ApplyPromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyPromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).ApplyPromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_ApplyPromoCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).ApplyPromoCode(ctx, req.(*ApplyPromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_MergeCarts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeCartsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).MergeCarts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_MergeCarts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).MergeCarts(ctx, req.(*MergeCartsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_GetCartSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCartSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).GetCartSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_GetCartSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).GetCartSummary(ctx, req.(*GetCartSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_RestoreAbandonedCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreAbandonedCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).RestoreAbandonedCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_RestoreAbandonedCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).RestoreAbandonedCart(ctx, req.(*RestoreAbandonedCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_GetAbandonedCartMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAbandonedCartMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).GetAbandonedCartMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_GetAbandonedCartMetrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).GetAbandonedCartMetrics(ctx, req.(*GetAbandonedCartMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Cart_CreateCartline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateCartlineRequest)
 	if err := dec(in); err != nil {
@@ -320,6 +500,24 @@ func _Cart_UpdateCartline_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cart_BatchUpdateCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).BatchUpdateCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_BatchUpdateCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).BatchUpdateCart(ctx, req.(*BatchUpdateCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Cart_DeleteCartline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteCartlineRequest)
 	if err := dec(in); err != nil {
@@ -383,6 +581,26 @@ var Cart_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PrepareOrder",
 			Handler:    _Cart_PrepareOrder_Handler,
 		},
+		{
+			MethodName: "ApplyPromoCode",
+			Handler:    _Cart_ApplyPromoCode_Handler,
+		},
+		{
+			MethodName: "MergeCarts",
+			Handler:    _Cart_MergeCarts_Handler,
+		},
+		{
+			MethodName: "GetCartSummary",
+			Handler:    _Cart_GetCartSummary_Handler,
+		},
+		{
+			MethodName: "RestoreAbandonedCart",
+			Handler:    _Cart_RestoreAbandonedCart_Handler,
+		},
+		{
+			MethodName: "GetAbandonedCartMetrics",
+			Handler:    _Cart_GetAbandonedCartMetrics_Handler,
+		},
 		{
 			MethodName: "CreateCartline",
 			Handler:    _Cart_CreateCartline_Handler,
@@ -391,6 +609,10 @@ var Cart_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCartline",
 			Handler:    _Cart_UpdateCartline_Handler,
 		},
+		{
+			MethodName: "BatchUpdateCart",
+			Handler:    _Cart_BatchUpdateCart_Handler,
+		},
 		{
 			MethodName: "DeleteCartline",
 			Handler:    _Cart_DeleteCartline_Handler,
@@ -24,37 +24,82 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Gateway_RegisterUser_FullMethodName        = "/gateway.Gateway/RegisterUser"
-	Gateway_Login_FullMethodName               = "/gateway.Gateway/Login"
-	Gateway_GetUser_FullMethodName             = "/gateway.Gateway/GetUser"
-	Gateway_GetUsers_FullMethodName            = "/gateway.Gateway/GetUsers"
-	Gateway_UpdateUser_FullMethodName          = "/gateway.Gateway/UpdateUser"
-	Gateway_ChangeUserRole_FullMethodName      = "/gateway.Gateway/ChangeUserRole"
-	Gateway_DeleteUser_FullMethodName          = "/gateway.Gateway/DeleteUser"
-	Gateway_CreateOrder_FullMethodName         = "/gateway.Gateway/CreateOrder"
-	Gateway_GetOrder_FullMethodName            = "/gateway.Gateway/GetOrder"
-	Gateway_GetOrders_FullMethodName           = "/gateway.Gateway/GetOrders"
-	Gateway_GetUserOrders_FullMethodName       = "/gateway.Gateway/GetUserOrders"
-	Gateway_DeleteOrder_FullMethodName         = "/gateway.Gateway/DeleteOrder"
-	Gateway_GetOrderline_FullMethodName        = "/gateway.Gateway/GetOrderline"
-	Gateway_UpdateOrderline_FullMethodName     = "/gateway.Gateway/UpdateOrderline"
-	Gateway_DeleteOrderline_FullMethodName     = "/gateway.Gateway/DeleteOrderline"
-	Gateway_GetUserCart_FullMethodName         = "/gateway.Gateway/GetUserCart"
-	Gateway_CreateCartline_FullMethodName      = "/gateway.Gateway/CreateCartline"
-	Gateway_UpdateCartline_FullMethodName      = "/gateway.Gateway/UpdateCartline"
-	Gateway_DeleteCartline_FullMethodName      = "/gateway.Gateway/DeleteCartline"
-	Gateway_DeleteCartCartlines_FullMethodName = "/gateway.Gateway/DeleteCartCartlines"
-	Gateway_GetProduct_FullMethodName          = "/gateway.Gateway/GetProduct"
-	Gateway_GetProducts_FullMethodName         = "/gateway.Gateway/GetProducts"
-	Gateway_GetUserProducts_FullMethodName     = "/gateway.Gateway/GetUserProducts"
-	Gateway_CreateProduct_FullMethodName       = "/gateway.Gateway/CreateProduct"
-	Gateway_UpdateProduct_FullMethodName       = "/gateway.Gateway/UpdateProduct"
-	Gateway_ModerateProduct_FullMethodName     = "/gateway.Gateway/ModerateProduct"
-	Gateway_DeleteProduct_FullMethodName       = "/gateway.Gateway/DeleteProduct"
-	Gateway_GetCategory_FullMethodName         = "/gateway.Gateway/GetCategory"
-	Gateway_GetAllCategories_FullMethodName    = "/gateway.Gateway/GetAllCategories"
-	Gateway_CreateDiscount_FullMethodName      = "/gateway.Gateway/CreateDiscount"
-	Gateway_DeleteDiscount_FullMethodName      = "/gateway.Gateway/DeleteDiscount"
+	Gateway_RegisterUser_FullMethodName            = "/gateway.Gateway/RegisterUser"
+	Gateway_Login_FullMethodName                   = "/gateway.Gateway/Login"
+	Gateway_GetUser_FullMethodName                 = "/gateway.Gateway/GetUser"
+	Gateway_GetUsers_FullMethodName                = "/gateway.Gateway/GetUsers"
+	Gateway_UpdateUser_FullMethodName              = "/gateway.Gateway/UpdateUser"
+	Gateway_ChangeUserRole_FullMethodName          = "/gateway.Gateway/ChangeUserRole"
+	Gateway_DeleteUser_FullMethodName              = "/gateway.Gateway/DeleteUser"
+	Gateway_CreateOrder_FullMethodName             = "/gateway.Gateway/CreateOrder"
+	Gateway_GetOrder_FullMethodName                = "/gateway.Gateway/GetOrder"
+	Gateway_WatchOrder_FullMethodName              = "/gateway.Gateway/WatchOrder"
+	Gateway_GetOrders_FullMethodName               = "/gateway.Gateway/GetOrders"
+	Gateway_GetUserOrders_FullMethodName           = "/gateway.Gateway/GetUserOrders"
+	Gateway_DeleteOrder_FullMethodName             = "/gateway.Gateway/DeleteOrder"
+	Gateway_GetOrderline_FullMethodName            = "/gateway.Gateway/GetOrderline"
+	Gateway_UpdateOrderline_FullMethodName         = "/gateway.Gateway/UpdateOrderline"
+	Gateway_DeleteOrderline_FullMethodName         = "/gateway.Gateway/DeleteOrderline"
+	Gateway_CreatePromoCode_FullMethodName         = "/gateway.Gateway/CreatePromoCode"
+	Gateway_GetPromoCode_FullMethodName            = "/gateway.Gateway/GetPromoCode"
+	Gateway_GetSellerLedger_FullMethodName         = "/gateway.Gateway/GetSellerLedger"
+	Gateway_CreatePayout_FullMethodName            = "/gateway.Gateway/CreatePayout"
+	Gateway_GetSellerSalesReport_FullMethodName    = "/gateway.Gateway/GetSellerSalesReport"
+	Gateway_GetSalesReport_FullMethodName          = "/gateway.Gateway/GetSalesReport"
+	Gateway_GetConversionReport_FullMethodName     = "/gateway.Gateway/GetConversionReport"
+	Gateway_CreateGuestCart_FullMethodName         = "/gateway.Gateway/CreateGuestCart"
+	Gateway_GetUserCart_FullMethodName             = "/gateway.Gateway/GetUserCart"
+	Gateway_GetCartSummary_FullMethodName          = "/gateway.Gateway/GetCartSummary"
+	Gateway_CreateCartline_FullMethodName          = "/gateway.Gateway/CreateCartline"
+	Gateway_UpdateCartline_FullMethodName          = "/gateway.Gateway/UpdateCartline"
+	Gateway_BatchUpdateCart_FullMethodName         = "/gateway.Gateway/BatchUpdateCart"
+	Gateway_DeleteCartline_FullMethodName          = "/gateway.Gateway/DeleteCartline"
+	Gateway_DeleteCartCartlines_FullMethodName     = "/gateway.Gateway/DeleteCartCartlines"
+	Gateway_ApplyPromoCode_FullMethodName          = "/gateway.Gateway/ApplyPromoCode"
+	Gateway_RestoreAbandonedCart_FullMethodName    = "/gateway.Gateway/RestoreAbandonedCart"
+	Gateway_GetAbandonedCartMetrics_FullMethodName = "/gateway.Gateway/GetAbandonedCartMetrics"
+	Gateway_GetProduct_FullMethodName              = "/gateway.Gateway/GetProduct"
+	Gateway_GetProducts_FullMethodName             = "/gateway.Gateway/GetProducts"
+	Gateway_GetUserProducts_FullMethodName         = "/gateway.Gateway/GetUserProducts"
+	Gateway_CreateProduct_FullMethodName           = "/gateway.Gateway/CreateProduct"
+	Gateway_UpdateProduct_FullMethodName           = "/gateway.Gateway/UpdateProduct"
+	Gateway_ModerateProduct_FullMethodName         = "/gateway.Gateway/ModerateProduct"
+	Gateway_SubmitProduct_FullMethodName           = "/gateway.Gateway/SubmitProduct"
+	Gateway_GetModerationQueue_FullMethodName      = "/gateway.Gateway/GetModerationQueue"
+	Gateway_DeleteProduct_FullMethodName           = "/gateway.Gateway/DeleteProduct"
+	Gateway_RestoreProduct_FullMethodName          = "/gateway.Gateway/RestoreProduct"
+	Gateway_GetProductHistory_FullMethodName       = "/gateway.Gateway/GetProductHistory"
+	Gateway_GetPriceHistory_FullMethodName         = "/gateway.Gateway/GetPriceHistory"
+	Gateway_GetCategory_FullMethodName             = "/gateway.Gateway/GetCategory"
+	Gateway_GetAllCategories_FullMethodName        = "/gateway.Gateway/GetAllCategories"
+	Gateway_CreateDiscount_FullMethodName          = "/gateway.Gateway/CreateDiscount"
+	Gateway_DeleteDiscount_FullMethodName          = "/gateway.Gateway/DeleteDiscount"
+	Gateway_CreateCategoryDiscount_FullMethodName  = "/gateway.Gateway/CreateCategoryDiscount"
+	Gateway_DeleteCategoryDiscount_FullMethodName  = "/gateway.Gateway/DeleteCategoryDiscount"
+	Gateway_CreateSellerDiscount_FullMethodName    = "/gateway.Gateway/CreateSellerDiscount"
+	Gateway_DeleteSellerDiscount_FullMethodName    = "/gateway.Gateway/DeleteSellerDiscount"
+	Gateway_GetDiscounts_FullMethodName            = "/gateway.Gateway/GetDiscounts"
+	Gateway_CreateReview_FullMethodName            = "/gateway.Gateway/CreateReview"
+	Gateway_GetProductReviews_FullMethodName       = "/gateway.Gateway/GetProductReviews"
+	Gateway_ReplyReview_FullMethodName             = "/gateway.Gateway/ReplyReview"
+	Gateway_CreateWarehouse_FullMethodName         = "/gateway.Gateway/CreateWarehouse"
+	Gateway_GetWarehouses_FullMethodName           = "/gateway.Gateway/GetWarehouses"
+	Gateway_SetWarehouseStock_FullMethodName       = "/gateway.Gateway/SetWarehouseStock"
+	Gateway_GetProductStocks_FullMethodName        = "/gateway.Gateway/GetProductStocks"
+	Gateway_SetStockAlert_FullMethodName           = "/gateway.Gateway/SetStockAlert"
+	Gateway_SetPurchaseLimit_FullMethodName        = "/gateway.Gateway/SetPurchaseLimit"
+	Gateway_GetNotifications_FullMethodName        = "/gateway.Gateway/GetNotifications"
+	Gateway_GetStorefront_FullMethodName           = "/gateway.Gateway/GetStorefront"
+	Gateway_SetSellerProfile_FullMethodName        = "/gateway.Gateway/SetSellerProfile"
+	Gateway_GetExchangeRates_FullMethodName        = "/gateway.Gateway/GetExchangeRates"
+	Gateway_SetExchangeRate_FullMethodName         = "/gateway.Gateway/SetExchangeRate"
+	Gateway_GetWishlists_FullMethodName            = "/gateway.Gateway/GetWishlists"
+	Gateway_CreateWishlist_FullMethodName          = "/gateway.Gateway/CreateWishlist"
+	Gateway_DeleteWishlist_FullMethodName          = "/gateway.Gateway/DeleteWishlist"
+	Gateway_AddWishlistItem_FullMethodName         = "/gateway.Gateway/AddWishlistItem"
+	Gateway_RemoveWishlistItem_FullMethodName      = "/gateway.Gateway/RemoveWishlistItem"
+	Gateway_MoveWishlistItemToCart_FullMethodName  = "/gateway.Gateway/MoveWishlistItemToCart"
+	Gateway_MoveCartlineToWishlist_FullMethodName  = "/gateway.Gateway/MoveCartlineToWishlist"
 )
 
 // GatewayClient is the client API for Gateway service.
@@ -73,18 +118,35 @@ type GatewayClient interface {
 	// Order
 	CreateOrder(ctx context.Context, in *order.CreateOrderRequest, opts ...grpc.CallOption) (*order.OrderResponse, error)
 	GetOrder(ctx context.Context, in *order.GetOrderRequest, opts ...grpc.CallOption) (*order.OrderResponse, error)
+	// Streams the status changes of the order to grpc clients,
+	// HTTP clients get them as Server-Sent Events from /api/v1/order/{order_id}/events
+	WatchOrder(ctx context.Context, in *order.WatchOrderRequest, opts ...grpc.CallOption) (Gateway_WatchOrderClient, error)
 	GetOrders(ctx context.Context, in *order.GetOrdersRequest, opts ...grpc.CallOption) (*order.OrdersResponse, error)
 	GetUserOrders(ctx context.Context, in *GetUserOrdersRequest, opts ...grpc.CallOption) (*order.OrdersResponse, error)
 	DeleteOrder(ctx context.Context, in *order.DeleteOrderRequest, opts ...grpc.CallOption) (*order.DeleteOrderResponse, error)
 	GetOrderline(ctx context.Context, in *order.GetOrderlineRequest, opts ...grpc.CallOption) (*order.OrderlineResponse, error)
 	UpdateOrderline(ctx context.Context, in *order.UpdateOrderlineRequest, opts ...grpc.CallOption) (*order.OrderlineResponse, error)
 	DeleteOrderline(ctx context.Context, in *order.DeleteOrderlineRequest, opts ...grpc.CallOption) (*order.DeleteOrderlineResponse, error)
+	CreatePromoCode(ctx context.Context, in *order.CreatePromoCodeRequest, opts ...grpc.CallOption) (*order.PromoCodeResponse, error)
+	GetPromoCode(ctx context.Context, in *order.GetPromoCodeRequest, opts ...grpc.CallOption) (*order.PromoCodeResponse, error)
+	GetSellerLedger(ctx context.Context, in *order.GetSellerLedgerRequest, opts ...grpc.CallOption) (*order.SellerLedgerResponse, error)
+	CreatePayout(ctx context.Context, in *order.CreatePayoutRequest, opts ...grpc.CallOption) (*order.PayoutsResponse, error)
+	GetSellerSalesReport(ctx context.Context, in *order.GetSalesReportRequest, opts ...grpc.CallOption) (*order.SalesReportResponse, error)
+	// Reports
+	GetSalesReport(ctx context.Context, in *order.GetSalesReportRequest, opts ...grpc.CallOption) (*order.SalesReportResponse, error)
+	GetConversionReport(ctx context.Context, in *order.GetConversionReportRequest, opts ...grpc.CallOption) (*order.ConversionReportResponse, error)
 	// Cart
+	CreateGuestCart(ctx context.Context, in *CreateGuestCartRequest, opts ...grpc.CallOption) (*CreateGuestCartResponse, error)
 	GetUserCart(ctx context.Context, in *cart.GetUserCartRequest, opts ...grpc.CallOption) (*cart.CartResponse, error)
+	GetCartSummary(ctx context.Context, in *cart.GetCartSummaryRequest, opts ...grpc.CallOption) (*cart.CartSummaryResponse, error)
 	CreateCartline(ctx context.Context, in *cart.CreateCartlineRequest, opts ...grpc.CallOption) (*cart.CartlineResponse, error)
 	UpdateCartline(ctx context.Context, in *cart.UpdateCartlineRequest, opts ...grpc.CallOption) (*cart.CartlineResponse, error)
+	BatchUpdateCart(ctx context.Context, in *cart.BatchUpdateCartRequest, opts ...grpc.CallOption) (*cart.CartResponse, error)
 	DeleteCartline(ctx context.Context, in *cart.DeleteCartlineRequest, opts ...grpc.CallOption) (*cart.DeleteCartlineResponse, error)
 	DeleteCartCartlines(ctx context.Context, in *cart.DeleteCartCartlinesRequest, opts ...grpc.CallOption) (*cart.DeleteCartCartlinesResponse, error)
+	ApplyPromoCode(ctx context.Context, in *cart.ApplyPromoCodeRequest, opts ...grpc.CallOption) (*cart.ApplyPromoCodeResponse, error)
+	RestoreAbandonedCart(ctx context.Context, in *cart.RestoreAbandonedCartRequest, opts ...grpc.CallOption) (*cart.CartResponse, error)
+	GetAbandonedCartMetrics(ctx context.Context, in *cart.GetAbandonedCartMetricsRequest, opts ...grpc.CallOption) (*cart.AbandonedCartMetricsResponse, error)
 	// Product
 	GetProduct(ctx context.Context, in *product.GetProductRequest, opts ...grpc.CallOption) (*product.ProductResponse, error)
 	GetProducts(ctx context.Context, in *product.GetProductsRequest, opts ...grpc.CallOption) (*product.ProductsResponse, error)
@@ -92,11 +154,43 @@ type GatewayClient interface {
 	CreateProduct(ctx context.Context, in *product.CreateProductRequest, opts ...grpc.CallOption) (*product.ProductResponse, error)
 	UpdateProduct(ctx context.Context, in *product.UpdateProductRequest, opts ...grpc.CallOption) (*product.ProductResponse, error)
 	ModerateProduct(ctx context.Context, in *product.ModerateProductRequest, opts ...grpc.CallOption) (*product.ProductResponse, error)
+	SubmitProduct(ctx context.Context, in *product.SubmitProductRequest, opts ...grpc.CallOption) (*product.ProductResponse, error)
+	GetModerationQueue(ctx context.Context, in *product.GetModerationQueueRequest, opts ...grpc.CallOption) (*product.ProductsResponse, error)
 	DeleteProduct(ctx context.Context, in *product.DeleteProductRequest, opts ...grpc.CallOption) (*product.DeleteProductResponse, error)
+	RestoreProduct(ctx context.Context, in *product.RestoreProductRequest, opts ...grpc.CallOption) (*product.ProductResponse, error)
+	GetProductHistory(ctx context.Context, in *product.GetProductHistoryRequest, opts ...grpc.CallOption) (*product.ProductHistoryResponse, error)
+	GetPriceHistory(ctx context.Context, in *product.GetPriceHistoryRequest, opts ...grpc.CallOption) (*product.PriceHistoryResponse, error)
 	GetCategory(ctx context.Context, in *product.GetCategoryRequest, opts ...grpc.CallOption) (*product.CategoryResponse, error)
 	GetAllCategories(ctx context.Context, in *product.GetAllCategoriesRequest, opts ...grpc.CallOption) (*product.CategoriesResponse, error)
 	CreateDiscount(ctx context.Context, in *product.CreateDiscountRequest, opts ...grpc.CallOption) (*product.ProductResponse, error)
 	DeleteDiscount(ctx context.Context, in *product.DeleteDiscountRequest, opts ...grpc.CallOption) (*product.ProductResponse, error)
+	CreateCategoryDiscount(ctx context.Context, in *product.CreateCategoryDiscountRequest, opts ...grpc.CallOption) (*product.DiscountResponse, error)
+	DeleteCategoryDiscount(ctx context.Context, in *product.DeleteCategoryDiscountRequest, opts ...grpc.CallOption) (*product.DeleteDiscountResponse, error)
+	CreateSellerDiscount(ctx context.Context, in *product.CreateSellerDiscountRequest, opts ...grpc.CallOption) (*product.DiscountResponse, error)
+	DeleteSellerDiscount(ctx context.Context, in *product.DeleteSellerDiscountRequest, opts ...grpc.CallOption) (*product.DeleteDiscountResponse, error)
+	GetDiscounts(ctx context.Context, in *product.GetDiscountsRequest, opts ...grpc.CallOption) (*product.DiscountsResponse, error)
+	// Review
+	CreateReview(ctx context.Context, in *product.CreateReviewRequest, opts ...grpc.CallOption) (*product.ReviewResponse, error)
+	GetProductReviews(ctx context.Context, in *product.GetProductReviewsRequest, opts ...grpc.CallOption) (*product.ReviewsResponse, error)
+	ReplyReview(ctx context.Context, in *product.ReplyReviewRequest, opts ...grpc.CallOption) (*product.ReviewResponse, error)
+	CreateWarehouse(ctx context.Context, in *product.CreateWarehouseRequest, opts ...grpc.CallOption) (*product.WarehouseResponse, error)
+	GetWarehouses(ctx context.Context, in *product.GetWarehousesRequest, opts ...grpc.CallOption) (*product.WarehousesResponse, error)
+	SetWarehouseStock(ctx context.Context, in *product.SetWarehouseStockRequest, opts ...grpc.CallOption) (*product.WarehouseStockResponse, error)
+	GetProductStocks(ctx context.Context, in *product.GetProductStocksRequest, opts ...grpc.CallOption) (*product.WarehouseStocksResponse, error)
+	SetStockAlert(ctx context.Context, in *product.SetStockAlertRequest, opts ...grpc.CallOption) (*product.ProductResponse, error)
+	SetPurchaseLimit(ctx context.Context, in *product.SetPurchaseLimitRequest, opts ...grpc.CallOption) (*product.ProductResponse, error)
+	GetNotifications(ctx context.Context, in *product.GetNotificationsRequest, opts ...grpc.CallOption) (*product.NotificationsResponse, error)
+	GetStorefront(ctx context.Context, in *product.GetStorefrontRequest, opts ...grpc.CallOption) (*product.StorefrontResponse, error)
+	SetSellerProfile(ctx context.Context, in *product.SetSellerProfileRequest, opts ...grpc.CallOption) (*product.SellerProfileResponse, error)
+	GetExchangeRates(ctx context.Context, in *product.GetExchangeRatesRequest, opts ...grpc.CallOption) (*product.ExchangeRatesResponse, error)
+	SetExchangeRate(ctx context.Context, in *product.SetExchangeRateRequest, opts ...grpc.CallOption) (*product.ExchangeRateResponse, error)
+	GetWishlists(ctx context.Context, in *product.GetWishlistsRequest, opts ...grpc.CallOption) (*product.WishlistsResponse, error)
+	CreateWishlist(ctx context.Context, in *product.CreateWishlistRequest, opts ...grpc.CallOption) (*product.WishlistResponse, error)
+	DeleteWishlist(ctx context.Context, in *product.DeleteWishlistRequest, opts ...grpc.CallOption) (*product.DeleteWishlistResponse, error)
+	AddWishlistItem(ctx context.Context, in *product.AddWishlistItemRequest, opts ...grpc.CallOption) (*product.WishlistResponse, error)
+	RemoveWishlistItem(ctx context.Context, in *product.RemoveWishlistItemRequest, opts ...grpc.CallOption) (*product.WishlistResponse, error)
+	MoveWishlistItemToCart(ctx context.Context, in *product.MoveWishlistItemToCartRequest, opts ...grpc.CallOption) (*product.WishlistResponse, error)
+	MoveCartlineToWishlist(ctx context.Context, in *product.MoveCartlineToWishlistRequest, opts ...grpc.CallOption) (*product.WishlistResponse, error)
 }
 
 type gatewayClient struct {
@@ -188,6 +282,38 @@ func (c *gatewayClient) GetOrder(ctx context.Context, in *order.GetOrderRequest,
 	return out, nil
 }
 
+func (c *gatewayClient) WatchOrder(ctx context.Context, in *order.WatchOrderRequest, opts ...grpc.CallOption) (Gateway_WatchOrderClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Gateway_ServiceDesc.Streams[0], Gateway_WatchOrder_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gatewayWatchOrderClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Gateway_WatchOrderClient interface {
+	Recv() (*order.OrderEvent, error)
+	grpc.ClientStream
+}
+
+type gatewayWatchOrderClient struct {
+	grpc.ClientStream
+}
+
+func (x *gatewayWatchOrderClient) Recv() (*order.OrderEvent, error) {
+	m := new(order.OrderEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *gatewayClient) GetOrders(ctx context.Context, in *order.GetOrdersRequest, opts ...grpc.CallOption) (*order.OrdersResponse, error) {
 	out := new(order.OrdersResponse)
 	err := c.cc.Invoke(ctx, Gateway_GetOrders_FullMethodName, in, out, opts...)
@@ -242,6 +368,78 @@ func (c *gatewayClient) DeleteOrderline(ctx context.Context, in *order.DeleteOrd
 	return out, nil
 }
 
+func (c *gatewayClient) CreatePromoCode(ctx context.Context, in *order.CreatePromoCodeRequest, opts ...grpc.CallOption) (*order.PromoCodeResponse, error) {
+	out := new(order.PromoCodeResponse)
+	err := c.cc.Invoke(ctx, Gateway_CreatePromoCode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) GetPromoCode(ctx context.Context, in *order.GetPromoCodeRequest, opts ...grpc.CallOption) (*order.PromoCodeResponse, error) {
+	out := new(order.PromoCodeResponse)
+	err := c.cc.Invoke(ctx, Gateway_GetPromoCode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) GetSellerLedger(ctx context.Context, in *order.GetSellerLedgerRequest, opts ...grpc.CallOption) (*order.SellerLedgerResponse, error) {
+	out := new(order.SellerLedgerResponse)
+	err := c.cc.Invoke(ctx, Gateway_GetSellerLedger_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) CreatePayout(ctx context.Context, in *order.CreatePayoutRequest, opts ...grpc.CallOption) (*order.PayoutsResponse, error) {
+	out := new(order.PayoutsResponse)
+	err := c.cc.Invoke(ctx, Gateway_CreatePayout_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) GetSellerSalesReport(ctx context.Context, in *order.GetSalesReportRequest, opts ...grpc.CallOption) (*order.SalesReportResponse, error) {
+	out := new(order.SalesReportResponse)
+	err := c.cc.Invoke(ctx, Gateway_GetSellerSalesReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) GetSalesReport(ctx context.Context, in *order.GetSalesReportRequest, opts ...grpc.CallOption) (*order.SalesReportResponse, error) {
+	out := new(order.SalesReportResponse)
+	err := c.cc.Invoke(ctx, Gateway_GetSalesReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) GetConversionReport(ctx context.Context, in *order.GetConversionReportRequest, opts ...grpc.CallOption) (*order.ConversionReportResponse, error) {
+	out := new(order.ConversionReportResponse)
+	err := c.cc.Invoke(ctx, Gateway_GetConversionReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) CreateGuestCart(ctx context.Context, in *CreateGuestCartRequest, opts ...grpc.CallOption) (*CreateGuestCartResponse, error) {
+	out := new(CreateGuestCartResponse)
+	err := c.cc.Invoke(ctx, Gateway_CreateGuestCart_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gatewayClient) GetUserCart(ctx context.Context, in *cart.GetUserCartRequest, opts ...grpc.CallOption) (*cart.CartResponse, error) {
 	out := new(cart.CartResponse)
 	err := c.cc.Invoke(ctx, Gateway_GetUserCart_FullMethodName, in, out, opts...)
@@ -251,6 +449,15 @@ func (c *gatewayClient) GetUserCart(ctx context.Context, in *cart.GetUserCartReq
 	return out, nil
 }
 
+func (c *gatewayClient) GetCartSummary(ctx context.Context, in *cart.GetCartSummaryRequest, opts ...grpc.CallOption) (*cart.CartSummaryResponse, error) {
+	out := new(cart.CartSummaryResponse)
+	err := c.cc.Invoke(ctx, Gateway_GetCartSummary_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gatewayClient) CreateCartline(ctx context.Context, in *cart.CreateCartlineRequest, opts ...grpc.CallOption) (*cart.CartlineResponse, error) {
 	out := new(cart.CartlineResponse)
 	err := c.cc.Invoke(ctx, Gateway_CreateCartline_FullMethodName, in, out, opts...)
@@ -269,6 +476,15 @@ func (c *gatewayClient) UpdateCartline(ctx context.Context, in *cart.UpdateCartl
 	return out, nil
 }
 
+func (c *gatewayClient) BatchUpdateCart(ctx context.Context, in *cart.BatchUpdateCartRequest, opts ...grpc.CallOption) (*cart.CartResponse, error) {
+	out := new(cart.CartResponse)
+	err := c.cc.Invoke(ctx, Gateway_BatchUpdateCart_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gatewayClient) DeleteCartline(ctx context.Context, in *cart.DeleteCartlineRequest, opts ...grpc.CallOption) (*cart.DeleteCartlineResponse, error) {
 	out := new(cart.DeleteCartlineResponse)
 	err := c.cc.Invoke(ctx, Gateway_DeleteCartline_FullMethodName, in, out, opts...)
@@ -287,6 +503,33 @@ func (c *gatewayClient) DeleteCartCartlines(ctx context.Context, in *cart.Delete
 	return out, nil
 }
 
+func (c *gatewayClient) ApplyPromoCode(ctx context.Context, in *cart.ApplyPromoCodeRequest, opts ...grpc.CallOption) (*cart.ApplyPromoCodeResponse, error) {
+	out := new(cart.ApplyPromoCodeResponse)
+	err := c.cc.Invoke(ctx, Gateway_ApplyPromoCode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) RestoreAbandonedCart(ctx context.Context, in *cart.RestoreAbandonedCartRequest, opts ...grpc.CallOption) (*cart.CartResponse, error) {
+	out := new(cart.CartResponse)
+	err := c.cc.Invoke(ctx, Gateway_RestoreAbandonedCart_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) GetAbandonedCartMetrics(ctx context.Context, in *cart.GetAbandonedCartMetricsRequest, opts ...grpc.CallOption) (*cart.AbandonedCartMetricsResponse, error) {
+	out := new(cart.AbandonedCartMetricsResponse)
+	err := c.cc.Invoke(ctx, Gateway_GetAbandonedCartMetrics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gatewayClient) GetProduct(ctx context.Context, in *product.GetProductRequest, opts ...grpc.CallOption) (*product.ProductResponse, error) {
 	out := new(product.ProductResponse)
 	err := c.cc.Invoke(ctx, Gateway_GetProduct_FullMethodName, in, out, opts...)
@@ -341,6 +584,24 @@ func (c *gatewayClient) ModerateProduct(ctx context.Context, in *product.Moderat
 	return out, nil
 }
 
+func (c *gatewayClient) SubmitProduct(ctx context.Context, in *product.SubmitProductRequest, opts ...grpc.CallOption) (*product.ProductResponse, error) {
+	out := new(product.ProductResponse)
+	err := c.cc.Invoke(ctx, Gateway_SubmitProduct_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) GetModerationQueue(ctx context.Context, in *product.GetModerationQueueRequest, opts ...grpc.CallOption) (*product.ProductsResponse, error) {
+	out := new(product.ProductsResponse)
+	err := c.cc.Invoke(ctx, Gateway_GetModerationQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gatewayClient) DeleteProduct(ctx context.Context, in *product.DeleteProductRequest, opts ...grpc.CallOption) (*product.DeleteProductResponse, error) {
 	out := new(product.DeleteProductResponse)
 	err := c.cc.Invoke(ctx, Gateway_DeleteProduct_FullMethodName, in, out, opts...)
@@ -350,6 +611,33 @@ func (c *gatewayClient) DeleteProduct(ctx context.Context, in *product.DeletePro
 	return out, nil
 }
 
+func (c *gatewayClient) RestoreProduct(ctx context.Context, in *product.RestoreProductRequest, opts ...grpc.CallOption) (*product.ProductResponse, error) {
+	out := new(product.ProductResponse)
+	err := c.cc.Invoke(ctx, Gateway_RestoreProduct_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) GetProductHistory(ctx context.Context, in *product.GetProductHistoryRequest, opts ...grpc.CallOption) (*product.ProductHistoryResponse, error) {
+	out := new(product.ProductHistoryResponse)
+	err := c.cc.Invoke(ctx, Gateway_GetProductHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) GetPriceHistory(ctx context.Context, in *product.GetPriceHistoryRequest, opts ...grpc.CallOption) (*product.PriceHistoryResponse, error) {
+	out := new(product.PriceHistoryResponse)
+	err := c.cc.Invoke(ctx, Gateway_GetPriceHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gatewayClient) GetCategory(ctx context.Context, in *product.GetCategoryRequest, opts ...grpc.CallOption) (*product.CategoryResponse, error) {
 	out := new(product.CategoryResponse)
 	err := c.cc.Invoke(ctx, Gateway_GetCategory_FullMethodName, in, out, opts...)
@@ -386,88 +674,374 @@ func (c *gatewayClient) DeleteDiscount(ctx context.Context, in *product.DeleteDi
 	return out, nil
 }
 
-// GatewayServer is the server API for Gateway service.
-// All implementations must embed UnimplementedGatewayServer
-// for forward compatibility
-type GatewayServer interface {
-	// Auth
-	RegisterUser(context.Context, *RegisterUserRequest) (*RegisterUserResponse, error)
-	Login(context.Context, *LoginRequest) (*LoginResponse, error)
-	// User
-	GetUser(context.Context, *user.GetUserRequest) (*user.UserResponse, error)
-	GetUsers(context.Context, *user.GetUsersRequest) (*user.UsersResponse, error)
-	UpdateUser(context.Context, *user.UpdateUserRequest) (*user.UserResponse, error)
-	ChangeUserRole(context.Context, *user.ChangeUserRoleRequest) (*user.UserResponse, error)
-	DeleteUser(context.Context, *user.DeleteUserRequest) (*user.DeleteUserResponse, error)
-	// Order
-	CreateOrder(context.Context, *order.CreateOrderRequest) (*order.OrderResponse, error)
-	GetOrder(context.Context, *order.GetOrderRequest) (*order.OrderResponse, error)
-	GetOrders(context.Context, *order.GetOrdersRequest) (*order.OrdersResponse, error)
-	GetUserOrders(context.Context, *GetUserOrdersRequest) (*order.OrdersResponse, error)
-	DeleteOrder(context.Context, *order.DeleteOrderRequest) (*order.DeleteOrderResponse, error)
-	GetOrderline(context.Context, *order.GetOrderlineRequest) (*order.OrderlineResponse, error)
-	UpdateOrderline(context.Context, *order.UpdateOrderlineRequest) (*order.OrderlineResponse, error)
-	DeleteOrderline(context.Context, *order.DeleteOrderlineRequest) (*order.DeleteOrderlineResponse, error)
-	// Cart
-	GetUserCart(context.Context, *cart.GetUserCartRequest) (*cart.CartResponse, error)
-	CreateCartline(context.Context, *cart.CreateCartlineRequest) (*cart.CartlineResponse, error)
-	UpdateCartline(context.Context, *cart.UpdateCartlineRequest) (*cart.CartlineResponse, error)
-	DeleteCartline(context.Context, *cart.DeleteCartlineRequest) (*cart.DeleteCartlineResponse, error)
-	DeleteCartCartlines(context.Context, *cart.DeleteCartCartlinesRequest) (*cart.DeleteCartCartlinesResponse, error)
-	// Product
-	GetProduct(context.Context, *product.GetProductRequest) (*product.ProductResponse, error)
-	GetProducts(context.Context, *product.GetProductsRequest) (*product.ProductsResponse, error)
-	GetUserProducts(context.Context, *GetUserProductsRequest) (*product.ProductsResponse, error)
-	CreateProduct(context.Context, *product.CreateProductRequest) (*product.ProductResponse, error)
-	UpdateProduct(context.Context, *product.UpdateProductRequest) (*product.ProductResponse, error)
-	ModerateProduct(context.Context, *product.ModerateProductRequest) (*product.ProductResponse, error)
-	DeleteProduct(context.Context, *product.DeleteProductRequest) (*product.DeleteProductResponse, error)
-	GetCategory(context.Context, *product.GetCategoryRequest) (*product.CategoryResponse, error)
-	GetAllCategories(context.Context, *product.GetAllCategoriesRequest) (*product.CategoriesResponse, error)
-	CreateDiscount(context.Context, *product.CreateDiscountRequest) (*product.ProductResponse, error)
-	DeleteDiscount(context.Context, *product.DeleteDiscountRequest) (*product.ProductResponse, error)
-	mustEmbedUnimplementedGatewayServer()
+func (c *gatewayClient) CreateCategoryDiscount(ctx context.Context, in *product.CreateCategoryDiscountRequest, opts ...grpc.CallOption) (*product.DiscountResponse, error) {
+	out := new(product.DiscountResponse)
+	err := c.cc.Invoke(ctx, Gateway_CreateCategoryDiscount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedGatewayServer must be embedded to have forward compatible implementations.
-type UnimplementedGatewayServer struct {
+func (c *gatewayClient) DeleteCategoryDiscount(ctx context.Context, in *product.DeleteCategoryDiscountRequest, opts ...grpc.CallOption) (*product.DeleteDiscountResponse, error) {
+	out := new(product.DeleteDiscountResponse)
+	err := c.cc.Invoke(ctx, Gateway_DeleteCategoryDiscount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedGatewayServer) RegisterUser(context.Context, *RegisterUserRequest) (*RegisterUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterUser not implemented")
-}
-func (UnimplementedGatewayServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
-}
-func (UnimplementedGatewayServer) GetUser(context.Context, *user.GetUserRequest) (*user.UserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+func (c *gatewayClient) CreateSellerDiscount(ctx context.Context, in *product.CreateSellerDiscountRequest, opts ...grpc.CallOption) (*product.DiscountResponse, error) {
+	out := new(product.DiscountResponse)
+	err := c.cc.Invoke(ctx, Gateway_CreateSellerDiscount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGatewayServer) GetUsers(context.Context, *user.GetUsersRequest) (*user.UsersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUsers not implemented")
+
+func (c *gatewayClient) DeleteSellerDiscount(ctx context.Context, in *product.DeleteSellerDiscountRequest, opts ...grpc.CallOption) (*product.DeleteDiscountResponse, error) {
+	out := new(product.DeleteDiscountResponse)
+	err := c.cc.Invoke(ctx, Gateway_DeleteSellerDiscount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGatewayServer) UpdateUser(context.Context, *user.UpdateUserRequest) (*user.UserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+
+func (c *gatewayClient) GetDiscounts(ctx context.Context, in *product.GetDiscountsRequest, opts ...grpc.CallOption) (*product.DiscountsResponse, error) {
+	out := new(product.DiscountsResponse)
+	err := c.cc.Invoke(ctx, Gateway_GetDiscounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGatewayServer) ChangeUserRole(context.Context, *user.ChangeUserRoleRequest) (*user.UserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangeUserRole not implemented")
+
+func (c *gatewayClient) CreateReview(ctx context.Context, in *product.CreateReviewRequest, opts ...grpc.CallOption) (*product.ReviewResponse, error) {
+	out := new(product.ReviewResponse)
+	err := c.cc.Invoke(ctx, Gateway_CreateReview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGatewayServer) DeleteUser(context.Context, *user.DeleteUserRequest) (*user.DeleteUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+
+func (c *gatewayClient) GetProductReviews(ctx context.Context, in *product.GetProductReviewsRequest, opts ...grpc.CallOption) (*product.ReviewsResponse, error) {
+	out := new(product.ReviewsResponse)
+	err := c.cc.Invoke(ctx, Gateway_GetProductReviews_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGatewayServer) CreateOrder(context.Context, *order.CreateOrderRequest) (*order.OrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateOrder not implemented")
+
+func (c *gatewayClient) ReplyReview(ctx context.Context, in *product.ReplyReviewRequest, opts ...grpc.CallOption) (*product.ReviewResponse, error) {
+	out := new(product.ReviewResponse)
+	err := c.cc.Invoke(ctx, Gateway_ReplyReview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGatewayServer) GetOrder(context.Context, *order.GetOrderRequest) (*order.OrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+
+func (c *gatewayClient) CreateWarehouse(ctx context.Context, in *product.CreateWarehouseRequest, opts ...grpc.CallOption) (*product.WarehouseResponse, error) {
+	out := new(product.WarehouseResponse)
+	err := c.cc.Invoke(ctx, Gateway_CreateWarehouse_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGatewayServer) GetOrders(context.Context, *order.GetOrdersRequest) (*order.OrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrders not implemented")
+
+func (c *gatewayClient) GetWarehouses(ctx context.Context, in *product.GetWarehousesRequest, opts ...grpc.CallOption) (*product.WarehousesResponse, error) {
+	out := new(product.WarehousesResponse)
+	err := c.cc.Invoke(ctx, Gateway_GetWarehouses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGatewayServer) GetUserOrders(context.Context, *GetUserOrdersRequest) (*order.OrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserOrders not implemented")
+
+func (c *gatewayClient) SetWarehouseStock(ctx context.Context, in *product.SetWarehouseStockRequest, opts ...grpc.CallOption) (*product.WarehouseStockResponse, error) {
+	out := new(product.WarehouseStockResponse)
+	err := c.cc.Invoke(ctx, Gateway_SetWarehouseStock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGatewayServer) DeleteOrder(context.Context, *order.DeleteOrderRequest) (*order.DeleteOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrder not implemented")
+
+func (c *gatewayClient) GetProductStocks(ctx context.Context, in *product.GetProductStocksRequest, opts ...grpc.CallOption) (*product.WarehouseStocksResponse, error) {
+	out := new(product.WarehouseStocksResponse)
+	err := c.cc.Invoke(ctx, Gateway_GetProductStocks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) SetStockAlert(ctx context.Context, in *product.SetStockAlertRequest, opts ...grpc.CallOption) (*product.ProductResponse, error) {
+	out := new(product.ProductResponse)
+	err := c.cc.Invoke(ctx, Gateway_SetStockAlert_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) SetPurchaseLimit(ctx context.Context, in *product.SetPurchaseLimitRequest, opts ...grpc.CallOption) (*product.ProductResponse, error) {
+	out := new(product.ProductResponse)
+	err := c.cc.Invoke(ctx, Gateway_SetPurchaseLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) GetNotifications(ctx context.Context, in *product.GetNotificationsRequest, opts ...grpc.CallOption) (*product.NotificationsResponse, error) {
+	out := new(product.NotificationsResponse)
+	err := c.cc.Invoke(ctx, Gateway_GetNotifications_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) GetStorefront(ctx context.Context, in *product.GetStorefrontRequest, opts ...grpc.CallOption) (*product.StorefrontResponse, error) {
+	out := new(product.StorefrontResponse)
+	err := c.cc.Invoke(ctx, Gateway_GetStorefront_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) SetSellerProfile(ctx context.Context, in *product.SetSellerProfileRequest, opts ...grpc.CallOption) (*product.SellerProfileResponse, error) {
+	out := new(product.SellerProfileResponse)
+	err := c.cc.Invoke(ctx, Gateway_SetSellerProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) GetExchangeRates(ctx context.Context, in *product.GetExchangeRatesRequest, opts ...grpc.CallOption) (*product.ExchangeRatesResponse, error) {
+	out := new(product.ExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, Gateway_GetExchangeRates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) SetExchangeRate(ctx context.Context, in *product.SetExchangeRateRequest, opts ...grpc.CallOption) (*product.ExchangeRateResponse, error) {
+	out := new(product.ExchangeRateResponse)
+	err := c.cc.Invoke(ctx, Gateway_SetExchangeRate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) GetWishlists(ctx context.Context, in *product.GetWishlistsRequest, opts ...grpc.CallOption) (*product.WishlistsResponse, error) {
+	out := new(product.WishlistsResponse)
+	err := c.cc.Invoke(ctx, Gateway_GetWishlists_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) CreateWishlist(ctx context.Context, in *product.CreateWishlistRequest, opts ...grpc.CallOption) (*product.WishlistResponse, error) {
+	out := new(product.WishlistResponse)
+	err := c.cc.Invoke(ctx, Gateway_CreateWishlist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) DeleteWishlist(ctx context.Context, in *product.DeleteWishlistRequest, opts ...grpc.CallOption) (*product.DeleteWishlistResponse, error) {
+	out := new(product.DeleteWishlistResponse)
+	err := c.cc.Invoke(ctx, Gateway_DeleteWishlist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) AddWishlistItem(ctx context.Context, in *product.AddWishlistItemRequest, opts ...grpc.CallOption) (*product.WishlistResponse, error) {
+	out := new(product.WishlistResponse)
+	err := c.cc.Invoke(ctx, Gateway_AddWishlistItem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) RemoveWishlistItem(ctx context.Context, in *product.RemoveWishlistItemRequest, opts ...grpc.CallOption) (*product.WishlistResponse, error) {
+	out := new(product.WishlistResponse)
+	err := c.cc.Invoke(ctx, Gateway_RemoveWishlistItem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) MoveWishlistItemToCart(ctx context.Context, in *product.MoveWishlistItemToCartRequest, opts ...grpc.CallOption) (*product.WishlistResponse, error) {
+	out := new(product.WishlistResponse)
+	err := c.cc.Invoke(ctx, Gateway_MoveWishlistItemToCart_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) MoveCartlineToWishlist(ctx context.Context, in *product.MoveCartlineToWishlistRequest, opts ...grpc.CallOption) (*product.WishlistResponse, error) {
+	out := new(product.WishlistResponse)
+	err := c.cc.Invoke(ctx, Gateway_MoveCartlineToWishlist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GatewayServer is the server API for Gateway service.
+// All implementations must embed UnimplementedGatewayServer
+// for forward compatibility
+type GatewayServer interface {
+	// Auth
+	RegisterUser(context.Context, *RegisterUserRequest) (*RegisterUserResponse, error)
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	// User
+	GetUser(context.Context, *user.GetUserRequest) (*user.UserResponse, error)
+	GetUsers(context.Context, *user.GetUsersRequest) (*user.UsersResponse, error)
+	UpdateUser(context.Context, *user.UpdateUserRequest) (*user.UserResponse, error)
+	ChangeUserRole(context.Context, *user.ChangeUserRoleRequest) (*user.UserResponse, error)
+	DeleteUser(context.Context, *user.DeleteUserRequest) (*user.DeleteUserResponse, error)
+	// Order
+	CreateOrder(context.Context, *order.CreateOrderRequest) (*order.OrderResponse, error)
+	GetOrder(context.Context, *order.GetOrderRequest) (*order.OrderResponse, error)
+	// Streams the status changes of the order to grpc clients,
+	// HTTP clients get them as Server-Sent Events from /api/v1/order/{order_id}/events
+	WatchOrder(*order.WatchOrderRequest, Gateway_WatchOrderServer) error
+	GetOrders(context.Context, *order.GetOrdersRequest) (*order.OrdersResponse, error)
+	GetUserOrders(context.Context, *GetUserOrdersRequest) (*order.OrdersResponse, error)
+	DeleteOrder(context.Context, *order.DeleteOrderRequest) (*order.DeleteOrderResponse, error)
+	GetOrderline(context.Context, *order.GetOrderlineRequest) (*order.OrderlineResponse, error)
+	UpdateOrderline(context.Context, *order.UpdateOrderlineRequest) (*order.OrderlineResponse, error)
+	DeleteOrderline(context.Context, *order.DeleteOrderlineRequest) (*order.DeleteOrderlineResponse, error)
+	CreatePromoCode(context.Context, *order.CreatePromoCodeRequest) (*order.PromoCodeResponse, error)
+	GetPromoCode(context.Context, *order.GetPromoCodeRequest) (*order.PromoCodeResponse, error)
+	GetSellerLedger(context.Context, *order.GetSellerLedgerRequest) (*order.SellerLedgerResponse, error)
+	CreatePayout(context.Context, *order.CreatePayoutRequest) (*order.PayoutsResponse, error)
+	GetSellerSalesReport(context.Context, *order.GetSalesReportRequest) (*order.SalesReportResponse, error)
+	// Reports
+	GetSalesReport(context.Context, *order.GetSalesReportRequest) (*order.SalesReportResponse, error)
+	GetConversionReport(context.Context, *order.GetConversionReportRequest) (*order.ConversionReportResponse, error)
+	// Cart
+	CreateGuestCart(context.Context, *CreateGuestCartRequest) (*CreateGuestCartResponse, error)
+	GetUserCart(context.Context, *cart.GetUserCartRequest) (*cart.CartResponse, error)
+	GetCartSummary(context.Context, *cart.GetCartSummaryRequest) (*cart.CartSummaryResponse, error)
+	CreateCartline(context.Context, *cart.CreateCartlineRequest) (*cart.CartlineResponse, error)
+	UpdateCartline(context.Context, *cart.UpdateCartlineRequest) (*cart.CartlineResponse, error)
+	BatchUpdateCart(context.Context, *cart.BatchUpdateCartRequest) (*cart.CartResponse, error)
+	DeleteCartline(context.Context, *cart.DeleteCartlineRequest) (*cart.DeleteCartlineResponse, error)
+	DeleteCartCartlines(context.Context, *cart.DeleteCartCartlinesRequest) (*cart.DeleteCartCartlinesResponse, error)
+	ApplyPromoCode(context.Context, *cart.ApplyPromoCodeRequest) (*cart.ApplyPromoCodeResponse, error)
+	RestoreAbandonedCart(context.Context, *cart.RestoreAbandonedCartRequest) (*cart.CartResponse, error)
+	GetAbandonedCartMetrics(context.Context, *cart.GetAbandonedCartMetricsRequest) (*cart.AbandonedCartMetricsResponse, error)
+	// Product
+	GetProduct(context.Context, *product.GetProductRequest) (*product.ProductResponse, error)
+	GetProducts(context.Context, *product.GetProductsRequest) (*product.ProductsResponse, error)
+	GetUserProducts(context.Context, *GetUserProductsRequest) (*product.ProductsResponse, error)
+	CreateProduct(context.Context, *product.CreateProductRequest) (*product.ProductResponse, error)
+	UpdateProduct(context.Context, *product.UpdateProductRequest) (*product.ProductResponse, error)
+	ModerateProduct(context.Context, *product.ModerateProductRequest) (*product.ProductResponse, error)
+	SubmitProduct(context.Context, *product.SubmitProductRequest) (*product.ProductResponse, error)
+	GetModerationQueue(context.Context, *product.GetModerationQueueRequest) (*product.ProductsResponse, error)
+	DeleteProduct(context.Context, *product.DeleteProductRequest) (*product.DeleteProductResponse, error)
+	RestoreProduct(context.Context, *product.RestoreProductRequest) (*product.ProductResponse, error)
+	GetProductHistory(context.Context, *product.GetProductHistoryRequest) (*product.ProductHistoryResponse, error)
+	GetPriceHistory(context.Context, *product.GetPriceHistoryRequest) (*product.PriceHistoryResponse, error)
+	GetCategory(context.Context, *product.GetCategoryRequest) (*product.CategoryResponse, error)
+	GetAllCategories(context.Context, *product.GetAllCategoriesRequest) (*product.CategoriesResponse, error)
+	CreateDiscount(context.Context, *product.CreateDiscountRequest) (*product.ProductResponse, error)
+	DeleteDiscount(context.Context, *product.DeleteDiscountRequest) (*product.ProductResponse, error)
+	CreateCategoryDiscount(context.Context, *product.CreateCategoryDiscountRequest) (*product.DiscountResponse, error)
+	DeleteCategoryDiscount(context.Context, *product.DeleteCategoryDiscountRequest) (*product.DeleteDiscountResponse, error)
+	CreateSellerDiscount(context.Context, *product.CreateSellerDiscountRequest) (*product.DiscountResponse, error)
+	DeleteSellerDiscount(context.Context, *product.DeleteSellerDiscountRequest) (*product.DeleteDiscountResponse, error)
+	GetDiscounts(context.Context, *product.GetDiscountsRequest) (*product.DiscountsResponse, error)
+	// Review
+	CreateReview(context.Context, *product.CreateReviewRequest) (*product.ReviewResponse, error)
+	GetProductReviews(context.Context, *product.GetProductReviewsRequest) (*product.ReviewsResponse, error)
+	ReplyReview(context.Context, *product.ReplyReviewRequest) (*product.ReviewResponse, error)
+	CreateWarehouse(context.Context, *product.CreateWarehouseRequest) (*product.WarehouseResponse, error)
+	GetWarehouses(context.Context, *product.GetWarehousesRequest) (*product.WarehousesResponse, error)
+	SetWarehouseStock(context.Context, *product.SetWarehouseStockRequest) (*product.WarehouseStockResponse, error)
+	GetProductStocks(context.Context, *product.GetProductStocksRequest) (*product.WarehouseStocksResponse, error)
+	SetStockAlert(context.Context, *product.SetStockAlertRequest) (*product.ProductResponse, error)
+	SetPurchaseLimit(context.Context, *product.SetPurchaseLimitRequest) (*product.ProductResponse, error)
+	GetNotifications(context.Context, *product.GetNotificationsRequest) (*product.NotificationsResponse, error)
+	GetStorefront(context.Context, *product.GetStorefrontRequest) (*product.StorefrontResponse, error)
+	SetSellerProfile(context.Context, *product.SetSellerProfileRequest) (*product.SellerProfileResponse, error)
+	GetExchangeRates(context.Context, *product.GetExchangeRatesRequest) (*product.ExchangeRatesResponse, error)
+	SetExchangeRate(context.Context, *product.SetExchangeRateRequest) (*product.ExchangeRateResponse, error)
+	GetWishlists(context.Context, *product.GetWishlistsRequest) (*product.WishlistsResponse, error)
+	CreateWishlist(context.Context, *product.CreateWishlistRequest) (*product.WishlistResponse, error)
+	DeleteWishlist(context.Context, *product.DeleteWishlistRequest) (*product.DeleteWishlistResponse, error)
+	AddWishlistItem(context.Context, *product.AddWishlistItemRequest) (*product.WishlistResponse, error)
+	RemoveWishlistItem(context.Context, *product.RemoveWishlistItemRequest) (*product.WishlistResponse, error)
+	MoveWishlistItemToCart(context.Context, *product.MoveWishlistItemToCartRequest) (*product.WishlistResponse, error)
+	MoveCartlineToWishlist(context.Context, *product.MoveCartlineToWishlistRequest) (*product.WishlistResponse, error)
+	mustEmbedUnimplementedGatewayServer()
+}
+
+// UnimplementedGatewayServer must be embedded to have forward compatible implementations.
+type UnimplementedGatewayServer struct {
+}
+
+func (UnimplementedGatewayServer) RegisterUser(context.Context, *RegisterUserRequest) (*RegisterUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterUser not implemented")
+}
+func (UnimplementedGatewayServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedGatewayServer) GetUser(context.Context, *user.GetUserRequest) (*user.UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedGatewayServer) GetUsers(context.Context, *user.GetUsersRequest) (*user.UsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsers not implemented")
+}
+func (UnimplementedGatewayServer) UpdateUser(context.Context, *user.UpdateUserRequest) (*user.UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedGatewayServer) ChangeUserRole(context.Context, *user.ChangeUserRoleRequest) (*user.UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeUserRole not implemented")
+}
+func (UnimplementedGatewayServer) DeleteUser(context.Context, *user.DeleteUserRequest) (*user.DeleteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedGatewayServer) CreateOrder(context.Context, *order.CreateOrderRequest) (*order.OrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrder not implemented")
+}
+func (UnimplementedGatewayServer) GetOrder(context.Context, *order.GetOrderRequest) (*order.OrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+}
+func (UnimplementedGatewayServer) WatchOrder(*order.WatchOrderRequest, Gateway_WatchOrderServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchOrder not implemented")
+}
+func (UnimplementedGatewayServer) GetOrders(context.Context, *order.GetOrdersRequest) (*order.OrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrders not implemented")
+}
+func (UnimplementedGatewayServer) GetUserOrders(context.Context, *GetUserOrdersRequest) (*order.OrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserOrders not implemented")
+}
+func (UnimplementedGatewayServer) DeleteOrder(context.Context, *order.DeleteOrderRequest) (*order.DeleteOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrder not implemented")
 }
 func (UnimplementedGatewayServer) GetOrderline(context.Context, *order.GetOrderlineRequest) (*order.OrderlineResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOrderline not implemented")
@@ -478,21 +1052,60 @@ func (UnimplementedGatewayServer) UpdateOrderline(context.Context, *order.Update
 func (UnimplementedGatewayServer) DeleteOrderline(context.Context, *order.DeleteOrderlineRequest) (*order.DeleteOrderlineResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrderline not implemented")
 }
+func (UnimplementedGatewayServer) CreatePromoCode(context.Context, *order.CreatePromoCodeRequest) (*order.PromoCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePromoCode not implemented")
+}
+func (UnimplementedGatewayServer) GetPromoCode(context.Context, *order.GetPromoCodeRequest) (*order.PromoCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPromoCode not implemented")
+}
+func (UnimplementedGatewayServer) GetSellerLedger(context.Context, *order.GetSellerLedgerRequest) (*order.SellerLedgerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSellerLedger not implemented")
+}
+func (UnimplementedGatewayServer) CreatePayout(context.Context, *order.CreatePayoutRequest) (*order.PayoutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePayout not implemented")
+}
+func (UnimplementedGatewayServer) GetSellerSalesReport(context.Context, *order.GetSalesReportRequest) (*order.SalesReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSellerSalesReport not implemented")
+}
+func (UnimplementedGatewayServer) GetSalesReport(context.Context, *order.GetSalesReportRequest) (*order.SalesReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSalesReport not implemented")
+}
+func (UnimplementedGatewayServer) GetConversionReport(context.Context, *order.GetConversionReportRequest) (*order.ConversionReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConversionReport not implemented")
+}
+func (UnimplementedGatewayServer) CreateGuestCart(context.Context, *CreateGuestCartRequest) (*CreateGuestCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGuestCart not implemented")
+}
 func (UnimplementedGatewayServer) GetUserCart(context.Context, *cart.GetUserCartRequest) (*cart.CartResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserCart not implemented")
 }
+func (UnimplementedGatewayServer) GetCartSummary(context.Context, *cart.GetCartSummaryRequest) (*cart.CartSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCartSummary not implemented")
+}
 func (UnimplementedGatewayServer) CreateCartline(context.Context, *cart.CreateCartlineRequest) (*cart.CartlineResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateCartline not implemented")
 }
 func (UnimplementedGatewayServer) UpdateCartline(context.Context, *cart.UpdateCartlineRequest) (*cart.CartlineResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCartline not implemented")
 }
+func (UnimplementedGatewayServer) BatchUpdateCart(context.Context, *cart.BatchUpdateCartRequest) (*cart.CartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateCart not implemented")
+}
 func (UnimplementedGatewayServer) DeleteCartline(context.Context, *cart.DeleteCartlineRequest) (*cart.DeleteCartlineResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCartline not implemented")
 }
 func (UnimplementedGatewayServer) DeleteCartCartlines(context.Context, *cart.DeleteCartCartlinesRequest) (*cart.DeleteCartCartlinesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCartCartlines not implemented")
 }
+func (UnimplementedGatewayServer) ApplyPromoCode(context.Context, *cart.ApplyPromoCodeRequest) (*cart.ApplyPromoCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyPromoCode not implemented")
+}
+func (UnimplementedGatewayServer) RestoreAbandonedCart(context.Context, *cart.RestoreAbandonedCartRequest) (*cart.CartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreAbandonedCart not implemented")
+}
+func (UnimplementedGatewayServer) GetAbandonedCartMetrics(context.Context, *cart.GetAbandonedCartMetricsRequest) (*cart.AbandonedCartMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAbandonedCartMetrics not implemented")
+}
 func (UnimplementedGatewayServer) GetProduct(context.Context, *product.GetProductRequest) (*product.ProductResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProduct not implemented")
 }
@@ -511,9 +1124,24 @@ func (UnimplementedGatewayServer) UpdateProduct(context.Context, *product.Update
 func (UnimplementedGatewayServer) ModerateProduct(context.Context, *product.ModerateProductRequest) (*product.ProductResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModerateProduct not implemented")
 }
+func (UnimplementedGatewayServer) SubmitProduct(context.Context, *product.SubmitProductRequest) (*product.ProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitProduct not implemented")
+}
+func (UnimplementedGatewayServer) GetModerationQueue(context.Context, *product.GetModerationQueueRequest) (*product.ProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModerationQueue not implemented")
+}
 func (UnimplementedGatewayServer) DeleteProduct(context.Context, *product.DeleteProductRequest) (*product.DeleteProductResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteProduct not implemented")
 }
+func (UnimplementedGatewayServer) RestoreProduct(context.Context, *product.RestoreProductRequest) (*product.ProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreProduct not implemented")
+}
+func (UnimplementedGatewayServer) GetProductHistory(context.Context, *product.GetProductHistoryRequest) (*product.ProductHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductHistory not implemented")
+}
+func (UnimplementedGatewayServer) GetPriceHistory(context.Context, *product.GetPriceHistoryRequest) (*product.PriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceHistory not implemented")
+}
 func (UnimplementedGatewayServer) GetCategory(context.Context, *product.GetCategoryRequest) (*product.CategoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCategory not implemented")
 }
@@ -526,6 +1154,84 @@ func (UnimplementedGatewayServer) CreateDiscount(context.Context, *product.Creat
 func (UnimplementedGatewayServer) DeleteDiscount(context.Context, *product.DeleteDiscountRequest) (*product.ProductResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteDiscount not implemented")
 }
+func (UnimplementedGatewayServer) CreateCategoryDiscount(context.Context, *product.CreateCategoryDiscountRequest) (*product.DiscountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategoryDiscount not implemented")
+}
+func (UnimplementedGatewayServer) DeleteCategoryDiscount(context.Context, *product.DeleteCategoryDiscountRequest) (*product.DeleteDiscountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategoryDiscount not implemented")
+}
+func (UnimplementedGatewayServer) CreateSellerDiscount(context.Context, *product.CreateSellerDiscountRequest) (*product.DiscountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSellerDiscount not implemented")
+}
+func (UnimplementedGatewayServer) DeleteSellerDiscount(context.Context, *product.DeleteSellerDiscountRequest) (*product.DeleteDiscountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSellerDiscount not implemented")
+}
+func (UnimplementedGatewayServer) GetDiscounts(context.Context, *product.GetDiscountsRequest) (*product.DiscountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiscounts not implemented")
+}
+func (UnimplementedGatewayServer) CreateReview(context.Context, *product.CreateReviewRequest) (*product.ReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReview not implemented")
+}
+func (UnimplementedGatewayServer) GetProductReviews(context.Context, *product.GetProductReviewsRequest) (*product.ReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductReviews not implemented")
+}
+func (UnimplementedGatewayServer) ReplyReview(context.Context, *product.ReplyReviewRequest) (*product.ReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplyReview not implemented")
+}
+func (UnimplementedGatewayServer) CreateWarehouse(context.Context, *product.CreateWarehouseRequest) (*product.WarehouseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWarehouse not implemented")
+}
+func (UnimplementedGatewayServer) GetWarehouses(context.Context, *product.GetWarehousesRequest) (*product.WarehousesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWarehouses not implemented")
+}
+func (UnimplementedGatewayServer) SetWarehouseStock(context.Context, *product.SetWarehouseStockRequest) (*product.WarehouseStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWarehouseStock not implemented")
+}
+func (UnimplementedGatewayServer) GetProductStocks(context.Context, *product.GetProductStocksRequest) (*product.WarehouseStocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductStocks not implemented")
+}
+func (UnimplementedGatewayServer) SetStockAlert(context.Context, *product.SetStockAlertRequest) (*product.ProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStockAlert not implemented")
+}
+func (UnimplementedGatewayServer) SetPurchaseLimit(context.Context, *product.SetPurchaseLimitRequest) (*product.ProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPurchaseLimit not implemented")
+}
+func (UnimplementedGatewayServer) GetNotifications(context.Context, *product.GetNotificationsRequest) (*product.NotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotifications not implemented")
+}
+func (UnimplementedGatewayServer) GetStorefront(context.Context, *product.GetStorefrontRequest) (*product.StorefrontResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStorefront not implemented")
+}
+func (UnimplementedGatewayServer) SetSellerProfile(context.Context, *product.SetSellerProfileRequest) (*product.SellerProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSellerProfile not implemented")
+}
+func (UnimplementedGatewayServer) GetExchangeRates(context.Context, *product.GetExchangeRatesRequest) (*product.ExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeRates not implemented")
+}
+func (UnimplementedGatewayServer) SetExchangeRate(context.Context, *product.SetExchangeRateRequest) (*product.ExchangeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExchangeRate not implemented")
+}
+func (UnimplementedGatewayServer) GetWishlists(context.Context, *product.GetWishlistsRequest) (*product.WishlistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWishlists not implemented")
+}
+func (UnimplementedGatewayServer) CreateWishlist(context.Context, *product.CreateWishlistRequest) (*product.WishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWishlist not implemented")
+}
+func (UnimplementedGatewayServer) DeleteWishlist(context.Context, *product.DeleteWishlistRequest) (*product.DeleteWishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWishlist not implemented")
+}
+func (UnimplementedGatewayServer) AddWishlistItem(context.Context, *product.AddWishlistItemRequest) (*product.WishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWishlistItem not implemented")
+}
+func (UnimplementedGatewayServer) RemoveWishlistItem(context.Context, *product.RemoveWishlistItemRequest) (*product.WishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWishlistItem not implemented")
+}
+func (UnimplementedGatewayServer) MoveWishlistItemToCart(context.Context, *product.MoveWishlistItemToCartRequest) (*product.WishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveWishlistItemToCart not implemented")
+}
+func (UnimplementedGatewayServer) MoveCartlineToWishlist(context.Context, *product.MoveCartlineToWishlistRequest) (*product.WishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveCartlineToWishlist not implemented")
+}
 func (UnimplementedGatewayServer) mustEmbedUnimplementedGatewayServer() {}
 
 // UnsafeGatewayServer may be embedded to opt out of forward compatibility for this service.
@@ -567,532 +1273,1345 @@ func _Gateway_Login_Handler(srv interface{}, ctx context.Context, dec func(inter
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Gateway_Login_FullMethodName,
+		FullMethod: Gateway_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(user.GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).GetUser(ctx, req.(*user.GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_GetUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(user.GetUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).GetUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_GetUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).GetUsers(ctx, req.(*user.GetUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(user.UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).UpdateUser(ctx, req.(*user.UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_ChangeUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(user.ChangeUserRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).ChangeUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_ChangeUserRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).ChangeUserRole(ctx, req.(*user.ChangeUserRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(user.DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).DeleteUser(ctx, req.(*user.DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_CreateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(order.CreateOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).CreateOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_CreateOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).CreateOrder(ctx, req.(*order.CreateOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(order.GetOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).GetOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_GetOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).GetOrder(ctx, req.(*order.GetOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_WatchOrder_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(order.WatchOrderRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GatewayServer).WatchOrder(m, &gatewayWatchOrderServer{stream})
+}
+
+type Gateway_WatchOrderServer interface {
+	Send(*order.OrderEvent) error
+	grpc.ServerStream
+}
+
+type gatewayWatchOrderServer struct {
+	grpc.ServerStream
+}
+
+func (x *gatewayWatchOrderServer) Send(m *order.OrderEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Gateway_GetOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(order.GetOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).GetOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_GetOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).GetOrders(ctx, req.(*order.GetOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_GetUserOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).GetUserOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_GetUserOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).GetUserOrders(ctx, req.(*GetUserOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_DeleteOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(order.DeleteOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).DeleteOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_DeleteOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).DeleteOrder(ctx, req.(*order.DeleteOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_GetOrderline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(order.GetOrderlineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).GetOrderline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_GetOrderline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).GetOrderline(ctx, req.(*order.GetOrderlineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_UpdateOrderline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(order.UpdateOrderlineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).UpdateOrderline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_UpdateOrderline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).UpdateOrderline(ctx, req.(*order.UpdateOrderlineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_DeleteOrderline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(order.DeleteOrderlineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).DeleteOrderline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_DeleteOrderline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).DeleteOrderline(ctx, req.(*order.DeleteOrderlineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_CreatePromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(order.CreatePromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).CreatePromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_CreatePromoCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).CreatePromoCode(ctx, req.(*order.CreatePromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_GetPromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(order.GetPromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).GetPromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_GetPromoCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).GetPromoCode(ctx, req.(*order.GetPromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_GetSellerLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(order.GetSellerLedgerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).GetSellerLedger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_GetSellerLedger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).GetSellerLedger(ctx, req.(*order.GetSellerLedgerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_CreatePayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(order.CreatePayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).CreatePayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_CreatePayout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).CreatePayout(ctx, req.(*order.CreatePayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_GetSellerSalesReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(order.GetSalesReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).GetSellerSalesReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_GetSellerSalesReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).GetSellerSalesReport(ctx, req.(*order.GetSalesReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_GetSalesReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(order.GetSalesReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).GetSalesReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_GetSalesReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).GetSalesReport(ctx, req.(*order.GetSalesReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_GetConversionReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(order.GetConversionReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).GetConversionReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_GetConversionReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).GetConversionReport(ctx, req.(*order.GetConversionReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_CreateGuestCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGuestCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).CreateGuestCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_CreateGuestCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).CreateGuestCart(ctx, req.(*CreateGuestCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_GetUserCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(cart.GetUserCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).GetUserCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_GetUserCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).GetUserCart(ctx, req.(*cart.GetUserCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_GetCartSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(cart.GetCartSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).GetCartSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_GetCartSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).GetCartSummary(ctx, req.(*cart.GetCartSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_CreateCartline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(cart.CreateCartlineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).CreateCartline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_CreateCartline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).CreateCartline(ctx, req.(*cart.CreateCartlineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_UpdateCartline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(cart.UpdateCartlineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).UpdateCartline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_UpdateCartline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).UpdateCartline(ctx, req.(*cart.UpdateCartlineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_BatchUpdateCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(cart.BatchUpdateCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).BatchUpdateCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_BatchUpdateCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).BatchUpdateCart(ctx, req.(*cart.BatchUpdateCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_DeleteCartline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(cart.DeleteCartlineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).DeleteCartline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_DeleteCartline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).DeleteCartline(ctx, req.(*cart.DeleteCartlineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_DeleteCartCartlines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(cart.DeleteCartCartlinesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).DeleteCartCartlines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_DeleteCartCartlines_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).DeleteCartCartlines(ctx, req.(*cart.DeleteCartCartlinesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_ApplyPromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(cart.ApplyPromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).ApplyPromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_ApplyPromoCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).ApplyPromoCode(ctx, req.(*cart.ApplyPromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_RestoreAbandonedCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(cart.RestoreAbandonedCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).RestoreAbandonedCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_RestoreAbandonedCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).RestoreAbandonedCart(ctx, req.(*cart.RestoreAbandonedCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_GetAbandonedCartMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(cart.GetAbandonedCartMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).GetAbandonedCartMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_GetAbandonedCartMetrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).GetAbandonedCartMetrics(ctx, req.(*cart.GetAbandonedCartMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.GetProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).GetProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_GetProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).GetProduct(ctx, req.(*product.GetProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_GetProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.GetProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).GetProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_GetProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).GetProducts(ctx, req.(*product.GetProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_GetUserProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).GetUserProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_GetUserProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).GetUserProducts(ctx, req.(*GetUserProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.CreateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).CreateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_CreateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).CreateProduct(ctx, req.(*product.CreateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.UpdateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).UpdateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_UpdateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).UpdateProduct(ctx, req.(*product.UpdateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_ModerateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.ModerateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).ModerateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_ModerateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).ModerateProduct(ctx, req.(*product.ModerateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_SubmitProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.SubmitProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).SubmitProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_SubmitProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).SubmitProduct(ctx, req.(*product.SubmitProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_GetModerationQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.GetModerationQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).GetModerationQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_GetModerationQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).GetModerationQueue(ctx, req.(*product.GetModerationQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.DeleteProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).DeleteProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_DeleteProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).DeleteProduct(ctx, req.(*product.DeleteProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_RestoreProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.RestoreProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).RestoreProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_RestoreProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).RestoreProduct(ctx, req.(*product.RestoreProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_GetProductHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.GetProductHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).GetProductHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_GetProductHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).GetProductHistory(ctx, req.(*product.GetProductHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_GetPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.GetPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).GetPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_GetPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).GetPriceHistory(ctx, req.(*product.GetPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_GetCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.GetCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).GetCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gateway_GetCategory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServer).Login(ctx, req.(*LoginRequest))
+		return srv.(GatewayServer).GetCategory(ctx, req.(*product.GetCategoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Gateway_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(user.GetUserRequest)
+func _Gateway_GetAllCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.GetAllCategoriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServer).GetUser(ctx, in)
+		return srv.(GatewayServer).GetAllCategories(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Gateway_GetUser_FullMethodName,
+		FullMethod: Gateway_GetAllCategories_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServer).GetUser(ctx, req.(*user.GetUserRequest))
+		return srv.(GatewayServer).GetAllCategories(ctx, req.(*product.GetAllCategoriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Gateway_GetUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(user.GetUsersRequest)
+func _Gateway_CreateDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.CreateDiscountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServer).GetUsers(ctx, in)
+		return srv.(GatewayServer).CreateDiscount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Gateway_GetUsers_FullMethodName,
+		FullMethod: Gateway_CreateDiscount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServer).GetUsers(ctx, req.(*user.GetUsersRequest))
+		return srv.(GatewayServer).CreateDiscount(ctx, req.(*product.CreateDiscountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Gateway_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(user.UpdateUserRequest)
+func _Gateway_DeleteDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.DeleteDiscountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServer).UpdateUser(ctx, in)
+		return srv.(GatewayServer).DeleteDiscount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Gateway_UpdateUser_FullMethodName,
+		FullMethod: Gateway_DeleteDiscount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServer).UpdateUser(ctx, req.(*user.UpdateUserRequest))
+		return srv.(GatewayServer).DeleteDiscount(ctx, req.(*product.DeleteDiscountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Gateway_ChangeUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(user.ChangeUserRoleRequest)
+func _Gateway_CreateCategoryDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.CreateCategoryDiscountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServer).ChangeUserRole(ctx, in)
+		return srv.(GatewayServer).CreateCategoryDiscount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Gateway_ChangeUserRole_FullMethodName,
+		FullMethod: Gateway_CreateCategoryDiscount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServer).ChangeUserRole(ctx, req.(*user.ChangeUserRoleRequest))
+		return srv.(GatewayServer).CreateCategoryDiscount(ctx, req.(*product.CreateCategoryDiscountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Gateway_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(user.DeleteUserRequest)
+func _Gateway_DeleteCategoryDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.DeleteCategoryDiscountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServer).DeleteUser(ctx, in)
+		return srv.(GatewayServer).DeleteCategoryDiscount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Gateway_DeleteUser_FullMethodName,
+		FullMethod: Gateway_DeleteCategoryDiscount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServer).DeleteUser(ctx, req.(*user.DeleteUserRequest))
+		return srv.(GatewayServer).DeleteCategoryDiscount(ctx, req.(*product.DeleteCategoryDiscountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Gateway_CreateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(order.CreateOrderRequest)
+func _Gateway_CreateSellerDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.CreateSellerDiscountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServer).CreateOrder(ctx, in)
+		return srv.(GatewayServer).CreateSellerDiscount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Gateway_CreateOrder_FullMethodName,
+		FullMethod: Gateway_CreateSellerDiscount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServer).CreateOrder(ctx, req.(*order.CreateOrderRequest))
+		return srv.(GatewayServer).CreateSellerDiscount(ctx, req.(*product.CreateSellerDiscountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Gateway_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(order.GetOrderRequest)
+func _Gateway_DeleteSellerDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.DeleteSellerDiscountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServer).GetOrder(ctx, in)
+		return srv.(GatewayServer).DeleteSellerDiscount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Gateway_GetOrder_FullMethodName,
+		FullMethod: Gateway_DeleteSellerDiscount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServer).GetOrder(ctx, req.(*order.GetOrderRequest))
+		return srv.(GatewayServer).DeleteSellerDiscount(ctx, req.(*product.DeleteSellerDiscountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Gateway_GetOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(order.GetOrdersRequest)
+func _Gateway_GetDiscounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.GetDiscountsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServer).GetOrders(ctx, in)
+		return srv.(GatewayServer).GetDiscounts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Gateway_GetOrders_FullMethodName,
+		FullMethod: Gateway_GetDiscounts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServer).GetOrders(ctx, req.(*order.GetOrdersRequest))
+		return srv.(GatewayServer).GetDiscounts(ctx, req.(*product.GetDiscountsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Gateway_GetUserOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserOrdersRequest)
+func _Gateway_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.CreateReviewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServer).GetUserOrders(ctx, in)
+		return srv.(GatewayServer).CreateReview(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Gateway_GetUserOrders_FullMethodName,
+		FullMethod: Gateway_CreateReview_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServer).GetUserOrders(ctx, req.(*GetUserOrdersRequest))
+		return srv.(GatewayServer).CreateReview(ctx, req.(*product.CreateReviewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Gateway_DeleteOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(order.DeleteOrderRequest)
+func _Gateway_GetProductReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.GetProductReviewsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServer).DeleteOrder(ctx, in)
+		return srv.(GatewayServer).GetProductReviews(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Gateway_DeleteOrder_FullMethodName,
+		FullMethod: Gateway_GetProductReviews_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServer).DeleteOrder(ctx, req.(*order.DeleteOrderRequest))
+		return srv.(GatewayServer).GetProductReviews(ctx, req.(*product.GetProductReviewsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Gateway_GetOrderline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(order.GetOrderlineRequest)
+func _Gateway_ReplyReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.ReplyReviewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServer).GetOrderline(ctx, in)
+		return srv.(GatewayServer).ReplyReview(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Gateway_GetOrderline_FullMethodName,
+		FullMethod: Gateway_ReplyReview_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServer).GetOrderline(ctx, req.(*order.GetOrderlineRequest))
+		return srv.(GatewayServer).ReplyReview(ctx, req.(*product.ReplyReviewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Gateway_UpdateOrderline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(order.UpdateOrderlineRequest)
+func _Gateway_CreateWarehouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.CreateWarehouseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServer).UpdateOrderline(ctx, in)
+		return srv.(GatewayServer).CreateWarehouse(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Gateway_UpdateOrderline_FullMethodName,
+		FullMethod: Gateway_CreateWarehouse_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServer).UpdateOrderline(ctx, req.(*order.UpdateOrderlineRequest))
+		return srv.(GatewayServer).CreateWarehouse(ctx, req.(*product.CreateWarehouseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Gateway_DeleteOrderline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(order.DeleteOrderlineRequest)
+func _Gateway_GetWarehouses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.GetWarehousesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServer).DeleteOrderline(ctx, in)
+		return srv.(GatewayServer).GetWarehouses(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Gateway_DeleteOrderline_FullMethodName,
+		FullMethod: Gateway_GetWarehouses_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServer).DeleteOrderline(ctx, req.(*order.DeleteOrderlineRequest))
+		return srv.(GatewayServer).GetWarehouses(ctx, req.(*product.GetWarehousesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Gateway_GetUserCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(cart.GetUserCartRequest)
+func _Gateway_SetWarehouseStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.SetWarehouseStockRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServer).GetUserCart(ctx, in)
+		return srv.(GatewayServer).SetWarehouseStock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Gateway_GetUserCart_FullMethodName,
+		FullMethod: Gateway_SetWarehouseStock_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServer).GetUserCart(ctx, req.(*cart.GetUserCartRequest))
+		return srv.(GatewayServer).SetWarehouseStock(ctx, req.(*product.SetWarehouseStockRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Gateway_CreateCartline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(cart.CreateCartlineRequest)
+func _Gateway_GetProductStocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.GetProductStocksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServer).CreateCartline(ctx, in)
+		return srv.(GatewayServer).GetProductStocks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Gateway_CreateCartline_FullMethodName,
+		FullMethod: Gateway_GetProductStocks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServer).CreateCartline(ctx, req.(*cart.CreateCartlineRequest))
+		return srv.(GatewayServer).GetProductStocks(ctx, req.(*product.GetProductStocksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Gateway_UpdateCartline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(cart.UpdateCartlineRequest)
+func _Gateway_SetStockAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.SetStockAlertRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServer).UpdateCartline(ctx, in)
+		return srv.(GatewayServer).SetStockAlert(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Gateway_UpdateCartline_FullMethodName,
+		FullMethod: Gateway_SetStockAlert_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServer).UpdateCartline(ctx, req.(*cart.UpdateCartlineRequest))
+		return srv.(GatewayServer).SetStockAlert(ctx, req.(*product.SetStockAlertRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Gateway_DeleteCartline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(cart.DeleteCartlineRequest)
+func _Gateway_SetPurchaseLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.SetPurchaseLimitRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServer).DeleteCartline(ctx, in)
+		return srv.(GatewayServer).SetPurchaseLimit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Gateway_DeleteCartline_FullMethodName,
+		FullMethod: Gateway_SetPurchaseLimit_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServer).DeleteCartline(ctx, req.(*cart.DeleteCartlineRequest))
+		return srv.(GatewayServer).SetPurchaseLimit(ctx, req.(*product.SetPurchaseLimitRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Gateway_DeleteCartCartlines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(cart.DeleteCartCartlinesRequest)
+func _Gateway_GetNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.GetNotificationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServer).DeleteCartCartlines(ctx, in)
+		return srv.(GatewayServer).GetNotifications(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Gateway_DeleteCartCartlines_FullMethodName,
+		FullMethod: Gateway_GetNotifications_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServer).DeleteCartCartlines(ctx, req.(*cart.DeleteCartCartlinesRequest))
+		return srv.(GatewayServer).GetNotifications(ctx, req.(*product.GetNotificationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Gateway_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(product.GetProductRequest)
+func _Gateway_GetStorefront_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.GetStorefrontRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServer).GetProduct(ctx, in)
+		return srv.(GatewayServer).GetStorefront(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Gateway_GetProduct_FullMethodName,
+		FullMethod: Gateway_GetStorefront_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServer).GetProduct(ctx, req.(*product.GetProductRequest))
+		return srv.(GatewayServer).GetStorefront(ctx, req.(*product.GetStorefrontRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Gateway_GetProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(product.GetProductsRequest)
+func _Gateway_SetSellerProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.SetSellerProfileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServer).GetProducts(ctx, in)
+		return srv.(GatewayServer).SetSellerProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Gateway_GetProducts_FullMethodName,
+		FullMethod: Gateway_SetSellerProfile_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServer).GetProducts(ctx, req.(*product.GetProductsRequest))
+		return srv.(GatewayServer).SetSellerProfile(ctx, req.(*product.SetSellerProfileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Gateway_GetUserProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserProductsRequest)
+func _Gateway_GetExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.GetExchangeRatesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServer).GetUserProducts(ctx, in)
+		return srv.(GatewayServer).GetExchangeRates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Gateway_GetUserProducts_FullMethodName,
+		FullMethod: Gateway_GetExchangeRates_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServer).GetUserProducts(ctx, req.(*GetUserProductsRequest))
+		return srv.(GatewayServer).GetExchangeRates(ctx, req.(*product.GetExchangeRatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Gateway_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(product.CreateProductRequest)
+func _Gateway_SetExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.SetExchangeRateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServer).CreateProduct(ctx, in)
+		return srv.(GatewayServer).SetExchangeRate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Gateway_CreateProduct_FullMethodName,
+		FullMethod: Gateway_SetExchangeRate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServer).CreateProduct(ctx, req.(*product.CreateProductRequest))
+		return srv.(GatewayServer).SetExchangeRate(ctx, req.(*product.SetExchangeRateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Gateway_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(product.UpdateProductRequest)
+func _Gateway_GetWishlists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.GetWishlistsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServer).UpdateProduct(ctx, in)
+		return srv.(GatewayServer).GetWishlists(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Gateway_UpdateProduct_FullMethodName,
+		FullMethod: Gateway_GetWishlists_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServer).UpdateProduct(ctx, req.(*product.UpdateProductRequest))
+		return srv.(GatewayServer).GetWishlists(ctx, req.(*product.GetWishlistsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Gateway_ModerateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(product.ModerateProductRequest)
+func _Gateway_CreateWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.CreateWishlistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServer).ModerateProduct(ctx, in)
+		return srv.(GatewayServer).CreateWishlist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Gateway_ModerateProduct_FullMethodName,
+		FullMethod: Gateway_CreateWishlist_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServer).ModerateProduct(ctx, req.(*product.ModerateProductRequest))
+		return srv.(GatewayServer).CreateWishlist(ctx, req.(*product.CreateWishlistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Gateway_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(product.DeleteProductRequest)
+func _Gateway_DeleteWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.DeleteWishlistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServer).DeleteProduct(ctx, in)
+		return srv.(GatewayServer).DeleteWishlist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Gateway_DeleteProduct_FullMethodName,
+		FullMethod: Gateway_DeleteWishlist_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServer).DeleteProduct(ctx, req.(*product.DeleteProductRequest))
+		return srv.(GatewayServer).DeleteWishlist(ctx, req.(*product.DeleteWishlistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Gateway_GetCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(product.GetCategoryRequest)
+func _Gateway_AddWishlistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.AddWishlistItemRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServer).GetCategory(ctx, in)
+		return srv.(GatewayServer).AddWishlistItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Gateway_GetCategory_FullMethodName,
+		FullMethod: Gateway_AddWishlistItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServer).GetCategory(ctx, req.(*product.GetCategoryRequest))
+		return srv.(GatewayServer).AddWishlistItem(ctx, req.(*product.AddWishlistItemRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Gateway_GetAllCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(product.GetAllCategoriesRequest)
+func _Gateway_RemoveWishlistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.RemoveWishlistItemRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServer).GetAllCategories(ctx, in)
+		return srv.(GatewayServer).RemoveWishlistItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Gateway_GetAllCategories_FullMethodName,
+		FullMethod: Gateway_RemoveWishlistItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServer).GetAllCategories(ctx, req.(*product.GetAllCategoriesRequest))
+		return srv.(GatewayServer).RemoveWishlistItem(ctx, req.(*product.RemoveWishlistItemRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Gateway_CreateDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(product.CreateDiscountRequest)
+func _Gateway_MoveWishlistItemToCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.MoveWishlistItemToCartRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServer).CreateDiscount(ctx, in)
+		return srv.(GatewayServer).MoveWishlistItemToCart(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Gateway_CreateDiscount_FullMethodName,
+		FullMethod: Gateway_MoveWishlistItemToCart_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServer).CreateDiscount(ctx, req.(*product.CreateDiscountRequest))
+		return srv.(GatewayServer).MoveWishlistItemToCart(ctx, req.(*product.MoveWishlistItemToCartRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Gateway_DeleteDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(product.DeleteDiscountRequest)
+func _Gateway_MoveCartlineToWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(product.MoveCartlineToWishlistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GatewayServer).DeleteDiscount(ctx, in)
+		return srv.(GatewayServer).MoveCartlineToWishlist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Gateway_DeleteDiscount_FullMethodName,
+		FullMethod: Gateway_MoveCartlineToWishlist_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GatewayServer).DeleteDiscount(ctx, req.(*product.DeleteDiscountRequest))
+		return srv.(GatewayServer).MoveCartlineToWishlist(ctx, req.(*product.MoveCartlineToWishlistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1164,10 +2683,46 @@ var Gateway_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteOrderline",
 			Handler:    _Gateway_DeleteOrderline_Handler,
 		},
+		{
+			MethodName: "CreatePromoCode",
+			Handler:    _Gateway_CreatePromoCode_Handler,
+		},
+		{
+			MethodName: "GetPromoCode",
+			Handler:    _Gateway_GetPromoCode_Handler,
+		},
+		{
+			MethodName: "GetSellerLedger",
+			Handler:    _Gateway_GetSellerLedger_Handler,
+		},
+		{
+			MethodName: "CreatePayout",
+			Handler:    _Gateway_CreatePayout_Handler,
+		},
+		{
+			MethodName: "GetSellerSalesReport",
+			Handler:    _Gateway_GetSellerSalesReport_Handler,
+		},
+		{
+			MethodName: "GetSalesReport",
+			Handler:    _Gateway_GetSalesReport_Handler,
+		},
+		{
+			MethodName: "GetConversionReport",
+			Handler:    _Gateway_GetConversionReport_Handler,
+		},
+		{
+			MethodName: "CreateGuestCart",
+			Handler:    _Gateway_CreateGuestCart_Handler,
+		},
 		{
 			MethodName: "GetUserCart",
 			Handler:    _Gateway_GetUserCart_Handler,
 		},
+		{
+			MethodName: "GetCartSummary",
+			Handler:    _Gateway_GetCartSummary_Handler,
+		},
 		{
 			MethodName: "CreateCartline",
 			Handler:    _Gateway_CreateCartline_Handler,
@@ -1176,6 +2731,10 @@ var Gateway_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCartline",
 			Handler:    _Gateway_UpdateCartline_Handler,
 		},
+		{
+			MethodName: "BatchUpdateCart",
+			Handler:    _Gateway_BatchUpdateCart_Handler,
+		},
 		{
 			MethodName: "DeleteCartline",
 			Handler:    _Gateway_DeleteCartline_Handler,
@@ -1184,6 +2743,18 @@ var Gateway_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCartCartlines",
 			Handler:    _Gateway_DeleteCartCartlines_Handler,
 		},
+		{
+			MethodName: "ApplyPromoCode",
+			Handler:    _Gateway_ApplyPromoCode_Handler,
+		},
+		{
+			MethodName: "RestoreAbandonedCart",
+			Handler:    _Gateway_RestoreAbandonedCart_Handler,
+		},
+		{
+			MethodName: "GetAbandonedCartMetrics",
+			Handler:    _Gateway_GetAbandonedCartMetrics_Handler,
+		},
 		{
 			MethodName: "GetProduct",
 			Handler:    _Gateway_GetProduct_Handler,
@@ -1208,10 +2779,30 @@ var Gateway_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ModerateProduct",
 			Handler:    _Gateway_ModerateProduct_Handler,
 		},
+		{
+			MethodName: "SubmitProduct",
+			Handler:    _Gateway_SubmitProduct_Handler,
+		},
+		{
+			MethodName: "GetModerationQueue",
+			Handler:    _Gateway_GetModerationQueue_Handler,
+		},
 		{
 			MethodName: "DeleteProduct",
 			Handler:    _Gateway_DeleteProduct_Handler,
 		},
+		{
+			MethodName: "RestoreProduct",
+			Handler:    _Gateway_RestoreProduct_Handler,
+		},
+		{
+			MethodName: "GetProductHistory",
+			Handler:    _Gateway_GetProductHistory_Handler,
+		},
+		{
+			MethodName: "GetPriceHistory",
+			Handler:    _Gateway_GetPriceHistory_Handler,
+		},
 		{
 			MethodName: "GetCategory",
 			Handler:    _Gateway_GetCategory_Handler,
@@ -1228,7 +2819,117 @@ var Gateway_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteDiscount",
 			Handler:    _Gateway_DeleteDiscount_Handler,
 		},
+		{
+			MethodName: "CreateCategoryDiscount",
+			Handler:    _Gateway_CreateCategoryDiscount_Handler,
+		},
+		{
+			MethodName: "DeleteCategoryDiscount",
+			Handler:    _Gateway_DeleteCategoryDiscount_Handler,
+		},
+		{
+			MethodName: "CreateSellerDiscount",
+			Handler:    _Gateway_CreateSellerDiscount_Handler,
+		},
+		{
+			MethodName: "DeleteSellerDiscount",
+			Handler:    _Gateway_DeleteSellerDiscount_Handler,
+		},
+		{
+			MethodName: "GetDiscounts",
+			Handler:    _Gateway_GetDiscounts_Handler,
+		},
+		{
+			MethodName: "CreateReview",
+			Handler:    _Gateway_CreateReview_Handler,
+		},
+		{
+			MethodName: "GetProductReviews",
+			Handler:    _Gateway_GetProductReviews_Handler,
+		},
+		{
+			MethodName: "ReplyReview",
+			Handler:    _Gateway_ReplyReview_Handler,
+		},
+		{
+			MethodName: "CreateWarehouse",
+			Handler:    _Gateway_CreateWarehouse_Handler,
+		},
+		{
+			MethodName: "GetWarehouses",
+			Handler:    _Gateway_GetWarehouses_Handler,
+		},
+		{
+			MethodName: "SetWarehouseStock",
+			Handler:    _Gateway_SetWarehouseStock_Handler,
+		},
+		{
+			MethodName: "GetProductStocks",
+			Handler:    _Gateway_GetProductStocks_Handler,
+		},
+		{
+			MethodName: "SetStockAlert",
+			Handler:    _Gateway_SetStockAlert_Handler,
+		},
+		{
+			MethodName: "SetPurchaseLimit",
+			Handler:    _Gateway_SetPurchaseLimit_Handler,
+		},
+		{
+			MethodName: "GetNotifications",
+			Handler:    _Gateway_GetNotifications_Handler,
+		},
+		{
+			MethodName: "GetStorefront",
+			Handler:    _Gateway_GetStorefront_Handler,
+		},
+		{
+			MethodName: "SetSellerProfile",
+			Handler:    _Gateway_SetSellerProfile_Handler,
+		},
+		{
+			MethodName: "GetExchangeRates",
+			Handler:    _Gateway_GetExchangeRates_Handler,
+		},
+		{
+			MethodName: "SetExchangeRate",
+			Handler:    _Gateway_SetExchangeRate_Handler,
+		},
+		{
+			MethodName: "GetWishlists",
+			Handler:    _Gateway_GetWishlists_Handler,
+		},
+		{
+			MethodName: "CreateWishlist",
+			Handler:    _Gateway_CreateWishlist_Handler,
+		},
+		{
+			MethodName: "DeleteWishlist",
+			Handler:    _Gateway_DeleteWishlist_Handler,
+		},
+		{
+			MethodName: "AddWishlistItem",
+			Handler:    _Gateway_AddWishlistItem_Handler,
+		},
+		{
+			MethodName: "RemoveWishlistItem",
+			Handler:    _Gateway_RemoveWishlistItem_Handler,
+		},
+		{
+			MethodName: "MoveWishlistItemToCart",
+			Handler:    _Gateway_MoveWishlistItemToCart_Handler,
+		},
+		{
+			MethodName: "MoveCartlineToWishlist",
+			Handler:    _Gateway_MoveCartlineToWishlist_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchOrder",
+			Handler:       _Gateway_WatchOrder_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "gateway.proto",
 }
@@ -20,14 +20,32 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Order_GetOrder_FullMethodName         = "/order.Order/GetOrder"
-	Order_GetOrders_FullMethodName        = "/order.Order/GetOrders"
-	Order_CreateOrder_FullMethodName      = "/order.Order/CreateOrder"
-	Order_DeleteOrder_FullMethodName      = "/order.Order/DeleteOrder"
-	Order_DeleteUserOrders_FullMethodName = "/order.Order/DeleteUserOrders"
-	Order_GetOrderline_FullMethodName     = "/order.Order/GetOrderline"
-	Order_UpdateOrderline_FullMethodName  = "/order.Order/UpdateOrderline"
-	Order_DeleteOrderline_FullMethodName  = "/order.Order/DeleteOrderline"
+	Order_GetOrder_FullMethodName             = "/order.Order/GetOrder"
+	Order_GetOrders_FullMethodName            = "/order.Order/GetOrders"
+	Order_CreateOrder_FullMethodName          = "/order.Order/CreateOrder"
+	Order_DeleteOrder_FullMethodName          = "/order.Order/DeleteOrder"
+	Order_DeleteUserOrders_FullMethodName     = "/order.Order/DeleteUserOrders"
+	Order_GetOrderline_FullMethodName         = "/order.Order/GetOrderline"
+	Order_UpdateOrderline_FullMethodName      = "/order.Order/UpdateOrderline"
+	Order_DeleteOrderline_FullMethodName      = "/order.Order/DeleteOrderline"
+	Order_HasReceivedProduct_FullMethodName   = "/order.Order/HasReceivedProduct"
+	Order_GetPurchasedQuantity_FullMethodName = "/order.Order/GetPurchasedQuantity"
+	Order_GetInvoice_FullMethodName           = "/order.Order/GetInvoice"
+	Order_CalculateTax_FullMethodName         = "/order.Order/CalculateTax"
+	Order_CreatePromoCode_FullMethodName      = "/order.Order/CreatePromoCode"
+	Order_GetPromoCode_FullMethodName         = "/order.Order/GetPromoCode"
+	Order_ValidatePromoCode_FullMethodName    = "/order.Order/ValidatePromoCode"
+	Order_GetSellerLedger_FullMethodName      = "/order.Order/GetSellerLedger"
+	Order_CreatePayout_FullMethodName         = "/order.Order/CreatePayout"
+	Order_GetOrderThread_FullMethodName       = "/order.Order/GetOrderThread"
+	Order_CreateOrderMessage_FullMethodName   = "/order.Order/CreateOrderMessage"
+	Order_MarkOrderThreadRead_FullMethodName  = "/order.Order/MarkOrderThreadRead"
+	Order_WatchOrderThread_FullMethodName     = "/order.Order/WatchOrderThread"
+	Order_WatchOrder_FullMethodName           = "/order.Order/WatchOrder"
+	Order_GetSalesReport_FullMethodName       = "/order.Order/GetSalesReport"
+	Order_ExportSalesReport_FullMethodName    = "/order.Order/ExportSalesReport"
+	Order_GetConversionReport_FullMethodName  = "/order.Order/GetConversionReport"
+	Order_ExportOrders_FullMethodName         = "/order.Order/ExportOrders"
 )
 
 // OrderClient is the client API for Order service.
@@ -42,6 +60,24 @@ type OrderClient interface {
 	GetOrderline(ctx context.Context, in *GetOrderlineRequest, opts ...grpc.CallOption) (*OrderlineResponse, error)
 	UpdateOrderline(ctx context.Context, in *UpdateOrderlineRequest, opts ...grpc.CallOption) (*OrderlineResponse, error)
 	DeleteOrderline(ctx context.Context, in *DeleteOrderlineRequest, opts ...grpc.CallOption) (*DeleteOrderlineResponse, error)
+	HasReceivedProduct(ctx context.Context, in *HasReceivedProductRequest, opts ...grpc.CallOption) (*HasReceivedProductResponse, error)
+	GetPurchasedQuantity(ctx context.Context, in *GetPurchasedQuantityRequest, opts ...grpc.CallOption) (*PurchasedQuantityResponse, error)
+	GetInvoice(ctx context.Context, in *GetInvoiceRequest, opts ...grpc.CallOption) (*InvoiceResponse, error)
+	CalculateTax(ctx context.Context, in *CalculateTaxRequest, opts ...grpc.CallOption) (*CalculateTaxResponse, error)
+	CreatePromoCode(ctx context.Context, in *CreatePromoCodeRequest, opts ...grpc.CallOption) (*PromoCodeResponse, error)
+	GetPromoCode(ctx context.Context, in *GetPromoCodeRequest, opts ...grpc.CallOption) (*PromoCodeResponse, error)
+	ValidatePromoCode(ctx context.Context, in *ValidatePromoCodeRequest, opts ...grpc.CallOption) (*ValidatePromoCodeResponse, error)
+	GetSellerLedger(ctx context.Context, in *GetSellerLedgerRequest, opts ...grpc.CallOption) (*SellerLedgerResponse, error)
+	CreatePayout(ctx context.Context, in *CreatePayoutRequest, opts ...grpc.CallOption) (*PayoutsResponse, error)
+	GetOrderThread(ctx context.Context, in *GetOrderThreadRequest, opts ...grpc.CallOption) (*OrderThreadResponse, error)
+	CreateOrderMessage(ctx context.Context, in *CreateOrderMessageRequest, opts ...grpc.CallOption) (*OrderMessageResponse, error)
+	MarkOrderThreadRead(ctx context.Context, in *MarkOrderThreadReadRequest, opts ...grpc.CallOption) (*ReadReceiptResponse, error)
+	WatchOrderThread(ctx context.Context, in *WatchOrderThreadRequest, opts ...grpc.CallOption) (Order_WatchOrderThreadClient, error)
+	WatchOrder(ctx context.Context, in *WatchOrderRequest, opts ...grpc.CallOption) (Order_WatchOrderClient, error)
+	GetSalesReport(ctx context.Context, in *GetSalesReportRequest, opts ...grpc.CallOption) (*SalesReportResponse, error)
+	ExportSalesReport(ctx context.Context, in *GetSalesReportRequest, opts ...grpc.CallOption) (*ReportFileResponse, error)
+	GetConversionReport(ctx context.Context, in *GetConversionReportRequest, opts ...grpc.CallOption) (*ConversionReportResponse, error)
+	ExportOrders(ctx context.Context, in *ExportOrdersRequest, opts ...grpc.CallOption) (Order_ExportOrdersClient, error)
 }
 
 type orderClient struct {
@@ -124,6 +160,237 @@ func (c *orderClient) DeleteOrderline(ctx context.Context, in *DeleteOrderlineRe
 	return out, nil
 }
 
+func (c *orderClient) HasReceivedProduct(ctx context.Context, in *HasReceivedProductRequest, opts ...grpc.CallOption) (*HasReceivedProductResponse, error) {
+	out := new(HasReceivedProductResponse)
+	err := c.cc.Invoke(ctx, Order_HasReceivedProduct_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) GetPurchasedQuantity(ctx context.Context, in *GetPurchasedQuantityRequest, opts ...grpc.CallOption) (*PurchasedQuantityResponse, error) {
+	out := new(PurchasedQuantityResponse)
+	err := c.cc.Invoke(ctx, Order_GetPurchasedQuantity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) GetInvoice(ctx context.Context, in *GetInvoiceRequest, opts ...grpc.CallOption) (*InvoiceResponse, error) {
+	out := new(InvoiceResponse)
+	err := c.cc.Invoke(ctx, Order_GetInvoice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) CalculateTax(ctx context.Context, in *CalculateTaxRequest, opts ...grpc.CallOption) (*CalculateTaxResponse, error) {
+	out := new(CalculateTaxResponse)
+	err := c.cc.Invoke(ctx, Order_CalculateTax_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) CreatePromoCode(ctx context.Context, in *CreatePromoCodeRequest, opts ...grpc.CallOption) (*PromoCodeResponse, error) {
+	out := new(PromoCodeResponse)
+	err := c.cc.Invoke(ctx, Order_CreatePromoCode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) GetPromoCode(ctx context.Context, in *GetPromoCodeRequest, opts ...grpc.CallOption) (*PromoCodeResponse, error) {
+	out := new(PromoCodeResponse)
+	err := c.cc.Invoke(ctx, Order_GetPromoCode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) ValidatePromoCode(ctx context.Context, in *ValidatePromoCodeRequest, opts ...grpc.CallOption) (*ValidatePromoCodeResponse, error) {
+	out := new(ValidatePromoCodeResponse)
+	err := c.cc.Invoke(ctx, Order_ValidatePromoCode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) GetSellerLedger(ctx context.Context, in *GetSellerLedgerRequest, opts ...grpc.CallOption) (*SellerLedgerResponse, error) {
+	out := new(SellerLedgerResponse)
+	err := c.cc.Invoke(ctx, Order_GetSellerLedger_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) CreatePayout(ctx context.Context, in *CreatePayoutRequest, opts ...grpc.CallOption) (*PayoutsResponse, error) {
+	out := new(PayoutsResponse)
+	err := c.cc.Invoke(ctx, Order_CreatePayout_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) GetOrderThread(ctx context.Context, in *GetOrderThreadRequest, opts ...grpc.CallOption) (*OrderThreadResponse, error) {
+	out := new(OrderThreadResponse)
+	err := c.cc.Invoke(ctx, Order_GetOrderThread_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) CreateOrderMessage(ctx context.Context, in *CreateOrderMessageRequest, opts ...grpc.CallOption) (*OrderMessageResponse, error) {
+	out := new(OrderMessageResponse)
+	err := c.cc.Invoke(ctx, Order_CreateOrderMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) MarkOrderThreadRead(ctx context.Context, in *MarkOrderThreadReadRequest, opts ...grpc.CallOption) (*ReadReceiptResponse, error) {
+	out := new(ReadReceiptResponse)
+	err := c.cc.Invoke(ctx, Order_MarkOrderThreadRead_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) WatchOrderThread(ctx context.Context, in *WatchOrderThreadRequest, opts ...grpc.CallOption) (Order_WatchOrderThreadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Order_ServiceDesc.Streams[0], Order_WatchOrderThread_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &orderWatchOrderThreadClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Order_WatchOrderThreadClient interface {
+	Recv() (*OrderThreadEvent, error)
+	grpc.ClientStream
+}
+
+type orderWatchOrderThreadClient struct {
+	grpc.ClientStream
+}
+
+func (x *orderWatchOrderThreadClient) Recv() (*OrderThreadEvent, error) {
+	m := new(OrderThreadEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *orderClient) WatchOrder(ctx context.Context, in *WatchOrderRequest, opts ...grpc.CallOption) (Order_WatchOrderClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Order_ServiceDesc.Streams[1], Order_WatchOrder_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &orderWatchOrderClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Order_WatchOrderClient interface {
+	Recv() (*OrderEvent, error)
+	grpc.ClientStream
+}
+
+type orderWatchOrderClient struct {
+	grpc.ClientStream
+}
+
+func (x *orderWatchOrderClient) Recv() (*OrderEvent, error) {
+	m := new(OrderEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *orderClient) GetSalesReport(ctx context.Context, in *GetSalesReportRequest, opts ...grpc.CallOption) (*SalesReportResponse, error) {
+	out := new(SalesReportResponse)
+	err := c.cc.Invoke(ctx, Order_GetSalesReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) ExportSalesReport(ctx context.Context, in *GetSalesReportRequest, opts ...grpc.CallOption) (*ReportFileResponse, error) {
+	out := new(ReportFileResponse)
+	err := c.cc.Invoke(ctx, Order_ExportSalesReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) GetConversionReport(ctx context.Context, in *GetConversionReportRequest, opts ...grpc.CallOption) (*ConversionReportResponse, error) {
+	out := new(ConversionReportResponse)
+	err := c.cc.Invoke(ctx, Order_GetConversionReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) ExportOrders(ctx context.Context, in *ExportOrdersRequest, opts ...grpc.CallOption) (Order_ExportOrdersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Order_ServiceDesc.Streams[2], Order_ExportOrders_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &orderExportOrdersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Order_ExportOrdersClient interface {
+	Recv() (*ExportOrdersResponse, error)
+	grpc.ClientStream
+}
+
+type orderExportOrdersClient struct {
+	grpc.ClientStream
+}
+
+func (x *orderExportOrdersClient) Recv() (*ExportOrdersResponse, error) {
+	m := new(ExportOrdersResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // OrderServer is the server API for Order service.
 // All implementations must embed UnimplementedOrderServer
 // for forward compatibility
@@ -136,6 +403,24 @@ type OrderServer interface {
 	GetOrderline(context.Context, *GetOrderlineRequest) (*OrderlineResponse, error)
 	UpdateOrderline(context.Context, *UpdateOrderlineRequest) (*OrderlineResponse, error)
 	DeleteOrderline(context.Context, *DeleteOrderlineRequest) (*DeleteOrderlineResponse, error)
+	HasReceivedProduct(context.Context, *HasReceivedProductRequest) (*HasReceivedProductResponse, error)
+	GetPurchasedQuantity(context.Context, *GetPurchasedQuantityRequest) (*PurchasedQuantityResponse, error)
+	GetInvoice(context.Context, *GetInvoiceRequest) (*InvoiceResponse, error)
+	CalculateTax(context.Context, *CalculateTaxRequest) (*CalculateTaxResponse, error)
+	CreatePromoCode(context.Context, *CreatePromoCodeRequest) (*PromoCodeResponse, error)
+	GetPromoCode(context.Context, *GetPromoCodeRequest) (*PromoCodeResponse, error)
+	ValidatePromoCode(context.Context, *ValidatePromoCodeRequest) (*ValidatePromoCodeResponse, error)
+	GetSellerLedger(context.Context, *GetSellerLedgerRequest) (*SellerLedgerResponse, error)
+	CreatePayout(context.Context, *CreatePayoutRequest) (*PayoutsResponse, error)
+	GetOrderThread(context.Context, *GetOrderThreadRequest) (*OrderThreadResponse, error)
+	CreateOrderMessage(context.Context, *CreateOrderMessageRequest) (*OrderMessageResponse, error)
+	MarkOrderThreadRead(context.Context, *MarkOrderThreadReadRequest) (*ReadReceiptResponse, error)
+	WatchOrderThread(*WatchOrderThreadRequest, Order_WatchOrderThreadServer) error
+	WatchOrder(*WatchOrderRequest, Order_WatchOrderServer) error
+	GetSalesReport(context.Context, *GetSalesReportRequest) (*SalesReportResponse, error)
+	ExportSalesReport(context.Context, *GetSalesReportRequest) (*ReportFileResponse, error)
+	GetConversionReport(context.Context, *GetConversionReportRequest) (*ConversionReportResponse, error)
+	ExportOrders(*ExportOrdersRequest, Order_ExportOrdersServer) error
 	mustEmbedUnimplementedOrderServer()
 }
 
@@ -167,6 +452,60 @@ func (UnimplementedOrderServer) UpdateOrderline(context.Context, *UpdateOrderlin
 func (UnimplementedOrderServer) DeleteOrderline(context.Context, *DeleteOrderlineRequest) (*DeleteOrderlineResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrderline not implemented")
 }
+func (UnimplementedOrderServer) HasReceivedProduct(context.Context, *HasReceivedProductRequest) (*HasReceivedProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HasReceivedProduct not implemented")
+}
+func (UnimplementedOrderServer) GetPurchasedQuantity(context.Context, *GetPurchasedQuantityRequest) (*PurchasedQuantityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPurchasedQuantity not implemented")
+}
+func (UnimplementedOrderServer) GetInvoice(context.Context, *GetInvoiceRequest) (*InvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvoice not implemented")
+}
+func (UnimplementedOrderServer) CalculateTax(context.Context, *CalculateTaxRequest) (*CalculateTaxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateTax not implemented")
+}
+func (UnimplementedOrderServer) CreatePromoCode(context.Context, *CreatePromoCodeRequest) (*PromoCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePromoCode not implemented")
+}
+func (UnimplementedOrderServer) GetPromoCode(context.Context, *GetPromoCodeRequest) (*PromoCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPromoCode not implemented")
+}
+func (UnimplementedOrderServer) ValidatePromoCode(context.Context, *ValidatePromoCodeRequest) (*ValidatePromoCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatePromoCode not implemented")
+}
+func (UnimplementedOrderServer) GetSellerLedger(context.Context, *GetSellerLedgerRequest) (*SellerLedgerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSellerLedger not implemented")
+}
+func (UnimplementedOrderServer) CreatePayout(context.Context, *CreatePayoutRequest) (*PayoutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePayout not implemented")
+}
+func (UnimplementedOrderServer) GetOrderThread(context.Context, *GetOrderThreadRequest) (*OrderThreadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderThread not implemented")
+}
+func (UnimplementedOrderServer) CreateOrderMessage(context.Context, *CreateOrderMessageRequest) (*OrderMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrderMessage not implemented")
+}
+func (UnimplementedOrderServer) MarkOrderThreadRead(context.Context, *MarkOrderThreadReadRequest) (*ReadReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkOrderThreadRead not implemented")
+}
+func (UnimplementedOrderServer) WatchOrderThread(*WatchOrderThreadRequest, Order_WatchOrderThreadServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchOrderThread not implemented")
+}
+func (UnimplementedOrderServer) WatchOrder(*WatchOrderRequest, Order_WatchOrderServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchOrder not implemented")
+}
+func (UnimplementedOrderServer) GetSalesReport(context.Context, *GetSalesReportRequest) (*SalesReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSalesReport not implemented")
+}
+func (UnimplementedOrderServer) ExportSalesReport(context.Context, *GetSalesReportRequest) (*ReportFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportSalesReport not implemented")
+}
+func (UnimplementedOrderServer) GetConversionReport(context.Context, *GetConversionReportRequest) (*ConversionReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConversionReport not implemented")
+}
+func (UnimplementedOrderServer) ExportOrders(*ExportOrdersRequest, Order_ExportOrdersServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportOrders not implemented")
+}
 func (UnimplementedOrderServer) mustEmbedUnimplementedOrderServer() {}
 
 // UnsafeOrderServer may be embedded to opt out of forward compatibility for this service.
@@ -324,6 +663,339 @@ func _Order_DeleteOrderline_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Order_HasReceivedProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HasReceivedProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).HasReceivedProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_HasReceivedProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).HasReceivedProduct(ctx, req.(*HasReceivedProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_GetPurchasedQuantity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPurchasedQuantityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).GetPurchasedQuantity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_GetPurchasedQuantity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).GetPurchasedQuantity(ctx, req.(*GetPurchasedQuantityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_GetInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).GetInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_GetInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).GetInvoice(ctx, req.(*GetInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_CalculateTax_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculateTaxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).CalculateTax(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_CalculateTax_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).CalculateTax(ctx, req.(*CalculateTaxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_CreatePromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).CreatePromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_CreatePromoCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).CreatePromoCode(ctx, req.(*CreatePromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_GetPromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).GetPromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_GetPromoCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).GetPromoCode(ctx, req.(*GetPromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_ValidatePromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatePromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).ValidatePromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_ValidatePromoCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).ValidatePromoCode(ctx, req.(*ValidatePromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_GetSellerLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSellerLedgerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).GetSellerLedger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_GetSellerLedger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).GetSellerLedger(ctx, req.(*GetSellerLedgerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_CreatePayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).CreatePayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_CreatePayout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).CreatePayout(ctx, req.(*CreatePayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_GetOrderThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).GetOrderThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_GetOrderThread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).GetOrderThread(ctx, req.(*GetOrderThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_CreateOrderMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrderMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).CreateOrderMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_CreateOrderMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).CreateOrderMessage(ctx, req.(*CreateOrderMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_MarkOrderThreadRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkOrderThreadReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).MarkOrderThreadRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_MarkOrderThreadRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).MarkOrderThreadRead(ctx, req.(*MarkOrderThreadReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_WatchOrderThread_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchOrderThreadRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrderServer).WatchOrderThread(m, &orderWatchOrderThreadServer{stream})
+}
+
+type Order_WatchOrderThreadServer interface {
+	Send(*OrderThreadEvent) error
+	grpc.ServerStream
+}
+
+type orderWatchOrderThreadServer struct {
+	grpc.ServerStream
+}
+
+func (x *orderWatchOrderThreadServer) Send(m *OrderThreadEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Order_WatchOrder_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchOrderRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrderServer).WatchOrder(m, &orderWatchOrderServer{stream})
+}
+
+type Order_WatchOrderServer interface {
+	Send(*OrderEvent) error
+	grpc.ServerStream
+}
+
+type orderWatchOrderServer struct {
+	grpc.ServerStream
+}
+
+func (x *orderWatchOrderServer) Send(m *OrderEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Order_GetSalesReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSalesReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).GetSalesReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_GetSalesReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).GetSalesReport(ctx, req.(*GetSalesReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_ExportSalesReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSalesReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).ExportSalesReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_ExportSalesReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).ExportSalesReport(ctx, req.(*GetSalesReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_GetConversionReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConversionReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).GetConversionReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_GetConversionReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).GetConversionReport(ctx, req.(*GetConversionReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_ExportOrders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportOrdersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrderServer).ExportOrders(m, &orderExportOrdersServer{stream})
+}
+
+type Order_ExportOrdersServer interface {
+	Send(*ExportOrdersResponse) error
+	grpc.ServerStream
+}
+
+type orderExportOrdersServer struct {
+	grpc.ServerStream
+}
+
+func (x *orderExportOrdersServer) Send(m *ExportOrdersResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Order_ServiceDesc is the grpc.ServiceDesc for Order service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -363,7 +1035,83 @@ var Order_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteOrderline",
 			Handler:    _Order_DeleteOrderline_Handler,
 		},
+		{
+			MethodName: "HasReceivedProduct",
+			Handler:    _Order_HasReceivedProduct_Handler,
+		},
+		{
+			MethodName: "GetPurchasedQuantity",
+			Handler:    _Order_GetPurchasedQuantity_Handler,
+		},
+		{
+			MethodName: "GetInvoice",
+			Handler:    _Order_GetInvoice_Handler,
+		},
+		{
+			MethodName: "CalculateTax",
+			Handler:    _Order_CalculateTax_Handler,
+		},
+		{
+			MethodName: "CreatePromoCode",
+			Handler:    _Order_CreatePromoCode_Handler,
+		},
+		{
+			MethodName: "GetPromoCode",
+			Handler:    _Order_GetPromoCode_Handler,
+		},
+		{
+			MethodName: "ValidatePromoCode",
+			Handler:    _Order_ValidatePromoCode_Handler,
+		},
+		{
+			MethodName: "GetSellerLedger",
+			Handler:    _Order_GetSellerLedger_Handler,
+		},
+		{
+			MethodName: "CreatePayout",
+			Handler:    _Order_CreatePayout_Handler,
+		},
+		{
+			MethodName: "GetOrderThread",
+			Handler:    _Order_GetOrderThread_Handler,
+		},
+		{
+			MethodName: "CreateOrderMessage",
+			Handler:    _Order_CreateOrderMessage_Handler,
+		},
+		{
+			MethodName: "MarkOrderThreadRead",
+			Handler:    _Order_MarkOrderThreadRead_Handler,
+		},
+		{
+			MethodName: "GetSalesReport",
+			Handler:    _Order_GetSalesReport_Handler,
+		},
+		{
+			MethodName: "ExportSalesReport",
+			Handler:    _Order_ExportSalesReport_Handler,
+		},
+		{
+			MethodName: "GetConversionReport",
+			Handler:    _Order_GetConversionReport_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchOrderThread",
+			Handler:       _Order_WatchOrderThread_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchOrder",
+			Handler:       _Order_WatchOrder_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportOrders",
+			Handler:       _Order_ExportOrders_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "order.proto",
 }
@@ -20,18 +20,57 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Product_GetProducts_FullMethodName        = "/product.Product/GetProducts"
-	Product_GetProduct_FullMethodName         = "/product.Product/GetProduct"
-	Product_CreateProduct_FullMethodName      = "/product.Product/CreateProduct"
-	Product_UpdateProducts_FullMethodName     = "/product.Product/UpdateProducts"
-	Product_UpdateProduct_FullMethodName      = "/product.Product/UpdateProduct"
-	Product_ModerateProduct_FullMethodName    = "/product.Product/ModerateProduct"
-	Product_DeleteProduct_FullMethodName      = "/product.Product/DeleteProduct"
-	Product_DeleteUserProducts_FullMethodName = "/product.Product/DeleteUserProducts"
-	Product_GetCategory_FullMethodName        = "/product.Product/GetCategory"
-	Product_GetAllCategories_FullMethodName   = "/product.Product/GetAllCategories"
-	Product_CreateDiscount_FullMethodName     = "/product.Product/CreateDiscount"
-	Product_DeleteDiscount_FullMethodName     = "/product.Product/DeleteDiscount"
+	Product_GetProducts_FullMethodName            = "/product.Product/GetProducts"
+	Product_GetProduct_FullMethodName             = "/product.Product/GetProduct"
+	Product_CreateProduct_FullMethodName          = "/product.Product/CreateProduct"
+	Product_UpdateProducts_FullMethodName         = "/product.Product/UpdateProducts"
+	Product_UpdateProduct_FullMethodName          = "/product.Product/UpdateProduct"
+	Product_ModerateProduct_FullMethodName        = "/product.Product/ModerateProduct"
+	Product_SubmitProduct_FullMethodName          = "/product.Product/SubmitProduct"
+	Product_GetModerationQueue_FullMethodName     = "/product.Product/GetModerationQueue"
+	Product_DeleteProduct_FullMethodName          = "/product.Product/DeleteProduct"
+	Product_DeleteUserProducts_FullMethodName     = "/product.Product/DeleteUserProducts"
+	Product_RestoreProduct_FullMethodName         = "/product.Product/RestoreProduct"
+	Product_ImportProducts_FullMethodName         = "/product.Product/ImportProducts"
+	Product_ExportProducts_FullMethodName         = "/product.Product/ExportProducts"
+	Product_GetCategory_FullMethodName            = "/product.Product/GetCategory"
+	Product_GetAllCategories_FullMethodName       = "/product.Product/GetAllCategories"
+	Product_CreateDiscount_FullMethodName         = "/product.Product/CreateDiscount"
+	Product_DeleteDiscount_FullMethodName         = "/product.Product/DeleteDiscount"
+	Product_CreateCategoryDiscount_FullMethodName = "/product.Product/CreateCategoryDiscount"
+	Product_DeleteCategoryDiscount_FullMethodName = "/product.Product/DeleteCategoryDiscount"
+	Product_CreateSellerDiscount_FullMethodName   = "/product.Product/CreateSellerDiscount"
+	Product_DeleteSellerDiscount_FullMethodName   = "/product.Product/DeleteSellerDiscount"
+	Product_GetDiscounts_FullMethodName           = "/product.Product/GetDiscounts"
+	Product_CreateReview_FullMethodName           = "/product.Product/CreateReview"
+	Product_GetProductReviews_FullMethodName      = "/product.Product/GetProductReviews"
+	Product_ReplyReview_FullMethodName            = "/product.Product/ReplyReview"
+	Product_CreateWarehouse_FullMethodName        = "/product.Product/CreateWarehouse"
+	Product_GetWarehouses_FullMethodName          = "/product.Product/GetWarehouses"
+	Product_SetWarehouseStock_FullMethodName      = "/product.Product/SetWarehouseStock"
+	Product_GetProductStocks_FullMethodName       = "/product.Product/GetProductStocks"
+	Product_ReserveProduct_FullMethodName         = "/product.Product/ReserveProduct"
+	Product_ReleaseProduct_FullMethodName         = "/product.Product/ReleaseProduct"
+	Product_ChangeReservations_FullMethodName     = "/product.Product/ChangeReservations"
+	Product_SetStockAlert_FullMethodName          = "/product.Product/SetStockAlert"
+	Product_SetPurchaseLimit_FullMethodName       = "/product.Product/SetPurchaseLimit"
+	Product_GetNotifications_FullMethodName       = "/product.Product/GetNotifications"
+	Product_CreateNotification_FullMethodName     = "/product.Product/CreateNotification"
+	Product_GetProductHistory_FullMethodName      = "/product.Product/GetProductHistory"
+	Product_GetPriceHistory_FullMethodName        = "/product.Product/GetPriceHistory"
+	Product_GetExchangeRates_FullMethodName       = "/product.Product/GetExchangeRates"
+	Product_SetExchangeRate_FullMethodName        = "/product.Product/SetExchangeRate"
+	Product_ImportExchangeRates_FullMethodName    = "/product.Product/ImportExchangeRates"
+	Product_GetSellerProfile_FullMethodName       = "/product.Product/GetSellerProfile"
+	Product_SetSellerProfile_FullMethodName       = "/product.Product/SetSellerProfile"
+	Product_GetStorefront_FullMethodName          = "/product.Product/GetStorefront"
+	Product_CreateWishlist_FullMethodName         = "/product.Product/CreateWishlist"
+	Product_GetWishlists_FullMethodName           = "/product.Product/GetWishlists"
+	Product_DeleteWishlist_FullMethodName         = "/product.Product/DeleteWishlist"
+	Product_AddWishlistItem_FullMethodName        = "/product.Product/AddWishlistItem"
+	Product_RemoveWishlistItem_FullMethodName     = "/product.Product/RemoveWishlistItem"
+	Product_MoveWishlistItemToCart_FullMethodName = "/product.Product/MoveWishlistItemToCart"
+	Product_MoveCartlineToWishlist_FullMethodName = "/product.Product/MoveCartlineToWishlist"
 )
 
 // ProductClient is the client API for Product service.
@@ -44,12 +83,51 @@ type ProductClient interface {
 	UpdateProducts(ctx context.Context, in *UpdateProductsRequest, opts ...grpc.CallOption) (*UpdateProductsResponse, error)
 	UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*ProductResponse, error)
 	ModerateProduct(ctx context.Context, in *ModerateProductRequest, opts ...grpc.CallOption) (*ProductResponse, error)
+	SubmitProduct(ctx context.Context, in *SubmitProductRequest, opts ...grpc.CallOption) (*ProductResponse, error)
+	GetModerationQueue(ctx context.Context, in *GetModerationQueueRequest, opts ...grpc.CallOption) (*ProductsResponse, error)
 	DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error)
 	DeleteUserProducts(ctx context.Context, in *DeleteUserProductsRequest, opts ...grpc.CallOption) (*DeleteUserProductsResponse, error)
+	RestoreProduct(ctx context.Context, in *RestoreProductRequest, opts ...grpc.CallOption) (*ProductResponse, error)
+	ImportProducts(ctx context.Context, opts ...grpc.CallOption) (Product_ImportProductsClient, error)
+	ExportProducts(ctx context.Context, in *ExportProductsRequest, opts ...grpc.CallOption) (Product_ExportProductsClient, error)
 	GetCategory(ctx context.Context, in *GetCategoryRequest, opts ...grpc.CallOption) (*CategoryResponse, error)
 	GetAllCategories(ctx context.Context, in *GetAllCategoriesRequest, opts ...grpc.CallOption) (*CategoriesResponse, error)
 	CreateDiscount(ctx context.Context, in *CreateDiscountRequest, opts ...grpc.CallOption) (*ProductResponse, error)
 	DeleteDiscount(ctx context.Context, in *DeleteDiscountRequest, opts ...grpc.CallOption) (*ProductResponse, error)
+	CreateCategoryDiscount(ctx context.Context, in *CreateCategoryDiscountRequest, opts ...grpc.CallOption) (*DiscountResponse, error)
+	DeleteCategoryDiscount(ctx context.Context, in *DeleteCategoryDiscountRequest, opts ...grpc.CallOption) (*DeleteDiscountResponse, error)
+	CreateSellerDiscount(ctx context.Context, in *CreateSellerDiscountRequest, opts ...grpc.CallOption) (*DiscountResponse, error)
+	DeleteSellerDiscount(ctx context.Context, in *DeleteSellerDiscountRequest, opts ...grpc.CallOption) (*DeleteDiscountResponse, error)
+	GetDiscounts(ctx context.Context, in *GetDiscountsRequest, opts ...grpc.CallOption) (*DiscountsResponse, error)
+	CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*ReviewResponse, error)
+	GetProductReviews(ctx context.Context, in *GetProductReviewsRequest, opts ...grpc.CallOption) (*ReviewsResponse, error)
+	ReplyReview(ctx context.Context, in *ReplyReviewRequest, opts ...grpc.CallOption) (*ReviewResponse, error)
+	CreateWarehouse(ctx context.Context, in *CreateWarehouseRequest, opts ...grpc.CallOption) (*WarehouseResponse, error)
+	GetWarehouses(ctx context.Context, in *GetWarehousesRequest, opts ...grpc.CallOption) (*WarehousesResponse, error)
+	SetWarehouseStock(ctx context.Context, in *SetWarehouseStockRequest, opts ...grpc.CallOption) (*WarehouseStockResponse, error)
+	GetProductStocks(ctx context.Context, in *GetProductStocksRequest, opts ...grpc.CallOption) (*WarehouseStocksResponse, error)
+	ReserveProduct(ctx context.Context, in *ReserveProductRequest, opts ...grpc.CallOption) (*ReservationResponse, error)
+	ReleaseProduct(ctx context.Context, in *ReleaseProductRequest, opts ...grpc.CallOption) (*ReservationResponse, error)
+	ChangeReservations(ctx context.Context, in *ChangeReservationsRequest, opts ...grpc.CallOption) (*ReservationsResponse, error)
+	SetStockAlert(ctx context.Context, in *SetStockAlertRequest, opts ...grpc.CallOption) (*ProductResponse, error)
+	SetPurchaseLimit(ctx context.Context, in *SetPurchaseLimitRequest, opts ...grpc.CallOption) (*ProductResponse, error)
+	GetNotifications(ctx context.Context, in *GetNotificationsRequest, opts ...grpc.CallOption) (*NotificationsResponse, error)
+	CreateNotification(ctx context.Context, in *CreateNotificationRequest, opts ...grpc.CallOption) (*NotificationResponse, error)
+	GetProductHistory(ctx context.Context, in *GetProductHistoryRequest, opts ...grpc.CallOption) (*ProductHistoryResponse, error)
+	GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*PriceHistoryResponse, error)
+	GetExchangeRates(ctx context.Context, in *GetExchangeRatesRequest, opts ...grpc.CallOption) (*ExchangeRatesResponse, error)
+	SetExchangeRate(ctx context.Context, in *SetExchangeRateRequest, opts ...grpc.CallOption) (*ExchangeRateResponse, error)
+	ImportExchangeRates(ctx context.Context, in *ImportExchangeRatesRequest, opts ...grpc.CallOption) (*ExchangeRatesResponse, error)
+	GetSellerProfile(ctx context.Context, in *GetSellerProfileRequest, opts ...grpc.CallOption) (*SellerProfileResponse, error)
+	SetSellerProfile(ctx context.Context, in *SetSellerProfileRequest, opts ...grpc.CallOption) (*SellerProfileResponse, error)
+	GetStorefront(ctx context.Context, in *GetStorefrontRequest, opts ...grpc.CallOption) (*StorefrontResponse, error)
+	CreateWishlist(ctx context.Context, in *CreateWishlistRequest, opts ...grpc.CallOption) (*WishlistResponse, error)
+	GetWishlists(ctx context.Context, in *GetWishlistsRequest, opts ...grpc.CallOption) (*WishlistsResponse, error)
+	DeleteWishlist(ctx context.Context, in *DeleteWishlistRequest, opts ...grpc.CallOption) (*DeleteWishlistResponse, error)
+	AddWishlistItem(ctx context.Context, in *AddWishlistItemRequest, opts ...grpc.CallOption) (*WishlistResponse, error)
+	RemoveWishlistItem(ctx context.Context, in *RemoveWishlistItemRequest, opts ...grpc.CallOption) (*WishlistResponse, error)
+	MoveWishlistItemToCart(ctx context.Context, in *MoveWishlistItemToCartRequest, opts ...grpc.CallOption) (*WishlistResponse, error)
+	MoveCartlineToWishlist(ctx context.Context, in *MoveCartlineToWishlistRequest, opts ...grpc.CallOption) (*WishlistResponse, error)
 }
 
 type productClient struct {
@@ -114,6 +192,24 @@ func (c *productClient) ModerateProduct(ctx context.Context, in *ModerateProduct
 	return out, nil
 }
 
+func (c *productClient) SubmitProduct(ctx context.Context, in *SubmitProductRequest, opts ...grpc.CallOption) (*ProductResponse, error) {
+	out := new(ProductResponse)
+	err := c.cc.Invoke(ctx, Product_SubmitProduct_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) GetModerationQueue(ctx context.Context, in *GetModerationQueueRequest, opts ...grpc.CallOption) (*ProductsResponse, error) {
+	out := new(ProductsResponse)
+	err := c.cc.Invoke(ctx, Product_GetModerationQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productClient) DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error) {
 	out := new(DeleteProductResponse)
 	err := c.cc.Invoke(ctx, Product_DeleteProduct_FullMethodName, in, out, opts...)
@@ -132,326 +228,1576 @@ func (c *productClient) DeleteUserProducts(ctx context.Context, in *DeleteUserPr
 	return out, nil
 }
 
+func (c *productClient) RestoreProduct(ctx context.Context, in *RestoreProductRequest, opts ...grpc.CallOption) (*ProductResponse, error) {
+	out := new(ProductResponse)
+	err := c.cc.Invoke(ctx, Product_RestoreProduct_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) ImportProducts(ctx context.Context, opts ...grpc.CallOption) (Product_ImportProductsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Product_ServiceDesc.Streams[0], Product_ImportProducts_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &productImportProductsClient{stream}
+	return x, nil
+}
+
+type Product_ImportProductsClient interface {
+	Send(*ImportProductsRequest) error
+	CloseAndRecv() (*ImportProductsResponse, error)
+	grpc.ClientStream
+}
+
+type productImportProductsClient struct {
+	grpc.ClientStream
+}
+
+func (x *productImportProductsClient) Send(m *ImportProductsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *productImportProductsClient) CloseAndRecv() (*ImportProductsResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportProductsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *productClient) ExportProducts(ctx context.Context, in *ExportProductsRequest, opts ...grpc.CallOption) (Product_ExportProductsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Product_ServiceDesc.Streams[1], Product_ExportProducts_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &productExportProductsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Product_ExportProductsClient interface {
+	Recv() (*ExportProductsResponse, error)
+	grpc.ClientStream
+}
+
+type productExportProductsClient struct {
+	grpc.ClientStream
+}
+
+func (x *productExportProductsClient) Recv() (*ExportProductsResponse, error) {
+	m := new(ExportProductsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *productClient) GetCategory(ctx context.Context, in *GetCategoryRequest, opts ...grpc.CallOption) (*CategoryResponse, error) {
 	out := new(CategoryResponse)
 	err := c.cc.Invoke(ctx, Product_GetCategory_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *productClient) GetAllCategories(ctx context.Context, in *GetAllCategoriesRequest, opts ...grpc.CallOption) (*CategoriesResponse, error) {
+	out := new(CategoriesResponse)
+	err := c.cc.Invoke(ctx, Product_GetAllCategories_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) CreateDiscount(ctx context.Context, in *CreateDiscountRequest, opts ...grpc.CallOption) (*ProductResponse, error) {
+	out := new(ProductResponse)
+	err := c.cc.Invoke(ctx, Product_CreateDiscount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) DeleteDiscount(ctx context.Context, in *DeleteDiscountRequest, opts ...grpc.CallOption) (*ProductResponse, error) {
+	out := new(ProductResponse)
+	err := c.cc.Invoke(ctx, Product_DeleteDiscount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) CreateCategoryDiscount(ctx context.Context, in *CreateCategoryDiscountRequest, opts ...grpc.CallOption) (*DiscountResponse, error) {
+	out := new(DiscountResponse)
+	err := c.cc.Invoke(ctx, Product_CreateCategoryDiscount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) DeleteCategoryDiscount(ctx context.Context, in *DeleteCategoryDiscountRequest, opts ...grpc.CallOption) (*DeleteDiscountResponse, error) {
+	out := new(DeleteDiscountResponse)
+	err := c.cc.Invoke(ctx, Product_DeleteCategoryDiscount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) CreateSellerDiscount(ctx context.Context, in *CreateSellerDiscountRequest, opts ...grpc.CallOption) (*DiscountResponse, error) {
+	out := new(DiscountResponse)
+	err := c.cc.Invoke(ctx, Product_CreateSellerDiscount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) DeleteSellerDiscount(ctx context.Context, in *DeleteSellerDiscountRequest, opts ...grpc.CallOption) (*DeleteDiscountResponse, error) {
+	out := new(DeleteDiscountResponse)
+	err := c.cc.Invoke(ctx, Product_DeleteSellerDiscount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) GetDiscounts(ctx context.Context, in *GetDiscountsRequest, opts ...grpc.CallOption) (*DiscountsResponse, error) {
+	out := new(DiscountsResponse)
+	err := c.cc.Invoke(ctx, Product_GetDiscounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*ReviewResponse, error) {
+	out := new(ReviewResponse)
+	err := c.cc.Invoke(ctx, Product_CreateReview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) GetProductReviews(ctx context.Context, in *GetProductReviewsRequest, opts ...grpc.CallOption) (*ReviewsResponse, error) {
+	out := new(ReviewsResponse)
+	err := c.cc.Invoke(ctx, Product_GetProductReviews_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) ReplyReview(ctx context.Context, in *ReplyReviewRequest, opts ...grpc.CallOption) (*ReviewResponse, error) {
+	out := new(ReviewResponse)
+	err := c.cc.Invoke(ctx, Product_ReplyReview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) CreateWarehouse(ctx context.Context, in *CreateWarehouseRequest, opts ...grpc.CallOption) (*WarehouseResponse, error) {
+	out := new(WarehouseResponse)
+	err := c.cc.Invoke(ctx, Product_CreateWarehouse_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) GetWarehouses(ctx context.Context, in *GetWarehousesRequest, opts ...grpc.CallOption) (*WarehousesResponse, error) {
+	out := new(WarehousesResponse)
+	err := c.cc.Invoke(ctx, Product_GetWarehouses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) SetWarehouseStock(ctx context.Context, in *SetWarehouseStockRequest, opts ...grpc.CallOption) (*WarehouseStockResponse, error) {
+	out := new(WarehouseStockResponse)
+	err := c.cc.Invoke(ctx, Product_SetWarehouseStock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) GetProductStocks(ctx context.Context, in *GetProductStocksRequest, opts ...grpc.CallOption) (*WarehouseStocksResponse, error) {
+	out := new(WarehouseStocksResponse)
+	err := c.cc.Invoke(ctx, Product_GetProductStocks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) ReserveProduct(ctx context.Context, in *ReserveProductRequest, opts ...grpc.CallOption) (*ReservationResponse, error) {
+	out := new(ReservationResponse)
+	err := c.cc.Invoke(ctx, Product_ReserveProduct_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) ReleaseProduct(ctx context.Context, in *ReleaseProductRequest, opts ...grpc.CallOption) (*ReservationResponse, error) {
+	out := new(ReservationResponse)
+	err := c.cc.Invoke(ctx, Product_ReleaseProduct_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) ChangeReservations(ctx context.Context, in *ChangeReservationsRequest, opts ...grpc.CallOption) (*ReservationsResponse, error) {
+	out := new(ReservationsResponse)
+	err := c.cc.Invoke(ctx, Product_ChangeReservations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) SetStockAlert(ctx context.Context, in *SetStockAlertRequest, opts ...grpc.CallOption) (*ProductResponse, error) {
+	out := new(ProductResponse)
+	err := c.cc.Invoke(ctx, Product_SetStockAlert_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) SetPurchaseLimit(ctx context.Context, in *SetPurchaseLimitRequest, opts ...grpc.CallOption) (*ProductResponse, error) {
+	out := new(ProductResponse)
+	err := c.cc.Invoke(ctx, Product_SetPurchaseLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) GetNotifications(ctx context.Context, in *GetNotificationsRequest, opts ...grpc.CallOption) (*NotificationsResponse, error) {
+	out := new(NotificationsResponse)
+	err := c.cc.Invoke(ctx, Product_GetNotifications_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) CreateNotification(ctx context.Context, in *CreateNotificationRequest, opts ...grpc.CallOption) (*NotificationResponse, error) {
+	out := new(NotificationResponse)
+	err := c.cc.Invoke(ctx, Product_CreateNotification_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) GetProductHistory(ctx context.Context, in *GetProductHistoryRequest, opts ...grpc.CallOption) (*ProductHistoryResponse, error) {
+	out := new(ProductHistoryResponse)
+	err := c.cc.Invoke(ctx, Product_GetProductHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*PriceHistoryResponse, error) {
+	out := new(PriceHistoryResponse)
+	err := c.cc.Invoke(ctx, Product_GetPriceHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) GetExchangeRates(ctx context.Context, in *GetExchangeRatesRequest, opts ...grpc.CallOption) (*ExchangeRatesResponse, error) {
+	out := new(ExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, Product_GetExchangeRates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) SetExchangeRate(ctx context.Context, in *SetExchangeRateRequest, opts ...grpc.CallOption) (*ExchangeRateResponse, error) {
+	out := new(ExchangeRateResponse)
+	err := c.cc.Invoke(ctx, Product_SetExchangeRate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) ImportExchangeRates(ctx context.Context, in *ImportExchangeRatesRequest, opts ...grpc.CallOption) (*ExchangeRatesResponse, error) {
+	out := new(ExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, Product_ImportExchangeRates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) GetSellerProfile(ctx context.Context, in *GetSellerProfileRequest, opts ...grpc.CallOption) (*SellerProfileResponse, error) {
+	out := new(SellerProfileResponse)
+	err := c.cc.Invoke(ctx, Product_GetSellerProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) SetSellerProfile(ctx context.Context, in *SetSellerProfileRequest, opts ...grpc.CallOption) (*SellerProfileResponse, error) {
+	out := new(SellerProfileResponse)
+	err := c.cc.Invoke(ctx, Product_SetSellerProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) GetStorefront(ctx context.Context, in *GetStorefrontRequest, opts ...grpc.CallOption) (*StorefrontResponse, error) {
+	out := new(StorefrontResponse)
+	err := c.cc.Invoke(ctx, Product_GetStorefront_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) CreateWishlist(ctx context.Context, in *CreateWishlistRequest, opts ...grpc.CallOption) (*WishlistResponse, error) {
+	out := new(WishlistResponse)
+	err := c.cc.Invoke(ctx, Product_CreateWishlist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) GetWishlists(ctx context.Context, in *GetWishlistsRequest, opts ...grpc.CallOption) (*WishlistsResponse, error) {
+	out := new(WishlistsResponse)
+	err := c.cc.Invoke(ctx, Product_GetWishlists_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) DeleteWishlist(ctx context.Context, in *DeleteWishlistRequest, opts ...grpc.CallOption) (*DeleteWishlistResponse, error) {
+	out := new(DeleteWishlistResponse)
+	err := c.cc.Invoke(ctx, Product_DeleteWishlist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) AddWishlistItem(ctx context.Context, in *AddWishlistItemRequest, opts ...grpc.CallOption) (*WishlistResponse, error) {
+	out := new(WishlistResponse)
+	err := c.cc.Invoke(ctx, Product_AddWishlistItem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) RemoveWishlistItem(ctx context.Context, in *RemoveWishlistItemRequest, opts ...grpc.CallOption) (*WishlistResponse, error) {
+	out := new(WishlistResponse)
+	err := c.cc.Invoke(ctx, Product_RemoveWishlistItem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) MoveWishlistItemToCart(ctx context.Context, in *MoveWishlistItemToCartRequest, opts ...grpc.CallOption) (*WishlistResponse, error) {
+	out := new(WishlistResponse)
+	err := c.cc.Invoke(ctx, Product_MoveWishlistItemToCart_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) MoveCartlineToWishlist(ctx context.Context, in *MoveCartlineToWishlistRequest, opts ...grpc.CallOption) (*WishlistResponse, error) {
+	out := new(WishlistResponse)
+	err := c.cc.Invoke(ctx, Product_MoveCartlineToWishlist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProductServer is the server API for Product service.
+// All implementations must embed UnimplementedProductServer
+// for forward compatibility
+type ProductServer interface {
+	GetProducts(context.Context, *GetProductsRequest) (*ProductsResponse, error)
+	GetProduct(context.Context, *GetProductRequest) (*ProductResponse, error)
+	CreateProduct(context.Context, *CreateProductRequest) (*ProductResponse, error)
+	UpdateProducts(context.Context, *UpdateProductsRequest) (*UpdateProductsResponse, error)
+	UpdateProduct(context.Context, *UpdateProductRequest) (*ProductResponse, error)
+	ModerateProduct(context.Context, *ModerateProductRequest) (*ProductResponse, error)
+	SubmitProduct(context.Context, *SubmitProductRequest) (*ProductResponse, error)
+	GetModerationQueue(context.Context, *GetModerationQueueRequest) (*ProductsResponse, error)
+	DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error)
+	DeleteUserProducts(context.Context, *DeleteUserProductsRequest) (*DeleteUserProductsResponse, error)
+	RestoreProduct(context.Context, *RestoreProductRequest) (*ProductResponse, error)
+	ImportProducts(Product_ImportProductsServer) error
+	ExportProducts(*ExportProductsRequest, Product_ExportProductsServer) error
+	GetCategory(context.Context, *GetCategoryRequest) (*CategoryResponse, error)
+	GetAllCategories(context.Context, *GetAllCategoriesRequest) (*CategoriesResponse, error)
+	CreateDiscount(context.Context, *CreateDiscountRequest) (*ProductResponse, error)
+	DeleteDiscount(context.Context, *DeleteDiscountRequest) (*ProductResponse, error)
+	CreateCategoryDiscount(context.Context, *CreateCategoryDiscountRequest) (*DiscountResponse, error)
+	DeleteCategoryDiscount(context.Context, *DeleteCategoryDiscountRequest) (*DeleteDiscountResponse, error)
+	CreateSellerDiscount(context.Context, *CreateSellerDiscountRequest) (*DiscountResponse, error)
+	DeleteSellerDiscount(context.Context, *DeleteSellerDiscountRequest) (*DeleteDiscountResponse, error)
+	GetDiscounts(context.Context, *GetDiscountsRequest) (*DiscountsResponse, error)
+	CreateReview(context.Context, *CreateReviewRequest) (*ReviewResponse, error)
+	GetProductReviews(context.Context, *GetProductReviewsRequest) (*ReviewsResponse, error)
+	ReplyReview(context.Context, *ReplyReviewRequest) (*ReviewResponse, error)
+	CreateWarehouse(context.Context, *CreateWarehouseRequest) (*WarehouseResponse, error)
+	GetWarehouses(context.Context, *GetWarehousesRequest) (*WarehousesResponse, error)
+	SetWarehouseStock(context.Context, *SetWarehouseStockRequest) (*WarehouseStockResponse, error)
+	GetProductStocks(context.Context, *GetProductStocksRequest) (*WarehouseStocksResponse, error)
+	ReserveProduct(context.Context, *ReserveProductRequest) (*ReservationResponse, error)
+	ReleaseProduct(context.Context, *ReleaseProductRequest) (*ReservationResponse, error)
+	ChangeReservations(context.Context, *ChangeReservationsRequest) (*ReservationsResponse, error)
+	SetStockAlert(context.Context, *SetStockAlertRequest) (*ProductResponse, error)
+	SetPurchaseLimit(context.Context, *SetPurchaseLimitRequest) (*ProductResponse, error)
+	GetNotifications(context.Context, *GetNotificationsRequest) (*NotificationsResponse, error)
+	CreateNotification(context.Context, *CreateNotificationRequest) (*NotificationResponse, error)
+	GetProductHistory(context.Context, *GetProductHistoryRequest) (*ProductHistoryResponse, error)
+	GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*PriceHistoryResponse, error)
+	GetExchangeRates(context.Context, *GetExchangeRatesRequest) (*ExchangeRatesResponse, error)
+	SetExchangeRate(context.Context, *SetExchangeRateRequest) (*ExchangeRateResponse, error)
+	ImportExchangeRates(context.Context, *ImportExchangeRatesRequest) (*ExchangeRatesResponse, error)
+	GetSellerProfile(context.Context, *GetSellerProfileRequest) (*SellerProfileResponse, error)
+	SetSellerProfile(context.Context, *SetSellerProfileRequest) (*SellerProfileResponse, error)
+	GetStorefront(context.Context, *GetStorefrontRequest) (*StorefrontResponse, error)
+	CreateWishlist(context.Context, *CreateWishlistRequest) (*WishlistResponse, error)
+	GetWishlists(context.Context, *GetWishlistsRequest) (*WishlistsResponse, error)
+	DeleteWishlist(context.Context, *DeleteWishlistRequest) (*DeleteWishlistResponse, error)
+	AddWishlistItem(context.Context, *AddWishlistItemRequest) (*WishlistResponse, error)
+	RemoveWishlistItem(context.Context, *RemoveWishlistItemRequest) (*WishlistResponse, error)
+	MoveWishlistItemToCart(context.Context, *MoveWishlistItemToCartRequest) (*WishlistResponse, error)
+	MoveCartlineToWishlist(context.Context, *MoveCartlineToWishlistRequest) (*WishlistResponse, error)
+	mustEmbedUnimplementedProductServer()
+}
+
+// UnimplementedProductServer must be embedded to have forward compatible implementations.
+type UnimplementedProductServer struct {
+}
+
+func (UnimplementedProductServer) GetProducts(context.Context, *GetProductsRequest) (*ProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProducts not implemented")
+}
+func (UnimplementedProductServer) GetProduct(context.Context, *GetProductRequest) (*ProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProduct not implemented")
+}
+func (UnimplementedProductServer) CreateProduct(context.Context, *CreateProductRequest) (*ProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProduct not implemented")
+}
+func (UnimplementedProductServer) UpdateProducts(context.Context, *UpdateProductsRequest) (*UpdateProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProducts not implemented")
+}
+func (UnimplementedProductServer) UpdateProduct(context.Context, *UpdateProductRequest) (*ProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProduct not implemented")
+}
+func (UnimplementedProductServer) ModerateProduct(context.Context, *ModerateProductRequest) (*ProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateProduct not implemented")
+}
+func (UnimplementedProductServer) SubmitProduct(context.Context, *SubmitProductRequest) (*ProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitProduct not implemented")
+}
+func (UnimplementedProductServer) GetModerationQueue(context.Context, *GetModerationQueueRequest) (*ProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModerationQueue not implemented")
+}
+func (UnimplementedProductServer) DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProduct not implemented")
+}
+func (UnimplementedProductServer) DeleteUserProducts(context.Context, *DeleteUserProductsRequest) (*DeleteUserProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserProducts not implemented")
+}
+func (UnimplementedProductServer) RestoreProduct(context.Context, *RestoreProductRequest) (*ProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreProduct not implemented")
+}
+func (UnimplementedProductServer) ImportProducts(Product_ImportProductsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportProducts not implemented")
+}
+func (UnimplementedProductServer) ExportProducts(*ExportProductsRequest, Product_ExportProductsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportProducts not implemented")
+}
+func (UnimplementedProductServer) GetCategory(context.Context, *GetCategoryRequest) (*CategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategory not implemented")
+}
+func (UnimplementedProductServer) GetAllCategories(context.Context, *GetAllCategoriesRequest) (*CategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllCategories not implemented")
+}
+func (UnimplementedProductServer) CreateDiscount(context.Context, *CreateDiscountRequest) (*ProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDiscount not implemented")
+}
+func (UnimplementedProductServer) DeleteDiscount(context.Context, *DeleteDiscountRequest) (*ProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDiscount not implemented")
+}
+func (UnimplementedProductServer) CreateCategoryDiscount(context.Context, *CreateCategoryDiscountRequest) (*DiscountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategoryDiscount not implemented")
+}
+func (UnimplementedProductServer) DeleteCategoryDiscount(context.Context, *DeleteCategoryDiscountRequest) (*DeleteDiscountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategoryDiscount not implemented")
+}
+func (UnimplementedProductServer) CreateSellerDiscount(context.Context, *CreateSellerDiscountRequest) (*DiscountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSellerDiscount not implemented")
+}
+func (UnimplementedProductServer) DeleteSellerDiscount(context.Context, *DeleteSellerDiscountRequest) (*DeleteDiscountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSellerDiscount not implemented")
+}
+func (UnimplementedProductServer) GetDiscounts(context.Context, *GetDiscountsRequest) (*DiscountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiscounts not implemented")
+}
+func (UnimplementedProductServer) CreateReview(context.Context, *CreateReviewRequest) (*ReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReview not implemented")
+}
+func (UnimplementedProductServer) GetProductReviews(context.Context, *GetProductReviewsRequest) (*ReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductReviews not implemented")
+}
+func (UnimplementedProductServer) ReplyReview(context.Context, *ReplyReviewRequest) (*ReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplyReview not implemented")
+}
+func (UnimplementedProductServer) CreateWarehouse(context.Context, *CreateWarehouseRequest) (*WarehouseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWarehouse not implemented")
+}
+func (UnimplementedProductServer) GetWarehouses(context.Context, *GetWarehousesRequest) (*WarehousesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWarehouses not implemented")
+}
+func (UnimplementedProductServer) SetWarehouseStock(context.Context, *SetWarehouseStockRequest) (*WarehouseStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWarehouseStock not implemented")
+}
+func (UnimplementedProductServer) GetProductStocks(context.Context, *GetProductStocksRequest) (*WarehouseStocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductStocks not implemented")
+}
+func (UnimplementedProductServer) ReserveProduct(context.Context, *ReserveProductRequest) (*ReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveProduct not implemented")
+}
+func (UnimplementedProductServer) ReleaseProduct(context.Context, *ReleaseProductRequest) (*ReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseProduct not implemented")
+}
+func (UnimplementedProductServer) ChangeReservations(context.Context, *ChangeReservationsRequest) (*ReservationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeReservations not implemented")
+}
+func (UnimplementedProductServer) SetStockAlert(context.Context, *SetStockAlertRequest) (*ProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStockAlert not implemented")
+}
+func (UnimplementedProductServer) SetPurchaseLimit(context.Context, *SetPurchaseLimitRequest) (*ProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPurchaseLimit not implemented")
+}
+func (UnimplementedProductServer) GetNotifications(context.Context, *GetNotificationsRequest) (*NotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotifications not implemented")
+}
+func (UnimplementedProductServer) CreateNotification(context.Context, *CreateNotificationRequest) (*NotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNotification not implemented")
+}
+func (UnimplementedProductServer) GetProductHistory(context.Context, *GetProductHistoryRequest) (*ProductHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductHistory not implemented")
+}
+func (UnimplementedProductServer) GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*PriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceHistory not implemented")
+}
+func (UnimplementedProductServer) GetExchangeRates(context.Context, *GetExchangeRatesRequest) (*ExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeRates not implemented")
+}
+func (UnimplementedProductServer) SetExchangeRate(context.Context, *SetExchangeRateRequest) (*ExchangeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExchangeRate not implemented")
+}
+func (UnimplementedProductServer) ImportExchangeRates(context.Context, *ImportExchangeRatesRequest) (*ExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportExchangeRates not implemented")
+}
+func (UnimplementedProductServer) GetSellerProfile(context.Context, *GetSellerProfileRequest) (*SellerProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSellerProfile not implemented")
+}
+func (UnimplementedProductServer) SetSellerProfile(context.Context, *SetSellerProfileRequest) (*SellerProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSellerProfile not implemented")
+}
+func (UnimplementedProductServer) GetStorefront(context.Context, *GetStorefrontRequest) (*StorefrontResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStorefront not implemented")
+}
+func (UnimplementedProductServer) CreateWishlist(context.Context, *CreateWishlistRequest) (*WishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWishlist not implemented")
+}
+func (UnimplementedProductServer) GetWishlists(context.Context, *GetWishlistsRequest) (*WishlistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWishlists not implemented")
+}
+func (UnimplementedProductServer) DeleteWishlist(context.Context, *DeleteWishlistRequest) (*DeleteWishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWishlist not implemented")
+}
+func (UnimplementedProductServer) AddWishlistItem(context.Context, *AddWishlistItemRequest) (*WishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWishlistItem not implemented")
+}
+func (UnimplementedProductServer) RemoveWishlistItem(context.Context, *RemoveWishlistItemRequest) (*WishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWishlistItem not implemented")
+}
+func (UnimplementedProductServer) MoveWishlistItemToCart(context.Context, *MoveWishlistItemToCartRequest) (*WishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveWishlistItemToCart not implemented")
+}
+func (UnimplementedProductServer) MoveCartlineToWishlist(context.Context, *MoveCartlineToWishlistRequest) (*WishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveCartlineToWishlist not implemented")
+}
+func (UnimplementedProductServer) mustEmbedUnimplementedProductServer() {}
+
+// UnsafeProductServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProductServer will
+// result in compilation errors.
+type UnsafeProductServer interface {
+	mustEmbedUnimplementedProductServer()
+}
+
+func RegisterProductServer(s grpc.ServiceRegistrar, srv ProductServer) {
+	s.RegisterService(&Product_ServiceDesc, srv)
+}
+
+func _Product_GetProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).GetProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_GetProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).GetProducts(ctx, req.(*GetProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).GetProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_GetProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).GetProduct(ctx, req.(*GetProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).CreateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_CreateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).CreateProduct(ctx, req.(*CreateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_UpdateProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).UpdateProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_UpdateProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).UpdateProducts(ctx, req.(*UpdateProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).UpdateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_UpdateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).UpdateProduct(ctx, req.(*UpdateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_ModerateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).ModerateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_ModerateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).ModerateProduct(ctx, req.(*ModerateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_SubmitProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).SubmitProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_SubmitProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).SubmitProduct(ctx, req.(*SubmitProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_GetModerationQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModerationQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).GetModerationQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_GetModerationQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).GetModerationQueue(ctx, req.(*GetModerationQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).DeleteProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_DeleteProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).DeleteProduct(ctx, req.(*DeleteProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_DeleteUserProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).DeleteUserProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_DeleteUserProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).DeleteUserProducts(ctx, req.(*DeleteUserProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_RestoreProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).RestoreProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_RestoreProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).RestoreProduct(ctx, req.(*RestoreProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_ImportProducts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ProductServer).ImportProducts(&productImportProductsServer{stream})
+}
+
+type Product_ImportProductsServer interface {
+	SendAndClose(*ImportProductsResponse) error
+	Recv() (*ImportProductsRequest, error)
+	grpc.ServerStream
+}
+
+type productImportProductsServer struct {
+	grpc.ServerStream
+}
+
+func (x *productImportProductsServer) SendAndClose(m *ImportProductsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *productImportProductsServer) Recv() (*ImportProductsRequest, error) {
+	m := new(ImportProductsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Product_ExportProducts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportProductsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProductServer).ExportProducts(m, &productExportProductsServer{stream})
+}
+
+type Product_ExportProductsServer interface {
+	Send(*ExportProductsResponse) error
+	grpc.ServerStream
+}
+
+type productExportProductsServer struct {
+	grpc.ServerStream
+}
+
+func (x *productExportProductsServer) Send(m *ExportProductsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Product_GetCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).GetCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_GetCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).GetCategory(ctx, req.(*GetCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_GetAllCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).GetAllCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_GetAllCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).GetAllCategories(ctx, req.(*GetAllCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_CreateDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDiscountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).CreateDiscount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_CreateDiscount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).CreateDiscount(ctx, req.(*CreateDiscountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_DeleteDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDiscountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).DeleteDiscount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_DeleteDiscount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).DeleteDiscount(ctx, req.(*DeleteDiscountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_CreateCategoryDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryDiscountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).CreateCategoryDiscount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_CreateCategoryDiscount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).CreateCategoryDiscount(ctx, req.(*CreateCategoryDiscountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_DeleteCategoryDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryDiscountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).DeleteCategoryDiscount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_DeleteCategoryDiscount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).DeleteCategoryDiscount(ctx, req.(*DeleteCategoryDiscountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_CreateSellerDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSellerDiscountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).CreateSellerDiscount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_CreateSellerDiscount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).CreateSellerDiscount(ctx, req.(*CreateSellerDiscountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_DeleteSellerDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSellerDiscountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).DeleteSellerDiscount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_DeleteSellerDiscount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).DeleteSellerDiscount(ctx, req.(*DeleteSellerDiscountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_GetDiscounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiscountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).GetDiscounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_GetDiscounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).GetDiscounts(ctx, req.(*GetDiscountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).CreateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_CreateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).CreateReview(ctx, req.(*CreateReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_GetProductReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).GetProductReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_GetProductReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).GetProductReviews(ctx, req.(*GetProductReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_ReplyReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplyReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).ReplyReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_ReplyReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).ReplyReview(ctx, req.(*ReplyReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_CreateWarehouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWarehouseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).CreateWarehouse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_CreateWarehouse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).CreateWarehouse(ctx, req.(*CreateWarehouseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_GetWarehouses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWarehousesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).GetWarehouses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_GetWarehouses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).GetWarehouses(ctx, req.(*GetWarehousesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_SetWarehouseStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWarehouseStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).SetWarehouseStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_SetWarehouseStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).SetWarehouseStock(ctx, req.(*SetWarehouseStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_GetProductStocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductStocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).GetProductStocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_GetProductStocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).GetProductStocks(ctx, req.(*GetProductStocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_ReserveProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).ReserveProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_ReserveProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).ReserveProduct(ctx, req.(*ReserveProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_ReleaseProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).ReleaseProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_ReleaseProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).ReleaseProduct(ctx, req.(*ReleaseProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_ChangeReservations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeReservationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).ChangeReservations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_ChangeReservations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).ChangeReservations(ctx, req.(*ChangeReservationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_SetStockAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStockAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).SetStockAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_SetStockAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).SetStockAlert(ctx, req.(*SetStockAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_SetPurchaseLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPurchaseLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).SetPurchaseLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_SetPurchaseLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).SetPurchaseLimit(ctx, req.(*SetPurchaseLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_GetNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).GetNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_GetNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).GetNotifications(ctx, req.(*GetNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_CreateNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).CreateNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_CreateNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).CreateNotification(ctx, req.(*CreateNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *productClient) GetAllCategories(ctx context.Context, in *GetAllCategoriesRequest, opts ...grpc.CallOption) (*CategoriesResponse, error) {
-	out := new(CategoriesResponse)
-	err := c.cc.Invoke(ctx, Product_GetAllCategories_FullMethodName, in, out, opts...)
-	if err != nil {
+func _Product_GetProductHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductHistoryRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ProductServer).GetProductHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_GetProductHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).GetProductHistory(ctx, req.(*GetProductHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *productClient) CreateDiscount(ctx context.Context, in *CreateDiscountRequest, opts ...grpc.CallOption) (*ProductResponse, error) {
-	out := new(ProductResponse)
-	err := c.cc.Invoke(ctx, Product_CreateDiscount_FullMethodName, in, out, opts...)
-	if err != nil {
+func _Product_GetPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceHistoryRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ProductServer).GetPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_GetPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).GetPriceHistory(ctx, req.(*GetPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *productClient) DeleteDiscount(ctx context.Context, in *DeleteDiscountRequest, opts ...grpc.CallOption) (*ProductResponse, error) {
-	out := new(ProductResponse)
-	err := c.cc.Invoke(ctx, Product_DeleteDiscount_FullMethodName, in, out, opts...)
-	if err != nil {
+func _Product_GetExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangeRatesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// ProductServer is the server API for Product service.
-// All implementations must embed UnimplementedProductServer
-// for forward compatibility
-type ProductServer interface {
-	GetProducts(context.Context, *GetProductsRequest) (*ProductsResponse, error)
-	GetProduct(context.Context, *GetProductRequest) (*ProductResponse, error)
-	CreateProduct(context.Context, *CreateProductRequest) (*ProductResponse, error)
-	UpdateProducts(context.Context, *UpdateProductsRequest) (*UpdateProductsResponse, error)
-	UpdateProduct(context.Context, *UpdateProductRequest) (*ProductResponse, error)
-	ModerateProduct(context.Context, *ModerateProductRequest) (*ProductResponse, error)
-	DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error)
-	DeleteUserProducts(context.Context, *DeleteUserProductsRequest) (*DeleteUserProductsResponse, error)
-	GetCategory(context.Context, *GetCategoryRequest) (*CategoryResponse, error)
-	GetAllCategories(context.Context, *GetAllCategoriesRequest) (*CategoriesResponse, error)
-	CreateDiscount(context.Context, *CreateDiscountRequest) (*ProductResponse, error)
-	DeleteDiscount(context.Context, *DeleteDiscountRequest) (*ProductResponse, error)
-	mustEmbedUnimplementedProductServer()
-}
-
-// UnimplementedProductServer must be embedded to have forward compatible implementations.
-type UnimplementedProductServer struct {
-}
-
-func (UnimplementedProductServer) GetProducts(context.Context, *GetProductsRequest) (*ProductsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProducts not implemented")
-}
-func (UnimplementedProductServer) GetProduct(context.Context, *GetProductRequest) (*ProductResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProduct not implemented")
-}
-func (UnimplementedProductServer) CreateProduct(context.Context, *CreateProductRequest) (*ProductResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateProduct not implemented")
-}
-func (UnimplementedProductServer) UpdateProducts(context.Context, *UpdateProductsRequest) (*UpdateProductsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateProducts not implemented")
-}
-func (UnimplementedProductServer) UpdateProduct(context.Context, *UpdateProductRequest) (*ProductResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateProduct not implemented")
-}
-func (UnimplementedProductServer) ModerateProduct(context.Context, *ModerateProductRequest) (*ProductResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ModerateProduct not implemented")
-}
-func (UnimplementedProductServer) DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteProduct not implemented")
-}
-func (UnimplementedProductServer) DeleteUserProducts(context.Context, *DeleteUserProductsRequest) (*DeleteUserProductsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserProducts not implemented")
-}
-func (UnimplementedProductServer) GetCategory(context.Context, *GetCategoryRequest) (*CategoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCategory not implemented")
-}
-func (UnimplementedProductServer) GetAllCategories(context.Context, *GetAllCategoriesRequest) (*CategoriesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllCategories not implemented")
-}
-func (UnimplementedProductServer) CreateDiscount(context.Context, *CreateDiscountRequest) (*ProductResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateDiscount not implemented")
-}
-func (UnimplementedProductServer) DeleteDiscount(context.Context, *DeleteDiscountRequest) (*ProductResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteDiscount not implemented")
-}
-func (UnimplementedProductServer) mustEmbedUnimplementedProductServer() {}
-
-// UnsafeProductServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ProductServer will
-// result in compilation errors.
-type UnsafeProductServer interface {
-	mustEmbedUnimplementedProductServer()
-}
-
-func RegisterProductServer(s grpc.ServiceRegistrar, srv ProductServer) {
-	s.RegisterService(&Product_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(ProductServer).GetExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_GetExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).GetExchangeRates(ctx, req.(*GetExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Product_GetProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProductsRequest)
+func _Product_SetExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExchangeRateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServer).GetProducts(ctx, in)
+		return srv.(ProductServer).SetExchangeRate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Product_GetProducts_FullMethodName,
+		FullMethod: Product_SetExchangeRate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServer).GetProducts(ctx, req.(*GetProductsRequest))
+		return srv.(ProductServer).SetExchangeRate(ctx, req.(*SetExchangeRateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Product_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProductRequest)
+func _Product_ImportExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportExchangeRatesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServer).GetProduct(ctx, in)
+		return srv.(ProductServer).ImportExchangeRates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Product_GetProduct_FullMethodName,
+		FullMethod: Product_ImportExchangeRates_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServer).GetProduct(ctx, req.(*GetProductRequest))
+		return srv.(ProductServer).ImportExchangeRates(ctx, req.(*ImportExchangeRatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Product_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateProductRequest)
+func _Product_GetSellerProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSellerProfileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServer).CreateProduct(ctx, in)
+		return srv.(ProductServer).GetSellerProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Product_CreateProduct_FullMethodName,
+		FullMethod: Product_GetSellerProfile_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServer).CreateProduct(ctx, req.(*CreateProductRequest))
+		return srv.(ProductServer).GetSellerProfile(ctx, req.(*GetSellerProfileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Product_UpdateProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateProductsRequest)
+func _Product_SetSellerProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSellerProfileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServer).UpdateProducts(ctx, in)
+		return srv.(ProductServer).SetSellerProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Product_UpdateProducts_FullMethodName,
+		FullMethod: Product_SetSellerProfile_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServer).UpdateProducts(ctx, req.(*UpdateProductsRequest))
+		return srv.(ProductServer).SetSellerProfile(ctx, req.(*SetSellerProfileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Product_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateProductRequest)
+func _Product_GetStorefront_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStorefrontRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServer).UpdateProduct(ctx, in)
+		return srv.(ProductServer).GetStorefront(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Product_UpdateProduct_FullMethodName,
+		FullMethod: Product_GetStorefront_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServer).UpdateProduct(ctx, req.(*UpdateProductRequest))
+		return srv.(ProductServer).GetStorefront(ctx, req.(*GetStorefrontRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Product_ModerateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ModerateProductRequest)
+func _Product_CreateWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWishlistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServer).ModerateProduct(ctx, in)
+		return srv.(ProductServer).CreateWishlist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Product_ModerateProduct_FullMethodName,
+		FullMethod: Product_CreateWishlist_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServer).ModerateProduct(ctx, req.(*ModerateProductRequest))
+		return srv.(ProductServer).CreateWishlist(ctx, req.(*CreateWishlistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Product_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteProductRequest)
+func _Product_GetWishlists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWishlistsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServer).DeleteProduct(ctx, in)
+		return srv.(ProductServer).GetWishlists(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Product_DeleteProduct_FullMethodName,
+		FullMethod: Product_GetWishlists_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServer).DeleteProduct(ctx, req.(*DeleteProductRequest))
+		return srv.(ProductServer).GetWishlists(ctx, req.(*GetWishlistsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Product_DeleteUserProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteUserProductsRequest)
+func _Product_DeleteWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWishlistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServer).DeleteUserProducts(ctx, in)
+		return srv.(ProductServer).DeleteWishlist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Product_DeleteUserProducts_FullMethodName,
+		FullMethod: Product_DeleteWishlist_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServer).DeleteUserProducts(ctx, req.(*DeleteUserProductsRequest))
+		return srv.(ProductServer).DeleteWishlist(ctx, req.(*DeleteWishlistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Product_GetCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCategoryRequest)
+func _Product_AddWishlistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWishlistItemRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServer).GetCategory(ctx, in)
+		return srv.(ProductServer).AddWishlistItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Product_GetCategory_FullMethodName,
+		FullMethod: Product_AddWishlistItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServer).GetCategory(ctx, req.(*GetCategoryRequest))
+		return srv.(ProductServer).AddWishlistItem(ctx, req.(*AddWishlistItemRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Product_GetAllCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllCategoriesRequest)
+func _Product_RemoveWishlistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveWishlistItemRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServer).GetAllCategories(ctx, in)
+		return srv.(ProductServer).RemoveWishlistItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Product_GetAllCategories_FullMethodName,
+		FullMethod: Product_RemoveWishlistItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServer).GetAllCategories(ctx, req.(*GetAllCategoriesRequest))
+		return srv.(ProductServer).RemoveWishlistItem(ctx, req.(*RemoveWishlistItemRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Product_CreateDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateDiscountRequest)
+func _Product_MoveWishlistItemToCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveWishlistItemToCartRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServer).CreateDiscount(ctx, in)
+		return srv.(ProductServer).MoveWishlistItemToCart(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Product_CreateDiscount_FullMethodName,
+		FullMethod: Product_MoveWishlistItemToCart_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServer).CreateDiscount(ctx, req.(*CreateDiscountRequest))
+		return srv.(ProductServer).MoveWishlistItemToCart(ctx, req.(*MoveWishlistItemToCartRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Product_DeleteDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteDiscountRequest)
+func _Product_MoveCartlineToWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveCartlineToWishlistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServer).DeleteDiscount(ctx, in)
+		return srv.(ProductServer).MoveCartlineToWishlist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Product_DeleteDiscount_FullMethodName,
+		FullMethod: Product_MoveCartlineToWishlist_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServer).DeleteDiscount(ctx, req.(*DeleteDiscountRequest))
+		return srv.(ProductServer).MoveCartlineToWishlist(ctx, req.(*MoveCartlineToWishlistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -487,6 +1833,14 @@ var Product_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ModerateProduct",
 			Handler:    _Product_ModerateProduct_Handler,
 		},
+		{
+			MethodName: "SubmitProduct",
+			Handler:    _Product_SubmitProduct_Handler,
+		},
+		{
+			MethodName: "GetModerationQueue",
+			Handler:    _Product_GetModerationQueue_Handler,
+		},
 		{
 			MethodName: "DeleteProduct",
 			Handler:    _Product_DeleteProduct_Handler,
@@ -495,6 +1849,10 @@ var Product_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUserProducts",
 			Handler:    _Product_DeleteUserProducts_Handler,
 		},
+		{
+			MethodName: "RestoreProduct",
+			Handler:    _Product_RestoreProduct_Handler,
+		},
 		{
 			MethodName: "GetCategory",
 			Handler:    _Product_GetCategory_Handler,
@@ -511,7 +1869,154 @@ var Product_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteDiscount",
 			Handler:    _Product_DeleteDiscount_Handler,
 		},
+		{
+			MethodName: "CreateCategoryDiscount",
+			Handler:    _Product_CreateCategoryDiscount_Handler,
+		},
+		{
+			MethodName: "DeleteCategoryDiscount",
+			Handler:    _Product_DeleteCategoryDiscount_Handler,
+		},
+		{
+			MethodName: "CreateSellerDiscount",
+			Handler:    _Product_CreateSellerDiscount_Handler,
+		},
+		{
+			MethodName: "DeleteSellerDiscount",
+			Handler:    _Product_DeleteSellerDiscount_Handler,
+		},
+		{
+			MethodName: "GetDiscounts",
+			Handler:    _Product_GetDiscounts_Handler,
+		},
+		{
+			MethodName: "CreateReview",
+			Handler:    _Product_CreateReview_Handler,
+		},
+		{
+			MethodName: "GetProductReviews",
+			Handler:    _Product_GetProductReviews_Handler,
+		},
+		{
+			MethodName: "ReplyReview",
+			Handler:    _Product_ReplyReview_Handler,
+		},
+		{
+			MethodName: "CreateWarehouse",
+			Handler:    _Product_CreateWarehouse_Handler,
+		},
+		{
+			MethodName: "GetWarehouses",
+			Handler:    _Product_GetWarehouses_Handler,
+		},
+		{
+			MethodName: "SetWarehouseStock",
+			Handler:    _Product_SetWarehouseStock_Handler,
+		},
+		{
+			MethodName: "GetProductStocks",
+			Handler:    _Product_GetProductStocks_Handler,
+		},
+		{
+			MethodName: "ReserveProduct",
+			Handler:    _Product_ReserveProduct_Handler,
+		},
+		{
+			MethodName: "ReleaseProduct",
+			Handler:    _Product_ReleaseProduct_Handler,
+		},
+		{
+			MethodName: "ChangeReservations",
+			Handler:    _Product_ChangeReservations_Handler,
+		},
+		{
+			MethodName: "SetStockAlert",
+			Handler:    _Product_SetStockAlert_Handler,
+		},
+		{
+			MethodName: "SetPurchaseLimit",
+			Handler:    _Product_SetPurchaseLimit_Handler,
+		},
+		{
+			MethodName: "GetNotifications",
+			Handler:    _Product_GetNotifications_Handler,
+		},
+		{
+			MethodName: "CreateNotification",
+			Handler:    _Product_CreateNotification_Handler,
+		},
+		{
+			MethodName: "GetProductHistory",
+			Handler:    _Product_GetProductHistory_Handler,
+		},
+		{
+			MethodName: "GetPriceHistory",
+			Handler:    _Product_GetPriceHistory_Handler,
+		},
+		{
+			MethodName: "GetExchangeRates",
+			Handler:    _Product_GetExchangeRates_Handler,
+		},
+		{
+			MethodName: "SetExchangeRate",
+			Handler:    _Product_SetExchangeRate_Handler,
+		},
+		{
+			MethodName: "ImportExchangeRates",
+			Handler:    _Product_ImportExchangeRates_Handler,
+		},
+		{
+			MethodName: "GetSellerProfile",
+			Handler:    _Product_GetSellerProfile_Handler,
+		},
+		{
+			MethodName: "SetSellerProfile",
+			Handler:    _Product_SetSellerProfile_Handler,
+		},
+		{
+			MethodName: "GetStorefront",
+			Handler:    _Product_GetStorefront_Handler,
+		},
+		{
+			MethodName: "CreateWishlist",
+			Handler:    _Product_CreateWishlist_Handler,
+		},
+		{
+			MethodName: "GetWishlists",
+			Handler:    _Product_GetWishlists_Handler,
+		},
+		{
+			MethodName: "DeleteWishlist",
+			Handler:    _Product_DeleteWishlist_Handler,
+		},
+		{
+			MethodName: "AddWishlistItem",
+			Handler:    _Product_AddWishlistItem_Handler,
+		},
+		{
+			MethodName: "RemoveWishlistItem",
+			Handler:    _Product_RemoveWishlistItem_Handler,
+		},
+		{
+			MethodName: "MoveWishlistItemToCart",
+			Handler:    _Product_MoveWishlistItemToCart_Handler,
+		},
+		{
+			MethodName: "MoveCartlineToWishlist",
+			Handler:    _Product_MoveCartlineToWishlist_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportProducts",
+			Handler:       _Product_ImportProducts_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportProducts",
+			Handler:       _Product_ExportProducts_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "product.proto",
 }